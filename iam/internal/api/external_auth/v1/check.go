@@ -6,20 +6,23 @@ import (
 	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 )
 
 func (api *API) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	locale := requestLocale(req)
+
 	sessionID, err := api.extractSessionID(req)
 	if err != nil {
 		logger.Error(ctx, "❌ [External Auth] Не удалось извлечь session ID", zap.Error(err))
-		return api.denyRequest("Missing or invalid session", 401), nil
+		return api.denyRequest(locale, model.ErrSessionNotFound.WithMessage(model.MsgSessionMissing), 401), nil
 	}
 
 	whoami, err := api.whoAMIService.Whoami(ctx, sessionID)
 	if err != nil {
 		logger.Error(ctx, "❌ [External Auth] Невалидная сессия", zap.Error(err))
-		return api.denyRequest("Invalid session", 401), nil
+		return api.denyRequest(locale, model.ErrSessionNotFound.WithMessage(model.MsgSessionInvalid), 401), nil
 	}
 
 	return api.allowRequest(whoami, sessionID), nil
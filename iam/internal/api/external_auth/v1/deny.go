@@ -1,7 +1,7 @@
 package v1
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -10,10 +10,19 @@ import (
 	statusv3 "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
 )
 
-func (api *API) denyRequest(message string, statusCode int32) *authv3.CheckResponse {
+// denyRequest формирует отказ с JSON телом: сообщение на языке клиента и машиночитаемая причина
+func (api *API) denyRequest(locale i18n.Locale, reason *apperr.Error, statusCode int32) *authv3.CheckResponse {
+	body, _ := json.Marshal(map[string]string{
+		"error":     reason.Localize(locale),
+		"reason":    reason.Reason(),
+		"timestamp": time.Now().Format(time.RFC3339),
+	})
+
 	return &authv3.CheckResponse{
 		Status: &statusv3.Status{Code: int32(codes.Unauthenticated)},
 		HttpResponse: &authv3.CheckResponse_DeniedResponse{
@@ -21,8 +30,7 @@ func (api *API) denyRequest(message string, statusCode int32) *authv3.CheckRespo
 				Status: &typev3.HttpStatus{
 					Code: typev3.StatusCode(statusCode),
 				},
-				Body: fmt.Sprintf(`{"error": "%s", "timestamp": "%s"}`,
-					message, time.Now().Format(time.RFC3339)),
+				Body: string(body),
 				Headers: []*corev3.HeaderValueOption{
 					{
 						Header: &corev3.HeaderValue{
@@ -30,6 +38,12 @@ func (api *API) denyRequest(message string, statusCode int32) *authv3.CheckRespo
 							Value: interceptor.ContentTypeJSON,
 						},
 					},
+					{
+						Header: &corev3.HeaderValue{
+							Key:   "Content-Language",
+							Value: string(locale),
+						},
+					},
 					{
 						Header: &corev3.HeaderValue{
 							Key:   interceptor.HeaderAuthStatus,
@@ -9,8 +9,14 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
 )
 
+// requestLocale определяет язык ответа об отказе по заголовку Accept-Language исходного запроса
+func requestLocale(req *authv3.CheckRequest) i18n.Locale {
+	return i18n.ParseAcceptLanguage(req.GetAttributes().GetRequest().GetHttp().GetHeaders()[i18n.AcceptLanguageKey])
+}
+
 func (api *API) extractSessionID(req *authv3.CheckRequest) (uuid.UUID, error) {
 	if req.Attributes == nil || req.Attributes.Request == nil {
 		return uuid.Nil, fmt.Errorf("no HTTP request found")
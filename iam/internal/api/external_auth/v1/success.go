@@ -9,17 +9,28 @@ import (
 	statusv3 "google.golang.org/genproto/googleapis/rpc/status"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
 )
 
 func (api *API) allowRequest(whoami *model.WhoAMI, sessionID uuid.UUID) *authv3.CheckResponse {
 	roleNames := make([]string, len(whoami.RolesWithPermissions))
 	permissionsSet := make(map[string]bool)
+	var conditional []authz.Permission
 
 	for i, role := range whoami.RolesWithPermissions {
 		roleNames[i] = role.Role.Name
 		for _, perm := range role.Permissions {
-			permissionsSet[perm.Resource+":"+perm.Action] = true
+			// Явные запреты передаются с префиксом "!" и учитываются PermissionInterceptor
+			permission := authz.Permission{Resource: perm.Resource, Action: perm.Action, Effect: perm.Effect, Condition: perm.Condition}
+
+			// Условные права передаются отдельным заголовком и вычисляются
+			// PermissionInterceptor по атрибутам запроса
+			if permission.IsConditional() {
+				conditional = append(conditional, permission)
+				continue
+			}
+			permissionsSet[permission.String()] = true
 		}
 	}
 
@@ -35,12 +46,26 @@ func (api *API) allowRequest(whoami *model.WhoAMI, sessionID uuid.UUID) *authv3.
 				Value: sessionID.String(),
 			},
 		},
+		{
+			// Идентификатор пользователя для аудита в сервисах
+			Header: &corev3.HeaderValue{
+				Key:   interceptor.HeaderUserID,
+				Value: whoami.User.ID.String(),
+			},
+		},
 		{
 			Header: &corev3.HeaderValue{
 				Key:   interceptor.HeaderUserPermissions,
 				Value: strings.Join(permissions, ","),
 			},
 		},
+		{
+			// Выставляется всегда, чтобы заголовок клиента не дошел до сервиса
+			Header: &corev3.HeaderValue{
+				Key:   interceptor.HeaderUserConditions,
+				Value: authz.EncodeConditional(conditional),
+			},
+		},
 	}
 
 	return &authv3.CheckResponse{
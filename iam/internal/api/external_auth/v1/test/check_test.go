@@ -1,6 +1,7 @@
 package v1_test
 
 import (
+	"strings"
 	"time"
 
 	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
@@ -9,6 +10,9 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
 )
 
 func (s *APISuite) TestCheckSuccess() {
@@ -57,7 +61,7 @@ func (s *APISuite) TestCheckSuccess() {
 			Request: &authv3.AttributeContext_Request{
 				Http: &authv3.AttributeContext_HttpRequest{
 					Headers: map[string]string{
-						"session-uuid": sessionID.String(),
+						interceptor.HeaderCookie: interceptor.SessionCookieName + "=" + sessionID.String(),
 					},
 				},
 			},
@@ -82,7 +86,7 @@ func (s *APISuite) TestCheckSuccess() {
 
 	// Проверяем заголовки
 	headers := okResponse.OkResponse.Headers
-	assert.Len(s.T(), headers, 4) // UUID, Login, Roles, Permissions
+	assert.Len(s.T(), headers, 4) // Session ID, User ID, Permissions, Conditions
 
 	// Проверяем конкретные заголовки
 	headerMap := make(map[string]string)
@@ -90,22 +94,22 @@ func (s *APISuite) TestCheckSuccess() {
 		headerMap[header.Header.Key] = header.Header.Value
 	}
 
-	assert.Equal(s.T(), userID.String(), headerMap["X-User-UUID"])
-	assert.Equal(s.T(), "testuser", headerMap["X-User-Login"])
-	assert.Equal(s.T(), "admin", headerMap["X-User-Roles"])
-	assert.Contains(s.T(), headerMap["X-User-Permissions"], "users:read")
-	assert.Contains(s.T(), headerMap["X-User-Permissions"], "users:write")
+	assert.Equal(s.T(), sessionID.String(), headerMap[interceptor.HeaderSessionID])
+	assert.Equal(s.T(), userID.String(), headerMap[interceptor.HeaderUserID])
+	assert.Contains(s.T(), headerMap[interceptor.HeaderUserPermissions], "users:read")
+	assert.Contains(s.T(), headerMap[interceptor.HeaderUserPermissions], "users:write")
+	assert.Equal(s.T(), authz.EncodeConditional(nil), headerMap[interceptor.HeaderUserConditions])
 
 	s.whoAMIService.AssertExpectations(s.T())
 }
 
 func (s *APISuite) TestCheckMissingSession() {
-	// Запрос без session UUID
+	// Запрос без session UUID, сообщение об отказе запрошено на английском
 	req := &authv3.CheckRequest{
 		Attributes: &authv3.AttributeContext{
 			Request: &authv3.AttributeContext_Request{
 				Http: &authv3.AttributeContext_HttpRequest{
-					Headers: map[string]string{},
+					Headers: map[string]string{"accept-language": "en-US,en;q=0.9"},
 				},
 			},
 		},
@@ -135,7 +139,7 @@ func (s *APISuite) TestCheckInvalidSession() {
 			Request: &authv3.AttributeContext_Request{
 				Http: &authv3.AttributeContext_HttpRequest{
 					Headers: map[string]string{
-						"session-uuid": sessionID.String(),
+						interceptor.HeaderCookie: interceptor.SessionCookieName + "=" + sessionID.String(),
 					},
 				},
 			},
@@ -157,7 +161,128 @@ func (s *APISuite) TestCheckInvalidSession() {
 	deniedResponse, ok := result.HttpResponse.(*authv3.CheckResponse_DeniedResponse)
 	assert.True(s.T(), ok)
 	assert.NotNil(s.T(), deniedResponse.DeniedResponse)
-	assert.Contains(s.T(), deniedResponse.DeniedResponse.Body, "Invalid session")
+	assert.Contains(s.T(), deniedResponse.DeniedResponse.Body, "недействительная сессия") // язык по умолчанию
+
+	s.whoAMIService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestCheckSuccessWithDenyAndWildcard() {
+	sessionID := uuid.New()
+
+	whoami := &model.WhoAMI{
+		Session: model.Session{ID: sessionID},
+		RolesWithPermissions: []*model.RoleWithPermissions{
+			{
+				Role: &model.Role{ID: uuid.New(), Name: "student"},
+				Permissions: []*model.Permission{
+					{ID: uuid.New(), Resource: "schedule", Action: "*"},
+					{ID: uuid.New(), Resource: "schedule", Action: "write", Effect: authz.EffectDeny},
+				},
+			},
+		},
+	}
+
+	req := &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Headers: map[string]string{
+						interceptor.HeaderCookie: interceptor.SessionCookieName + "=" + sessionID.String(),
+					},
+				},
+			},
+		},
+	}
+
+	s.whoAMIService.On("Whoami", mock.Anything, sessionID).Return(whoami, nil).Once()
+
+	result, err := s.api.Check(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+
+	okResponse, ok := result.HttpResponse.(*authv3.CheckResponse_OkResponse)
+	assert.True(s.T(), ok)
+
+	var permissionsHeader string
+	for _, header := range okResponse.OkResponse.Headers {
+		if header.Header.Key == interceptor.HeaderUserPermissions {
+			permissionsHeader = header.Header.Value
+		}
+	}
+
+	permissions := authz.ParseList(strings.Split(permissionsHeader, ","))
+	assert.True(s.T(), authz.IsAllowed(permissions, "schedule:read"))
+	assert.False(s.T(), authz.IsAllowed(permissions, "schedule:write"))
+
+	s.whoAMIService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestCheckSuccessCarriesConditions() {
+	sessionID := uuid.New()
+
+	whoami := &model.WhoAMI{
+		Session: model.Session{ID: sessionID},
+		RolesWithPermissions: []*model.RoleWithPermissions{
+			{
+				Role: &model.Role{ID: uuid.New(), Name: "teacher"},
+				Permissions: []*model.Permission{
+					{ID: uuid.New(), Resource: "schedule", Action: "read"},
+					{ID: uuid.New(), Resource: "lesson", Action: "write", Condition: `resource.teacher_id == subject.id`},
+					{ID: uuid.New(), Resource: "schedule", Action: "read", Effect: authz.EffectDeny, Condition: `resource.archived == true`},
+				},
+			},
+		},
+	}
+
+	req := &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Headers: map[string]string{
+						interceptor.HeaderCookie: interceptor.SessionCookieName + "=" + sessionID.String(),
+					},
+				},
+			},
+		},
+	}
+
+	s.whoAMIService.On("Whoami", mock.Anything, sessionID).Return(whoami, nil).Once()
+
+	result, err := s.api.Check(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+
+	okResponse, ok := result.HttpResponse.(*authv3.CheckResponse_OkResponse)
+	assert.True(s.T(), ok)
+
+	var permissionsHeader, conditionsHeader string
+	for _, header := range okResponse.OkResponse.Headers {
+		switch header.Header.Key {
+		case interceptor.HeaderUserPermissions:
+			permissionsHeader = header.Header.Value
+		case interceptor.HeaderUserConditions:
+			conditionsHeader = header.Header.Value
+		}
+	}
+
+	// Безусловные права не содержат условных, условные приходят отдельным заголовком
+	permissions := authz.ParseList(strings.Split(permissionsHeader, ","))
+	assert.False(s.T(), authz.IsAllowed(permissions, "lesson:write"))
+	assert.Equal(s.T(), authz.DecisionAllow, authz.Evaluate(permissions, "schedule:read"))
+
+	conditional, err := authz.DecodeConditional(conditionsHeader)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), conditional, 2)
+
+	all := append(permissions, conditional...)
+	teacherID := uuid.NewString()
+	attrs := condition.NewAttributes(
+		map[string]any{"id": teacherID},
+		map[string]any{"teacher_id": teacherID, "archived": true},
+		nil,
+	)
+	assert.Equal(s.T(), authz.DecisionAllow, authz.EvaluateWithAttributes(all, "lesson:write", attrs))
+	assert.Equal(s.T(), authz.DecisionDeny, authz.EvaluateWithAttributes(all, "schedule:read", attrs))
 
 	s.whoAMIService.AssertExpectations(s.T())
 }
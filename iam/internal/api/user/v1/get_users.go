@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/converter"
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+func (api *API) GetUsers(ctx context.Context, req *userV1.GetUsersRequest) (*userV1.GetUsersResponse, error) {
+	userIDs := make([]uuid.UUID, 0, len(req.UserIds))
+	for _, id := range req.UserIds {
+		userID, err := uuid.Parse(id)
+		if err != nil {
+			logger.Warn(ctx, "❌ [API] Неверный формат UUID пользователя", zap.Error(err))
+			return nil, apperr.ToStatus(ctx, model.ErrInvalidSessionData)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	users, err := api.userService.GetUsers(ctx, userIDs)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка пакетного получения пользователей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	result := make([]*commonV1.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, converter.UserToProto(user))
+	}
+
+	return &userV1.GetUsersResponse{
+		Users: result,
+	}, nil
+}
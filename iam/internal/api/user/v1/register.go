@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
 )
@@ -13,7 +14,7 @@ func (api *API) Register(ctx context.Context, req *userV1.RegisterRequest) (*use
 	user, err := api.userService.Register(ctx, req.GetInfo().GetLogin(), req.GetInfo().GetEmail(), req.GetPassword())
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка регистрации пользователя", zap.Error(err))
-		return nil, mapProtoError(ctx, err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	logger.Info(ctx, "✅ [API] Пользователь успешно зарегистрирован")
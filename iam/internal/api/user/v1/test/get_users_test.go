@@ -0,0 +1,80 @@
+package user_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+func (s *APISuite) TestGetUsers() {
+	firstID := uuid.New()
+	secondID := uuid.New()
+
+	users := []*model.User{
+		{ID: firstID, Login: "first", Email: "first@example.com", CreatedAt: time.Now()},
+		{ID: secondID, Login: "second", Email: "second@example.com", CreatedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *userV1.GetUsersRequest
+		serviceUsers  []*model.User
+		serviceError  error
+		expectService bool
+		expectedCode  codes.Code
+		expectedCount int
+	}{
+		{
+			name:          "Success",
+			req:           &userV1.GetUsersRequest{UserIds: []string{firstID.String(), secondID.String()}},
+			serviceUsers:  users,
+			expectService: true,
+			expectedCode:  codes.OK,
+			expectedCount: 2,
+		},
+		{
+			name:          "InvalidUUID",
+			req:           &userV1.GetUsersRequest{UserIds: []string{"not-a-uuid"}},
+			expectedCode:  codes.InvalidArgument,
+			expectedCount: 0,
+		},
+		{
+			name:          "InternalError",
+			req:           &userV1.GetUsersRequest{UserIds: []string{firstID.String()}},
+			serviceError:  model.ErrInternal,
+			expectService: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			if tc.expectService {
+				s.userService.On("GetUsers", mock.Anything, mock.AnythingOfType("[]uuid.UUID")).Return(tc.serviceUsers, tc.serviceError).Once()
+			}
+
+			result, err := s.api.GetUsers(s.ctx, tc.req)
+			if tc.expectedCode != codes.OK {
+				assert.Nil(t, result)
+				grpcErr, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tc.expectedCode, grpcErr.Code())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Users, tc.expectedCount)
+				assert.Equal(t, "first", result.Users[0].Info.Login)
+				assert.Equal(t, "second@example.com", result.Users[1].Info.Email)
+			}
+
+			s.userService.AssertExpectations(s.T())
+		})
+	}
+}
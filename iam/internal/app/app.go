@@ -14,6 +14,7 @@ import (
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/closer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/gateway"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/health"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
 	platformgrpc "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/server"
@@ -28,6 +29,7 @@ type App struct {
 	cfg         contracts.Provider
 	diContainer *diContainer
 	grpcServer  *grpc.Server
+	gateway     *gateway.Server
 	listener    net.Listener
 }
 
@@ -42,6 +44,20 @@ func New(ctx context.Context, cfg contracts.Provider) (*App, error) {
 }
 
 func (app *App) Start(ctx context.Context) error {
+	go func() {
+		if err := app.diContainer.HealthRegistry().Run(ctx); err != nil {
+			logger.Error(ctx, "❌ [Health] Опрос проб остановлен с ошибкой", zap.Error(err))
+		}
+	}()
+
+	if app.gateway != nil {
+		go func() {
+			if err := app.gateway.Serve(ctx); err != nil {
+				logger.Error(ctx, "❌ [Gateway] HTTP шлюз остановлен с ошибкой", zap.Error(err))
+			}
+		}()
+	}
+
 	return app.runGRPCServer(ctx)
 }
 
@@ -56,6 +72,7 @@ func (app *App) initDeps(ctx context.Context) error {
 		app.initMigrations,
 		app.initListener,
 		app.initGRPCServer,
+		app.initGateway,
 	}
 	for _, step := range steps {
 		if err := step(ctx); err != nil {
@@ -184,17 +201,79 @@ func (app *App) initListener(_ context.Context) error {
 	return nil
 }
 
+// rateLimitInterceptor возвращает интерсептор ограничения частоты запросов.
+// Выключенное ограничение даёт пустой Interceptor, который сервер пропускает
+func (app *App) rateLimitInterceptor(ctx context.Context) (platformgrpc.Interceptor, error) {
+	if !app.cfg.RateLimit().IsEnabled() {
+		return platformgrpc.Interceptor{}, nil
+	}
+
+	limiter, err := app.diContainer.RateLimiter(ctx)
+	if err != nil {
+		return platformgrpc.Interceptor{}, fmt.Errorf("create rate limiter: %w", err)
+	}
+
+	rateLimit := interceptor.NewRateLimitInterceptor(ctx, limiter, app.cfg.RateLimit())
+	return platformgrpc.Interceptor{Unary: rateLimit.Unary(), Stream: rateLimit.Stream()}, nil
+}
+
+// idempotencyInterceptor возвращает интерсептор ключей идемпотентности.
+// Выключенная обработка даёт пустой Interceptor, который сервер пропускает
+func (app *App) idempotencyInterceptor(ctx context.Context) (platformgrpc.Interceptor, error) {
+	if !app.cfg.Idempotency().IsEnabled() {
+		return platformgrpc.Interceptor{}, nil
+	}
+
+	store, err := app.diContainer.IdempotencyStore(ctx)
+	if err != nil {
+		return platformgrpc.Interceptor{}, fmt.Errorf("create idempotency store: %w", err)
+	}
+
+	idempotencyKeys := interceptor.NewIdempotencyInterceptor(store, app.cfg.Idempotency())
+	return platformgrpc.Interceptor{Unary: idempotencyKeys.Unary()}, nil
+}
+
 func (app *App) initGRPCServer(ctx context.Context) error {
-	app.grpcServer = platformgrpc.New(ctx,
+	rateLimitInterceptor, err := app.rateLimitInterceptor(ctx)
+	if err != nil {
+		return err
+	}
+
+	idempotencyInterceptor, err := app.idempotencyInterceptor(ctx)
+	if err != nil {
+		return err
+	}
+
+	interceptors := []platformgrpc.Interceptor{
+		{
+			Unary:  tracing.UnaryServerInterceptor(app.cfg.App().Name()),
+			Stream: tracing.StreamServerInterceptor(app.cfg.App().Name()),
+		},
+		{
+			Unary:  metric.UnaryServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
+			Stream: metric.StreamServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
+		},
+	}
+	// Проверка доступа идет до ограничения частоты и идемпотентности: лимит считается
+	// по подтвержденному пользователю, а сохраненный ответ возвращается только прошедшему авторизацию вызову
+	interceptors = append(interceptors,
+		platformgrpc.AccessInterceptors(
+			interceptor.WithTrustedPeers(app.cfg.GRPC().TrustedPeers()),
+			interceptor.WithServiceTokens(app.cfg.GRPC().ServiceTokens()),
+		)...)
+	interceptors = append(interceptors, rateLimitInterceptor, idempotencyInterceptor)
+
+	grpcServer, err := platformgrpc.New(ctx,
 		app.cfg.GRPC().Timeout(),
 		app.cfg.GRPC().MaxRecvMsgSize(),
 		app.cfg.GRPC().MaxSendMsgSize(),
-		tracing.UnaryServerInterceptor(app.cfg.App().Name()),
-		metric.UnaryServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
-		interceptor.NewPublicFilter().Unary(),
-		interceptor.NewAuthInterceptor().Unary(),
-		interceptor.NewPermissionInterceptor().UnaryServerInterceptor(),
+		app.cfg.GRPC().TLS(),
+		interceptors...,
 	)
+	if err != nil {
+		return fmt.Errorf("create grpc server: %w", err)
+	}
+	app.grpcServer = grpcServer
 
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		logger.Info(ctx, "⚡ [Shutdown] Остановка gRPC сервера")
@@ -218,7 +297,7 @@ func (app *App) initGRPCServer(ctx context.Context) error {
 	}
 
 	reflection.Register(app.grpcServer)
-	health.RegisterService(app.grpcServer)
+	health.RegisterService(app.grpcServer, app.diContainer.HealthRegistry())
 	authV1.RegisterAuthServiceServer(app.grpcServer, authAPI)
 	userV1.RegisterUserServiceServer(app.grpcServer, userAPI)
 	authv3.RegisterAuthorizationServer(app.grpcServer, externalAuthAPI)
@@ -231,6 +310,43 @@ func (app *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+// initGateway создаёт HTTP/JSON шлюз для работы без Envoy; аутентификация выполняется
+// тем же External Auth API, что вызывает Envoy
+func (app *App) initGateway(ctx context.Context) error {
+	if !app.cfg.Gateway().IsEnabled() {
+		return nil
+	}
+
+	externalAuthAPI, err := app.diContainer.ExternalAuthV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create external auth v1 api: %w", err)
+	}
+
+	gw, err := gateway.New(ctx,
+		app.cfg.Gateway(),
+		app.cfg.GRPC(),
+		app.cfg.Metric().BucketBoundaries(),
+		externalAuthAPI,
+		gateway.Service{Register: authV1.RegisterAuthServiceHandler, OpenAPI: authV1.AuthOpenAPISpec},
+		gateway.Service{Register: userV1.RegisterUserServiceHandler, OpenAPI: userV1.UserOpenAPISpec},
+	)
+	if err != nil {
+		return fmt.Errorf("create http gateway: %w", err)
+	}
+
+	closer.AddNamed("HTTP gateway", func(ctx context.Context) error {
+		logger.Info(ctx, "🌐 [Shutdown] Остановка HTTP шлюза")
+		ctx, cancel := context.WithTimeout(ctx, app.cfg.Gateway().ShutdownTimeout())
+		defer cancel()
+		return gw.Shutdown(ctx)
+	})
+
+	app.gateway = gw
+	logger.Info(ctx, "✅ [Gateway] HTTP шлюз инициализирован", zap.String("address", app.cfg.Gateway().Address()))
+
+	return nil
+}
+
 func (app *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx,
 		"🚀 [gRPC] IAM сервис слушает адрес",
@@ -29,9 +29,12 @@ import (
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/closer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
 	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/health"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/idempotency"
 	producerBuilder "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka/producer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/migrator"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/ratelimit"
 	authv1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/auth/v1"
 	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
 	generatedRbacV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
@@ -59,6 +62,9 @@ type diContainer struct {
 	postgresWritePool *pgxpool.Pool
 	postgresReadPool  *pgxpool.Pool
 	redisClient       cache.RedisClient
+	rateLimiter       ratelimit.Limiter
+	idempotencyStore  idempotency.Store
+	healthRegistry    *health.Registry
 	migrator          *migrator.Migrator
 }
 
@@ -233,6 +239,8 @@ func (d *diContainer) PostgresWritePool(ctx context.Context) (*pgxpool.Pool, err
 			return nil
 		})
 
+		d.HealthRegistry().Register("postgres_write", health.Readiness, health.PingProbe(pool))
+
 		logger.Info(ctx, "✅ [Database] PostgreSQL write pool создан")
 		d.postgresWritePool = pool
 	}
@@ -268,6 +276,8 @@ func (d *diContainer) PostgresReadPool(ctx context.Context) (*pgxpool.Pool, erro
 			return nil
 		})
 
+		d.HealthRegistry().Register("postgres_read", health.Readiness, health.PingProbe(pool))
+
 		logger.Info(ctx, "✅ [Database] PostgreSQL read pool создан")
 		d.postgresReadPool = pool
 	}
@@ -293,6 +303,21 @@ func (d *diContainer) SessionRepository(ctx context.Context) (repository.Session
 	return d.sessionRepository, nil
 }
 
+func (d *diContainer) HealthRegistry() *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+
+		closer.OnShutdown(func(ctx context.Context) {
+			logger.Info(ctx, "🩺 [Shutdown] Перевод readiness в NOT_SERVING")
+			registry.Shutdown()
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
+
 func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error) {
 	if d.redisClient == nil {
 		redisBuilder := builder.NewRedisBuilder(d.cfg.Redis())
@@ -314,6 +339,8 @@ func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error
 			return nil // go-redis клиент закрывается автоматически
 		})
 
+		d.HealthRegistry().Register("redis", health.Readiness, health.PingProbe(client))
+
 		logger.Info(ctx, "✅ [Cache] Redis кластер клиент создан")
 		d.redisClient = client
 	}
@@ -321,6 +348,48 @@ func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error
 	return d.redisClient, nil
 }
 
+// RateLimiter возвращает хранилище лимитов частоты: Redis при включённом кластере,
+// иначе лимиты считаются в памяти каждой реплики
+func (d *diContainer) RateLimiter(ctx context.Context) (ratelimit.Limiter, error) {
+	if d.rateLimiter == nil {
+		if !d.cfg.Redis().Cluster().IsEnabled() {
+			logger.Warn(ctx, "⚠️ [RateLimit] Redis выключен, лимиты считаются в памяти процесса")
+			d.rateLimiter = ratelimit.NewMemoryLimiter()
+			return d.rateLimiter, nil
+		}
+
+		client, err := d.RedisClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.rateLimiter = ratelimit.NewRedisLimiter(client, d.cfg.RateLimit().KeyPrefix())
+	}
+
+	return d.rateLimiter, nil
+}
+
+// IdempotencyStore возвращает хранилище ключей идемпотентности: Redis при включённом кластере,
+// иначе записи хранятся в памяти каждой реплики
+func (d *diContainer) IdempotencyStore(ctx context.Context) (idempotency.Store, error) {
+	if d.idempotencyStore == nil {
+		if !d.cfg.Redis().Cluster().IsEnabled() {
+			logger.Warn(ctx, "⚠️ [Idempotency] Redis выключен, ключи идемпотентности хранятся в памяти процесса")
+			d.idempotencyStore = idempotency.NewMemoryStore()
+			return d.idempotencyStore, nil
+		}
+
+		client, err := d.RedisClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.idempotencyStore = idempotency.NewRedisStore(client, d.cfg.Idempotency().KeyPrefix())
+	}
+
+	return d.idempotencyStore, nil
+}
+
 func (d *diContainer) RunMigrations(ctx context.Context) error {
 	if d.migrator != nil {
 		return nil
@@ -361,6 +430,8 @@ func (d *diContainer) RBACClient(ctx context.Context) (grpcClient.RBACClient, er
 			return conn.Close()
 		})
 
+		d.HealthRegistry().Register("grpc_rbac", health.Readiness, health.ClientConnProbe(conn))
+
 		logger.Info(ctx, "✅ [gRPC] Подключение к RBAC установлено", zap.String("address", addr))
 	}
 
@@ -377,7 +448,12 @@ func (d *diContainer) dialServiceConn(ctx context.Context, serviceName string) (
 	addr := svc.Address()
 	limits := d.cfg.GRPC()
 
-	conn, err := grpcclient.NewClient(addr, limits.MaxRecvMsgSize(), limits.MaxSendMsgSize(), limits.Timeout())
+	extra := append(grpcclient.ResilienceInterceptors(ctx, serviceName, svc),
+		grpcclient.ServiceTokenUnaryClientInterceptor(svc.Token()))
+
+	conn, err := grpcclient.NewClient(addr, svc.TLS(), limits.MaxRecvMsgSize(), limits.MaxSendMsgSize(), limits.Timeout(),
+		extra...,
+	)
 	if err != nil {
 		logger.Error(ctx, "❌ [gRPC] Не удалось подключиться к сервису", zap.String("service", serviceName), zap.String("address", addr), zap.Error(err))
 		return nil, "", fmt.Errorf("connect to %s failed: %w", serviceName, err)
@@ -408,6 +484,8 @@ func (d *diContainer) UserProducerService(ctx context.Context) (service.UserProd
 			return nil // Producer закрывается автоматически
 		})
 
+		d.HealthRegistry().Register("kafka_user_created_producer", health.Readiness, health.DialProbe(d.cfg.Kafka().Brokers()))
+
 		logger.Info(ctx, "✅ [Kafka] UserCreated producer создан")
 	}
 
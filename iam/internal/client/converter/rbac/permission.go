@@ -4,6 +4,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
 
@@ -19,8 +20,10 @@ func PermissionToDomain(p *commonV1.Permission) *model.Permission {
 	}
 
 	return &model.Permission{
-		ID:       permissionID,
-		Resource: p.Resource,
-		Action:   p.Action,
+		ID:        permissionID,
+		Resource:  p.Resource,
+		Action:    p.Action,
+		Effect:    authz.EffectFromProto(p.Effect),
+		Condition: p.Condition,
 	}
 }
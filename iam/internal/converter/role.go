@@ -4,6 +4,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
 
@@ -34,9 +35,11 @@ func PermissionToProto(p *model.Permission) *commonV1.Permission {
 	}
 
 	return &commonV1.Permission{
-		Id:       p.ID.String(),
-		Resource: p.Resource,
-		Action:   p.Action,
+		Id:        p.ID.String(),
+		Resource:  p.Resource,
+		Action:    p.Action,
+		Effect:    authz.EffectToProto(p.Effect),
+		Condition: p.Condition,
 	}
 }
 
@@ -1,39 +1,44 @@
 package model
 
-import "errors"
+import "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+
+// ErrorDomain домен причин ошибок IAM (google.rpc.ErrorInfo.Domain)
+const ErrorDomain apperr.Domain = "iam"
 
 var (
-	ErrBadRequest = errors.New("bad request")
-	ErrInternal   = errors.New("internal server error")
-
-	ErrInvalidCredentials = errors.New("invalid login or password")
-
-	ErrUserNotFound            = errors.New("user not found")
-	ErrUserAlreadyExists       = errors.New("user already exists")
-	ErrFailedToCreateUser      = errors.New("failed to create user")
-	ErrFailedToUpdateUser      = errors.New("failed to update user")
-	ErrFailedToDeleteUser      = errors.New("failed to delete user")
-	ErrFailedToGetUser         = errors.New("failed to get user")
-	ErrUserConstraintViolation = errors.New("user constraint violation")
-	ErrInvalidUserData         = errors.New("invalid user data")
-
-	ErrSessionNotFound       = errors.New("session not found")
-	ErrSessionExpired        = errors.New("session expired")
-	ErrFailedToCreateSession = errors.New("failed to create session")
-	ErrFailedToDeleteSession = errors.New("failed to delete session")
-	ErrFailedToStoreInCache  = errors.New("failed to store in cache")
-	ErrFailedToReadFromCache = errors.New("failed to read from cache")
-	ErrInvalidSessionData    = errors.New("invalid session data")
-
-	ErrNotificationNotFound      = errors.New("notification method not found")
-	ErrNotificationAlreadyExists = errors.New("notification method already exists")
-
-	ErrFailedToCreateNotification = errors.New("failed to create notification method")
-	ErrFailedToDeleteNotification = errors.New("failed to delete notification method")
-	ErrFailedToGetNotification    = errors.New("failed to get notification method")
-	ErrFailedToListNotifications  = errors.New("failed to list notification methods")
-
-	ErrInvalidNotificationData = errors.New("invalid notification data")
-
-	ErrNotificationUserConstraintViolation = errors.New("notification user constraint violation")
+	ErrBadRequest = ErrorDomain.Validation("BAD_REQUEST", "iam.bad_request")
+	ErrInternal   = ErrorDomain.Internal("INTERNAL", "iam.internal")
+
+	ErrInvalidCredentials = ErrorDomain.Unauthenticated("INVALID_CREDENTIALS", "iam.invalid_credentials")
+
+	ErrUserNotFound            = ErrorDomain.NotFound("USER_NOT_FOUND", "iam.user_not_found")
+	ErrUserAlreadyExists       = ErrorDomain.Conflict("USER_ALREADY_EXISTS", "iam.user_already_exists")
+	ErrFailedToCreateUser      = ErrorDomain.Internal("USER_CREATE_FAILED", "iam.user_create_failed")
+	ErrFailedToUpdateUser      = ErrorDomain.Internal("USER_UPDATE_FAILED", "iam.user_update_failed")
+	ErrFailedToDeleteUser      = ErrorDomain.Internal("USER_DELETE_FAILED", "iam.user_delete_failed")
+	ErrFailedToGetUser         = ErrorDomain.Internal("USER_GET_FAILED", "iam.user_get_failed")
+	ErrUserConstraintViolation = ErrorDomain.Validation("USER_CONSTRAINT_VIOLATION", "iam.user_constraint_violation")
+	ErrInvalidUserData         = ErrorDomain.Validation("INVALID_USER_DATA", "iam.invalid_user_data")
+
+	ErrSessionNotFound       = ErrorDomain.Unauthenticated("SESSION_NOT_FOUND", "iam.session_not_found")
+	ErrSessionExpired        = ErrorDomain.Unauthenticated("SESSION_EXPIRED", "iam.session_expired")
+	ErrFailedToCreateSession = ErrorDomain.Internal("SESSION_CREATE_FAILED", "iam.session_create_failed")
+	ErrFailedToDeleteSession = ErrorDomain.Internal("SESSION_DELETE_FAILED", "iam.session_delete_failed")
+	ErrFailedToStoreInCache  = ErrorDomain.Internal("CACHE_STORE_FAILED", "iam.cache_store_failed")
+	ErrFailedToReadFromCache = ErrorDomain.Internal("CACHE_READ_FAILED", "iam.cache_read_failed")
+	ErrInvalidSessionData    = ErrorDomain.Validation("INVALID_SESSION_DATA", "iam.invalid_session_data")
+
+	ErrRBACUnavailable = ErrorDomain.Unavailable("RBAC_UNAVAILABLE", "iam.rbac_unavailable")
+
+	ErrNotificationNotFound      = ErrorDomain.NotFound("NOTIFICATION_NOT_FOUND", "iam.notification_not_found")
+	ErrNotificationAlreadyExists = ErrorDomain.Conflict("NOTIFICATION_ALREADY_EXISTS", "iam.notification_already_exists")
+
+	ErrFailedToCreateNotification = ErrorDomain.Internal("NOTIFICATION_CREATE_FAILED", "iam.notification_create_failed")
+	ErrFailedToDeleteNotification = ErrorDomain.Internal("NOTIFICATION_DELETE_FAILED", "iam.notification_delete_failed")
+	ErrFailedToGetNotification    = ErrorDomain.Internal("NOTIFICATION_GET_FAILED", "iam.notification_get_failed")
+	ErrFailedToListNotifications  = ErrorDomain.Internal("NOTIFICATION_LIST_FAILED", "iam.notification_list_failed")
+
+	ErrInvalidNotificationData = ErrorDomain.Validation("INVALID_NOTIFICATION_DATA", "iam.invalid_notification_data")
+
+	ErrNotificationUserConstraintViolation = ErrorDomain.Validation("NOTIFICATION_USER_CONSTRAINT_VIOLATION", "iam.notification_user_constraint_violation")
 )
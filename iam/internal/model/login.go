@@ -6,5 +6,5 @@ type LoginCredentials struct {
 }
 
 func (lc *LoginCredentials) Validate() error {
-	return validate.Struct(lc)
+	return validateStruct(lc)
 }
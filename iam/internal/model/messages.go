@@ -0,0 +1,77 @@
+package model
+
+import "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+
+// Ключи уточняющих сообщений ошибок (apperr.Error.WithMessage)
+const (
+	MsgSessionMissing = "iam.session.missing"
+	MsgSessionInvalid = "iam.session.invalid"
+)
+
+func init() {
+	i18n.Register(i18n.RU, map[string]string{
+		"iam.bad_request":                            "некорректный запрос",
+		"iam.internal":                               "внутренняя ошибка сервера",
+		"iam.invalid_credentials":                    "неверный логин или пароль",
+		"iam.user_not_found":                         "пользователь не найден",
+		"iam.user_already_exists":                    "пользователь уже существует",
+		"iam.user_create_failed":                     "не удалось создать пользователя",
+		"iam.user_update_failed":                     "не удалось обновить пользователя",
+		"iam.user_delete_failed":                     "не удалось удалить пользователя",
+		"iam.user_get_failed":                        "не удалось получить пользователя",
+		"iam.user_constraint_violation":              "данные пользователя нарушают ограничения",
+		"iam.invalid_user_data":                      "некорректные данные пользователя",
+		"iam.session_not_found":                      "сессия не найдена",
+		"iam.session_expired":                        "сессия истекла",
+		"iam.session_create_failed":                  "не удалось создать сессию",
+		"iam.session_delete_failed":                  "не удалось удалить сессию",
+		"iam.cache_store_failed":                     "не удалось сохранить данные в кэш",
+		"iam.cache_read_failed":                      "не удалось прочитать данные из кэша",
+		"iam.invalid_session_data":                   "некорректные данные сессии",
+		"iam.rbac_unavailable":                       "сервис ролей недоступен, повторите вход позже",
+		"iam.notification_not_found":                 "способ уведомления не найден",
+		"iam.notification_already_exists":            "способ уведомления уже существует",
+		"iam.notification_create_failed":             "не удалось создать способ уведомления",
+		"iam.notification_delete_failed":             "не удалось удалить способ уведомления",
+		"iam.notification_get_failed":                "не удалось получить способ уведомления",
+		"iam.notification_list_failed":               "не удалось получить способы уведомления",
+		"iam.invalid_notification_data":              "некорректные данные способа уведомления",
+		"iam.notification_user_constraint_violation": "способ уведомления ссылается на несуществующего пользователя",
+
+		MsgSessionMissing: "сессия отсутствует или недействительна",
+		MsgSessionInvalid: "недействительная сессия",
+	})
+
+	i18n.Register(i18n.EN, map[string]string{
+		"iam.bad_request":                            "bad request",
+		"iam.internal":                               "internal server error",
+		"iam.invalid_credentials":                    "invalid login or password",
+		"iam.user_not_found":                         "user not found",
+		"iam.user_already_exists":                    "user already exists",
+		"iam.user_create_failed":                     "failed to create user",
+		"iam.user_update_failed":                     "failed to update user",
+		"iam.user_delete_failed":                     "failed to delete user",
+		"iam.user_get_failed":                        "failed to get user",
+		"iam.user_constraint_violation":              "user constraint violation",
+		"iam.invalid_user_data":                      "invalid user data",
+		"iam.session_not_found":                      "session not found",
+		"iam.session_expired":                        "session expired",
+		"iam.session_create_failed":                  "failed to create session",
+		"iam.session_delete_failed":                  "failed to delete session",
+		"iam.cache_store_failed":                     "failed to store in cache",
+		"iam.cache_read_failed":                      "failed to read from cache",
+		"iam.invalid_session_data":                   "invalid session data",
+		"iam.rbac_unavailable":                       "role service is unavailable, try to log in later",
+		"iam.notification_not_found":                 "notification method not found",
+		"iam.notification_already_exists":            "notification method already exists",
+		"iam.notification_create_failed":             "failed to create notification method",
+		"iam.notification_delete_failed":             "failed to delete notification method",
+		"iam.notification_get_failed":                "failed to get notification method",
+		"iam.notification_list_failed":               "failed to list notification methods",
+		"iam.invalid_notification_data":              "invalid notification data",
+		"iam.notification_user_constraint_violation": "notification user constraint violation",
+
+		MsgSessionMissing: "Missing or invalid session",
+		MsgSessionInvalid: "Invalid session",
+	})
+}
@@ -15,5 +15,5 @@ type NotificationMethod struct {
 }
 
 func (nm *NotificationMethod) Validate() error {
-	return validate.Struct(nm)
+	return validateStruct(nm)
 }
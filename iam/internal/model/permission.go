@@ -2,11 +2,15 @@ package model
 
 import (
 	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 )
 
 // Permission представляет право доступа
 type Permission struct {
-	ID       uuid.UUID
-	Resource string
-	Action   string
+	ID        uuid.UUID
+	Resource  string
+	Action    string
+	Effect    authz.Effect
+	Condition string
 }
@@ -13,5 +13,5 @@ type Provider struct {
 }
 
 func (p *Provider) Validate() error {
-	return validate.Struct(p)
+	return validateStruct(p)
 }
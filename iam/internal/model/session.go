@@ -14,5 +14,5 @@ type Session struct {
 }
 
 func (s *Session) Validate() error {
-	return validate.Struct(s)
+	return validateStruct(s)
 }
@@ -1,7 +1,57 @@
 package model
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/go-playground/validator/v10"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
 )
 
 var validate = validator.New()
+
+// validateStruct проверяет структуру и переводит нарушения правил в ErrBadRequest
+// с перечнем полей; исходные validator.ValidationErrors остаются в цепочке ошибки
+func validateStruct(v any) error {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	fields := make([]apperr.FieldViolation, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		key, args := ruleMessage(fe)
+		fields = append(fields, apperr.FieldViolation{Field: fe.Field(), Description: key, Args: args})
+	}
+
+	return fmt.Errorf("%w: %w", ErrBadRequest.WithFields(fields...), err)
+}
+
+// ruleMessage возвращает ключ каталога i18n и аргументы для нарушенного правила validator
+func ruleMessage(fe validator.FieldError) (string, []any) {
+	switch fe.Tag() {
+	case "required":
+		return i18n.MsgValidateRequired, nil
+	case "min":
+		return i18n.MsgValidateMinLen, []any{fe.Param()}
+	case "max":
+		return i18n.MsgValidateMaxLen, []any{fe.Param()}
+	case "email":
+		return i18n.MsgValidateEmail, nil
+	case "uuid", "uuid4":
+		return i18n.MsgValidateUUID, nil
+	default:
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+		return i18n.MsgValidateRule, []any{rule}
+	}
+}
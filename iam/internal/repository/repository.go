@@ -12,6 +12,7 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user model.User) (*model.User, error)
 	Get(ctx context.Context, value string) (*model.User, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error)
 	Update(ctx context.Context, user model.User) (*model.User, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/iam/internal/repository/model"
+)
+
+func (r *userRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error) {
+	query := `
+		SELECT id, login, email, password_hash, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1)
+		ORDER BY id`
+	rows, err := r.readPool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, r.mapDatabaseError(err, "get")
+	}
+	defer rows.Close()
+
+	users, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.User])
+	if err != nil {
+		return nil, r.mapDatabaseError(err, "get")
+	}
+
+	result := make([]*model.User, 0, len(users))
+	for i := range users {
+		result = append(result, converter.ToDomainUser(&users[i]))
+	}
+
+	return result, nil
+}
@@ -43,10 +43,12 @@ func (s *AuthService) Login(ctx context.Context, credentials *model.LoginCredent
 
 	user.NotificationMethods = notificationMethods
 
+	// Сессия без ролей молча лишила бы пользователя прав до следующего входа,
+	// поэтому после исчерпания повторов и открытия circuit breaker вход отклоняется
 	roles, err := s.rbacClient.GetUserRoles(ctx, user.ID)
 	if err != nil {
-		errreport.Report(ctx, "⚠️ [Service] Ошибка получения ролей пользователя при логине", err)
-		roles = []*model.RoleWithPermissions{}
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ролей пользователя при логине", err)
+		return uuid.Nil, model.ErrRBACUnavailable
 	}
 
 	expiresAt := time.Now().Add(s.sessionTTL)
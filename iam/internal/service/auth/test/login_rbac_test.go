@@ -0,0 +1,109 @@
+package auth_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/client/grpc/rbac"
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/service/auth"
+	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	grpcserver "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/server"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+const (
+	iamPeerID    = "spiffe://school.local/ns/school-schedule-services/sa/iam"
+	iamPeerToken = "iam-service-token"
+)
+
+// plaintextTLS транспорт без TLS, как в конфигурациях k8s
+type plaintextTLS struct{}
+
+func (plaintextTLS) Enabled() bool                 { return false }
+func (plaintextTLS) CertFile() string              { return "" }
+func (plaintextTLS) KeyFile() string               { return "" }
+func (plaintextTLS) CAFile() string                { return "" }
+func (plaintextTLS) RequireClientCert() bool       { return false }
+func (plaintextTLS) AllowedPeerIDs() []string      { return nil }
+func (plaintextTLS) ServerName() string            { return "" }
+func (plaintextTLS) ReloadInterval() time.Duration { return 0 }
+
+// userRoleService RBAC, отдающий одну роль с одним правом
+type userRoleService struct {
+	userRoleV1.UnimplementedUserRoleServiceServer
+
+	roleID       string
+	permissionID string
+}
+
+func (s userRoleService) GetUserRoles(context.Context, *userRoleV1.GetUserRolesRequest) (*userRoleV1.GetUserRolesResponse, error) {
+	return &userRoleV1.GetUserRolesResponse{
+		Data: []*commonV1.RoleWithPermissions{{
+			Role:        &commonV1.Role{Id: s.roleID, Name: "teacher"},
+			Permissions: []*commonV1.Permission{{Id: s.permissionID, Resource: "schedule", Action: "read"}},
+		}},
+	}, nil
+}
+
+// startRBAC запускает RBAC-сервер с проверкой доступа, как в rbac/internal/app, и без mTLS
+func (s *ServiceSuite) startRBAC(service userRoleService) string {
+	srv, err := grpcserver.New(s.ctx, 5*time.Second, 4<<20, 4<<20, plaintextTLS{},
+		grpcserver.AccessInterceptors(
+			interceptor.WithTrustedPeers(map[string][]string{iamPeerID: {"user_role:read"}}),
+			interceptor.WithServiceTokens(map[string]string{iamPeerToken: iamPeerID}),
+		)...,
+	)
+	s.Require().NoError(err)
+	userRoleV1.RegisterUserRoleServiceServer(srv, service)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	go func() { _ = srv.Serve(listener) }()
+	s.T().Cleanup(srv.Stop)
+
+	return listener.Addr().String()
+}
+
+// TestLoginLoadsRolesThroughRBAC проверяет, что при выключенном mTLS сессия получает роли
+// пользователя от RBAC: IAM подтверждает себя токеном сервиса
+func (s *ServiceSuite) TestLoginLoadsRolesThroughRBAC() {
+	userID := uuid.New()
+	rbacService := userRoleService{roleID: uuid.NewString(), permissionID: uuid.NewString()}
+
+	conn, err := grpcclient.NewClient(s.startRBAC(rbacService), plaintextTLS{}, 4<<20, 4<<20, 5*time.Second,
+		grpcclient.ServiceTokenUnaryClientInterceptor(iamPeerToken))
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	service := auth.NewService(s.userRepository, s.notificationRepository, s.sessionRepository,
+		rbac.NewClient(userRoleV1.NewUserRoleServiceClient(conn)), 24*time.Hour)
+
+	credentials := &model.LoginCredentials{Login: "testuser123", Password: "password123456"}
+	user := &model.User{ID: userID, Login: credentials.Login, PasswordHash: validPasswordHash}
+
+	var whoami *model.WhoAMI
+	s.userRepository.On("Get", mock.Anything, credentials.Login).Return(user, nil)
+	s.notificationRepository.On("GetByUser", mock.Anything, userID).Return([]*model.NotificationMethod{}, nil)
+	s.sessionRepository.On("Create", mock.Anything, mock.MatchedBy(func(w *model.WhoAMI) bool {
+		whoami = w
+		return true
+	}), mock.AnythingOfType("time.Time")).Return(uuid.New(), nil)
+
+	_, err = service.Login(s.ctx, credentials)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(whoami)
+	s.Require().Len(whoami.RolesWithPermissions, 1)
+	role := whoami.RolesWithPermissions[0]
+	assert.Equal(s.T(), rbacService.roleID, role.Role.ID.String())
+	s.Require().Len(role.Permissions, 1)
+	assert.Equal(s.T(), rbacService.permissionID, role.Permissions[0].ID.String())
+}
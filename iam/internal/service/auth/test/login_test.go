@@ -1,6 +1,8 @@
 package auth_test
 
 import (
+	"errors"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +19,13 @@ var validPasswordHash = func() string {
 	return string(hash)
 }()
 
+// whoamiOf сопоставляет данные сессии с пользователем и его ролями
+func whoamiOf(user model.User, roles []*model.RoleWithPermissions) any {
+	return mock.MatchedBy(func(w *model.WhoAMI) bool {
+		return reflect.DeepEqual(w.User, user) && reflect.DeepEqual(w.RolesWithPermissions, roles)
+	})
+}
+
 func (s *ServiceSuite) TestLoginSuccess() {
 	userID := uuid.New()
 	sessionID := uuid.New()
@@ -48,9 +57,12 @@ func (s *ServiceSuite) TestLoginSuccess() {
 	userWithNotifications := *user
 	userWithNotifications.NotificationMethods = notificationMethods
 
+	roles := []*model.RoleWithPermissions{{Role: &model.Role{ID: uuid.New(), Name: "teacher"}}}
+
 	s.userRepository.On("Get", mock.Anything, credentials.Login).Return(user, nil)
 	s.notificationRepository.On("GetByUser", mock.Anything, userID).Return(notificationMethods, nil)
-	s.sessionRepository.On("Create", mock.Anything, userWithNotifications, mock.AnythingOfType("time.Time")).Return(sessionID, nil)
+	s.rbacClient.On("GetUserRoles", mock.Anything, userID).Return(roles, nil)
+	s.sessionRepository.On("Create", mock.Anything, whoamiOf(userWithNotifications, roles), mock.AnythingOfType("time.Time")).Return(sessionID, nil)
 
 	result, err := s.service.Login(s.ctx, credentials)
 
@@ -174,7 +186,8 @@ func (s *ServiceSuite) TestLoginSessionCreationError() {
 
 	s.userRepository.On("Get", mock.Anything, credentials.Login).Return(user, nil)
 	s.notificationRepository.On("GetByUser", mock.Anything, userID).Return(notificationMethods, nil)
-	s.sessionRepository.On("Create", mock.Anything, userWithNotifications, mock.AnythingOfType("time.Time")).Return(uuid.Nil, model.ErrFailedToCreateSession)
+	s.rbacClient.On("GetUserRoles", mock.Anything, userID).Return([]*model.RoleWithPermissions{}, nil)
+	s.sessionRepository.On("Create", mock.Anything, whoamiOf(userWithNotifications, []*model.RoleWithPermissions{}), mock.AnythingOfType("time.Time")).Return(uuid.Nil, model.ErrFailedToCreateSession)
 
 	result, err := s.service.Login(s.ctx, credentials)
 
@@ -186,3 +199,34 @@ func (s *ServiceSuite) TestLoginSessionCreationError() {
 	s.notificationRepository.AssertExpectations(s.T())
 	s.sessionRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestLoginFailsWhenRBACUnavailable() {
+	userID := uuid.New()
+
+	credentials := &model.LoginCredentials{
+		Login:    "testuser123",
+		Password: "password123456",
+	}
+
+	user := &model.User{
+		ID:           userID,
+		Login:        "testuser123",
+		Email:        "test@example.com",
+		PasswordHash: validPasswordHash,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    nil,
+	}
+
+	s.userRepository.On("Get", mock.Anything, credentials.Login).Return(user, nil)
+	s.notificationRepository.On("GetByUser", mock.Anything, userID).Return([]*model.NotificationMethod{}, nil)
+	s.rbacClient.On("GetUserRoles", mock.Anything, userID).Return(nil, errors.New("circuit breaker is open"))
+
+	result, err := s.service.Login(s.ctx, credentials)
+
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), model.ErrRBACUnavailable, err)
+	assert.Equal(s.T(), uuid.Nil, result)
+
+	s.rbacClient.AssertExpectations(s.T())
+	s.sessionRepository.AssertNotCalled(s.T(), "Create")
+}
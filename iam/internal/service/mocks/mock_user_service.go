@@ -83,6 +83,65 @@ func (_c *UserService_GetUser_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
+// GetUsers provides a mock function with given fields: ctx, ids
+func (_m *UserService) GetUsers(ctx context.Context, ids []uuid.UUID) ([]*model.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*model.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*model.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type UserService_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *UserService_Expecter) GetUsers(ctx interface{}, ids interface{}) *UserService_GetUsers_Call {
+	return &UserService_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, ids)}
+}
+
+func (_c *UserService_GetUsers_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *UserService_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUsers_Call) Return(_a0 []*model.User, _a1 error) *UserService_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUsers_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]*model.User, error)) *UserService_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: ctx, login, email, password
 func (_m *UserService) Register(ctx context.Context, login string, email string, password string) (*model.User, error) {
 	ret := _m.Called(ctx, login, email, password)
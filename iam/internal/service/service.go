@@ -11,6 +11,7 @@ import (
 type UserService interface {
 	Register(ctx context.Context, login, email, password string) (*model.User, error)
 	GetUser(ctx context.Context, id uuid.UUID) (*model.User, error)
+	GetUsers(ctx context.Context, ids []uuid.UUID) ([]*model.User, error)
 }
 
 type AuthService interface {
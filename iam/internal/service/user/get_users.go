@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+)
+
+// GetUsers возвращает найденных пользователей без методов уведомлений
+func (s *UserService) GetUsers(ctx context.Context, ids []uuid.UUID) ([]*model.User, error) {
+	users, err := s.userRepository.GetByIDs(ctx, ids)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка пакетного получения пользователей", err)
+		return nil, err
+	}
+
+	return users, nil
+}
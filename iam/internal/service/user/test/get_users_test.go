@@ -0,0 +1,41 @@
+package user_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestGetUsersSuccess() {
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	users := []*model.User{
+		{ID: ids[0], Login: "first", Email: "first@example.com", CreatedAt: time.Now()},
+		{ID: ids[1], Login: "second", Email: "second@example.com", CreatedAt: time.Now()},
+	}
+
+	s.userRepository.On("GetByIDs", mock.Anything, ids).Return(users, nil).Once()
+
+	result, err := s.service.GetUsers(s.ctx, ids)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), users, result)
+
+	s.userRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetUsersRepositoryError() {
+	ids := []uuid.UUID{uuid.New()}
+
+	s.userRepository.On("GetByIDs", mock.Anything, ids).Return(nil, model.ErrFailedToGetUser).Once()
+
+	result, err := s.service.GetUsers(s.ctx, ids)
+
+	assert.ErrorIs(s.T(), err, model.ErrFailedToGetUser)
+	assert.Nil(s.T(), result)
+
+	s.userRepository.AssertExpectations(s.T())
+}
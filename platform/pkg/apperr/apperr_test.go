@@ -0,0 +1,205 @@
+package apperr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+)
+
+const testDomain Domain = "test"
+
+var (
+	errRoleNotFound = testDomain.NotFound("ROLE_NOT_FOUND", "test.role_not_found")
+	errInvalidInput = testDomain.Validation("INVALID_INPUT", "test.invalid_input")
+	errForbidden    = testDomain.PermissionDenied("PERMISSION_DENIED", "недостаточно прав")
+	errRateLimited  = testDomain.ResourceExhausted("RATE_LIMITED", "слишком много запросов")
+)
+
+func init() {
+	i18n.Register(i18n.RU, map[string]string{
+		"test.role_not_found": "роль не найдена",
+		"test.invalid_input":  "некорректные данные",
+		"test.empty_name":     "пустое имя роли %s",
+		"test.required":       "обязательное поле",
+	})
+	i18n.Register(i18n.EN, map[string]string{
+		"test.role_not_found": "role not found",
+		"test.required":       "value is required",
+	})
+}
+
+func TestErrorIsSentinel(t *testing.T) {
+	detailed := errRoleNotFound.WithResource("role", "42").WithMetadata("k", "v")
+	wrapped := fmt.Errorf("get role: %w", detailed)
+
+	if !errors.Is(wrapped, errRoleNotFound) {
+		t.Fatal("detailed copy must match sentinel")
+	}
+	if errors.Is(wrapped, errInvalidInput) {
+		t.Fatal("different sentinels must not match")
+	}
+	if len(errRoleNotFound.Metadata()) != 0 {
+		t.Fatal("With-methods must not mutate sentinel")
+	}
+}
+
+func TestToStatusDetails(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		code  codes.Code
+		check func(t *testing.T, st *status.Status)
+	}{
+		{
+			name: "not found with resource",
+			err:  errRoleNotFound.WithResource("role", "42"),
+			code: codes.NotFound,
+			check: func(t *testing.T, st *status.Status) {
+				info := detail[*errdetails.ResourceInfo](st)
+				if info == nil || info.GetResourceType() != "role" || info.GetResourceName() != "42" {
+					t.Fatalf("unexpected resource info: %v", info)
+				}
+			},
+		},
+		{
+			name: "validation with specific message and fields",
+			err:  fmt.Errorf("create: %w", errInvalidInput.WithMessage("test.empty_name", "x").WithField("name", "test.required")),
+			code: codes.InvalidArgument,
+			check: func(t *testing.T, st *status.Status) {
+				if st.Message() != "пустое имя роли x" {
+					t.Fatalf("message = %q", st.Message())
+				}
+				badRequest := detail[*errdetails.BadRequest](st)
+				if badRequest == nil || badRequest.GetFieldViolations()[0].GetField() != "name" ||
+					badRequest.GetFieldViolations()[0].GetDescription() != "обязательное поле" {
+					t.Fatalf("unexpected bad request: %v", badRequest)
+				}
+			},
+		},
+		{
+			name: "permission denied",
+			err:  errForbidden.WithPermission("roles:write"),
+			code: codes.PermissionDenied,
+			check: func(t *testing.T, st *status.Status) {
+				info := detail[*errdetails.ErrorInfo](st)
+				if info == nil || info.GetDomain() != "test" || info.GetMetadata()[PermissionMetadataKey] != "roles:write" {
+					t.Fatalf("unexpected error info: %v", info)
+				}
+			},
+		},
+		{
+			name: "retry info",
+			err:  errRateLimited.WithRetryAfter(3 * time.Second),
+			code: codes.ResourceExhausted,
+			check: func(t *testing.T, st *status.Status) {
+				retry := detail[*errdetails.RetryInfo](st)
+				if retry == nil || retry.GetRetryDelay().AsDuration() != 3*time.Second {
+					t.Fatalf("unexpected retry info: %v", retry)
+				}
+			},
+		},
+		{
+			name: "unknown error is hidden",
+			err:  errors.New("pq: connection refused"),
+			code: codes.Internal,
+			check: func(t *testing.T, st *status.Status) {
+				if st.Message() != i18n.T(i18n.DefaultLocale, i18n.MsgInternal) {
+					t.Fatalf("internal details leaked: %q", st.Message())
+				}
+			},
+		},
+		{
+			name: "grpc status passes through",
+			err:  status.Error(codes.Unavailable, "iam unavailable"),
+			code: codes.Unavailable,
+		},
+		{
+			name: "context canceled",
+			err:  fmt.Errorf("query: %w", context.Canceled),
+			code: codes.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(ToStatus(context.Background(), tt.err))
+			if st.Code() != tt.code {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.code)
+			}
+			if tt.check != nil {
+				tt.check(t, st)
+			}
+		})
+	}
+
+	if ToStatus(context.Background(), nil) != nil {
+		t.Fatal("nil error must stay nil")
+	}
+}
+
+func TestToStatusLocalized(t *testing.T) {
+	ctx := i18n.WithLocale(context.Background(), i18n.EN)
+	st := status.Convert(ToStatus(ctx, errRoleNotFound.WithField("role_id", "test.required")))
+
+	if st.Message() != "role not found" {
+		t.Fatalf("message = %q, want english", st.Message())
+	}
+
+	localized := detail[*errdetails.LocalizedMessage](st)
+	if localized == nil || localized.GetLocale() != "en" || localized.GetMessage() != "role not found" {
+		t.Fatalf("unexpected localized message: %v", localized)
+	}
+
+	badRequest := detail[*errdetails.BadRequest](st)
+	if badRequest.GetFieldViolations()[0].GetDescription() != "value is required" {
+		t.Fatalf("field description is not localized: %v", badRequest)
+	}
+
+	if errRoleNotFound.Error() != "роль не найдена" {
+		t.Fatalf("Error() must use default locale, got %q", errRoleNotFound.Error())
+	}
+}
+
+func TestProblem(t *testing.T) {
+	err := ToStatus(context.Background(), errRateLimited.WithRetryAfter(1500*time.Millisecond))
+	st := status.Convert(err)
+
+	recorder := httptest.NewRecorder()
+	NewProblem(http.StatusTooManyRequests, st).Write(recorder)
+
+	if recorder.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d", recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != ProblemContentType || recorder.Header().Get("Retry-After") != "2" {
+		t.Fatalf("unexpected headers: %v", recorder.Header())
+	}
+
+	var problem Problem
+	if err = json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatal(err)
+	}
+	if problem.Reason != "RATE_LIMITED" || problem.Code != codes.ResourceExhausted.String() || problem.RetryAfter != 2 {
+		t.Fatalf("unexpected problem: %+v", problem)
+	}
+}
+
+func detail[T any](st *status.Status) T {
+	var zero T
+	for _, d := range st.Details() {
+		if typed, ok := d.(T); ok {
+			return typed
+		}
+	}
+	return zero
+}
@@ -0,0 +1,254 @@
+package apperr
+
+import (
+	"maps"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+)
+
+// Kind категория доменной ошибки, определяющая gRPC код и HTTP статус
+type Kind int
+
+const (
+	// KindInternal внутренняя ошибка сервиса
+	KindInternal Kind = iota
+	// KindValidation некорректные входные данные, детализируются нарушениями полей
+	KindValidation
+	// KindUnauthenticated отсутствует или недействительна аутентификация
+	KindUnauthenticated
+	// KindPermissionDenied недостаточно прав, может содержать недостающее право
+	KindPermissionDenied
+	// KindNotFound ресурс не найден
+	KindNotFound
+	// KindConflict ресурс уже существует
+	KindConflict
+	// KindFailedPrecondition состояние системы не позволяет выполнить операцию
+	KindFailedPrecondition
+	// KindAborted операция прервана конкурентным изменением и может быть повторена
+	KindAborted
+	// KindResourceExhausted исчерпан лимит, повтор возможен после RetryAfter
+	KindResourceExhausted
+	// KindUnavailable зависимость временно недоступна, повтор возможен после RetryAfter
+	KindUnavailable
+)
+
+// PermissionMetadataKey ключ недостающего права в метаданных ErrorInfo
+const PermissionMetadataKey = "permission"
+
+// Resource ресурс, к которому относится ошибка (ResourceInfo)
+type Resource struct {
+	Type string
+	Name string
+}
+
+// FieldViolation нарушение правила валидации поля (BadRequest.FieldViolation).
+// Description - ключ каталога i18n с аргументами Args или готовый текст
+type FieldViolation struct {
+	Field       string
+	Description string
+	Args        []any
+}
+
+// PreconditionViolation нарушенное условие операции (PreconditionFailure.Violation).
+// Description - ключ каталога i18n с аргументами Args или готовый текст
+type PreconditionViolation struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Args        []any  `json:"-"`
+}
+
+// Error типизированная доменная ошибка.
+// Объявляется один раз как sentinel (var ErrXxx = domain.NotFound(...)), а With-методы
+// возвращают копию с деталями, которая по-прежнему сравнивается с sentinel через errors.Is.
+// Сообщение задаётся ключом каталога i18n и переводится на язык клиента в ToStatus
+type Error struct {
+	kind       Kind
+	domain     Domain
+	reason     string
+	key        string
+	args       []any
+	resource   *Resource
+	fields     []FieldViolation
+	violations []PreconditionViolation
+	retryAfter time.Duration
+	metadata   map[string]string
+
+	// base исходный sentinel, от которого получена копия
+	base *Error
+}
+
+// Domain пространство имён причин ошибок сервиса (ErrorInfo.Domain), например "rbac"
+type Domain string
+
+func (d Domain) newError(kind Kind, reason, key string) *Error {
+	return &Error{kind: kind, domain: d, reason: reason, key: key}
+}
+
+// Internal создаёт внутреннюю ошибку
+func (d Domain) Internal(reason, message string) *Error {
+	return d.newError(KindInternal, reason, message)
+}
+
+// Validation создаёт ошибку некорректных входных данных
+func (d Domain) Validation(reason, message string) *Error {
+	return d.newError(KindValidation, reason, message)
+}
+
+// Unauthenticated создаёт ошибку аутентификации
+func (d Domain) Unauthenticated(reason, message string) *Error {
+	return d.newError(KindUnauthenticated, reason, message)
+}
+
+// PermissionDenied создаёт ошибку недостаточных прав
+func (d Domain) PermissionDenied(reason, message string) *Error {
+	return d.newError(KindPermissionDenied, reason, message)
+}
+
+// NotFound создаёт ошибку отсутствующего ресурса
+func (d Domain) NotFound(reason, message string) *Error {
+	return d.newError(KindNotFound, reason, message)
+}
+
+// Conflict создаёт ошибку уже существующего ресурса
+func (d Domain) Conflict(reason, message string) *Error {
+	return d.newError(KindConflict, reason, message)
+}
+
+// FailedPrecondition создаёт ошибку нарушенного условия операции
+func (d Domain) FailedPrecondition(reason, message string) *Error {
+	return d.newError(KindFailedPrecondition, reason, message)
+}
+
+// Aborted создаёт ошибку операции, прерванной конкурентным изменением
+func (d Domain) Aborted(reason, message string) *Error {
+	return d.newError(KindAborted, reason, message)
+}
+
+// ResourceExhausted создаёт ошибку исчерпанного лимита
+func (d Domain) ResourceExhausted(reason, message string) *Error {
+	return d.newError(KindResourceExhausted, reason, message)
+}
+
+// Unavailable создаёт ошибку временной недоступности
+func (d Domain) Unavailable(reason, message string) *Error {
+	return d.newError(KindUnavailable, reason, message)
+}
+
+// Error возвращает сообщение ошибки на языке по умолчанию
+func (e *Error) Error() string {
+	return e.Localize(i18n.DefaultLocale)
+}
+
+// Localize возвращает сообщение ошибки на указанном языке
+func (e *Error) Localize(locale i18n.Locale) string {
+	return i18n.T(locale, e.key, e.args...)
+}
+
+// Is сравнивает ошибку с sentinel: копии с деталями равны исходной ошибке
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.root() == e.root()
+}
+
+// Kind возвращает категорию ошибки
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// Reason возвращает машиночитаемую причину (ErrorInfo.Reason)
+func (e *Error) Reason() string {
+	return e.reason
+}
+
+// Domain возвращает домен причины (ErrorInfo.Domain)
+func (e *Error) Domain() Domain {
+	return e.domain
+}
+
+// Fields возвращает нарушения валидации полей
+func (e *Error) Fields() []FieldViolation {
+	return e.fields
+}
+
+// Metadata возвращает метаданные ErrorInfo
+func (e *Error) Metadata() map[string]string {
+	return e.metadata
+}
+
+// WithMessage заменяет сообщение более конкретным ключом каталога i18n с аргументами,
+// сохраняя причину и категорию ошибки
+func (e *Error) WithMessage(key string, args ...any) *Error {
+	c := e.clone()
+	c.key = key
+	c.args = args
+	return c
+}
+
+// WithResource указывает ресурс, к которому относится ошибка
+func (e *Error) WithResource(resourceType, name string) *Error {
+	c := e.clone()
+	c.resource = &Resource{Type: resourceType, Name: name}
+	return c
+}
+
+// WithField добавляет нарушение валидации поля; description - ключ каталога i18n или текст
+func (e *Error) WithField(field, description string, args ...any) *Error {
+	return e.WithFields(FieldViolation{Field: field, Description: description, Args: args})
+}
+
+// WithFields добавляет нарушения валидации полей
+func (e *Error) WithFields(fields ...FieldViolation) *Error {
+	c := e.clone()
+	c.fields = append(c.fields, fields...)
+	return c
+}
+
+// WithViolation добавляет нарушенное условие операции; description - ключ каталога i18n или текст
+func (e *Error) WithViolation(violationType, subject, description string, args ...any) *Error {
+	c := e.clone()
+	c.violations = append(c.violations, PreconditionViolation{
+		Type:        violationType,
+		Subject:     subject,
+		Description: description,
+		Args:        args,
+	})
+	return c
+}
+
+// WithPermission указывает недостающее право доступа
+func (e *Error) WithPermission(permission string) *Error {
+	return e.WithMetadata(PermissionMetadataKey, permission)
+}
+
+// WithRetryAfter указывает, через сколько клиент может повторить запрос (RetryInfo)
+func (e *Error) WithRetryAfter(delay time.Duration) *Error {
+	c := e.clone()
+	c.retryAfter = delay
+	return c
+}
+
+// WithMetadata добавляет пару ключ-значение в метаданные ErrorInfo
+func (e *Error) WithMetadata(key, value string) *Error {
+	c := e.clone()
+	c.metadata[key] = value
+	return c
+}
+
+func (e *Error) root() *Error {
+	if e.base != nil {
+		return e.base
+	}
+	return e
+}
+
+func (e *Error) clone() *Error {
+	c := *e
+	c.base = e.root()
+	c.fields = append([]FieldViolation(nil), e.fields...)
+	c.violations = append([]PreconditionViolation(nil), e.violations...)
+	c.metadata = make(map[string]string, len(e.metadata)+1)
+	maps.Copy(c.metadata, e.metadata)
+	return &c
+}
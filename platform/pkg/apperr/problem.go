@@ -0,0 +1,107 @@
+package apperr
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// ProblemContentType тип содержимого ответа об ошибке (RFC 9457)
+const ProblemContentType = "application/problem+json"
+
+// Problem HTTP JSON ответ об ошибке (RFC 9457) с расширениями из деталей google.rpc
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// Code имя gRPC кода
+	Code string `json:"code"`
+	// Locale язык Detail и описаний нарушений
+	Locale string `json:"locale,omitempty"`
+
+	Reason        string                  `json:"reason,omitempty"`
+	Domain        string                  `json:"domain,omitempty"`
+	Metadata      map[string]string       `json:"metadata,omitempty"`
+	Resource      *ProblemResource        `json:"resource,omitempty"`
+	InvalidParams []ProblemInvalidParam   `json:"invalid_params,omitempty"`
+	Violations    []PreconditionViolation `json:"violations,omitempty"`
+	// RetryAfter через сколько секунд можно повторить запрос
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// ProblemResource ресурс, к которому относится ошибка
+type ProblemResource struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+// ProblemInvalidParam нарушение валидации поля запроса
+type ProblemInvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// NewProblem собирает Problem из gRPC статуса и его деталей
+func NewProblem(httpStatus int, st *status.Status) *Problem {
+	problem := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(httpStatus),
+		Status: httpStatus,
+		Detail: st.Message(),
+		Code:   st.Code().String(),
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.LocalizedMessage:
+			problem.Detail = d.GetMessage()
+			problem.Locale = d.GetLocale()
+		case *errdetails.ErrorInfo:
+			problem.Reason = d.GetReason()
+			problem.Domain = d.GetDomain()
+			problem.Metadata = d.GetMetadata()
+		case *errdetails.ResourceInfo:
+			problem.Resource = &ProblemResource{Type: d.GetResourceType(), Name: d.GetResourceName()}
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				problem.InvalidParams = append(problem.InvalidParams, ProblemInvalidParam{Name: v.GetField(), Reason: v.GetDescription()})
+			}
+		case *errdetails.PreconditionFailure:
+			for _, v := range d.GetViolations() {
+				problem.Violations = append(problem.Violations, PreconditionViolation{
+					Type:        v.GetType(),
+					Subject:     v.GetSubject(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.RetryInfo:
+			problem.RetryAfter = int(math.Ceil(d.GetRetryDelay().AsDuration().Seconds()))
+		}
+	}
+
+	return problem
+}
+
+// Write отправляет Problem клиенту, выставляя Retry-After при наличии RetryInfo
+func (p *Problem) Write(w http.ResponseWriter) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	if p.Locale != "" {
+		w.Header().Set("Content-Language", p.Locale)
+	}
+	if p.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(p.RetryAfter))
+	}
+	w.WriteHeader(p.Status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,136 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// Code возвращает gRPC код категории ошибки
+func (k Kind) Code() codes.Code {
+	switch k {
+	case KindValidation:
+		return codes.InvalidArgument
+	case KindUnauthenticated:
+		return codes.Unauthenticated
+	case KindPermissionDenied:
+		return codes.PermissionDenied
+	case KindNotFound:
+		return codes.NotFound
+	case KindConflict:
+		return codes.AlreadyExists
+	case KindFailedPrecondition:
+		return codes.FailedPrecondition
+	case KindAborted:
+		return codes.Aborted
+	case KindResourceExhausted:
+		return codes.ResourceExhausted
+	case KindUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// ToStatus преобразует ошибку сервисного слоя в gRPC статус с деталями google.rpc:
+// ErrorInfo, LocalizedMessage, ResourceInfo, BadRequest, PreconditionFailure и RetryInfo.
+// Сообщения переводятся на язык из контекста (i18n.FromContext).
+// Готовые gRPC статусы и ошибки контекста передаются как есть, прочие ошибки
+// логируются и скрываются за codes.Internal
+func ToStatus(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	locale := i18n.FromContext(ctx)
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.status(locale).Err()
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	logger.Error(ctx, "❌ [API] Неожиданная ошибка", zap.Error(err))
+	return status.Error(codes.Internal, i18n.T(locale, i18n.MsgInternal))
+}
+
+func (e *Error) status(locale i18n.Locale) *status.Status {
+	message := e.Localize(locale)
+
+	st := status.New(e.kind.Code(), message)
+	detailed, err := st.WithDetails(e.details(locale, message)...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+func (e *Error) details(locale i18n.Locale, message string) []protoadapt.MessageV1 {
+	var details []protoadapt.MessageV1
+
+	if e.reason != "" {
+		details = append(details, &errdetails.ErrorInfo{
+			Reason:   e.reason,
+			Domain:   string(e.domain),
+			Metadata: e.metadata,
+		})
+	}
+
+	details = append(details, &errdetails.LocalizedMessage{
+		Locale:  string(locale),
+		Message: message,
+	})
+
+	if e.resource != nil {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: e.resource.Type,
+			ResourceName: e.resource.Name,
+			Description:  message,
+		})
+	}
+
+	if len(e.fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.fields))
+		for _, field := range e.fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: i18n.T(locale, field.Description, field.Args...),
+			})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	if len(e.violations) > 0 {
+		violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(e.violations))
+		for _, violation := range e.violations {
+			violations = append(violations, &errdetails.PreconditionFailure_Violation{
+				Type:        violation.Type,
+				Subject:     violation.Subject,
+				Description: i18n.T(locale, violation.Description, violation.Args...),
+			})
+		}
+		details = append(details, &errdetails.PreconditionFailure{Violations: violations})
+	}
+
+	if e.retryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.retryAfter)})
+	}
+
+	return details
+}
@@ -0,0 +1,56 @@
+// Package audit содержит общие типы аудита административных изменений.
+package audit
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// ActorType тип инициатора изменения
+type ActorType string
+
+const (
+	// ActorTypeUser пользователь, выполнивший запрос через Envoy
+	ActorTypeUser ActorType = "user"
+	// ActorTypeSystem фоновый процесс или обработчик событий
+	ActorTypeSystem ActorType = "system"
+	// ActorTypeAnonymous запрос без сессии (например, прямой вызов между сервисами)
+	ActorTypeAnonymous ActorType = "anonymous"
+)
+
+type contextKey string
+
+const systemActorContextKey contextKey = "audit-system-actor"
+
+// Actor инициатор изменения
+type Actor struct {
+	Type      ActorType `json:"type"`
+	ID        string    `json:"id,omitempty"` // user ID или имя системного процесса
+	SessionID string    `json:"session_id,omitempty"`
+}
+
+// WithSystemActor помечает контекст как выполняемый системным процессом с указанным именем
+func WithSystemActor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, systemActorContextKey, name)
+}
+
+// ActorFromContext определяет инициатора по контексту запроса
+func ActorFromContext(ctx context.Context) Actor {
+	if name, ok := ctx.Value(systemActorContextKey).(string); ok && name != "" {
+		return Actor{Type: ActorTypeSystem, ID: name}
+	}
+
+	sessionID, _ := interceptor.GetSessionIDFromContext(ctx)
+	if userID, ok := interceptor.GetUserIDFromContext(ctx); ok {
+		return Actor{Type: ActorTypeUser, ID: userID, SessionID: sessionID}
+	}
+
+	return Actor{Type: ActorTypeAnonymous, SessionID: sessionID}
+}
+
+// RequestIDFromContext возвращает идентификатор запроса для записи аудита
+func RequestIDFromContext(ctx context.Context) string {
+	return logger.RequestIDFrom(ctx)
+}
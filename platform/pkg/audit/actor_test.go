@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+)
+
+// TestActorFromContext проверяет определение инициатора изменения
+func TestActorFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		system   string
+		expected Actor
+	}{
+		{
+			name: "user from envoy headers",
+			metadata: map[string]string{
+				interceptor.HeaderUserID:    "user-1",
+				interceptor.HeaderSessionID: "session-1",
+			},
+			expected: Actor{Type: ActorTypeUser, ID: "user-1", SessionID: "session-1"},
+		},
+		{
+			name:     "system actor takes precedence",
+			metadata: map[string]string{interceptor.HeaderUserID: "user-1"},
+			system:   "user_role_expiry",
+			expected: Actor{Type: ActorTypeSystem, ID: "user_role_expiry"},
+		},
+		{
+			name:     "anonymous without headers",
+			expected: Actor{Type: ActorTypeAnonymous},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(tt.metadata))
+
+			var actor Actor
+			handler := func(ctx context.Context, _ any) (any, error) {
+				if tt.system != "" {
+					ctx = WithSystemActor(ctx, tt.system)
+				}
+				actor = ActorFromContext(ctx)
+				return nil, nil
+			}
+
+			_, _ = interceptor.IdentityInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+
+			if actor != tt.expected {
+				t.Errorf("ActorFromContext() = %+v, expected %+v", actor, tt.expected)
+			}
+		})
+	}
+}
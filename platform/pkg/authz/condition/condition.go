@@ -0,0 +1,119 @@
+// Package condition реализует язык условий для назначений прав (ABAC).
+//
+// Условие — логическое выражение над атрибутами запроса:
+//
+//	resource.subject_id in subject.subject_ids
+//	request.time >= "2025-09-01T00:00:00Z" && request.time < "2026-06-01T00:00:00Z"
+//	!(resource.owner_id == subject.id) || subject.attributes.senior == true
+//
+// Поддерживаются операторы ==, !=, <, <=, >, >=, in, &&, ||, ! и скобки,
+// литералы: строки, числа, true, false, null и списки [..].
+// Атрибуты адресуются путями от корней subject, resource и request.
+// Отсутствующий атрибут равен null, сравнение с ним ложно.
+// Строки в формате RFC3339 сравниваются со временем как время.
+package condition
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxLength максимальная длина выражения
+const MaxLength = 1000
+
+// Корни атрибутов
+const (
+	RootSubject  = "subject"
+	RootResource = "resource"
+	RootRequest  = "request"
+)
+
+var roots = map[string]struct{}{
+	RootSubject:  {},
+	RootResource: {},
+	RootRequest:  {},
+}
+
+// Attributes атрибуты, доступные выражению (subject, resource, request)
+type Attributes map[string]any
+
+// NewAttributes создает атрибуты запроса; request.time заполняется текущим временем,
+// если не передано явно
+func NewAttributes(subject, resource, request map[string]any) Attributes {
+	if request == nil {
+		request = map[string]any{}
+	}
+	if _, ok := request["time"]; !ok {
+		request["time"] = time.Now().UTC()
+	}
+
+	return Attributes{
+		RootSubject:  subject,
+		RootResource: resource,
+		RootRequest:  request,
+	}
+}
+
+// Expression скомпилированное условие
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse компилирует условие и проверяет его синтаксис
+func Parse(source string) (*Expression, error) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return nil, fmt.Errorf("condition is empty")
+	}
+
+	if len(source) > MaxLength {
+		return nil, fmt.Errorf("condition is longer than %d characters", MaxLength)
+	}
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// Validate проверяет синтаксис условия
+func Validate(source string) error {
+	_, err := Parse(source)
+	return err
+}
+
+// String возвращает исходный текст условия
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate вычисляет условие для переданных атрибутов
+func (e *Expression) Evaluate(attrs Attributes) (bool, error) {
+	if attrs == nil {
+		attrs = Attributes{}
+	}
+	return evalBool(e.root, attrs)
+}
+
+// Evaluate компилирует и вычисляет условие
+func Evaluate(source string, attrs Attributes) (bool, error) {
+	expr, err := Parse(source)
+	if err != nil {
+		return false, err
+	}
+	return expr.Evaluate(attrs)
+}
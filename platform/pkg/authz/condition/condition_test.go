@@ -0,0 +1,107 @@
+package condition
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr bool
+	}{
+		{name: "equality", source: `subject.id == resource.owner_id`},
+		{name: "membership", source: `resource.subject_id in subject.subject_ids`},
+		{name: "literal list", source: `resource.status in ["draft", 'review']`},
+		{name: "logical with parens", source: `!(subject.id == "x") && (request.time >= "2025-09-01T00:00:00Z" || true)`},
+		{name: "number", source: `resource.grade <= 11`},
+		{name: "empty", source: "   ", wantErr: true},
+		{name: "unknown root", source: `user.id == "x"`, wantErr: true},
+		{name: "unterminated string", source: `subject.id == "x`, wantErr: true},
+		{name: "dangling operator", source: `subject.id ==`, wantErr: true},
+		{name: "unbalanced paren", source: `(subject.id == "x"`, wantErr: true},
+		{name: "trailing token", source: `subject.id == "x" "y"`, wantErr: true},
+		{name: "unexpected character", source: `subject.id = "x"`, wantErr: true},
+		{name: "empty path segment", source: `subject..id == "x"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.source)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.source, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	attrs := NewAttributes(
+		map[string]any{
+			"id":          "teacher-1",
+			"subject_ids": []string{"math", "physics"},
+			"attributes":  map[string]any{"senior": true},
+		},
+		map[string]any{
+			"owner_id":   "teacher-1",
+			"subject_id": "math",
+			"grade":      9,
+		},
+		map[string]any{
+			"time": time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+		},
+	)
+
+	tests := []struct {
+		name     string
+		source   string
+		expected bool
+		wantErr  bool
+	}{
+		{name: "owner matches", source: `resource.owner_id == subject.id`, expected: true},
+		{name: "owner differs", source: `resource.owner_id != subject.id`, expected: false},
+		{name: "subject in list", source: `resource.subject_id in subject.subject_ids`, expected: true},
+		{name: "subject not in literal list", source: `resource.subject_id in ["history"]`, expected: false},
+		{name: "number comparison", source: `resource.grade >= 9 && resource.grade < 12`, expected: true},
+		{name: "time window", source: `request.time >= "2025-09-01T00:00:00Z" && request.time < "2026-06-01T00:00:00Z"`, expected: true},
+		{name: "outside time window", source: `request.time < "2025-09-01T00:00:00Z"`, expected: false},
+		{name: "nested attribute", source: `subject.attributes.senior == true`, expected: true},
+		{name: "negation", source: `!(subject.id == "teacher-2")`, expected: true},
+		{name: "missing attribute is null", source: `resource.missing == null`, expected: true},
+		{name: "missing attribute in list", source: `resource.missing in subject.subject_ids`, expected: false},
+		{name: "short circuit or", source: `true || resource.grade`, expected: true},
+		{name: "non boolean result", source: `resource.grade`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Evaluate(tt.source, attrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate(%q) error = %v, wantErr %v", tt.source, err, tt.wantErr)
+			}
+			if result != tt.expected {
+				t.Errorf("Evaluate(%q) = %v, expected %v", tt.source, result, tt.expected)
+			}
+		})
+	}
+}
+
+// BenchmarkEvaluate бенчмарк вычисления скомпилированного условия
+func BenchmarkEvaluate(b *testing.B) {
+	expr, err := Parse(`resource.subject_id in subject.subject_ids && request.time >= "2025-09-01T00:00:00Z"`)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	attrs := NewAttributes(
+		map[string]any{"subject_ids": []string{"math", "physics"}},
+		map[string]any{"subject_id": "physics"},
+		nil,
+	)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = expr.Evaluate(attrs)
+	}
+}
@@ -0,0 +1,259 @@
+package condition
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type node interface {
+	eval(attrs Attributes) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(Attributes) (any, error) {
+	return n.value, nil
+}
+
+type pathNode struct {
+	path []string
+}
+
+func (n *pathNode) eval(attrs Attributes) (any, error) {
+	var current any = map[string]any(attrs)
+	for _, part := range n.path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		current = m[part]
+	}
+	return normalize(current), nil
+}
+
+type listNode struct {
+	items []node
+}
+
+func (n *listNode) eval(attrs Attributes) (any, error) {
+	result := make([]any, 0, len(n.items))
+	for _, item := range n.items {
+		value, err := item.eval(attrs)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) eval(attrs Attributes) (any, error) {
+	value, err := evalBool(n.operand, attrs)
+	if err != nil {
+		return nil, err
+	}
+	return !value, nil
+}
+
+type logicalNode struct {
+	op          string
+	left, right node
+}
+
+func (n *logicalNode) eval(attrs Attributes) (any, error) {
+	left, err := evalBool(n.left, attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Короткое замыкание
+	if n.op == "&&" && !left {
+		return false, nil
+	}
+	if n.op == "||" && left {
+		return true, nil
+	}
+
+	return evalBool(n.right, attrs)
+}
+
+type compareNode struct {
+	op          string
+	left, right node
+}
+
+func (n *compareNode) eval(attrs Attributes) (any, error) {
+	left, err := n.left.eval(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := n.right.eval(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "in":
+		list, ok := right.([]any)
+		if !ok {
+			return false, nil
+		}
+		for _, item := range list {
+			if equal(left, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		cmp, ok := compare(left, right)
+		if !ok {
+			return false, nil
+		}
+		switch n.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+	}
+}
+
+func evalBool(n node, attrs Attributes) (bool, error) {
+	value, err := n.eval(attrs)
+	if err != nil {
+		return false, err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case nil:
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected boolean, got %T", value)
+	}
+}
+
+// normalize приводит значения атрибутов к типам выражения:
+// числа — float64, списки — []any, время остается time.Time
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil, string, bool, float64, time.Time, []any, map[string]any:
+		return v
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case []string:
+		result := make([]any, len(v))
+		for i, s := range v {
+			result[i] = s
+		}
+		return result
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice {
+		result := make([]any, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			result[i] = normalize(rv.Index(i).Interface())
+		}
+		return result
+	}
+
+	return value
+}
+
+func equal(left, right any) bool {
+	left, right = coerceTime(left, right)
+	if l, ok := left.(time.Time); ok {
+		r, ok := right.(time.Time)
+		return ok && l.Equal(r)
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func compare(left, right any) (int, bool) {
+	left, right = coerceTime(left, right)
+
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		}
+		return 0, true
+	case time.Time:
+		r, ok := right.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return l.Compare(r), true
+	}
+
+	return 0, false
+}
+
+// coerceTime разбирает строку RFC3339, если второй операнд — время
+func coerceTime(left, right any) (any, any) {
+	if _, ok := left.(time.Time); ok {
+		if s, ok := right.(string); ok {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				return left, t
+			}
+		}
+	}
+	if _, ok := right.(time.Time); ok {
+		if s, ok := left.(string); ok {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				return t, right
+			}
+		}
+	}
+	return left, right
+}
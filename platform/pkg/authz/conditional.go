@@ -0,0 +1,59 @@
+package authz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// conditionalPermission условное право в заголовке: строковое право с эффектом и условие
+type conditionalPermission struct {
+	Permission string `json:"permission"`
+	Condition  string `json:"condition"`
+}
+
+// EncodeConditional кодирует условные права для передачи сервисам в заголовке.
+// Условие может содержать символы, недопустимые в значении заголовка, поэтому список
+// передается как JSON в base64. Пустой список тоже кодируется: заголовок всегда перезаписывается
+func EncodeConditional(permissions []Permission) string {
+	items := make([]conditionalPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		items = append(items, conditionalPermission{Permission: permission.String(), Condition: permission.Condition})
+	}
+
+	data, _ := json.Marshal(items) //nolint:errchkjson // строки сериализуются всегда
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeConditional разбирает условные права из заголовка. Некорректное значение возвращает ошибку:
+// потерянный условный запрет ослабил бы проверку, поэтому вызывающий должен отказать в доступе
+func DecodeConditional(value string) ([]Permission, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("decode conditional permissions: %w", err)
+	}
+
+	var items []conditionalPermission
+	if err = json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal conditional permissions: %w", err)
+	}
+
+	result := make([]Permission, 0, len(items))
+	for _, item := range items {
+		permission, err := Parse(item.Permission)
+		if err != nil {
+			return nil, err
+		}
+		permission.Condition = item.Condition
+		if !permission.IsConditional() {
+			return nil, fmt.Errorf("permission %q has no condition", item.Permission)
+		}
+		result = append(result, permission)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestConditionalRoundTrip проверяет передачу условных прав через заголовок
+func TestConditionalRoundTrip(t *testing.T) {
+	permissions := []Permission{
+		{Resource: "lesson", Action: "write", Condition: `resource.teacher_id == subject.id`},
+		{Resource: "schedule", Action: "*", Effect: EffectDeny, Condition: `request.time >= "2026-06-01T00:00:00Z"`},
+	}
+
+	decoded, err := DecodeConditional(EncodeConditional(permissions))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, permissions) {
+		t.Fatalf("expected %v, got %v", permissions, decoded)
+	}
+
+	empty, err := DecodeConditional(EncodeConditional(nil))
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("expected empty list, got %v, %v", empty, err)
+	}
+}
+
+// TestDecodeConditionalInvalid проверяет, что некорректный заголовок не разбирается частично
+func TestDecodeConditionalInvalid(t *testing.T) {
+	tests := map[string]string{
+		"not base64":         "%%%",
+		"not json":           "bm90IGpzb24",
+		"invalid permission": EncodeConditional([]Permission{{Resource: "schedule", Condition: "true"}}),
+		"missing condition":  "W3sicGVybWlzc2lvbiI6InNjaGVkdWxlOnJlYWQiLCJjb25kaXRpb24iOiIifV0",
+	}
+
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := DecodeConditional(value); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
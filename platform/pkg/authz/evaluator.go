@@ -0,0 +1,86 @@
+package authz
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// Decision результат вычисления прав
+type Decision int
+
+const (
+	// DecisionNotApplicable ни одно право не подошло (доступ запрещен по умолчанию)
+	DecisionNotApplicable Decision = iota
+	// DecisionAllow доступ разрешен
+	DecisionAllow
+	// DecisionDeny доступ явно запрещен
+	DecisionDeny
+)
+
+// String возвращает текстовое представление решения
+func (d Decision) String() string {
+	switch d {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "not_applicable"
+	}
+}
+
+// Evaluate вычисляет решение для требуемого права ("resource:action").
+// Явный запрет имеет приоритет над любыми разрешениями, включая шаблонные.
+// Условные права вычисляются без атрибутов: разрешения пропускаются, запреты применяются.
+func Evaluate(permissions []Permission, required string) Decision {
+	return EvaluateWithAttributes(permissions, required, nil)
+}
+
+// EvaluateWithAttributes вычисляет решение с учетом условий прав.
+// Если атрибуты не переданы или условие не удалось вычислить, условное разрешение
+// не применяется, а условный запрет применяется.
+func EvaluateWithAttributes(permissions []Permission, required string, attrs condition.Attributes) Decision {
+	target, err := Parse(required)
+	if err != nil {
+		return DecisionNotApplicable
+	}
+
+	decision := DecisionNotApplicable
+	for _, permission := range permissions {
+		if !permission.Matches(target.Resource, target.Action) {
+			continue
+		}
+
+		if permission.IsConditional() && !conditionHolds(permission, attrs) {
+			continue
+		}
+
+		if permission.Effect == EffectDeny {
+			return DecisionDeny
+		}
+
+		decision = DecisionAllow
+	}
+
+	return decision
+}
+
+// IsAllowed возвращает true, если требуемое право разрешено и не запрещено явно
+func IsAllowed(permissions []Permission, required string) bool {
+	return Evaluate(permissions, required) == DecisionAllow
+}
+
+// conditionHolds вычисляет условие права; при невозможности вычисления
+// возвращает true для запрета и false для разрешения
+func conditionHolds(permission Permission, attrs condition.Attributes) bool {
+	failClosed := permission.Effect == EffectDeny
+	if attrs == nil {
+		return failClosed
+	}
+
+	result, err := condition.Evaluate(permission.Condition, attrs)
+	if err != nil {
+		return failClosed
+	}
+
+	return result
+}
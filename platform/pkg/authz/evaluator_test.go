@@ -0,0 +1,193 @@
+package authz
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// TestEvaluate проверяет шаблоны и приоритет явных запретов
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []string
+		required    string
+		expected    Decision
+	}{
+		{
+			name:        "exact allow",
+			permissions: []string{"schedule:read"},
+			required:    "schedule:read",
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "no matching permission",
+			permissions: []string{"schedule:read"},
+			required:    "schedule:write",
+			expected:    DecisionNotApplicable,
+		},
+		{
+			name:        "action wildcard",
+			permissions: []string{"schedule:*"},
+			required:    "schedule:write",
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "resource wildcard",
+			permissions: []string{"*:read"},
+			required:    "room:read",
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "resource wildcard does not cover other actions",
+			permissions: []string{"*:read"},
+			required:    "room:write",
+			expected:    DecisionNotApplicable,
+		},
+		{
+			name:        "full wildcard",
+			permissions: []string{"*:*"},
+			required:    "user_role:write",
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "deny overrides wildcard allow",
+			permissions: []string{"schedule:*", "!schedule:write"},
+			required:    "schedule:write",
+			expected:    DecisionDeny,
+		},
+		{
+			name:        "deny does not affect other actions",
+			permissions: []string{"schedule:*", "!schedule:write"},
+			required:    "schedule:read",
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "deny order does not matter",
+			permissions: []string{"!schedule:write", "schedule:write"},
+			required:    "schedule:write",
+			expected:    DecisionDeny,
+		},
+		{
+			name:        "wildcard deny",
+			permissions: []string{"*:*", "!user:*"},
+			required:    "user:read",
+			expected:    DecisionDeny,
+		},
+		{
+			name:        "invalid required permission",
+			permissions: []string{"*:*"},
+			required:    "schedule",
+			expected:    DecisionNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Evaluate(ParseList(tt.permissions), tt.required)
+			if result != tt.expected {
+				t.Errorf("Evaluate() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParse проверяет разбор строкового представления прав
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Permission
+		wantErr  bool
+	}{
+		{input: "schedule:read", expected: Allow("schedule", "read")},
+		{input: "!schedule:write", expected: Deny("schedule", "write")},
+		{input: " *:read ", expected: Allow("*", "read")},
+		{input: "schedule", wantErr: true},
+		{input: "schedule:", wantErr: true},
+		{input: ":read", wantErr: true},
+		{input: "a:b:c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := Parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Parse(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Parse(%q) = %+v, expected %+v", tt.input, result, tt.expected)
+			}
+			if result.String() != strings.TrimSpace(tt.input) {
+				t.Errorf("String() = %q, expected %q", result.String(), strings.TrimSpace(tt.input))
+			}
+		})
+	}
+}
+
+// TestEvaluateWithAttributes проверяет вычисление условных прав
+func TestEvaluateWithAttributes(t *testing.T) {
+	ownLessons := Permission{Resource: "lesson", Action: "write", Condition: `resource.teacher_id == subject.id`}
+	foreignDeny := Permission{Resource: "lesson", Action: "write", Effect: EffectDeny, Condition: `resource.locked == true`}
+
+	own := condition.NewAttributes(map[string]any{"id": "t1"}, map[string]any{"teacher_id": "t1"}, nil)
+	foreign := condition.NewAttributes(map[string]any{"id": "t1"}, map[string]any{"teacher_id": "t2"}, nil)
+	locked := condition.NewAttributes(map[string]any{"id": "t1"}, map[string]any{"teacher_id": "t1", "locked": true}, nil)
+
+	tests := []struct {
+		name        string
+		permissions []Permission
+		attrs       condition.Attributes
+		expected    Decision
+	}{
+		{
+			name:        "condition satisfied",
+			permissions: []Permission{ownLessons},
+			attrs:       own,
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "condition not satisfied",
+			permissions: []Permission{ownLessons},
+			attrs:       foreign,
+			expected:    DecisionNotApplicable,
+		},
+		{
+			name:        "conditional allow without attributes is skipped",
+			permissions: []Permission{ownLessons},
+			expected:    DecisionNotApplicable,
+		},
+		{
+			name:        "conditional deny applies when satisfied",
+			permissions: []Permission{Allow("lesson", "*"), foreignDeny},
+			attrs:       locked,
+			expected:    DecisionDeny,
+		},
+		{
+			name:        "conditional deny skipped when not satisfied",
+			permissions: []Permission{Allow("lesson", "*"), foreignDeny},
+			attrs:       own,
+			expected:    DecisionAllow,
+		},
+		{
+			name:        "conditional deny without attributes applies",
+			permissions: []Permission{Allow("lesson", "*"), foreignDeny},
+			expected:    DecisionDeny,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := EvaluateWithAttributes(tt.permissions, "lesson:write", tt.attrs)
+			if result != tt.expected {
+				t.Errorf("EvaluateWithAttributes() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
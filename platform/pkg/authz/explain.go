@@ -0,0 +1,47 @@
+package authz
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// Match право, покрывающее требуемое, и результат его применения
+type Match struct {
+	// Index индекс права во входном списке
+	Index      int
+	Permission Permission
+	// Applied false, если условие права не выполнено или не вычислено
+	Applied bool
+}
+
+// Explain вычисляет решение по тем же правилам, что и EvaluateWithAttributes,
+// и возвращает все права, покрывающие требуемое, в порядке входного списка.
+// Используется для диагностики отказов, поэтому не останавливается на первом запрете.
+func Explain(permissions []Permission, required string, attrs condition.Attributes) (Decision, []Match) {
+	target, err := Parse(required)
+	if err != nil {
+		return DecisionNotApplicable, nil
+	}
+
+	decision := DecisionNotApplicable
+	var matches []Match
+	for i, permission := range permissions {
+		if !permission.Matches(target.Resource, target.Action) {
+			continue
+		}
+
+		applied := !permission.IsConditional() || conditionHolds(permission, attrs)
+		matches = append(matches, Match{Index: i, Permission: permission, Applied: applied})
+		if !applied {
+			continue
+		}
+
+		switch {
+		case permission.Effect == EffectDeny:
+			decision = DecisionDeny
+		case decision == DecisionNotApplicable:
+			decision = DecisionAllow
+		}
+	}
+
+	return decision, matches
+}
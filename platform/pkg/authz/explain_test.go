@@ -0,0 +1,66 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// TestExplainMatchesEvaluate проверяет, что объяснение дает то же решение, что и вычисление
+func TestExplainMatchesEvaluate(t *testing.T) {
+	attrs := condition.NewAttributes(map[string]any{"id": "u1"}, map[string]any{"owner_id": "u1"}, nil)
+	owner := Allow("schedule", "write")
+	owner.Condition = "resource.owner_id == subject.id"
+	notOwner := Deny("schedule", "write")
+	notOwner.Condition = "resource.owner_id != subject.id"
+
+	cases := [][]Permission{
+		{Allow("schedule", "read")},
+		{Allow("schedule", Wildcard), Deny("schedule", "write")},
+		{Deny("schedule", "write"), Allow(Wildcard, Wildcard)},
+		{owner},
+		{owner, notOwner},
+		{},
+	}
+
+	for _, permissions := range cases {
+		for _, a := range []condition.Attributes{nil, attrs} {
+			expected := EvaluateWithAttributes(permissions, "schedule:write", a)
+			decision, _ := Explain(permissions, "schedule:write", a)
+			if decision != expected {
+				t.Errorf("Explain(%v) = %s, EvaluateWithAttributes = %s", permissions, decision, expected)
+			}
+		}
+	}
+}
+
+// TestExplainReturnsAllMatches проверяет, что в объяснение попадают все покрывающие права
+func TestExplainReturnsAllMatches(t *testing.T) {
+	conditional := Allow("schedule", "write")
+	conditional.Condition = "subject.id == \"u1\""
+
+	permissions := []Permission{
+		Allow("room", "read"),
+		Allow("schedule", Wildcard),
+		conditional,
+		Deny("schedule", "write"),
+	}
+
+	decision, matches := Explain(permissions, "schedule:write", nil)
+
+	if decision != DecisionDeny {
+		t.Fatalf("decision = %s, want deny", decision)
+	}
+	if len(matches) != 3 {
+		t.Fatalf("matches = %d, want 3", len(matches))
+	}
+	if matches[0].Index != 1 || !matches[0].Applied {
+		t.Errorf("wildcard allow must be applied: %+v", matches[0])
+	}
+	if matches[1].Index != 2 || matches[1].Applied {
+		t.Errorf("conditional allow without attributes must not be applied: %+v", matches[1])
+	}
+	if matches[2].Index != 3 || !matches[2].Applied {
+		t.Errorf("deny must be applied: %+v", matches[2])
+	}
+}
@@ -0,0 +1,131 @@
+// Package authz содержит общую модель прав доступа и правила их вычисления.
+//
+// Используется RBAC, PermissionInterceptor и внешней аутентификацией (ext-auth),
+// чтобы права интерпретировались одинаково во всех сервисах.
+//
+// Строковый формат права: "resource:action". Любая из частей может быть
+// шаблоном "*" (schedule:*, *:read, *:*). Явный запрет записывается с
+// префиксом "!" (!schedule:write) и имеет приоритет над любыми разрешениями.
+//
+// Право может содержать условие (см. пакет condition), вычисляемое по атрибутам
+// запроса. Условное право без атрибутов трактуется осторожно: разрешение не
+// применяется, запрет применяется.
+package authz
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Wildcard шаблон, совпадающий с любым ресурсом или действием
+	Wildcard = "*"
+	// Separator разделитель ресурса и действия
+	Separator = ":"
+	// DenyPrefix префикс явного запрета в строковом представлении
+	DenyPrefix = "!"
+)
+
+// Effect эффект права доступа
+type Effect int
+
+const (
+	// EffectAllow разрешение
+	EffectAllow Effect = iota
+	// EffectDeny явный запрет
+	EffectDeny
+)
+
+// String возвращает текстовое представление эффекта
+func (e Effect) String() string {
+	if e == EffectDeny {
+		return "deny"
+	}
+	return "allow"
+}
+
+// MarshalText сериализует эффект как "allow" или "deny"
+func (e Effect) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// Permission право доступа с эффектом и необязательным условием
+type Permission struct {
+	Resource  string
+	Action    string
+	Effect    Effect
+	Condition string
+}
+
+// Allow создает разрешение
+func Allow(resource, action string) Permission {
+	return Permission{Resource: resource, Action: action, Effect: EffectAllow}
+}
+
+// Deny создает явный запрет
+func Deny(resource, action string) Permission {
+	return Permission{Resource: resource, Action: action, Effect: EffectDeny}
+}
+
+// Parse разбирает строковое представление права ("schedule:read", "!schedule:*")
+func Parse(s string) (Permission, error) {
+	s = strings.TrimSpace(s)
+
+	effect := EffectAllow
+	if strings.HasPrefix(s, DenyPrefix) {
+		effect = EffectDeny
+		s = strings.TrimPrefix(s, DenyPrefix)
+	}
+
+	resource, action, ok := strings.Cut(s, Separator)
+	if !ok || resource == "" || action == "" || strings.Contains(action, Separator) {
+		return Permission{}, fmt.Errorf("invalid permission %q: expected resource:action", s)
+	}
+
+	return Permission{Resource: resource, Action: action, Effect: effect}, nil
+}
+
+// ParseList разбирает список строковых прав, пропуская некорректные
+func ParseList(items []string) []Permission {
+	result := make([]Permission, 0, len(items))
+	for _, item := range items {
+		permission, err := Parse(item)
+		if err != nil {
+			continue
+		}
+		result = append(result, permission)
+	}
+	return result
+}
+
+// Key возвращает "resource:action" без учета эффекта
+func (p Permission) Key() string {
+	return p.Resource + Separator + p.Action
+}
+
+// String возвращает строковое представление права с учетом эффекта
+func (p Permission) String() string {
+	if p.Effect == EffectDeny {
+		return DenyPrefix + p.Key()
+	}
+	return p.Key()
+}
+
+// IsConditional возвращает true, если право действует только при выполнении условия
+func (p Permission) IsConditional() bool {
+	return strings.TrimSpace(p.Condition) != ""
+}
+
+// IsWildcard возвращает true, если право содержит шаблон
+func (p Permission) IsWildcard() bool {
+	return p.Resource == Wildcard || p.Action == Wildcard
+}
+
+// Matches проверяет, покрывает ли право указанные ресурс и действие
+func (p Permission) Matches(resource, action string) bool {
+	return matchPart(p.Resource, resource) && matchPart(p.Action, action)
+}
+
+func matchPart(pattern, value string) bool {
+	return pattern == Wildcard || pattern == value
+}
@@ -0,0 +1,45 @@
+package authz
+
+import (
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+// FromProto преобразует protobuf право в Permission
+func FromProto(p *commonV1.Permission) Permission {
+	return Permission{
+		Resource:  p.GetResource(),
+		Action:    p.GetAction(),
+		Effect:    EffectFromProto(p.GetEffect()),
+		Condition: p.GetCondition(),
+	}
+}
+
+// FromRoles собирает права всех ролей пользователя
+func FromRoles(roles []*commonV1.RoleWithPermissions) []Permission {
+	result := make([]Permission, 0)
+	for _, role := range roles {
+		for _, permission := range role.GetPermissions() {
+			if permission == nil {
+				continue
+			}
+			result = append(result, FromProto(permission))
+		}
+	}
+	return result
+}
+
+// EffectFromProto преобразует protobuf эффект (UNSPECIFIED трактуется как разрешение)
+func EffectFromProto(effect commonV1.PermissionEffect) Effect {
+	if effect == commonV1.PermissionEffect_PERMISSION_EFFECT_DENY {
+		return EffectDeny
+	}
+	return EffectAllow
+}
+
+// EffectToProto преобразует эффект в protobuf
+func EffectToProto(effect Effect) commonV1.PermissionEffect {
+	if effect == EffectDeny {
+		return commonV1.PermissionEffect_PERMISSION_EFFECT_DENY
+	}
+	return commonV1.PermissionEffect_PERMISSION_EFFECT_ALLOW
+}
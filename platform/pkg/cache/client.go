@@ -7,12 +7,22 @@ import (
 
 type RedisClient interface {
 	Set(ctx context.Context, key string, value any, ttl time.Duration) error
+	// SetMany записывает значения с общим TTL одним конвейером (pipeline)
+	SetMany(ctx context.Context, values map[string]any, ttl time.Duration) error
 	Get(ctx context.Context, key string) ([]byte, error)
+	// MGet возвращает значения в порядке ключей; для отсутствующих ключей — nil.
+	// Ключи читаются одним конвейером GET, поэтому в Redis Cluster могут находиться в разных слотах.
+	MGet(ctx context.Context, keys ...string) ([][]byte, error)
 	Del(ctx context.Context, key string) error
+	Incr(ctx context.Context, key string) (int64, error)
 	Ping(ctx context.Context) error
 
 	// Hash operations
 	HSet(ctx context.Context, key string, values map[string]interface{}) error
 	HGetAll(ctx context.Context, key string) (map[string]string, error)
 	Expire(ctx context.Context, key string, ttl time.Duration) error
+
+	// Eval выполняет Lua-скрипт атомарно (через EVALSHA с откатом на EVAL).
+	// В Redis Cluster все ключи скрипта должны находиться в одном слоте.
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
 }
@@ -3,6 +3,7 @@ package redis
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -15,6 +16,8 @@ type client struct {
 	rdb     redis.Cmdable
 	logger  Logger
 	timeout time.Duration
+	// scripts кэш подготовленных Lua-скриптов (текст → *redis.Script с вычисленным SHA1)
+	scripts sync.Map
 }
 
 type Logger interface {
@@ -37,6 +40,23 @@ func (c *client) Set(ctx context.Context, key string, value any, ttl time.Durati
 	return c.rdb.Set(ctx, key, value, ttl).Err()
 }
 
+func (c *client) SetMany(ctx context.Context, values map[string]any, ttl time.Duration) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range values {
+			pipe.Set(ctx, key, value, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
 func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
@@ -47,12 +67,53 @@ func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
 	return data, err
 }
 
+func (c *client) MGet(ctx context.Context, keys ...string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	// Конвейер вместо MGET: ClusterClient распределяет команды по узлам слотов
+	cmds := make([]*redis.StringCmd, len(keys))
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.Get(ctx, key)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	result := make([][]byte, len(keys))
+	for i, cmd := range cmds {
+		data, err := cmd.Bytes()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result[i] = data
+	}
+
+	return result, nil
+}
+
 func (c *client) Del(ctx context.Context, key string) error {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 	return c.rdb.Del(ctx, key).Err()
 }
 
+func (c *client) Incr(ctx context.Context, key string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.rdb.Incr(ctx, key).Result()
+}
+
 func (c *client) Ping(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
@@ -76,3 +137,16 @@ func (c *client) Expire(ctx context.Context, key string, ttl time.Duration) erro
 	defer cancel()
 	return c.rdb.Expire(ctx, key, ttl).Err()
 }
+
+func (c *client) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	cached, ok := c.scripts.Load(script)
+	if !ok {
+		cached, _ = c.scripts.LoadOrStore(script, redis.NewScript(script))
+	}
+
+	// Run сначала пробует EVALSHA и при NOSCRIPT повторяет через EVAL
+	return cached.(*redis.Script).Run(ctx, c.rdb, keys, args...).Result()
+}
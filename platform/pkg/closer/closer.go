@@ -7,6 +7,7 @@
 // - Thread-safe операции для concurrent использования
 // - Автоматическая обработка системных сигналов
 // - Защита от паник в функциях завершения
+// - Хуки начала остановки (например, перевод readiness в NOT_SERVING)
 //
 // Пример использования:
 //
@@ -59,6 +60,7 @@ type Closer struct {
 	once   sync.Once                     // Гарантия однократного вызова CloseAll
 	done   chan struct{}                 // Канал для оповещения о завершении
 	funcs  []func(context.Context) error // Зарегистрированные функции закрытия
+	hooks  []func(context.Context)       // Хуки, вызываемые до функций закрытия
 	logger Logger                        // Используемый логгер
 }
 
@@ -79,6 +81,11 @@ func Add(f ...func(context.Context) error) {
 	globalCloser.Add(f...)
 }
 
+// OnShutdown добавляет хук начала остановки в глобальный closer
+func OnShutdown(f func(context.Context)) {
+	globalCloser.OnShutdown(f)
+}
+
 // CloseAll инициирует процесс закрытия всех зарегистрированных функций глобального closer'а
 func CloseAll(ctx context.Context) error {
 	return globalCloser.CloseAll(ctx)
@@ -166,6 +173,14 @@ func (c *Closer) Add(f ...func(context.Context) error) {
 	c.funcs = append(c.funcs, f...)
 }
 
+// OnShutdown добавляет хук, который синхронно вызывается в начале CloseAll,
+// до параллельного выполнения функций закрытия
+func (c *Closer) OnShutdown(f func(context.Context)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, f)
+}
+
 // CloseAll вызывает все зарегистрированные функции закрытия.
 // Возвращает первую возникшую ошибку, если таковая была.
 func (c *Closer) CloseAll(ctx context.Context) error {
@@ -176,9 +191,15 @@ func (c *Closer) CloseAll(ctx context.Context) error {
 
 		c.mu.Lock()
 		funcs := c.funcs
+		hooks := c.hooks
 		c.funcs = nil // освободим память
+		c.hooks = nil
 		c.mu.Unlock()
 
+		for _, hook := range hooks {
+			hook(ctx)
+		}
+
 		if len(funcs) == 0 {
 			c.logger.Info(ctx, "ℹ️ Нет функций для закрытия.")
 			return
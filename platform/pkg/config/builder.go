@@ -4,12 +4,15 @@ import (
 	"fmt"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/app"
+	gatewaymodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/gateway"
 	grpcmodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/grpc"
+	idempotencymodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/idempotency"
 	kafkamodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/kafka"
 	loggermodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/logger"
 	metricmodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/metric"
 	mongomodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/mongo"
 	postgresmodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/postgres"
+	ratelimitmodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/ratelimit"
 	redismodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/redis"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/services"
 	sessionmodule "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/session"
@@ -30,6 +33,12 @@ func buildModularConfig() (*config, error) {
 		return nil, fmt.Errorf("initializing grpc module: %w", err)
 	}
 
+	// Gateway модуль (HTTP/JSON шлюз)
+	gatewayCfg, err := gatewaymodule.New()
+	if err != nil {
+		return nil, fmt.Errorf("initializing gateway module: %w", err)
+	}
+
 	// App модуль (приложение)
 	appCfg, err := app.New()
 	if err != nil {
@@ -72,6 +81,18 @@ func buildModularConfig() (*config, error) {
 		return nil, fmt.Errorf("initializing redis module: %w", err)
 	}
 
+	// Rate limit модуль (ограничение частоты запросов)
+	rateLimitCfg, err := ratelimitmodule.New()
+	if err != nil {
+		return nil, fmt.Errorf("initializing rate limit module: %w", err)
+	}
+
+	// Idempotency модуль (ключи идемпотентности)
+	idempotencyCfg, err := idempotencymodule.New()
+	if err != nil {
+		return nil, fmt.Errorf("initializing idempotency module: %w", err)
+	}
+
 	// Session модуль (конфигурация сессий)
 	sessionCfg, err := sessionmodule.New()
 	if err != nil {
@@ -91,16 +112,19 @@ func buildModularConfig() (*config, error) {
 	}
 
 	return &config{
-		grpcConfig:     grpcCfg,
-		appConfig:      appCfg,
-		loggerConfig:   loggerCfg,
-		postgresConfig: postgresCfg,
-		mongoConfig:    mongoCfg,
-		servicesConfig: servicesCfg,
-		kafkaConfig:    kafkaCfg,
-		redisConfig:    redisCfg,
-		sessionConfig:  sessionCfg,
-		tracingConfig:  tracingCfg,
-		metricConfig:   metricCfg,
+		grpcConfig:        grpcCfg,
+		gatewayConfig:     gatewayCfg,
+		appConfig:         appCfg,
+		loggerConfig:      loggerCfg,
+		postgresConfig:    postgresCfg,
+		mongoConfig:       mongoCfg,
+		servicesConfig:    servicesCfg,
+		kafkaConfig:       kafkaCfg,
+		redisConfig:       redisCfg,
+		rateLimitConfig:   rateLimitCfg,
+		idempotencyConfig: idempotencyCfg,
+		sessionConfig:     sessionCfg,
+		tracingConfig:     tracingCfg,
+		metricConfig:      metricCfg,
 	}, nil
 }
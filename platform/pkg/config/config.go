@@ -11,9 +11,12 @@
 // - App:       Базовые настройки сервиса (имя, версия, окружение)
 // - Logger:    Логирование с OpenTelemetry интеграцией
 // - GRPC:      gRPC сервер и клиентские настройки
+// - Gateway:   HTTP/JSON шлюз (grpc-gateway) без Envoy
 // - Postgres:  PostgreSQL Primary-Replica архитектура
 // - Mongo:     MongoDB Primary-Replica архитектура
 // - Redis:     Redis Cluster (3 шарда + 3 реплики)
+// - RateLimit: Ограничение частоты вызовов gRPC-методов
+// - Idempotency: Ключи идемпотентности мутирующих методов
 // - Services:  Внешние микросервисы
 // - Kafka:     Apache Kafka брокеры, consumers, producers
 // - Telegram:  Telegram Bot интеграция
@@ -63,17 +66,20 @@ import (
 // Каждое поле представляет отдельный модуль с собственной областью ответственности.
 // Использует интерфейсы для обеспечения инкапсуляции и возможности замены реализаций.
 type config struct {
-	grpcConfig     contracts.GRPCConfig     // gRPC конфигурация
-	appConfig      contracts.AppConfig      // Приложение
-	loggerConfig   contracts.LoggerConfig   // Логирование
-	postgresConfig contracts.PostgresConfig // PostgreSQL база данных
-	mongoConfig    contracts.MongoConfig    // MongoDB база данных
-	servicesConfig contracts.ServicesConfig // Внешние сервисы
-	kafkaConfig    contracts.KafkaConfig    // Apache Kafka
-	redisConfig    contracts.RedisConfig    // Redis кэш и сессии
-	sessionConfig  contracts.SessionConfig  // Конфигурация сессий
-	tracingConfig  contracts.TracingConfig  // Трейсинг OpenTelemetry
-	metricConfig   contracts.MetricConfig   // Метрики OpenTelemetry
+	grpcConfig        contracts.GRPCConfig        // gRPC конфигурация
+	gatewayConfig     contracts.GatewayConfig     // HTTP/JSON шлюз
+	appConfig         contracts.AppConfig         // Приложение
+	loggerConfig      contracts.LoggerConfig      // Логирование
+	postgresConfig    contracts.PostgresConfig    // PostgreSQL база данных
+	mongoConfig       contracts.MongoConfig       // MongoDB база данных
+	servicesConfig    contracts.ServicesConfig    // Внешние сервисы
+	kafkaConfig       contracts.KafkaConfig       // Apache Kafka
+	redisConfig       contracts.RedisConfig       // Redis кэш и сессии
+	rateLimitConfig   contracts.RateLimitConfig   // Ограничение частоты запросов
+	idempotencyConfig contracts.IdempotencyConfig // Ключи идемпотентности
+	sessionConfig     contracts.SessionConfig     // Конфигурация сессий
+	tracingConfig     contracts.TracingConfig     // Трейсинг OpenTelemetry
+	metricConfig      contracts.MetricConfig      // Метрики OpenTelemetry
 }
 
 // =============================================================================
@@ -141,6 +147,11 @@ func (c *config) GRPC() contracts.GRPCConfig {
 	return c.grpcConfig
 }
 
+// Gateway возвращает конфигурацию HTTP/JSON шлюза
+func (c *config) Gateway() contracts.GatewayConfig {
+	return c.gatewayConfig
+}
+
 // Logger возвращает модуль логирования
 func (c *config) Logger() contracts.LoggerConfig {
 	return c.loggerConfig
@@ -170,6 +181,16 @@ func (c *config) Redis() contracts.RedisConfig {
 	return c.redisConfig
 }
 
+// RateLimit возвращает конфигурацию ограничения частоты запросов
+func (c *config) RateLimit() contracts.RateLimitConfig {
+	return c.rateLimitConfig
+}
+
+// Idempotency возвращает конфигурацию ключей идемпотентности
+func (c *config) Idempotency() contracts.IdempotencyConfig {
+	return c.idempotencyConfig
+}
+
 // Session возвращает конфигурацию сессий
 func (c *config) Session() contracts.SessionConfig {
 	return c.sessionConfig
@@ -0,0 +1,19 @@
+package contracts
+
+import "time"
+
+// GatewayConfig описывает встроенный HTTP/JSON шлюз (grpc-gateway) сервиса.
+// Шлюз опционален и предназначен для локальной разработки и тестов без Envoy.
+type GatewayConfig interface {
+	// IsEnabled возвращает true, если HTTP шлюз нужно запустить
+	IsEnabled() bool
+
+	// Address возвращает адрес HTTP сервера шлюза (host:port)
+	Address() string
+
+	// ReadHeaderTimeout ограничивает время чтения заголовков запроса
+	ReadHeaderTimeout() time.Duration
+
+	// ShutdownTimeout ограничивает время graceful shutdown HTTP сервера
+	ShutdownTimeout() time.Duration
+}
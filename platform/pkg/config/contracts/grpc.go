@@ -9,6 +9,13 @@ type GRPCConfig interface {
 	Timeout() time.Duration
 	IdleTimeout() time.Duration
 	ShutdownTimeout() time.Duration
+	TLS() TLSConfig
+	// TrustedPeers права сервисов, вызывающих сервер без пользовательской сессии,
+	// по идентичности mTLS-собеседника (SPIFFE ID или CN)
+	TrustedPeers() map[string][]string
+	// ServiceTokens идентичности доверенных сервисов по токену сервиса (заголовок x-service-token)
+	// для вызовов без mTLS; права сервиса берутся из TrustedPeers
+	ServiceTokens() map[string]string
 
 	// Настройки клиента
 	MaxRecvMsgSize() int
@@ -0,0 +1,22 @@
+package contracts
+
+import "time"
+
+// IdempotencyConfig описывает хранение результатов запросов с заголовком idempotency-key.
+// Ключ принимают только методы с аннотацией (common.v1.idempotency_key).
+type IdempotencyConfig interface {
+	// IsEnabled возвращает true, если ключи идемпотентности обрабатываются
+	IsEnabled() bool
+
+	// KeyPrefix префикс записей в Redis
+	KeyPrefix() string
+
+	// TTL время хранения результата запроса
+	TTL() time.Duration
+
+	// LockTimeout время резервирования ключа выполняющимся запросом
+	LockTimeout() time.Duration
+
+	// FailOpen выполнять запросы без защиты от повторов, когда хранилище недоступно
+	FailOpen() bool
+}
@@ -28,8 +28,11 @@ package contracts
 // Доступные модули:
 //   - App(): приложение и логирование
 //   - GRPC(): gRPC транспорт
+//   - Gateway(): HTTP/JSON шлюз
 //   - Database(): агрегация баз данных (PostgreSQL, MongoDB)
 //   - Redis(): конфигурация Redis кэша
+//   - RateLimit(): ограничение частоты запросов
+//   - Idempotency(): ключи идемпотентности
 //   - Services(): внешние сервисы
 //   - Kafka(): конфигурация Apache Kafka
 //
@@ -50,6 +53,9 @@ type Provider interface {
 	// GRPC возвращает gRPC конфигурацию
 	GRPC() GRPCConfig
 
+	// Gateway возвращает конфигурацию HTTP/JSON шлюза
+	Gateway() GatewayConfig
+
 	// Logger возвращает модуль логирования
 	Logger() LoggerConfig
 
@@ -65,6 +71,12 @@ type Provider interface {
 	// Redis возвращает конфигурацию Redis кэша
 	Redis() RedisConfig
 
+	// RateLimit возвращает конфигурацию ограничения частоты запросов
+	RateLimit() RateLimitConfig
+
+	// Idempotency возвращает конфигурацию ключей идемпотентности
+	Idempotency() IdempotencyConfig
+
 	// Kafka возвращает конфигурацию Apache Kafka
 	Kafka() KafkaConfig
 
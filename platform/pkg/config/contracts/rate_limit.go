@@ -0,0 +1,38 @@
+package contracts
+
+import "time"
+
+// RateLimitConfig описывает ограничение частоты вызовов gRPC-методов сервиса.
+// Правило метода берётся из конфигурации, затем из аннотации (common.v1.rate_limit),
+// иначе применяется лимит по умолчанию.
+type RateLimitConfig interface {
+	// IsEnabled возвращает true, если ограничение частоты включено
+	IsEnabled() bool
+
+	// KeyPrefix префикс ключей корзин в Redis
+	KeyPrefix() string
+
+	// FailOpen пропускать запросы, когда хранилище лимитов недоступно
+	FailOpen() bool
+
+	// Default лимит для методов без собственного правила (Requests() == 0 - без ограничения)
+	Default() RateLimitRule
+
+	// Methods возвращает правила из конфигурации по полному имени метода (/pkg.Service/Method)
+	Methods() map[string]RateLimitRule
+}
+
+// RateLimitRule описывает лимит token bucket для метода
+type RateLimitRule interface {
+	// Requests количество запросов за период
+	Requests() int
+
+	// Period длительность периода
+	Period() time.Duration
+
+	// Burst емкость корзины (0 - равна Requests)
+	Burst() int
+
+	// Key признак, по которому считаются запросы: user, session, ip, api_key
+	Key() string
+}
@@ -14,4 +14,43 @@ type ServiceConfig interface {
 	Port() int
 	Timeout() time.Duration
 	Address() string
+	TLS() TLSConfig
+	// Token токен сервиса для вызовов службы без mTLS (заголовок x-service-token);
+	// пустое значение - токен не передается
+	Token() string
+
+	// Устойчивость клиента к сбоям службы
+	Retry() RetryConfig
+	CircuitBreaker() CircuitBreakerConfig
+	Hedging() HedgingConfig
+}
+
+// RetryConfig описывает повтор запросов к службе.
+// Повторяются только идемпотентные методы (idempotency_level в proto).
+type RetryConfig interface {
+	Enabled() bool
+	MaxAttempts() int
+	InitialBackoff() time.Duration
+	MaxBackoff() time.Duration
+	// Jitter доля случайного отклонения задержки (0..1)
+	Jitter() float64
+}
+
+// CircuitBreakerConfig описывает автоматический выключатель для службы.
+type CircuitBreakerConfig interface {
+	Enabled() bool
+	// FailureThreshold число подряд идущих сбоев для размыкания
+	FailureThreshold() int
+	// OpenTimeout время в разомкнутом состоянии до пробных запросов
+	OpenTimeout() time.Duration
+	// HalfOpenMaxRequests число одновременных пробных запросов
+	HalfOpenMaxRequests() int
+}
+
+// HedgingConfig описывает опережающие (hedged) запросы к службе.
+type HedgingConfig interface {
+	Enabled() bool
+	MaxAttempts() int
+	// Delay задержка перед отправкой очередной копии запроса
+	Delay() time.Duration
 }
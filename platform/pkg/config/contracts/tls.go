@@ -0,0 +1,22 @@
+package contracts
+
+import "time"
+
+// TLSConfig описывает TLS/mTLS для gRPC сервера или клиента.
+type TLSConfig interface {
+	Enabled() bool
+	// CertFile и KeyFile собственный сертификат (для клиента - сертификат mTLS)
+	CertFile() string
+	KeyFile() string
+	// CAFile бандл доверенных CA для проверки собеседника
+	CAFile() string
+	// RequireClientCert требовать сертификат клиента (mTLS, только сервер)
+	RequireClientCert() bool
+	// AllowedPeerIDs допустимые SPIFFE ID собеседника (spiffe://trust-domain/path);
+	// пустой список - достаточно проверки цепочки (и имени хоста для клиента)
+	AllowedPeerIDs() []string
+	// ServerName имя сервера для проверки сертификата (только клиент)
+	ServerName() string
+	// ReloadInterval как часто проверять сертификаты на диске на обновление
+	ReloadInterval() time.Duration
+}
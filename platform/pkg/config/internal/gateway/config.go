@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+)
+
+// Компиляционная проверка
+var _ contracts.GatewayConfig = (*Config)(nil)
+
+// rawConfig для загрузки данных из YAML/ENV
+type rawConfig struct {
+	Enabled           bool          `mapstructure:"enabled"             yaml:"enabled"             env:"GATEWAY_ENABLED"`
+	Host              string        `mapstructure:"host"                yaml:"host"                env:"GATEWAY_HOST"`
+	Port              int           `mapstructure:"port"                yaml:"port"                env:"GATEWAY_PORT"`
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout" yaml:"read_header_timeout" env:"GATEWAY_READ_HEADER_TIMEOUT"`
+	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"    yaml:"shutdown_timeout"    env:"GATEWAY_SHUTDOWN_TIMEOUT"`
+}
+
+// Config публичная структура для использования
+type Config struct {
+	raw rawConfig
+}
+
+// defaultConfig возвращает rawConfig с дефолтными значениями: шлюз выключен
+func defaultConfig() rawConfig {
+	return rawConfig{
+		Enabled:           false,
+		Host:              "localhost",
+		Port:              8081,
+		ReadHeaderTimeout: 5 * time.Second,
+		ShutdownTimeout:   10 * time.Second,
+	}
+}
+
+func (c *Config) IsEnabled() bool                  { return c.raw.Enabled }
+func (c *Config) Address() string                  { return net.JoinHostPort(c.raw.Host, strconv.Itoa(c.raw.Port)) }
+func (c *Config) ReadHeaderTimeout() time.Duration { return c.raw.ReadHeaderTimeout }
+func (c *Config) ShutdownTimeout() time.Duration   { return c.raw.ShutdownTimeout }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/tlsconf"
 )
 
 // rawConfig для загрузки данных из YAML/ENV
@@ -16,6 +17,8 @@ type rawConfig struct {
 	Timeout         time.Duration `mapstructure:"timeout"            yaml:"timeout"              env:"GRPC_TIMEOUT"`
 	IdleTimeout     time.Duration `mapstructure:"idle_timeout"       yaml:"idle_timeout"         env:"GRPC_IDLE_TIMEOUT"`
 	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"   yaml:"shutdown_timeout"     env:"GRPC_SHUTDOWN_TIMEOUT"`
+	TLS             tlsconf.Raw   `mapstructure:"tls"                yaml:"tls"`
+	TrustedPeers    []rawPeer     `mapstructure:"trusted_peers"      yaml:"trusted_peers"`
 
 	// Настройки клиента
 	MaxRecvMsgSize int           `mapstructure:"max_recv_msg_size" yaml:"max_recv_msg_size" env:"GRPC_MAX_REC_MSG_SIZE"`
@@ -23,6 +26,14 @@ type rawConfig struct {
 	ClientTimeout  time.Duration `mapstructure:"client_timeout"    yaml:"client_timeout"    env:"GRPC_CLIENT_TIMEOUT"`
 }
 
+// rawPeer доверенный сервис и выданные ему права. Token подтверждает сервис без mTLS
+// и задается через подстановку переменной окружения (${IAM_SERVICE_TOKEN})
+type rawPeer struct {
+	ID          string   `mapstructure:"id"          yaml:"id"`
+	Token       string   `mapstructure:"token"       yaml:"token"`
+	Permissions []string `mapstructure:"permissions" yaml:"permissions"`
+}
+
 // Config публичная структура для использования
 type Config struct {
 	raw rawConfig
@@ -40,6 +51,7 @@ func defaultConfig() rawConfig {
 		Timeout:         30 * time.Second,
 		IdleTimeout:     120 * time.Second,
 		ShutdownTimeout: 10 * time.Second,
+		TLS:             tlsconf.Default(),
 
 		// Клиент
 		MaxRecvMsgSize: 4 * 1024 * 1024, // 4MB
@@ -53,6 +65,27 @@ func (c *Config) Address() string                { return net.JoinHostPort(c.raw
 func (c *Config) Timeout() time.Duration         { return c.raw.Timeout }
 func (c *Config) IdleTimeout() time.Duration     { return c.raw.IdleTimeout }
 func (c *Config) ShutdownTimeout() time.Duration { return c.raw.ShutdownTimeout }
+func (c *Config) TLS() contracts.TLSConfig       { return &c.raw.TLS }
+
+func (c *Config) TrustedPeers() map[string][]string {
+	peers := make(map[string][]string, len(c.raw.TrustedPeers))
+	for _, p := range c.raw.TrustedPeers {
+		if p.ID != "" {
+			peers[p.ID] = append(peers[p.ID], p.Permissions...)
+		}
+	}
+	return peers
+}
+
+func (c *Config) ServiceTokens() map[string]string {
+	tokens := make(map[string]string, len(c.raw.TrustedPeers))
+	for _, p := range c.raw.TrustedPeers {
+		if p.ID != "" && p.Token != "" {
+			tokens[p.Token] = p.ID
+		}
+	}
+	return tokens
+}
 
 // Методы клиента
 func (c *Config) MaxRecvMsgSize() int          { return c.raw.MaxRecvMsgSize }
@@ -0,0 +1,42 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+)
+
+// Компиляционная проверка
+var _ contracts.IdempotencyConfig = (*Config)(nil)
+
+// rawConfig для загрузки данных из YAML/ENV
+type rawConfig struct {
+	Enabled     bool          `mapstructure:"enabled"      yaml:"enabled"      env:"IDEMPOTENCY_ENABLED"`
+	Prefix      string        `mapstructure:"key_prefix"   yaml:"key_prefix"   env:"IDEMPOTENCY_KEY_PREFIX"`
+	TTL         time.Duration `mapstructure:"ttl"          yaml:"ttl"          env:"IDEMPOTENCY_TTL"`
+	LockTimeout time.Duration `mapstructure:"lock_timeout" yaml:"lock_timeout" env:"IDEMPOTENCY_LOCK_TIMEOUT"`
+	FailOpen    bool          `mapstructure:"fail_open"    yaml:"fail_open"    env:"IDEMPOTENCY_FAIL_OPEN"`
+}
+
+// Config публичная структура для использования
+type Config struct {
+	raw rawConfig
+}
+
+// defaultConfig возвращает rawConfig с дефолтными значениями: обработка выключена,
+// результат хранится сутки, сбой Redis не блокирует запросы
+func defaultConfig() rawConfig {
+	return rawConfig{
+		Enabled:     false,
+		Prefix:      "idempotency:",
+		TTL:         24 * time.Hour,
+		LockTimeout: time.Minute,
+		FailOpen:    true,
+	}
+}
+
+func (c *Config) IsEnabled() bool            { return c.raw.Enabled }
+func (c *Config) KeyPrefix() string          { return c.raw.Prefix }
+func (c *Config) TTL() time.Duration         { return c.raw.TTL }
+func (c *Config) LockTimeout() time.Duration { return c.raw.LockTimeout }
+func (c *Config) FailOpen() bool             { return c.raw.FailOpen }
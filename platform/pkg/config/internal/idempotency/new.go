@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/helpers"
+)
+
+// New создает конфигурацию ключей идемпотентности по стратегии: Defaults → YAML → ENV
+func New() (contracts.IdempotencyConfig, error) {
+	// 1. Создаем конфигурацию с дефолтными значениями
+	cfg := &Config{
+		raw: defaultConfig(),
+	}
+
+	// 2. Перезаписываем YAML'ом (если есть)
+	if section := helpers.GetSection("idempotency"); section != nil {
+		if err := section.Unmarshal(&cfg.raw); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal idempotency YAML: %w", err)
+		}
+	}
+
+	// 3. Перезаписываем ENV переменными (финальный приоритет)
+	if err := env.Parse(&cfg.raw); err != nil {
+		return nil, fmt.Errorf("failed to parse idempotency ENV: %w", err)
+	}
+
+	// 4. Резерв ключа не должен переживать сохранённый результат
+	if cfg.raw.TTL <= 0 || cfg.raw.LockTimeout <= 0 || cfg.raw.LockTimeout > cfg.raw.TTL {
+		return nil, fmt.Errorf("idempotency: ttl and lock_timeout must be positive, lock_timeout <= ttl")
+	}
+
+	return cfg, nil
+}
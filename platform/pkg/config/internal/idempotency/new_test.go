@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/helpers"
+)
+
+func TestNew_DefaultsAndENV(t *testing.T) {
+	helpers.Reset()
+	t.Setenv("IDEMPOTENCY_ENABLED", "true")
+	t.Setenv("IDEMPOTENCY_TTL", "2h")
+
+	if err := helpers.InitViper(""); err != nil {
+		t.Fatalf("Failed to init viper: %v", err)
+	}
+
+	cfg, err := New()
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	if !cfg.IsEnabled() || !cfg.FailOpen() || cfg.KeyPrefix() != "idempotency:" {
+		t.Errorf("unexpected flags: enabled=%v failOpen=%v prefix=%q", cfg.IsEnabled(), cfg.FailOpen(), cfg.KeyPrefix())
+	}
+	if cfg.TTL() != 2*time.Hour || cfg.LockTimeout() != time.Minute {
+		t.Errorf("unexpected durations: ttl=%v lock=%v", cfg.TTL(), cfg.LockTimeout())
+	}
+}
+
+func TestNew_LockLongerThanTTL(t *testing.T) {
+	helpers.Reset()
+	t.Setenv("IDEMPOTENCY_TTL", "30s")
+
+	if err := helpers.InitViper(""); err != nil {
+		t.Fatalf("Failed to init viper: %v", err)
+	}
+
+	if _, err := New(); err == nil {
+		t.Fatal("New() must reject lock_timeout longer than ttl")
+	}
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+)
+
+// Компиляционные проверки
+var (
+	_ contracts.RateLimitConfig = (*Config)(nil)
+	_ contracts.RateLimitRule   = (*rawRule)(nil)
+)
+
+// rawRule лимит метода (секция rate_limit.default или элемент rate_limit.methods)
+type rawRule struct {
+	FullMethod string        `mapstructure:"method"   yaml:"method"`
+	Limit      int           `mapstructure:"requests" yaml:"requests" env:"REQUESTS"`
+	Window     time.Duration `mapstructure:"period"   yaml:"period"   env:"PERIOD"`
+	Capacity   int           `mapstructure:"burst"    yaml:"burst"    env:"BURST"`
+	KeyKind    string        `mapstructure:"key"      yaml:"key"      env:"KEY"`
+}
+
+// rawConfig для загрузки данных из YAML/ENV.
+// Правила методов задаются списком: имя метода содержит точки и не может быть ключом YAML
+type rawConfig struct {
+	Enabled  bool      `mapstructure:"enabled"    yaml:"enabled"    env:"RATE_LIMIT_ENABLED"`
+	Prefix   string    `mapstructure:"key_prefix" yaml:"key_prefix" env:"RATE_LIMIT_KEY_PREFIX"`
+	FailOpen bool      `mapstructure:"fail_open"  yaml:"fail_open"  env:"RATE_LIMIT_FAIL_OPEN"`
+	Default  rawRule   `mapstructure:"default"    yaml:"default"    envPrefix:"RATE_LIMIT_DEFAULT_"`
+	Methods  []rawRule `mapstructure:"methods"    yaml:"methods"`
+}
+
+// Config публичная структура для использования
+type Config struct {
+	raw     rawConfig
+	methods map[string]*rawRule
+}
+
+// defaultConfig возвращает rawConfig с дефолтными значениями: ограничение выключено,
+// при включении действуют только правила методов, сбой Redis не блокирует запросы
+func defaultConfig() rawConfig {
+	return rawConfig{
+		Enabled:  false,
+		Prefix:   "rate_limit:",
+		FailOpen: true,
+		Default: rawRule{
+			Window:  time.Minute,
+			KeyKind: "user",
+		},
+	}
+}
+
+func (c *Config) IsEnabled() bool                  { return c.raw.Enabled }
+func (c *Config) KeyPrefix() string                { return c.raw.Prefix }
+func (c *Config) FailOpen() bool                   { return c.raw.FailOpen }
+func (c *Config) Default() contracts.RateLimitRule { return &c.raw.Default }
+
+func (c *Config) Methods() map[string]contracts.RateLimitRule {
+	out := make(map[string]contracts.RateLimitRule, len(c.methods))
+	for name, rule := range c.methods {
+		out[name] = rule
+	}
+	return out
+}
+
+func (r *rawRule) Requests() int         { return r.Limit }
+func (r *rawRule) Period() time.Duration { return r.Window }
+func (r *rawRule) Burst() int            { return r.Capacity }
+func (r *rawRule) Key() string           { return r.KeyKind }
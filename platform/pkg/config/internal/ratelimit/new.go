@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/helpers"
+)
+
+// keyKinds допустимые признаки подсчёта запросов
+var keyKinds = map[string]bool{"": true, "user": true, "session": true, "ip": true, "api_key": true}
+
+// New создает конфигурацию ограничения частоты по стратегии: Defaults → YAML → ENV
+func New() (contracts.RateLimitConfig, error) {
+	// 1. Создаем конфигурацию с дефолтными значениями
+	cfg := &Config{
+		raw: defaultConfig(),
+	}
+
+	// 2. Перезаписываем YAML'ом (если есть)
+	if section := helpers.GetSection("rate_limit"); section != nil {
+		if err := section.Unmarshal(&cfg.raw); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rate_limit YAML: %w", err)
+		}
+	}
+
+	// 3. Перезаписываем ENV переменными (финальный приоритет)
+	if err := env.Parse(&cfg.raw); err != nil {
+		return nil, fmt.Errorf("failed to parse rate_limit ENV: %w", err)
+	}
+
+	// 4. Проверяем правила и индексируем их по имени метода
+	if err := validateRule(&cfg.raw.Default); err != nil {
+		return nil, fmt.Errorf("rate_limit.default: %w", err)
+	}
+
+	cfg.methods = make(map[string]*rawRule, len(cfg.raw.Methods))
+	for i := range cfg.raw.Methods {
+		rule := &cfg.raw.Methods[i]
+		if rule.FullMethod == "" {
+			return nil, fmt.Errorf("rate_limit.methods[%d]: method is required", i)
+		}
+		if err := validateRule(rule); err != nil {
+			return nil, fmt.Errorf("rate_limit.methods[%d] %s: %w", i, rule.FullMethod, err)
+		}
+		cfg.methods[rule.FullMethod] = rule
+	}
+
+	return cfg, nil
+}
+
+// validateRule проверяет признак подсчёта и период лимита
+func validateRule(rule *rawRule) error {
+	if !keyKinds[rule.KeyKind] {
+		return fmt.Errorf("unknown key %q (expected user, session, ip or api_key)", rule.KeyKind)
+	}
+	if rule.Limit > 0 && rule.Window <= 0 {
+		return fmt.Errorf("period must be positive")
+	}
+	return nil
+}
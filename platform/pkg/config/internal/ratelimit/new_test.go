@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/helpers"
+)
+
+func TestNew_DefaultsAndENV(t *testing.T) {
+	helpers.Reset()
+	t.Setenv("RATE_LIMIT_ENABLED", "true")
+	t.Setenv("RATE_LIMIT_DEFAULT_REQUESTS", "100")
+	t.Setenv("RATE_LIMIT_DEFAULT_KEY", "session")
+
+	if err := helpers.InitViper(""); err != nil {
+		t.Fatalf("Failed to init viper: %v", err)
+	}
+
+	cfg, err := New()
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	if !cfg.IsEnabled() || !cfg.FailOpen() || cfg.KeyPrefix() != "rate_limit:" {
+		t.Errorf("unexpected flags: enabled=%v failOpen=%v prefix=%q", cfg.IsEnabled(), cfg.FailOpen(), cfg.KeyPrefix())
+	}
+
+	def := cfg.Default()
+	if def.Requests() != 100 || def.Period() != time.Minute || def.Key() != "session" {
+		t.Errorf("unexpected default rule: %d/%v key=%s", def.Requests(), def.Period(), def.Key())
+	}
+
+	if len(cfg.Methods()) != 0 {
+		t.Error("no method rules expected without YAML")
+	}
+}
+
+func TestNew_UnknownKey(t *testing.T) {
+	helpers.Reset()
+	t.Setenv("RATE_LIMIT_DEFAULT_KEY", "tenant")
+
+	if err := helpers.InitViper(""); err != nil {
+		t.Fatalf("Failed to init viper: %v", err)
+	}
+
+	if _, err := New(); err == nil {
+		t.Fatal("New() must reject unknown key")
+	}
+}
@@ -15,20 +15,21 @@ func New() (contracts.ServicesConfig, error) {
 	}
 
 	// 2. Загружаем сервисы из YAML (если есть)
-	if section := helpers.GetSection("services"); section != nil {
-		var servicesMap map[string]rawServiceConfig
-		if err := section.Unmarshal(&servicesMap); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal services YAML: %w", err)
-		}
+	section := helpers.GetSection("services")
+	if section == nil {
+		return cfg, nil
+	}
 
-		// 3. Инициализируем сервисы из YAML данных
-		for name, rawSvc := range servicesMap {
-			// Применяем дефолтный таймаут если не задан
-			if rawSvc.Timeout == 0 {
-				rawSvc.Timeout = defaultService().Timeout
+	// 3. Каждый сервис читается поверх дефолтов, чтобы незаданные
+	// в YAML параметры (таймаут, повторы, выключатель) получили значения по умолчанию
+	for name := range section.AllSettings() {
+		rawSvc := defaultService()
+		if sub := section.Sub(name); sub != nil {
+			if err := sub.Unmarshal(&rawSvc); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal service %q YAML: %w", name, err)
 			}
-			cfg.services[name] = newServiceConfig(rawSvc)
 		}
+		cfg.services[name] = newServiceConfig(rawSvc)
 	}
 
 	return cfg, nil
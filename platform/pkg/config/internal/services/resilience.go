@@ -0,0 +1,83 @@
+package services
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+)
+
+// Компиляционные проверки
+var (
+	_ contracts.RetryConfig          = (*rawRetryConfig)(nil)
+	_ contracts.CircuitBreakerConfig = (*rawCircuitBreakerConfig)(nil)
+	_ contracts.HedgingConfig        = (*rawHedgingConfig)(nil)
+)
+
+// rawRetryConfig настройки повторов (секция services.<name>.retry)
+type rawRetryConfig struct {
+	IsEnabled      bool          `mapstructure:"enabled"         yaml:"enabled"`
+	Attempts       int           `mapstructure:"max_attempts"    yaml:"max_attempts"`
+	Initial        time.Duration `mapstructure:"initial_backoff" yaml:"initial_backoff"`
+	Max            time.Duration `mapstructure:"max_backoff"     yaml:"max_backoff"`
+	JitterFraction float64       `mapstructure:"jitter"          yaml:"jitter"`
+}
+
+// rawCircuitBreakerConfig настройки выключателя (секция services.<name>.circuit_breaker)
+type rawCircuitBreakerConfig struct {
+	IsEnabled        bool          `mapstructure:"enabled"                yaml:"enabled"`
+	Threshold        int           `mapstructure:"failure_threshold"      yaml:"failure_threshold"`
+	Open             time.Duration `mapstructure:"open_timeout"           yaml:"open_timeout"`
+	HalfOpenRequests int           `mapstructure:"half_open_max_requests" yaml:"half_open_max_requests"`
+}
+
+// rawHedgingConfig настройки опережающих запросов (секция services.<name>.hedging)
+type rawHedgingConfig struct {
+	IsEnabled bool          `mapstructure:"enabled"      yaml:"enabled"`
+	Attempts  int           `mapstructure:"max_attempts" yaml:"max_attempts"`
+	Wait      time.Duration `mapstructure:"delay"        yaml:"delay"`
+}
+
+// defaultRetry по умолчанию три попытки с экспоненциальной задержкой 100ms..1s
+func defaultRetry() rawRetryConfig {
+	return rawRetryConfig{
+		IsEnabled:      true,
+		Attempts:       3,
+		Initial:        100 * time.Millisecond,
+		Max:            time.Second,
+		JitterFraction: 0.2,
+	}
+}
+
+// defaultCircuitBreaker по умолчанию размыкание после 5 сбоев подряд на 10 секунд
+func defaultCircuitBreaker() rawCircuitBreakerConfig {
+	return rawCircuitBreakerConfig{
+		IsEnabled:        true,
+		Threshold:        5,
+		Open:             10 * time.Second,
+		HalfOpenRequests: 1,
+	}
+}
+
+// defaultHedging по умолчанию выключено: дублирует нагрузку на службу
+func defaultHedging() rawHedgingConfig {
+	return rawHedgingConfig{
+		IsEnabled: false,
+		Attempts:  2,
+		Wait:      50 * time.Millisecond,
+	}
+}
+
+func (r *rawRetryConfig) Enabled() bool                 { return r.IsEnabled }
+func (r *rawRetryConfig) MaxAttempts() int              { return r.Attempts }
+func (r *rawRetryConfig) InitialBackoff() time.Duration { return r.Initial }
+func (r *rawRetryConfig) MaxBackoff() time.Duration     { return r.Max }
+func (r *rawRetryConfig) Jitter() float64               { return r.JitterFraction }
+
+func (c *rawCircuitBreakerConfig) Enabled() bool              { return c.IsEnabled }
+func (c *rawCircuitBreakerConfig) FailureThreshold() int      { return c.Threshold }
+func (c *rawCircuitBreakerConfig) OpenTimeout() time.Duration { return c.Open }
+func (c *rawCircuitBreakerConfig) HalfOpenMaxRequests() int   { return c.HalfOpenRequests }
+
+func (h *rawHedgingConfig) Enabled() bool        { return h.IsEnabled }
+func (h *rawHedgingConfig) MaxAttempts() int     { return h.Attempts }
+func (h *rawHedgingConfig) Delay() time.Duration { return h.Wait }
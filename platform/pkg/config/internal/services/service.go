@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/internal/tlsconf"
 )
 
 // Компиляционная проверка
@@ -13,9 +14,14 @@ var _ contracts.ServiceConfig = (*ServiceConfig)(nil)
 
 // rawServiceConfig для загрузки данных из YAML
 type rawServiceConfig struct {
-	Host    string        `mapstructure:"host"    yaml:"host"`
-	Port    int           `mapstructure:"port"    yaml:"port"`
-	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout"`
+	Host           string                  `mapstructure:"host"            yaml:"host"`
+	Port           int                     `mapstructure:"port"            yaml:"port"`
+	Timeout        time.Duration           `mapstructure:"timeout"         yaml:"timeout"`
+	TLS            tlsconf.Raw             `mapstructure:"tls"             yaml:"tls"`
+	Token          string                  `mapstructure:"token"           yaml:"token"`
+	Retry          rawRetryConfig          `mapstructure:"retry"           yaml:"retry"`
+	CircuitBreaker rawCircuitBreakerConfig `mapstructure:"circuit_breaker" yaml:"circuit_breaker"`
+	Hedging        rawHedgingConfig        `mapstructure:"hedging"         yaml:"hedging"`
 }
 
 // ServiceConfig публичная структура для использования
@@ -26,9 +32,13 @@ type ServiceConfig struct {
 // defaultService возвращает rawServiceConfig с дефолтными значениями
 func defaultService() rawServiceConfig {
 	return rawServiceConfig{
-		Host:    "",
-		Port:    0,
-		Timeout: 30 * time.Second,
+		Host:           "",
+		Port:           0,
+		Timeout:        30 * time.Second,
+		TLS:            tlsconf.Default(),
+		Retry:          defaultRetry(),
+		CircuitBreaker: defaultCircuitBreaker(),
+		Hedging:        defaultHedging(),
 	}
 }
 
@@ -45,3 +55,9 @@ func (s *ServiceConfig) Timeout() time.Duration { return s.raw.Timeout }
 func (s *ServiceConfig) Address() string {
 	return net.JoinHostPort(s.raw.Host, strconv.Itoa(s.raw.Port))
 }
+
+func (s *ServiceConfig) TLS() contracts.TLSConfig                       { return &s.raw.TLS }
+func (s *ServiceConfig) Token() string                                  { return s.raw.Token }
+func (s *ServiceConfig) Retry() contracts.RetryConfig                   { return &s.raw.Retry }
+func (s *ServiceConfig) CircuitBreaker() contracts.CircuitBreakerConfig { return &s.raw.CircuitBreaker }
+func (s *ServiceConfig) Hedging() contracts.HedgingConfig               { return &s.raw.Hedging }
@@ -0,0 +1,44 @@
+// Package tlsconf содержит общую TLS-конфигурацию для gRPC сервера (секция grpc.tls)
+// и клиентов внешних сервисов (секция services.<name>.tls).
+package tlsconf
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+)
+
+// Компиляционная проверка
+var _ contracts.TLSConfig = (*Raw)(nil)
+
+// Raw для загрузки данных из YAML/ENV.
+// ENV-переменные применяются только к серверной секции grpc.tls
+type Raw struct {
+	IsEnabled  bool          `mapstructure:"enabled"             yaml:"enabled"             env:"GRPC_TLS_ENABLED"`
+	Cert       string        `mapstructure:"cert_file"           yaml:"cert_file"           env:"GRPC_TLS_CERT_FILE"`
+	Key        string        `mapstructure:"key_file"            yaml:"key_file"            env:"GRPC_TLS_KEY_FILE"`
+	CA         string        `mapstructure:"ca_file"             yaml:"ca_file"             env:"GRPC_TLS_CA_FILE"`
+	ClientCert bool          `mapstructure:"require_client_cert" yaml:"require_client_cert" env:"GRPC_TLS_REQUIRE_CLIENT_CERT"`
+	PeerIDs    []string      `mapstructure:"allowed_peer_ids"    yaml:"allowed_peer_ids"    env:"GRPC_TLS_ALLOWED_PEER_IDS" envSeparator:","`
+	Name       string        `mapstructure:"server_name"         yaml:"server_name"         env:"GRPC_TLS_SERVER_NAME"`
+	Reload     time.Duration `mapstructure:"reload_interval"     yaml:"reload_interval"     env:"GRPC_TLS_RELOAD_INTERVAL"`
+}
+
+// Default возвращает Raw с дефолтными значениями: TLS выключен
+func Default() Raw {
+	return Raw{
+		IsEnabled:  false,
+		ClientCert: false,
+		PeerIDs:    []string{},
+		Reload:     30 * time.Second,
+	}
+}
+
+func (r *Raw) Enabled() bool                 { return r.IsEnabled }
+func (r *Raw) CertFile() string              { return r.Cert }
+func (r *Raw) KeyFile() string               { return r.Key }
+func (r *Raw) CAFile() string                { return r.CA }
+func (r *Raw) RequireClientCert() bool       { return r.ClientCert }
+func (r *Raw) AllowedPeerIDs() []string      { return r.PeerIDs }
+func (r *Raw) ServerName() string            { return r.Name }
+func (r *Raw) ReloadInterval() time.Duration { return r.Reload }
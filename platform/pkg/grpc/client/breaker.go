@@ -0,0 +1,203 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+)
+
+// BreakerState состояние автоматического выключателя
+type BreakerState int64
+
+const (
+	// BreakerClosed запросы проходят, сбои подсчитываются
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen пропускается ограниченное число пробных запросов
+	BreakerHalfOpen
+	// BreakerOpen запросы отклоняются без обращения к службе
+	BreakerOpen
+)
+
+// String возвращает имя состояния для логов и метрик
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitOpenError возвращается при разомкнутом выключателе.
+// Имеет код Unavailable, но не повторяется RetryUnaryClientInterceptor
+type circuitOpenError struct {
+	target string
+}
+
+func (e *circuitOpenError) Error() string {
+	return "circuit breaker is open for " + e.target
+}
+
+// GRPCStatus позволяет status.Code определить код ошибки
+func (e *circuitOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+// IsCircuitOpen проверяет, отклонён ли запрос разомкнутым выключателем
+func IsCircuitOpen(err error) bool {
+	var target *circuitOpenError
+	return errors.As(err, &target)
+}
+
+// circuitBreaker выключатель для одной цели (службы)
+type circuitBreaker struct {
+	mu       sync.Mutex
+	target   string
+	cfg      contracts.CircuitBreakerConfig
+	metrics  *metric.GRPCClientMetrics
+	now      func() time.Time
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	inFlight int
+}
+
+func newCircuitBreaker(target string, cfg contracts.CircuitBreakerConfig, metrics *metric.GRPCClientMetrics) *circuitBreaker {
+	return &circuitBreaker{
+		target:  target,
+		cfg:     cfg,
+		metrics: metrics,
+		now:     time.Now,
+	}
+}
+
+// allow решает, можно ли отправить запрос
+func (b *circuitBreaker) allow(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen {
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout() {
+			return &circuitOpenError{target: b.target}
+		}
+		b.setState(ctx, BreakerHalfOpen)
+	}
+
+	if b.state == BreakerHalfOpen {
+		if b.inFlight >= max(b.cfg.HalfOpenMaxRequests(), 1) {
+			return &circuitOpenError{target: b.target}
+		}
+		b.inFlight++
+	}
+
+	return nil
+}
+
+// done учитывает результат запроса, пропущенного allow
+func (b *circuitBreaker) done(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failure := isBreakerFailure(err)
+
+	switch b.state {
+	case BreakerHalfOpen:
+		b.inFlight--
+		if failure {
+			b.open(ctx)
+			return
+		}
+		b.failures = 0
+		b.setState(ctx, BreakerClosed)
+	case BreakerClosed:
+		if !failure {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold() {
+			b.open(ctx)
+		}
+	case BreakerOpen:
+		// Результат запроса, начатого до размыкания, не меняет состояние
+	}
+}
+
+func (b *circuitBreaker) open(ctx context.Context) {
+	b.openedAt = b.now()
+	b.inFlight = 0
+	b.setState(ctx, BreakerOpen)
+}
+
+func (b *circuitBreaker) setState(ctx context.Context, state BreakerState) {
+	if b.state == state {
+		return
+	}
+
+	logger.Warn(ctx, "⚡ [gRPC] Изменение состояния выключателя",
+		zap.String("target", b.target),
+		zap.String("from", b.state.String()),
+		zap.String("to", state.String()),
+	)
+
+	b.state = state
+	b.metrics.RecordBreakerState(ctx, b.target, state.String(), int64(state))
+}
+
+// isBreakerFailure считает сбоем только признаки недоступности службы,
+// а не ошибки бизнес-логики (NotFound, InvalidArgument и т.п.).
+// ResourceExhausted с RetryInfo означает лимит запросов конкретного клиента,
+// а не перегрузку службы, поэтому цепь к цели из-за него не размыкается
+func isBreakerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	case codes.ResourceExhausted:
+		_, throttled := retryAfter(err)
+		return !throttled
+	default:
+		return false
+	}
+}
+
+// CircuitBreakerUnaryClientInterceptor размыкает цепь к цели после серии сбоев
+// и возвращает codes.Unavailable без обращения к службе, пока цепь разомкнута.
+func CircuitBreakerUnaryClientInterceptor(target string, cfg contracts.CircuitBreakerConfig, metrics *metric.GRPCClientMetrics) grpc.UnaryClientInterceptor {
+	breaker := newCircuitBreaker(target, cfg, metrics)
+
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !cfg.Enabled() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if err := breaker.allow(ctx); err != nil {
+			return err
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		breaker.done(ctx, err)
+
+		return err
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
 	grpcint "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	grpctls "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/tls"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 )
 
@@ -42,6 +44,22 @@ func PropagateIDsUnaryClientInterceptor() grpc.UnaryClientInterceptor {
 	}
 }
 
+// PropagateLocaleUnaryClientInterceptor передаёт язык клиента из контекста в заголовке accept-language,
+// чтобы ошибки вызываемого сервиса возвращались на том же языке
+func PropagateLocaleUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, i18n.AcceptLanguageKey, string(i18n.FromContext(ctx)))
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 // PropagateSessionIDUnaryClientInterceptor добавляет session-id в исходящие gRPC metadata
 func PropagateSessionIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
 	return func(
@@ -56,20 +74,46 @@ func PropagateSessionIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
 	}
 }
 
+// ServiceTokenUnaryClientInterceptor предъявляет вызываемой службе токен сервиса
+// (x-service-token), если он задан в конфигурации службы. Токен подтверждает вызывающий
+// сервис без mTLS; при включенном mTLS служба опирается на сертификат
+func ServiceTokenUnaryClientInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, grpcint.HeaderServiceToken, token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
 // NewClient создаёт gRPC‑клиент (*grpc.ClientConn) с клиентскими интерсепторами по умолчанию,
 // а также с дополнительными интерсепторами, переданными в extra.
-func NewClient(address string, maxRecvMsgSize, maxSendMsgSize int, timeout time.Duration, extra ...grpc.UnaryClientInterceptor) (*grpc.ClientConn, error) {
+// Транспорт (plaintext, TLS или mTLS) определяется конфигурацией tlsCfg.
+func NewClient(address string, tlsCfg contracts.TLSConfig, maxRecvMsgSize, maxSendMsgSize int, timeout time.Duration, extra ...grpc.UnaryClientInterceptor) (*grpc.ClientConn, error) {
 	chain := append(
 		[]grpc.UnaryClientInterceptor{
 			PropagateIDsUnaryClientInterceptor(),
+			PropagateLocaleUnaryClientInterceptor(),
 			PropagateSessionIDUnaryClientInterceptor(),
 			grpcint.TimeoutUnaryClientInterceptor(timeout),
 		},
 		extra...,
 	)
 
+	creds, err := grpctls.ClientCredentials(tlsCfg, address)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithChainUnaryInterceptor(chain...),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
@@ -0,0 +1,85 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+)
+
+// HedgingUnaryClientInterceptor отправляет копию идемпотентного запроса, если ответ
+// не получен за Delay, и возвращает первый успешный ответ; остальные попытки отменяются.
+// Временная ошибка одной копии сразу запускает следующую, постоянная завершает вызов.
+func HedgingUnaryClientInterceptor(target string, cfg contracts.HedgingConfig, metrics *metric.GRPCClientMetrics) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		msg, ok := reply.(proto.Message)
+		if !cfg.Enabled() || cfg.MaxAttempts() <= 1 || !ok || !isIdempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			reply proto.Message
+			err   error
+		}
+		// Буфер на все попытки: проигравшие копии не блокируются после возврата
+		results := make(chan result, cfg.MaxAttempts())
+
+		launched, pending := 0, 0
+		launch := func() {
+			launched++
+			pending++
+			attemptReply := msg.ProtoReflect().New().Interface()
+			go func() {
+				err := invoker(ctx, method, req, attemptReply, cc, opts...)
+				results <- result{reply: attemptReply, err: err}
+			}()
+		}
+
+		launch()
+		timer := time.NewTimer(cfg.Delay())
+		defer timer.Stop()
+
+		var lastErr error
+		for pending > 0 {
+			select {
+			case <-timer.C:
+				if launched < cfg.MaxAttempts() {
+					metrics.RecordHedge(ctx, target, method)
+					launch()
+					timer.Reset(cfg.Delay())
+				}
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					proto.Merge(msg, res.reply)
+					return nil
+				}
+
+				lastErr = res.err
+				if !isRetryable(res.err) {
+					return res.err
+				}
+				if launched < cfg.MaxAttempts() {
+					metrics.RecordHedge(ctx, target, method)
+					launch()
+				}
+			}
+		}
+
+		return lastErr
+	}
+}
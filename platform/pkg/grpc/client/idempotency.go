@@ -0,0 +1,51 @@
+package grpcclient
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	idempotentOnce    sync.Once
+	idempotentMethods map[string]bool
+)
+
+// isIdempotent проверяет, помечен ли метод в proto как безопасный для повтора
+// (option idempotency_level = NO_SIDE_EFFECTS или IDEMPOTENT).
+// Кеш строится при первом вызове, когда все proto-пакеты уже зарегистрированы
+func isIdempotent(fullMethod string) bool {
+	idempotentOnce.Do(buildIdempotentMethodsCache)
+	return idempotentMethods[fullMethod]
+}
+
+// buildIdempotentMethodsCache заполняет кеш идемпотентных методов из proto аннотаций
+func buildIdempotentMethodsCache() {
+	idempotentMethods = make(map[string]bool)
+
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for j := 0; j < services.Len(); j++ {
+			service := services.Get(j)
+
+			methods := service.Methods()
+			for k := 0; k < methods.Len(); k++ {
+				method := methods.Get(k)
+
+				options, ok := method.Options().(*descriptorpb.MethodOptions)
+				if !ok || options == nil {
+					continue
+				}
+
+				switch options.GetIdempotencyLevel() {
+				case descriptorpb.MethodOptions_NO_SIDE_EFFECTS, descriptorpb.MethodOptions_IDEMPOTENT:
+					fullMethod := "/" + string(service.FullName()) + "/" + string(method.Name())
+					idempotentMethods[fullMethod] = true
+				}
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,24 @@
+package grpcclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+)
+
+// ResilienceInterceptors строит цепочку интерсепторов устойчивости для службы target
+// по её конфигурации: повторы → опережающие запросы → выключатель.
+// Выключатель стоит ближе всех к сети, поэтому учитывает каждую отдельную попытку,
+// а повторы прекращаются, как только цепь разомкнута.
+func ResilienceInterceptors(ctx context.Context, target string, svc contracts.ServiceConfig) []grpc.UnaryClientInterceptor {
+	metrics := metric.NewGRPCClientMetrics(ctx)
+
+	return []grpc.UnaryClientInterceptor{
+		RetryUnaryClientInterceptor(target, svc.Retry(), metrics),
+		HedgingUnaryClientInterceptor(target, svc.Hedging(), metrics),
+		CircuitBreakerUnaryClientInterceptor(target, svc.CircuitBreaker(), metrics),
+	}
+}
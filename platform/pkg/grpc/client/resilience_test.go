@@ -0,0 +1,242 @@
+package grpcclient
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+const (
+	idempotentMethod    = "/user_role.v1.UserRoleService/GetUserRoles"
+	nonIdempotentMethod = "/user_role.v1.UserRoleService/Assign"
+)
+
+type retryConfig struct {
+	attempts int
+}
+
+func (c retryConfig) Enabled() bool                 { return true }
+func (c retryConfig) MaxAttempts() int              { return c.attempts }
+func (c retryConfig) InitialBackoff() time.Duration { return time.Millisecond }
+func (c retryConfig) MaxBackoff() time.Duration     { return 4 * time.Millisecond }
+func (c retryConfig) Jitter() float64               { return 0 }
+
+type breakerConfig struct{}
+
+func (breakerConfig) Enabled() bool              { return true }
+func (breakerConfig) FailureThreshold() int      { return 2 }
+func (breakerConfig) OpenTimeout() time.Duration { return time.Minute }
+func (breakerConfig) HalfOpenMaxRequests() int   { return 1 }
+
+type hedgingConfig struct{}
+
+func (hedgingConfig) Enabled() bool        { return true }
+func (hedgingConfig) MaxAttempts() int     { return 2 }
+func (hedgingConfig) Delay() time.Duration { return 10 * time.Millisecond }
+
+// failingInvoker возвращает ошибку с кодом code первые failures вызовов
+func failingInvoker(calls *int32, failures int32, code codes.Code) grpc.UnaryInvoker {
+	return func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		if atomic.AddInt32(calls, 1) <= failures {
+			return status.Error(code, "failure")
+		}
+		return nil
+	}
+}
+
+// throttledError ответ лимита запросов клиента с задержкой повтора в RetryInfo
+func throttledError(t *testing.T, delay time.Duration) error {
+	t.Helper()
+
+	st, err := status.New(codes.ResourceExhausted, "rate limit").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
+
+// TestIsIdempotent проверяет чтение idempotency_level из proto
+func TestIsIdempotent(t *testing.T) {
+	if !isIdempotent(idempotentMethod) {
+		t.Fatalf("%s must be idempotent", idempotentMethod)
+	}
+	if isIdempotent(nonIdempotentMethod) {
+		t.Fatalf("%s must not be idempotent", nonIdempotentMethod)
+	}
+}
+
+// TestRetryUnaryClientInterceptor проверяет повторы только для идемпотентных методов и временных ошибок
+func TestRetryUnaryClientInterceptor(t *testing.T) {
+	retry := RetryUnaryClientInterceptor("rbac", retryConfig{attempts: 3}, nil)
+
+	tests := []struct {
+		name          string
+		method        string
+		failures      int32
+		code          codes.Code
+		expectedCalls int32
+		expectedCode  codes.Code
+	}{
+		{"recovers after transient failure", idempotentMethod, 2, codes.Unavailable, 3, codes.OK},
+		{"gives up after max attempts", idempotentMethod, 5, codes.Unavailable, 3, codes.Unavailable},
+		{"non-retryable code", idempotentMethod, 5, codes.NotFound, 1, codes.NotFound},
+		{"non-idempotent method", nonIdempotentMethod, 5, codes.Unavailable, 1, codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			err := retry(context.Background(), tt.method, nil, nil, nil, failingInvoker(&calls, tt.failures, tt.code))
+
+			if status.Code(err) != tt.expectedCode {
+				t.Fatalf("expected %v, got %v", tt.expectedCode, err)
+			}
+			if calls != tt.expectedCalls {
+				t.Fatalf("expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+// TestRetryHonoursRetryInfo проверяет, что повтор ждёт задержку из RetryInfo, а не backoff
+func TestRetryHonoursRetryInfo(t *testing.T) {
+	retry := RetryUnaryClientInterceptor("rbac", retryConfig{attempts: 2}, nil)
+	delay := 50 * time.Millisecond
+
+	var calls int32
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return throttledError(t, delay)
+		}
+		return nil
+	}
+
+	started := time.Now()
+	if err := retry(context.Background(), idempotentMethod, nil, nil, nil, invoker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed < delay {
+		t.Fatalf("expected retry after %s, got %s", delay, elapsed)
+	}
+
+	// Задержка больше оставшегося дедлайна: повтор бесполезен, ошибка возвращается сразу
+	calls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	invoker = func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		atomic.AddInt32(&calls, 1)
+		return throttledError(t, time.Minute)
+	}
+	if err := retry(ctx, idempotentMethod, nil, nil, nil, invoker); status.Code(err) != codes.ResourceExhausted || calls != 1 {
+		t.Fatalf("expected single ResourceExhausted call, got %d calls, %v", calls, err)
+	}
+}
+
+// TestBackoff проверяет экспоненциальный рост задержки с ограничением сверху
+func TestBackoff(t *testing.T) {
+	cfg := retryConfig{attempts: 10}
+	expected := []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond}
+
+	for i, want := range expected {
+		if got := backoff(cfg, i+1); got != want {
+			t.Fatalf("attempt %d: expected %s, got %s", i+1, want, got)
+		}
+	}
+}
+
+// TestCircuitBreaker проверяет размыкание, пробный запрос и замыкание выключателя
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	breaker := newCircuitBreaker("rbac", breakerConfig{}, nil)
+	breaker.now = func() time.Time { return now }
+
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	// Лимит запросов отдельного клиента (RetryInfo) не размыкает цепь
+	for i := 0; i < 3; i++ {
+		if err := breaker.allow(ctx); err != nil {
+			t.Fatalf("unexpected reject: %v", err)
+		}
+		breaker.done(ctx, throttledError(t, time.Second))
+	}
+	if !isBreakerFailure(status.Error(codes.ResourceExhausted, "overloaded")) {
+		t.Fatal("ResourceExhausted without RetryInfo must count as failure")
+	}
+
+	// Ошибки бизнес-логики не размыкают цепь
+	for i := 0; i < 3; i++ {
+		if err := breaker.allow(ctx); err != nil {
+			t.Fatalf("unexpected reject: %v", err)
+		}
+		breaker.done(ctx, status.Error(codes.NotFound, "missing"))
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := breaker.allow(ctx); err != nil {
+			t.Fatalf("unexpected reject: %v", err)
+		}
+		breaker.done(ctx, unavailable)
+	}
+
+	err := breaker.allow(ctx)
+	if !IsCircuitOpen(err) || status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected open circuit, got %v", err)
+	}
+	if isRetryable(err) {
+		t.Fatal("open circuit must not be retried")
+	}
+
+	// После OpenTimeout пропускается ровно один пробный запрос
+	now = now.Add(time.Minute)
+	if err = breaker.allow(ctx); err != nil {
+		t.Fatalf("expected half-open probe, got %v", err)
+	}
+	if err = breaker.allow(ctx); !IsCircuitOpen(err) {
+		t.Fatalf("expected second probe to be rejected, got %v", err)
+	}
+
+	breaker.done(ctx, nil)
+	if breaker.state != BreakerClosed {
+		t.Fatalf("expected closed breaker, got %s", breaker.state)
+	}
+}
+
+// TestHedgingUnaryClientInterceptor проверяет, что возвращается ответ более быстрой копии
+func TestHedgingUnaryClientInterceptor(t *testing.T) {
+	hedging := HedgingUnaryClientInterceptor("rbac", hedgingConfig{}, nil)
+
+	var calls int32
+	invoker := func(ctx context.Context, _ string, _, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		attempt := atomic.AddInt32(&calls, 1)
+		if attempt == 1 {
+			// Первая копия "зависла" и отменяется после ответа второй
+			<-ctx.Done()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		reply.(*userRoleV1.GetUserRolesResponse).Data = []*commonV1.RoleWithPermissions{{}}
+		return nil
+	}
+
+	reply := &userRoleV1.GetUserRolesResponse{}
+	if err := hedging(context.Background(), idempotentMethod, nil, reply, nil, invoker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", calls)
+	}
+	if len(reply.GetData()) != 1 {
+		t.Fatalf("expected reply from hedged attempt, got %v", reply)
+	}
+}
@@ -0,0 +1,116 @@
+package grpcclient
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+)
+
+// isRetryable проверяет, что ошибка временная и запрос можно повторить
+func isRetryable(err error) bool {
+	if IsCircuitOpen(err) {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter возвращает задержку из RetryInfo, которую сервер указал для повтора
+// (например, при превышении лимита запросов клиента)
+func retryAfter(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
+
+// backoff вычисляет задержку перед попыткой attempt+1: экспонента от initial
+// с ограничением max и случайным отклонением ±jitter
+func backoff(cfg contracts.RetryConfig, attempt int) time.Duration {
+	delay := cfg.InitialBackoff()
+	for i := 1; i < attempt && delay < cfg.MaxBackoff(); i++ {
+		delay *= 2
+	}
+	delay = min(delay, cfg.MaxBackoff())
+
+	if jitter := cfg.Jitter(); jitter > 0 {
+		delay = time.Duration(float64(delay) * (1 + jitter*(2*rand.Float64()-1))) //nolint:gosec // jitter, not security
+	}
+
+	return delay
+}
+
+// RetryUnaryClientInterceptor повторяет идемпотентные запросы при временных ошибках
+// (Unavailable, ResourceExhausted, Aborted) с экспоненциальной задержкой и jitter.
+// Если сервер указал RetryInfo, повтор выполняется не раньше этой задержки; если она
+// не укладывается в дедлайн запроса, ошибка возвращается сразу.
+// Неидемпотентные методы не повторяются: запрос мог быть выполнен до обрыва соединения.
+func RetryUnaryClientInterceptor(target string, cfg contracts.RetryConfig, metrics *metric.GRPCClientMetrics) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !cfg.Enabled() || cfg.MaxAttempts() <= 1 || !isIdempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= cfg.MaxAttempts() || !isRetryable(err) {
+				return err
+			}
+
+			delay, ok := retryAfter(err)
+			if !ok {
+				delay = backoff(cfg, attempt)
+			} else if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Until(deadline) <= delay {
+				return err
+			}
+			code := status.Code(err).String()
+
+			logger.Warn(ctx, "🔁 [gRPC] Повтор запроса после временной ошибки",
+				zap.String("target", target),
+				zap.String("method", method),
+				zap.Int("attempt", attempt),
+				zap.String("grpc_code", code),
+				zap.Duration("backoff", delay),
+			)
+			metrics.RecordRetry(ctx, target, method, code)
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// Authorizer выполняет ext-auth проверку запроса (envoy.service.auth.v3.Authorization/Check)
+type Authorizer interface {
+	Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error)
+}
+
+// clientAuthorizer адаптирует gRPC-клиент ext-auth к Authorizer
+type clientAuthorizer struct {
+	client authv3.AuthorizationClient
+}
+
+// AuthorizerFromClient возвращает Authorizer, вызывающий ext-auth удалённого сервиса (IAM)
+func AuthorizerFromClient(client authv3.AuthorizationClient) Authorizer {
+	return &clientAuthorizer{client: client}
+}
+
+func (a *clientAuthorizer) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	return a.client.Check(ctx, req)
+}
+
+// forwardedHeaders заголовки, передаваемые в ext-auth (allowed_headers фильтра Envoy)
+var forwardedHeaders = []string{
+	interceptor.HeaderCookie,
+	"user-agent",
+	"x-forwarded-for",
+	"x-real-ip",
+	i18n.AcceptLanguageKey,
+}
+
+// identityHeaders заголовки, которые выставляет только ext-auth; присланные клиентом удаляются
+var identityHeaders = []string{
+	interceptor.HeaderSessionID,
+	interceptor.HeaderUserID,
+	interceptor.HeaderUserPermissions,
+	interceptor.HeaderUserConditions,
+}
+
+// clientHeaders заголовки клиента, передаваемые в gRPC под своим именем
+var clientHeaders = []string{
+	interceptor.HeaderAPIKey,
+	interceptor.HeaderIdempotencyKey,
+}
+
+// denialContextKey ключ отказа ext-auth в контексте запроса
+type denialContextKey struct{}
+
+// denial отказ ext-auth: отдаётся клиенту, если вызываемый метод не публичный
+type denial struct {
+	status  int
+	headers http.Header
+	body    string
+}
+
+// errAccessDenied возвращается клиентским интерсептором вместо вызова непубличного метода
+var errAccessDenied = status.Error(codes.Unauthenticated, "access denied by external auth")
+
+// authMiddleware выполняет ext-auth Check для каждого запроса. При успехе выставляет заголовки
+// идентичности, при отказе сохраняет ответ в контексте: публичность метода становится известна
+// только после маршрутизации, поэтому отказ применяет denyUnaryClientInterceptor
+func authMiddleware(authorizer Authorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			for _, h := range identityHeaders {
+				r.Header.Del(h)
+			}
+
+			resp, err := authorizer.Check(ctx, checkRequest(r))
+			if err != nil {
+				// failure_mode_allow: false - недоступный ext-auth приводит к отказу, как в Envoy
+				logger.Error(ctx, "❌ [Gateway] Ошибка вызова external auth", zap.Error(err))
+				resp = &authv3.CheckResponse{HttpResponse: &authv3.CheckResponse_DeniedResponse{
+					DeniedResponse: &authv3.DeniedHttpResponse{},
+				}}
+			}
+
+			if ok := resp.GetOkResponse(); ok != nil {
+				for _, h := range ok.GetHeaders() {
+					r.Header.Set(h.GetHeader().GetKey(), h.GetHeader().GetValue())
+				}
+				for _, h := range ok.GetHeadersToRemove() {
+					r.Header.Del(h)
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			denied := resp.GetDeniedResponse()
+			d := &denial{
+				status:  http.StatusForbidden,
+				headers: make(http.Header),
+				body:    denied.GetBody(),
+			}
+			if code := int(denied.GetStatus().GetCode()); code != 0 {
+				d.status = code
+			}
+			for _, h := range denied.GetHeaders() {
+				d.headers.Set(h.GetHeader().GetKey(), h.GetHeader().GetValue())
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, denialContextKey{}, d)))
+		})
+	}
+}
+
+// checkRequest собирает CheckRequest из HTTP-запроса так же, как ext_authz фильтр Envoy
+func checkRequest(r *http.Request) *authv3.CheckRequest {
+	headers := map[string]string{"host": r.Host}
+	for _, h := range forwardedHeaders {
+		if v := r.Header.Values(h); len(v) > 0 {
+			headers[h] = strings.Join(v, "; ")
+		}
+	}
+
+	return &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{
+					Method:  r.Method,
+					Path:    r.URL.RequestURI(),
+					Host:    r.Host,
+					Headers: headers,
+				},
+			},
+		},
+	}
+}
+
+// denyUnaryClientInterceptor не пропускает вызов непубличного метода, если ext-auth отказал
+func denyUnaryClientInterceptor(publicFilter *interceptor.PublicFilter) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, denied := ctx.Value(denialContextKey{}).(*denial); denied && !publicFilter.IsPublic(method) {
+			return errAccessDenied
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// incomingHeaderMatcher передаёт в gRPC заголовки идентичности (уже проверенные ext-auth)
+// клиентские заголовки API ключа и ключа идемпотентности и стандартные HTTP-заголовки. Произвольные Grpc-Metadata-* не принимаются,
+// чтобы клиент не мог подменить идентичность в обход ext-auth
+func incomingHeaderMatcher(key string) (string, bool) {
+	lower := strings.ToLower(key)
+	for _, h := range identityHeaders {
+		if lower == h {
+			return h, true
+		}
+	}
+
+	for _, h := range clientHeaders {
+		if lower == h {
+			return h, true
+		}
+	}
+
+	if strings.HasPrefix(lower, strings.ToLower(runtime.MetadataHeaderPrefix)) {
+		return "", false
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// errorHandler отдаёт отказ ext-auth в исходном виде, остальные ошибки - как application/problem+json
+// с деталями google.rpc (нарушения полей, ресурс, недостающее право, Retry-After)
+func errorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if d, ok := r.Context().Value(denialContextKey{}).(*denial); ok && errors.Is(err, errAccessDenied) {
+		for key, values := range d.headers {
+			w.Header()[key] = values
+		}
+		w.WriteHeader(d.status)
+		_, _ = w.Write([]byte(d.body))
+		return
+	}
+
+	st := status.Convert(err)
+	apperr.NewProblem(runtime.HTTPStatusFromCode(st.Code()), st).Write(w)
+}
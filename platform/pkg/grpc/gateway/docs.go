@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// OpenAPIPath путь объединённого OpenAPI 3 документа сервисов шлюза
+	OpenAPIPath = "/openapi.json"
+	// DocsPath путь UI документации API
+	DocsPath = "/docs"
+
+	// swaggerUIBase точная версия swagger-ui-dist: плавающая мажорная версия подменила бы
+	// скрипт страницы документации при любом новом выпуске пакета
+	swaggerUIBase = "https://unpkg.com/swagger-ui-dist@5.17.14"
+)
+
+// docsPage страница Swagger UI, загружающая документ шлюза
+const docsPage = `<!DOCTYPE html>
+<html lang="ru">
+<head>
+  <meta charset="utf-8">
+  <title>API</title>
+  <link rel="stylesheet" href="` + swaggerUIBase + `/swagger-ui.css" crossorigin="anonymous">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="` + swaggerUIBase + `/swagger-ui-bundle.js" crossorigin="anonymous"></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: "` + OpenAPIPath + `", dom_id: "#swagger-ui", withCredentials: true});
+  </script>
+</body>
+</html>
+`
+
+// mergeOpenAPI объединяет документы сервисов в один: пути, схемы, схемы безопасности и теги.
+// Имена схем содержат proto-пакет, поэтому общие типы (common.v1.*) совпадают и не конфликтуют
+func mergeOpenAPI(specs [][]byte) ([]byte, error) {
+	paths := make(map[string]any)
+	schemas := make(map[string]any)
+	securitySchemes := make(map[string]any)
+	var tags []any
+	var titles []string
+	version := ""
+
+	for _, spec := range specs {
+		var doc struct {
+			OpenAPI string `json:"openapi"`
+			Info    struct {
+				Title   string `json:"title"`
+				Version string `json:"version"`
+			} `json:"info"`
+			Tags       []any          `json:"tags"`
+			Paths      map[string]any `json:"paths"`
+			Components struct {
+				Schemas         map[string]any `json:"schemas"`
+				SecuritySchemes map[string]any `json:"securitySchemes"`
+			} `json:"components"`
+		}
+		if err := json.Unmarshal(spec, &doc); err != nil {
+			return nil, fmt.Errorf("parse openapi document: %w", err)
+		}
+
+		if version == "" {
+			version = doc.OpenAPI
+		}
+		titles = append(titles, doc.Info.Title)
+		tags = append(tags, doc.Tags...)
+		for key, value := range doc.Paths {
+			paths[key] = value
+		}
+		for key, value := range doc.Components.Schemas {
+			schemas[key] = value
+		}
+		for key, value := range doc.Components.SecuritySchemes {
+			securitySchemes[key] = value
+		}
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"openapi": version,
+		"info": map[string]any{
+			"title":   strings.Join(titles, ", "),
+			"version": "v1",
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": map[string]any{
+			"schemas":         schemas,
+			"securitySchemes": securitySchemes,
+		},
+	}, "", "  ")
+}
+
+// mountDocs публикует объединённый документ и Swagger UI (без аутентификации, как /healthz в Envoy)
+func mountDocs(mux *http.ServeMux, specs [][]byte) error {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	doc, err := mergeOpenAPI(specs)
+	if err != nil {
+		return err
+	}
+
+	mux.HandleFunc("GET "+OpenAPIPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(doc)
+	})
+	mux.HandleFunc("GET "+DocsPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(docsPage))
+	})
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Package gateway запускает встроенный HTTP/JSON шлюз (grpc-gateway) поверх gRPC-сервера сервиса.
+// Шлюз повторяет поведение Envoy: REST по аннотациям google.api.http, аутентификация
+// через ext-auth Check по сессионной cookie и те же заголовки идентичности для интерсепторов.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+)
+
+// RegisterFunc регистрирует HTTP-обработчики сервиса в mux.
+// Совпадает с сигнатурой сгенерированных Register<Service>Handler
+type RegisterFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// Service описывает gRPC-сервис, публикуемый шлюзом
+type Service struct {
+	// Register регистрирует HTTP-обработчики (Register<Service>Handler)
+	Register RegisterFunc
+	// OpenAPI документ сервиса (<File>OpenAPISpec); nil - сервис не попадает в документацию
+	OpenAPI []byte
+}
+
+// Server HTTP/JSON шлюз, проксирующий запросы в gRPC-сервер этого же сервиса.
+// Запросы проходят полную цепочку серверных интерсепторов (auth, permission, validation)
+type Server struct {
+	httpServer *http.Server
+	conn       *grpc.ClientConn
+}
+
+// New создаёт шлюз: подключается к собственному gRPC-адресу сервиса и регистрирует обработчики.
+// authorizer выполняет ext-auth Check так же, как Envoy перед транскодером.
+// OpenAPI документы сервисов объединяются и публикуются по OpenAPIPath вместе с UI на DocsPath
+func New(ctx context.Context,
+	cfg contracts.GatewayConfig,
+	grpcCfg contracts.GRPCConfig,
+	bucketBoundaries []float64,
+	authorizer Authorizer,
+	services ...Service,
+) (*Server, error) {
+	publicFilter := interceptor.NewPublicFilter()
+
+	conn, err := grpcclient.NewClient(loopbackAddress(grpcCfg.Address()), grpcCfg.TLS(),
+		grpcCfg.MaxRecvMsgSize(), grpcCfg.MaxSendMsgSize(), grpcCfg.Timeout(),
+		denyUnaryClientInterceptor(publicFilter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, jsonMarshaler()),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithForwardResponseOption(sessionCookie(publicFilter)),
+	)
+
+	root := http.NewServeMux()
+	root.Handle("/", authMiddleware(authorizer)(mux))
+
+	var specs [][]byte
+	for _, service := range services {
+		if err = service.Register(ctx, mux, conn); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		if service.OpenAPI != nil {
+			specs = append(specs, service.OpenAPI)
+		}
+	}
+
+	if err = mountDocs(root, specs); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	handler := requestIDMiddleware(
+		logger.HTTPMiddleware(
+			metric.HTTPMiddleware(ctx, bucketBoundaries)(root),
+		),
+	)
+
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              cfg.Address(),
+			Handler:           handler,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout(),
+		},
+		conn: conn,
+	}, nil
+}
+
+// Serve принимает HTTP-запросы до вызова Shutdown
+func (s *Server) Serve(ctx context.Context) error {
+	logger.Info(ctx, "🚀 [Gateway] HTTP шлюз слушает адрес", zap.String("address", s.httpServer.Addr))
+
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown дожидается завершения активных запросов и закрывает соединение с gRPC-сервером
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.httpServer.Shutdown(ctx)
+	return errors.Join(err, s.conn.Close())
+}
+
+// jsonMarshaler повторяет print_options транскодера Envoy: имена полей из proto,
+// вывод полей со значениями по умолчанию, enum строками; неизвестные поля игнорируются
+func jsonMarshaler() runtime.Marshaler {
+	return &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			Multiline:       true,
+			Indent:          "  ",
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	}
+}
+
+// loopbackAddress заменяет адрес "слушать всё" на localhost для подключения к своему серверу
+func loopbackAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+
+	return net.JoinHostPort(host, port)
+}
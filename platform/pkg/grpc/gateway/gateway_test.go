@@ -0,0 +1,318 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	authV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+const testSessionID = "6f1c2d3e-4b5a-4c6d-8e9f-0a1b2c3d4e5f"
+
+// fakeAuthService запоминает входящие метаданные последнего вызова
+type fakeAuthService struct {
+	authV1.UnimplementedAuthServiceServer
+	md     metadata.MD
+	called bool
+}
+
+func (s *fakeAuthService) Login(ctx context.Context, _ *authV1.LoginRequest) (*authV1.LoginResponse, error) {
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	s.called = true
+	return &authV1.LoginResponse{SessionId: testSessionID}, nil
+}
+
+func (s *fakeAuthService) Whoami(ctx context.Context, _ *authV1.WhoamiRequest) (*authV1.WhoamiResponse, error) {
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	s.called = true
+	return &authV1.WhoamiResponse{Info: &commonV1.WhoamiInfo{}}, nil
+}
+
+// fakeAuthorizer пропускает запросы с сессионной cookie, остальные отклоняет как IAM
+type fakeAuthorizer struct {
+	headers map[string]string
+}
+
+func (a *fakeAuthorizer) Check(_ context.Context, req *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	a.headers = req.GetAttributes().GetRequest().GetHttp().GetHeaders()
+
+	if !strings.Contains(a.headers[interceptor.HeaderCookie], interceptor.SessionCookieName+"="+testSessionID) {
+		return &authv3.CheckResponse{HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: typev3.StatusCode_Unauthorized},
+				Body:   `{"error": "Missing or invalid session"}`,
+				Headers: []*corev3.HeaderValueOption{{Header: &corev3.HeaderValue{
+					Key: interceptor.HeaderAuthStatus, Value: interceptor.AuthStatusDenied,
+				}}},
+			},
+		}}, nil
+	}
+
+	return &authv3.CheckResponse{HttpResponse: &authv3.CheckResponse_OkResponse{
+		OkResponse: &authv3.OkHttpResponse{
+			Headers: []*corev3.HeaderValueOption{
+				{Header: &corev3.HeaderValue{Key: interceptor.HeaderSessionID, Value: testSessionID}},
+				{Header: &corev3.HeaderValue{Key: interceptor.HeaderUserPermissions, Value: "role:read"}},
+			},
+			HeadersToRemove: []string{interceptor.HeaderCookie},
+		},
+	}}, nil
+}
+
+type testGatewayConfig struct{}
+
+func (testGatewayConfig) IsEnabled() bool                  { return true }
+func (testGatewayConfig) Address() string                  { return "127.0.0.1:0" }
+func (testGatewayConfig) ReadHeaderTimeout() time.Duration { return time.Second }
+func (testGatewayConfig) ShutdownTimeout() time.Duration   { return time.Second }
+
+type testTLSConfig struct{}
+
+func (testTLSConfig) Enabled() bool                 { return false }
+func (testTLSConfig) CertFile() string              { return "" }
+func (testTLSConfig) KeyFile() string               { return "" }
+func (testTLSConfig) CAFile() string                { return "" }
+func (testTLSConfig) RequireClientCert() bool       { return false }
+func (testTLSConfig) AllowedPeerIDs() []string      { return nil }
+func (testTLSConfig) ServerName() string            { return "" }
+func (testTLSConfig) ReloadInterval() time.Duration { return 0 }
+
+type testGRPCConfig struct {
+	address string
+}
+
+func (c testGRPCConfig) Address() string                 { return c.address }
+func (testGRPCConfig) Timeout() time.Duration            { return 5 * time.Second }
+func (testGRPCConfig) IdleTimeout() time.Duration        { return 0 }
+func (testGRPCConfig) ShutdownTimeout() time.Duration    { return 0 }
+func (testGRPCConfig) TLS() contracts.TLSConfig          { return testTLSConfig{} }
+func (testGRPCConfig) TrustedPeers() map[string][]string { return nil }
+func (testGRPCConfig) ServiceTokens() map[string]string  { return nil }
+func (testGRPCConfig) MaxRecvMsgSize() int               { return 4 << 20 }
+func (testGRPCConfig) MaxSendMsgSize() int               { return 4 << 20 }
+func (testGRPCConfig) ClientTimeout() time.Duration      { return 5 * time.Second }
+
+func newTestGateway(t *testing.T) (*httptest.Server, *fakeAuthService, *fakeAuthorizer) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &fakeAuthService{}
+	grpcServer := grpc.NewServer()
+	authV1.RegisterAuthServiceServer(grpcServer, service)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	authorizer := &fakeAuthorizer{}
+	gw, err := New(context.Background(), testGatewayConfig{}, testGRPCConfig{address: listener.Addr().String()},
+		nil, authorizer, Service{Register: authV1.RegisterAuthServiceHandler, OpenAPI: authV1.AuthOpenAPISpec})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = gw.conn.Close() })
+
+	server := httptest.NewServer(gw.httpServer.Handler)
+	t.Cleanup(server.Close)
+
+	return server, service, authorizer
+}
+
+func TestGatewayPublicMethodSetsSessionCookie(t *testing.T) {
+	server, service, _ := newTestGateway(t)
+
+	resp, err := http.Post(server.URL+"/api/v1/auth/login", "application/json",
+		strings.NewReader(`{"login":"admin","password":"secret1"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || !service.called {
+		t.Fatalf("public method must bypass ext-auth denial, status = %d", resp.StatusCode)
+	}
+
+	var cookie *http.Cookie
+	for _, c := range resp.Cookies() {
+		if c.Name == interceptor.SessionCookieName {
+			cookie = c
+		}
+	}
+	if cookie == nil || cookie.Value != testSessionID || !cookie.HttpOnly {
+		t.Fatalf("session cookie = %+v", cookie)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), `"session_id"`) {
+		t.Fatalf("response must use proto field names like Envoy: %s", body)
+	}
+	if resp.Header.Get(interceptor.HeaderRequestID) == "" {
+		t.Fatal("response must carry x-request-id")
+	}
+}
+
+func TestGatewayDeniesProtectedMethodWithoutSession(t *testing.T) {
+	server, service, _ := newTestGateway(t)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/auth/whoami", nil)
+	// Попытка подменить идентичность в обход ext-auth
+	req.Header.Set(interceptor.HeaderSessionID, testSessionID)
+	req.Header.Set("Grpc-Metadata-X-User-Permissions", "*")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", resp.StatusCode)
+	}
+	if resp.Header.Get(interceptor.HeaderAuthStatus) != interceptor.AuthStatusDenied {
+		t.Fatal("denied response headers from ext-auth must be preserved")
+	}
+	if service.called {
+		t.Fatal("protected method must not be called after ext-auth denial")
+	}
+}
+
+func TestGatewayForwardsIdentityFromExtAuth(t *testing.T) {
+	server, service, authorizer := newTestGateway(t)
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/auth/whoami", nil)
+	req.AddCookie(&http.Cookie{Name: interceptor.SessionCookieName, Value: testSessionID})
+	req.Header.Set(interceptor.HeaderUserPermissions, "*")
+	req.Header.Set("Grpc-Metadata-X-User-Id", "spoofed")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if authorizer.headers["host"] == "" {
+		t.Fatal("check request must carry host header")
+	}
+
+	if got := service.md.Get(interceptor.HeaderSessionID); len(got) != 1 || got[0] != testSessionID {
+		t.Fatalf("x-session-id = %v", got)
+	}
+	if got := service.md.Get(interceptor.HeaderUserPermissions); len(got) != 1 || got[0] != "role:read" {
+		t.Fatalf("x-user-permissions = %v, want value from ext-auth only", got)
+	}
+	if got := service.md.Get(interceptor.HeaderUserID); len(got) != 0 {
+		t.Fatalf("x-user-id = %v, spoofed metadata must be dropped", got)
+	}
+	if got := service.md.Get("grpcgateway-cookie"); len(got) != 0 {
+		t.Fatal("cookie must be removed as requested by ext-auth")
+	}
+}
+
+func TestLoopbackAddress(t *testing.T) {
+	tests := map[string]string{
+		"0.0.0.0:50051":   "localhost:50051",
+		"[::]:50051":      "localhost:50051",
+		":50051":          "localhost:50051",
+		"iam:50051":       "iam:50051",
+		"127.0.0.1:50051": "127.0.0.1:50051",
+	}
+
+	for in, want := range tests {
+		if got := loopbackAddress(in); got != want {
+			t.Errorf("loopbackAddress(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGatewayServesOpenAPIWithoutSession(t *testing.T) {
+	server, _, _ := newTestGateway(t)
+
+	resp, err := http.Get(server.URL + OpenAPIPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		t.Fatalf("openapi = %q, want 3.x", doc.OpenAPI)
+	}
+	if _, ok := doc.Paths["/api/v1/auth/login"]["post"]; !ok {
+		t.Fatalf("login route is missing in merged document: %v", doc.Paths)
+	}
+
+	docs, err := http.Get(server.URL + DocsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer docs.Body.Close()
+
+	if docs.StatusCode != http.StatusOK {
+		t.Fatalf("docs status = %d, want 200", docs.StatusCode)
+	}
+
+	page, err := io.ReadAll(docs.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(page), "swagger-ui-dist@5/") || !strings.Contains(string(page), swaggerUIBase+"/swagger-ui-bundle.js") {
+		t.Fatalf("docs page must load pinned swagger-ui assets:\n%s", page)
+	}
+}
+
+func TestMergeOpenAPI(t *testing.T) {
+	first := []byte(`{"openapi":"3.0.3","info":{"title":"a.v1"},"paths":{"/a":{}},"components":{"schemas":{"common.v1.X":{},"a.v1.A":{}}}}`)
+	second := []byte(`{"openapi":"3.0.3","info":{"title":"b.v1"},"paths":{"/b":{}},"components":{"schemas":{"common.v1.X":{},"b.v1.B":{}}}}`)
+
+	merged, err := mergeOpenAPI([][]byte{first, second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Info       struct{ Title string }           `json:"info"`
+		Paths      map[string]any                   `json:"paths"`
+		Components struct{ Schemas map[string]any } `json:"components"`
+	}
+	if err = json.Unmarshal(merged, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.Paths) != 2 || len(doc.Components.Schemas) != 3 || doc.Info.Title != "a.v1, b.v1" {
+		t.Fatalf("unexpected merged document: %s", merged)
+	}
+
+	if _, err = mergeOpenAPI([][]byte{[]byte("{")}); err == nil {
+		t.Fatal("invalid document must fail")
+	}
+}
@@ -0,0 +1,59 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// requestIDMiddleware кладёт в контекст x-request-id клиента или новый идентификатор;
+// дальше он попадает в логи и исходящие gRPC metadata
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(interceptor.HeaderRequestID)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		w.Header().Set(interceptor.HeaderRequestID, requestID)
+		next.ServeHTTP(w, r.WithContext(logger.WithIDs(r.Context(), "", requestID)))
+	})
+}
+
+// sessionIDResponse ответ, содержащий идентификатор новой сессии (LoginResponse)
+type sessionIDResponse interface {
+	GetSessionId() string
+}
+
+// sessionCookie выставляет сессионную cookie по ответу публичного метода входа,
+// чтобы последующие запросы аутентифицировались через ext-auth без ручной установки cookie
+func sessionCookie(publicFilter *interceptor.PublicFilter) func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(ctx context.Context, w http.ResponseWriter, msg proto.Message) error {
+		method, ok := runtime.RPCMethod(ctx)
+		if !ok || !publicFilter.IsPublic(method) {
+			return nil
+		}
+
+		resp, ok := msg.(sessionIDResponse)
+		if !ok || resp.GetSessionId() == "" {
+			return nil
+		}
+
+		//nolint:gosec // шлюз предназначен для локальной разработки и обслуживает HTTP без TLS
+		http.SetCookie(w, &http.Cookie{
+			Name:     interceptor.SessionCookieName,
+			Value:    resp.GetSessionId(),
+			Path:     "/",
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		return nil
+	}
+}
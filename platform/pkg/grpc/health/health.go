@@ -4,28 +4,96 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
+// Server реализует gRPC HealthCheck API поверх Registry.
+//
+// Поддерживаемые имена сервисов:
+//   - "" и "readiness" - готовность принимать трафик (все readiness-пробы исправны, нет shutdown);
+//   - "liveness" - работоспособность процесса (все liveness-пробы исправны);
+//   - имя компонента из Registry - статус отдельной зависимости;
+//   - полное имя зарегистрированного gRPC-сервиса - готовность этого сервиса.
 type Server struct {
 	grpc_health_v1.UnimplementedHealthServer
+
+	registry   *Registry
+	grpcServer *grpc.Server
+}
+
+// NewServer создаёт Health-сервер. grpcServer используется для ответа по именам gRPC-сервисов
+func NewServer(registry *Registry, grpcServer *grpc.Server) *Server {
+	return &Server{registry: registry, grpcServer: grpcServer}
 }
 
 // Check возвращает текущий статус сервиса для HealthCheck API.
-func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	}, nil
+func (s *Server) Check(_ context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	st, ok := s.status(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+
+	return &grpc_health_v1.HealthCheckResponse{Status: st}, nil
 }
 
-// Watch отправляет клиенту статус SERVING в потоковом режиме.
+// Watch отправляет текущий статус и затем каждое его изменение до закрытия потока.
+// Для неизвестного сервиса отправляется SERVICE_UNKNOWN, как требует протокол
 func (s *Server) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+	var (
+		last    grpc_health_v1.HealthCheckResponse_ServingStatus
+		started bool
+	)
+
+	for {
+		// Канал берётся до чтения статуса, чтобы не пропустить изменение между ними
+		changed := s.registry.Changed()
+
+		st, _ := s.status(req.GetService())
+		if !started || st != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last, started = st, true
+		}
+
+		// Открытый поток не даст GracefulStop завершиться, поэтому после
+		// отправки NOT_SERVING при остановке поток закрывается
+		if s.registry.ShuttingDown() {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case <-changed:
+		}
+	}
+}
+
+func (s *Server) status(service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	switch service {
+	case "", ReadinessService:
+		return s.registry.Readiness(), true
+	case LivenessService:
+		return s.registry.Liveness(), true
+	}
+
+	if st, ok := s.registry.Component(service); ok {
+		return st, true
+	}
+
+	if s.grpcServer != nil {
+		if _, ok := s.grpcServer.GetServiceInfo()[service]; ok {
+			return s.registry.Readiness(), true
+		}
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, false
 }
 
 // RegisterService регистрирует реализацию Health-сервиса в gRPC-сервере.
-func RegisterService(s *grpc.Server) {
-	grpc_health_v1.RegisterHealthServer(s, &Server{})
+func RegisterService(s *grpc.Server, registry *Registry) {
+	grpc_health_v1.RegisterHealthServer(s, NewServer(registry, s))
 }
@@ -0,0 +1,152 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// fakeWatchStream собирает отправленные статусы в канал
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(resp *grpc_health_v1.HealthCheckResponse) error {
+	s.sent <- resp.GetStatus()
+	return nil
+}
+
+func check(t *testing.T, s *Server, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("check %q: %v", service, err)
+	}
+	return resp.GetStatus()
+}
+
+// TestRegistryStatuses проверяет раздельные liveness/readiness и статусы компонентов
+func TestRegistryStatuses(t *testing.T) {
+	var redisErr error
+	registry := NewRegistry()
+	registry.Register("postgres", Readiness, func(context.Context) error { return nil })
+	registry.Register("redis", Readiness, func(context.Context) error { return redisErr })
+	server := NewServer(registry, nil)
+
+	// До первого опроса компоненты считаются неготовыми
+	if st := check(t, server, ""); st != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING before first check, got %v", st)
+	}
+
+	registry.CheckAll(context.Background())
+	if st := check(t, server, ReadinessService); st != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING readiness, got %v", st)
+	}
+
+	redisErr = errors.New("connection refused")
+	registry.CheckAll(context.Background())
+
+	if st := check(t, server, ""); st != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING readiness, got %v", st)
+	}
+	if st := check(t, server, LivenessService); st != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("readiness failure must not affect liveness, got %v", st)
+	}
+	if st := check(t, server, "redis"); st != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected redis NOT_SERVING, got %v", st)
+	}
+	if st := check(t, server, "postgres"); st != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("expected postgres SERVING, got %v", st)
+	}
+
+	_, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for unknown service, got %v", err)
+	}
+}
+
+// TestRegistryShutdown проверяет перевод readiness в NOT_SERVING при остановке
+func TestRegistryShutdown(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("postgres", Readiness, func(context.Context) error { return nil })
+	registry.CheckAll(context.Background())
+	server := NewServer(registry, nil)
+
+	registry.Shutdown()
+
+	if st := check(t, server, ""); st != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected NOT_SERVING after shutdown, got %v", st)
+	}
+	if st := check(t, server, LivenessService); st != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Fatalf("expected liveness SERVING after shutdown, got %v", st)
+	}
+}
+
+// TestWatchStreamsChanges проверяет, что Watch отправляет только изменения статуса
+func TestWatchStreamsChanges(t *testing.T) {
+	var dbErr error
+	registry := NewRegistry()
+	registry.Register("postgres", Readiness, func(context.Context) error { return dbErr })
+	registry.CheckAll(context.Background())
+	server := NewServer(registry, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &fakeWatchStream{ctx: ctx, sent: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Watch(&grpc_health_v1.HealthCheckRequest{}, stream)
+	}()
+
+	expect := func(expected grpc_health_v1.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		select {
+		case st := <-stream.sent:
+			if st != expected {
+				t.Fatalf("expected %v, got %v", expected, st)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %v", expected)
+		}
+	}
+
+	expect(grpc_health_v1.HealthCheckResponse_SERVING)
+
+	// Повторный опрос без изменений не порождает сообщений
+	registry.CheckAll(context.Background())
+
+	dbErr = errors.New("connection refused")
+	registry.CheckAll(context.Background())
+	expect(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	dbErr = nil
+	registry.CheckAll(context.Background())
+	expect(grpc_health_v1.HealthCheckResponse_SERVING)
+
+	// При остановке поток получает NOT_SERVING и закрывается, не блокируя GracefulStop
+	registry.Shutdown()
+	expect(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.Unavailable {
+			t.Fatalf("expected Unavailable, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watch did not finish after shutdown")
+	}
+}
@@ -0,0 +1,61 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Pinger компонент с проверкой доступности (pgxpool.Pool, cache.RedisClient)
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// PingProbe создаёт пробу на основе Ping компонента
+func PingProbe(p Pinger) Probe {
+	return p.Ping
+}
+
+// DialProbe создаёт пробу, успешную при TCP-доступности хотя бы одного адреса.
+// Используется для Kafka producer/consumer: sarama не предоставляет проверку соединения,
+// а брокеры перечисляются в конфигурации через запятую
+func DialProbe(addresses string) Probe {
+	list := strings.Split(addresses, ",")
+
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		var lastErr error
+		for _, addr := range list {
+			conn, err := dialer.DialContext(ctx, "tcp", strings.TrimSpace(addr))
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			_ = conn.Close()
+			return nil
+		}
+
+		return fmt.Errorf("no reachable address in %q: %w", addresses, lastErr)
+	}
+}
+
+// ClientConnProbe создаёт пробу состояния соединения с нижестоящим gRPC-сервисом.
+// Неактивное соединение переподключается; ошибкой считаются только TRANSIENT_FAILURE и SHUTDOWN
+func ClientConnProbe(conn *grpc.ClientConn) Probe {
+	return func(_ context.Context) error {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Idle:
+			conn.Connect()
+			return nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("grpc connection to %s is %s", conn.Target(), state)
+		default:
+			return nil
+		}
+	}
+}
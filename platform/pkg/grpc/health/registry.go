@@ -0,0 +1,284 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+const (
+	// LivenessService имя сервиса в HealthCheck API для liveness-проверки
+	LivenessService = "liveness"
+	// ReadinessService имя сервиса в HealthCheck API для readiness-проверки.
+	// Пустое имя сервиса ("") также отвечает статусом готовности
+	ReadinessService = "readiness"
+
+	// DefaultInterval интервал опроса проб по умолчанию
+	DefaultInterval = 5 * time.Second
+	// DefaultProbeTimeout таймаут одной пробы по умолчанию
+	DefaultProbeTimeout = 2 * time.Second
+)
+
+// Kind определяет, на какую проверку влияет проба
+type Kind int
+
+const (
+	// Readiness проба зависимости: при ошибке под перестаёт получать трафик
+	Readiness Kind = iota
+	// Liveness проба работоспособности процесса: при ошибке под перезапускается
+	Liveness
+)
+
+// Probe проверяет состояние компонента. nil означает, что компонент исправен
+type Probe func(ctx context.Context) error
+
+type component struct {
+	kind    Kind
+	probe   Probe
+	serving bool
+	checked bool
+}
+
+// Registry хранит пробы компонентов и кеширует их статусы.
+// Статусы обновляются фоновым опросом (Run), поэтому Check не обращается к зависимостям.
+type Registry struct {
+	mu           sync.RWMutex
+	components   map[string]*component
+	shuttingDown bool
+	// changed закрывается и пересоздаётся при каждом изменении статусов
+	changed chan struct{}
+
+	interval     time.Duration
+	probeTimeout time.Duration
+}
+
+// Option настраивает Registry
+type Option func(*Registry)
+
+// WithInterval задаёт интервал опроса проб
+func WithInterval(interval time.Duration) Option {
+	return func(r *Registry) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// WithProbeTimeout задаёт таймаут одной пробы
+func WithProbeTimeout(timeout time.Duration) Option {
+	return func(r *Registry) {
+		if timeout > 0 {
+			r.probeTimeout = timeout
+		}
+	}
+}
+
+// NewRegistry создаёт пустой реестр проб
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{
+		components:   make(map[string]*component),
+		changed:      make(chan struct{}),
+		interval:     DefaultInterval,
+		probeTimeout: DefaultProbeTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Register добавляет пробу компонента. До первого опроса компонент считается неготовым.
+// Повторная регистрация с тем же именем заменяет пробу
+func (r *Registry) Register(name string, kind Kind, probe Probe) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components[name] = &component{kind: kind, probe: probe}
+	r.notifyLocked()
+}
+
+// Run опрашивает пробы сразу и далее с заданным интервалом до отмены контекста
+func (r *Registry) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll однократно выполняет все пробы и обновляет статусы
+func (r *Registry) CheckAll(ctx context.Context) {
+	r.mu.RLock()
+	probes := make(map[string]Probe, len(r.components))
+	for name, c := range r.components {
+		probes[name] = c.probe
+	}
+	r.mu.RUnlock()
+
+	results := make(map[string]error, len(probes))
+	var (
+		wg      sync.WaitGroup
+		resultM sync.Mutex
+	)
+	for name, probe := range probes {
+		wg.Add(1)
+		go func(name string, probe Probe) {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, r.probeTimeout)
+			defer cancel()
+
+			err := probe(probeCtx)
+
+			resultM.Lock()
+			results[name] = err
+			resultM.Unlock()
+		}(name, probe)
+	}
+	wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed := false
+	for name, err := range results {
+		c, ok := r.components[name]
+		if !ok {
+			continue
+		}
+
+		serving := err == nil
+		if c.checked && c.serving == serving {
+			continue
+		}
+
+		if serving {
+			logger.Info(ctx, "✅ [Health] Компонент доступен", zap.String("component", name))
+		} else {
+			logger.Warn(ctx, "⚠️ [Health] Компонент недоступен", zap.String("component", name), zap.Error(err))
+		}
+
+		c.serving = serving
+		c.checked = true
+		changed = true
+	}
+
+	if changed {
+		r.notifyLocked()
+	}
+}
+
+// Shutdown переводит readiness в NOT_SERVING, чтобы балансировщик перестал
+// направлять трафик до остановки сервера. Liveness не меняется:
+// перезапуск пода во время graceful shutdown не нужен
+func (r *Registry) Shutdown() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.shuttingDown {
+		return
+	}
+
+	r.shuttingDown = true
+	r.notifyLocked()
+}
+
+// ShuttingDown сообщает, начата ли остановка сервиса
+func (r *Registry) ShuttingDown() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.shuttingDown
+}
+
+// Liveness возвращает статус liveness-проверки
+func (r *Registry) Liveness() grpc_health_v1.HealthCheckResponse_ServingStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.aggregateLocked(Liveness)
+}
+
+// Readiness возвращает статус readiness-проверки
+func (r *Registry) Readiness() grpc_health_v1.HealthCheckResponse_ServingStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.shuttingDown {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return r.aggregateLocked(Readiness)
+}
+
+// Component возвращает статус отдельного компонента
+func (r *Registry) Component(name string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.components[name]
+	if !ok {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+
+	return servingStatus(c.serving), true
+}
+
+// Components возвращает имена зарегистрированных компонентов в алфавитном порядке
+func (r *Registry) Components() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.components))
+	for name := range r.components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Changed возвращает канал, который закроется при следующем изменении статусов
+func (r *Registry) Changed() <-chan struct{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.changed
+}
+
+func (r *Registry) aggregateLocked(kind Kind) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	for _, c := range r.components {
+		if c.kind == kind && !c.serving {
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+func (r *Registry) notifyLocked() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func servingStatus(serving bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if serving {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
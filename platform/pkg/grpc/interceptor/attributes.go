@@ -0,0 +1,41 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// requestAttributes собирает атрибуты для условных прав:
+// subject - пользователь из заголовков Envoy, resource - поля запроса под именами из proto,
+// request - вызываемый метод и время (заполняется condition.NewAttributes)
+func requestAttributes(ctx context.Context, fullMethod string, req any) condition.Attributes {
+	subject := map[string]any{}
+	if userID, ok := GetUserIDFromContext(ctx); ok {
+		subject["id"] = userID
+	}
+
+	return condition.NewAttributes(subject, messageAttributes(req), map[string]any{"method": fullMethod})
+}
+
+// messageAttributes преобразует сообщение запроса в дерево атрибутов так же, как его видит JSON клиент
+func messageAttributes(req any) map[string]any {
+	attrs := map[string]any{}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return attrs
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return attrs
+	}
+
+	_ = json.Unmarshal(data, &attrs) //nolint:errcheck // protojson всегда выдает JSON-объект
+	return attrs
+}
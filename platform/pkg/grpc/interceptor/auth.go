@@ -2,19 +2,29 @@ package interceptor
 
 import (
 	"context"
+	"crypto/subtle"
 	"strings"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 )
 
 const (
 	// Заголовки от Envoy External Auth (после успешной аутентификации)
-	// Минимальный набор: session ID для идентификации и permissions для авторизации
+	// Минимальный набор: session ID и user ID для идентификации, permissions для авторизации
 	HeaderSessionID       = "x-session-id"
+	HeaderUserID          = "x-user-id"
 	HeaderUserPermissions = "x-user-permissions"
+	HeaderUserConditions  = "x-user-conditions" // условные права (authz.EncodeConditional)
+	HeaderRequestID       = "x-request-id"
+
+	// HeaderServiceToken токен доверенного сервиса для вызовов без mTLS.
+	// Выставляется только клиентом сервиса; Envoy удаляет его из внешних запросов
+	HeaderServiceToken = "x-service-token"
 
 	// HTTP заголовки
 	HeaderCookie        = "cookie"
@@ -35,17 +45,62 @@ type contextKey string
 const (
 	// sessionIDContextKey ключ для хранения session ID в контексте
 	sessionIDContextKey contextKey = "session-id"
+	// userIDContextKey ключ для хранения user ID в контексте
+	userIDContextKey contextKey = "user-id"
 	// userPermissionsStringsContextKey ключ для хранения прав как строк
 	userPermissionsStringsContextKey contextKey = "user-permissions-strings"
+	// userConditionalPermissionsContextKey ключ для хранения условных прав
+	userConditionalPermissionsContextKey contextKey = "user-conditional-permissions"
+	// callerContextKey ключ для вызывающего, подтвержденного AuthInterceptor
+	callerContextKey contextKey = "authenticated-caller"
 )
 
+// Caller вызывающий, подтвержденный AuthInterceptor: пользователь сессии Envoy или доверенный сервис.
+// В отличие от идентификаторов IdentityInterceptor не берется из заголовков публичных методов,
+// которые клиент может подделать
+type Caller struct {
+	UserID    string
+	SessionID string
+	PeerID    string
+}
+
 // AuthInterceptor interceptor для чтения данных пользователя из Envoy заголовков
 // Работает ТОЛЬКО с защищенными методами (публичные уже отфильтрованы PublicFilter)
-type AuthInterceptor struct{}
+type AuthInterceptor struct {
+	// trustedPeers права доверенных сервисов по идентичности mTLS-собеседника
+	trustedPeers map[string][]string
+	// serviceTokens идентичности доверенных сервисов по токену сервиса
+	serviceTokens map[string]string
+}
+
+// AuthOption настраивает AuthInterceptor
+type AuthOption func(*AuthInterceptor)
+
+// WithTrustedPeers разрешает вызовы сервисов без пользовательской сессии.
+// Ключ - идентичность mTLS-собеседника (SPIFFE ID или CN), значение - права, выданные сервису
+func WithTrustedPeers(peers map[string][]string) AuthOption {
+	return func(i *AuthInterceptor) {
+		i.trustedPeers = peers
+	}
+}
+
+// WithServiceTokens разрешает доверенным сервисам подтверждать идентичность токеном
+// в заголовке x-service-token, когда mTLS не включен. Ключ - токен, значение - идентичность
+// сервиса из WithTrustedPeers, права которой получает вызов
+func WithServiceTokens(tokens map[string]string) AuthOption {
+	return func(i *AuthInterceptor) {
+		i.serviceTokens = tokens
+	}
+}
 
 // NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor() *AuthInterceptor {
-	return &AuthInterceptor{}
+func NewAuthInterceptor(opts ...AuthOption) *AuthInterceptor {
+	i := &AuthInterceptor{}
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
 }
 
 // Unary возвращает unary server interceptor для аутентификации
@@ -69,16 +124,42 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	}
 }
 
+// Stream возвращает stream server interceptor для аутентификации
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if IsPublicMethod(ss.Context()) {
+			return handler(srv, ss)
+		}
+
+		authCtx, err := i.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = authCtx
+		return handler(srv, wrapped)
+	}
+}
+
 // authenticate читает данные пользователя из Envoy заголовков и создает контекст
 func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		return nil, apperr.ToStatus(ctx, errMissingMetadata)
 	}
 
 	sessionIDs := md.Get(HeaderSessionID)
 	if len(sessionIDs) == 0 || sessionIDs[0] == "" {
-		return nil, status.Error(codes.Unauthenticated, "missing session ID from Envoy")
+		if peerCtx, ok := i.authenticatePeer(ctx, md); ok {
+			return peerCtx, nil
+		}
+		return nil, apperr.ToStatus(ctx, errMissingSession)
 	}
 
 	var permissions []string
@@ -86,10 +167,57 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 		permissions = strings.Split(permHeaders[0], ",")
 	}
 
+	// Нечитаемые условные права отклоняют запрос: среди них могут быть запреты
+	conditional, err := authz.DecodeConditional(firstValue(md, HeaderUserConditions))
+	if err != nil {
+		return nil, apperr.ToStatus(ctx, errPermissionsMissing)
+	}
+
+	caller := Caller{SessionID: sessionIDs[0]}
 	authCtx := context.WithValue(ctx, sessionIDContextKey, sessionIDs[0])
 	authCtx = context.WithValue(authCtx, userPermissionsStringsContextKey, permissions)
+	authCtx = context.WithValue(authCtx, userConditionalPermissionsContextKey, conditional)
+	if userIDs := md.Get(HeaderUserID); len(userIDs) > 0 && userIDs[0] != "" {
+		caller.UserID = userIDs[0]
+		authCtx = context.WithValue(authCtx, userIDContextKey, userIDs[0])
+	}
+
+	return context.WithValue(authCtx, callerContextKey, caller), nil
+}
+
+// authenticatePeer пропускает вызов доверенного сервиса без сессии: идентичность подтверждена
+// сертификатом mTLS или токеном сервиса, а права ограничены выданными сервису в конфигурации
+func (i *AuthInterceptor) authenticatePeer(ctx context.Context, md metadata.MD) (context.Context, bool) {
+	peerID, ok := GetPeerIdentityFromContext(ctx)
+	if !ok {
+		if peerID, ok = i.serviceByToken(firstValue(md, HeaderServiceToken)); !ok {
+			return nil, false
+		}
+	}
 
-	return authCtx, nil
+	permissions, trusted := i.trustedPeers[peerID]
+	if !trusted {
+		return nil, false
+	}
+
+	ctx = context.WithValue(ctx, callerContextKey, Caller{PeerID: peerID})
+	return context.WithValue(ctx, userPermissionsStringsContextKey, permissions), true
+}
+
+// serviceByToken находит сервис по токену. Сравнение за постоянное время, чтобы токен
+// нельзя было подобрать по времени ответа
+func (i *AuthInterceptor) serviceByToken(token string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+
+	var peerID string
+	for candidate, id := range i.serviceTokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			peerID = id
+		}
+	}
+	return peerID, peerID != ""
 }
 
 // GetSessionIDContextKey возвращает ключ для session ID в контексте
@@ -103,8 +231,27 @@ func GetSessionIDFromContext(ctx context.Context) (string, bool) {
 	return sessionID, ok
 }
 
+// GetUserIDFromContext извлекает user ID из контекста
+func GetUserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDContextKey).(string)
+	return userID, ok && userID != ""
+}
+
+// GetCallerFromContext возвращает вызывающего, подтвержденного AuthInterceptor.
+// Для публичных методов и вызовов до AuthInterceptor вызывающий не определен
+func GetCallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerContextKey).(Caller)
+	return caller, ok
+}
+
 // GetUserPermissionsStringsFromContext извлекает права как строки из контекста для авторизации
 func GetUserPermissionsStringsFromContext(ctx context.Context) ([]string, bool) {
 	permissions, ok := ctx.Value(userPermissionsStringsContextKey).([]string)
 	return permissions, ok
 }
+
+// GetUserConditionalPermissionsFromContext извлекает условные права пользователя из контекста
+func GetUserConditionalPermissionsFromContext(ctx context.Context) []authz.Permission {
+	permissions, _ := ctx.Value(userConditionalPermissionsContextKey).([]authz.Permission)
+	return permissions
+}
@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+)
+
+const (
+	// authDomain домен ошибок аутентификации и авторизации на уровне gRPC
+	authDomain apperr.Domain = "auth"
+	// validationDomain домен ошибок правил validate.rules из proto
+	validationDomain apperr.Domain = "validate"
+	// serverDomain домен внутренних ошибок сервера
+	serverDomain apperr.Domain = "server"
+	// rateLimitDomain домен ошибок ограничения частоты запросов
+	rateLimitDomain apperr.Domain = "rate_limit"
+	// idempotencyDomain домен ошибок ключей идемпотентности
+	idempotencyDomain apperr.Domain = "idempotency"
+)
+
+var (
+	errMissingMetadata    = authDomain.Unauthenticated("MISSING_METADATA", i18n.MsgMissingMetadata)
+	errMissingSession     = authDomain.Unauthenticated("MISSING_SESSION", i18n.MsgMissingSession)
+	errPermissionsMissing = authDomain.Unauthenticated("PERMISSIONS_MISSING", i18n.MsgPermissionsMissing)
+	errPermissionDenied   = authDomain.PermissionDenied("PERMISSION_DENIED", i18n.MsgPermissionDenied)
+
+	errRequestValidation = validationDomain.Validation("INVALID_REQUEST", i18n.MsgValidationFailed)
+
+	errPanic = serverDomain.Internal("PANIC", i18n.MsgInternal)
+
+	errRateLimited   = rateLimitDomain.ResourceExhausted("RATE_LIMITED", i18n.MsgRateLimited)
+	errRateLimitDown = rateLimitDomain.Unavailable("RATE_LIMIT_UNAVAILABLE", i18n.MsgRateLimitDown)
+
+	errIdempotencyKeyInvalid = idempotencyDomain.Validation("INVALID_IDEMPOTENCY_KEY", i18n.MsgValidationFailed)
+	errIdempotencyKeyReused  = idempotencyDomain.FailedPrecondition("IDEMPOTENCY_KEY_REUSED", i18n.MsgIdempotencyReused)
+	errIdempotencyPending    = idempotencyDomain.Aborted("IDEMPOTENCY_IN_PROGRESS", i18n.MsgIdempotencyPending)
+	errIdempotencyDown       = idempotencyDomain.Unavailable("IDEMPOTENCY_UNAVAILABLE", i18n.MsgIdempotencyDown)
+)
@@ -0,0 +1,290 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/idempotency"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+const (
+	// HeaderIdempotencyKey заголовок с ключом идемпотентности запроса
+	HeaderIdempotencyKey = "idempotency-key"
+	// HeaderIdempotentReplayed заголовок ответа, восстановленного по ключу идемпотентности
+	HeaderIdempotentReplayed = "idempotent-replayed"
+
+	// maxIdempotencyKeyLen максимальная длина ключа идемпотентности
+	maxIdempotencyKeyLen = 255
+	// idempotencyPendingRetry через сколько повторять запрос, пока первый ещё выполняется
+	idempotencyPendingRetry = time.Second
+)
+
+// idempotentCodes коды ошибок, которые являются окончательным результатом запроса и сохраняются.
+// После остальных ошибок (Internal, Unavailable, DeadlineExceeded, ...) ключ освобождается,
+// чтобы повтор выполнил запрос заново
+var idempotentCodes = map[codes.Code]bool{
+	codes.InvalidArgument:    true,
+	codes.NotFound:           true,
+	codes.AlreadyExists:      true,
+	codes.PermissionDenied:   true,
+	codes.FailedPrecondition: true,
+	codes.OutOfRange:         true,
+}
+
+// IdempotencyInterceptor выполняет запрос с заголовком idempotency-key не более одного раза.
+// Ключ принимают методы с аннотацией (common.v1.idempotency_key); запись хранится по методу,
+// вызывающему (пользователь или сессия) и ключу. Повтор получает сохранённый ответ или статус,
+// тот же ключ с другим телом запроса отклоняется
+type IdempotencyInterceptor struct {
+	store       idempotency.Store
+	ttl         time.Duration
+	lockTimeout time.Duration
+	failOpen    bool
+	// Статический кеш методов, принимающих ключ (заполняется при инициализации)
+	methodsCache map[string]bool
+}
+
+// NewIdempotencyInterceptor создает interceptor ключей идемпотентности поверх store
+func NewIdempotencyInterceptor(store idempotency.Store, cfg contracts.IdempotencyConfig) *IdempotencyInterceptor {
+	interceptor := &IdempotencyInterceptor{
+		store:        store,
+		ttl:          cfg.TTL(),
+		lockTimeout:  cfg.LockTimeout(),
+		failOpen:     cfg.FailOpen(),
+		methodsCache: make(map[string]bool),
+	}
+
+	// Предварительно заполняем кеш всеми аннотациями
+	interceptor.buildMethodsCache()
+
+	return interceptor
+}
+
+// Unary возвращает unary server interceptor ключей идемпотентности.
+// Потоковые методы ключ не поддерживают: их ответ нельзя воспроизвести одной записью
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !i.methodsCache[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKey(ctx)
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, apperr.ToStatus(ctx, errIdempotencyKeyInvalid.
+				WithField(HeaderIdempotencyKey, i18n.MsgIdempotencyKey, maxIdempotencyKeyLen))
+		}
+
+		hash, err := requestHash(msg)
+		if err != nil {
+			return nil, apperr.ToStatus(ctx, err)
+		}
+
+		storeKey := info.FullMethod + ":" + idempotencySubject(ctx) + ":" + key
+		record, acquired, err := i.store.Reserve(ctx, storeKey, hash, i.lockTimeout)
+		if err != nil {
+			if i.failOpen {
+				logger.Warn(ctx, "⚠️ [Idempotency] Хранилище ключей недоступно, запрос выполняется без защиты от повторов",
+					zap.String("method", info.FullMethod), zap.Error(err))
+				return handler(ctx, req)
+			}
+
+			logger.Error(ctx, "❌ [Idempotency] Хранилище ключей недоступно, запрос отклонён",
+				zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, apperr.ToStatus(ctx, errIdempotencyDown)
+		}
+
+		if !acquired {
+			return i.replay(ctx, info.FullMethod, hash, record)
+		}
+
+		resp, handlerErr := handler(ctx, req)
+		i.complete(ctx, info.FullMethod, storeKey, hash, resp, handlerErr)
+
+		return resp, handlerErr
+	}
+}
+
+// replay возвращает результат запроса, уже выполненного (или выполняющегося) с тем же ключом
+func (i *IdempotencyInterceptor) replay(ctx context.Context, fullMethod, hash string, record *idempotency.Record) (any, error) {
+	if record.Hash != hash {
+		return nil, apperr.ToStatus(ctx, errIdempotencyKeyReused.WithMetadata("method", fullMethod))
+	}
+
+	if !record.Done {
+		return nil, apperr.ToStatus(ctx, errIdempotencyPending.WithRetryAfter(idempotencyPendingRetry))
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(HeaderIdempotentReplayed, "true"))
+
+	if len(record.Status) > 0 {
+		st := &spb.Status{}
+		if err := proto.Unmarshal(record.Status, st); err != nil {
+			return nil, apperr.ToStatus(ctx, err)
+		}
+		return nil, status.ErrorProto(st)
+	}
+
+	packed := &anypb.Any{}
+	if err := proto.Unmarshal(record.Response, packed); err != nil {
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	resp, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	logger.Debug(ctx, "🔁 [Idempotency] Возвращён сохранённый результат запроса", zap.String("method", fullMethod))
+	return resp, nil
+}
+
+// complete сохраняет окончательный результат запроса или освобождает ключ после временной ошибки.
+// Запрос уже выполнен, поэтому ошибки хранилища только логируются
+func (i *IdempotencyInterceptor) complete(ctx context.Context, fullMethod, storeKey, hash string, resp any, handlerErr error) {
+	// Результат сохраняется, даже если клиент уже отменил запрос
+	ctx = context.WithoutCancel(ctx)
+
+	record, err := idempotencyRecord(hash, resp, handlerErr)
+	if errors.Is(err, errTransientResult) {
+		if err = i.store.Release(ctx, storeKey); err != nil {
+			logger.Error(ctx, "❌ [Idempotency] Ошибка освобождения ключа идемпотентности",
+				zap.String("method", fullMethod), zap.Error(err))
+		}
+		return
+	}
+
+	if err == nil {
+		err = i.store.Save(ctx, storeKey, record, i.ttl)
+	}
+	if err != nil {
+		logger.Error(ctx, "❌ [Idempotency] Ошибка сохранения результата запроса",
+			zap.String("method", fullMethod), zap.Error(err))
+	}
+}
+
+// errTransientResult результат запроса не окончательный и не сохраняется
+var errTransientResult = errors.New("transient result")
+
+// idempotencyRecord упаковывает ответ или окончательный статус ошибки в запись
+func idempotencyRecord(hash string, resp any, handlerErr error) (idempotency.Record, error) {
+	record := idempotency.Record{Hash: hash, Done: true}
+
+	if handlerErr != nil {
+		st, ok := status.FromError(handlerErr)
+		if !ok || !idempotentCodes[st.Code()] {
+			return record, errTransientResult
+		}
+
+		data, err := proto.Marshal(st.Proto())
+		if err != nil {
+			return record, err
+		}
+		record.Status = data
+		return record, nil
+	}
+
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return record, errTransientResult
+	}
+
+	packed, err := anypb.New(msg)
+	if err != nil {
+		return record, err
+	}
+
+	if record.Response, err = proto.Marshal(packed); err != nil {
+		return record, err
+	}
+
+	return record, nil
+}
+
+// requestHash хеш тела запроса: детерминированная сериализация не зависит от порядка полей map
+func requestHash(msg proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotencyKey читает ключ из gRPC metadata или из HTTP заголовка, переданного шлюзом
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	return firstValue(md, HeaderIdempotencyKey)
+}
+
+// idempotencySubject вызывающий, в пределах которого действует ключ. Используется только
+// идентичность, подтвержденная AuthInterceptor. Анонимные вызовы публичных методов (Register)
+// разделяются по IP клиента, чтобы совпавший ключ не отдавал ответ чужому клиенту
+func idempotencySubject(ctx context.Context) string {
+	caller, _ := GetCallerFromContext(ctx)
+	switch {
+	case caller.UserID != "":
+		return "user:" + caller.UserID
+	case caller.SessionID != "":
+		return "session:" + caller.SessionID
+	case caller.PeerID != "":
+		return "peer:" + caller.PeerID
+	}
+
+	return "ip:" + clientIP(ctx)
+}
+
+// buildMethodsCache заполняет кеш методами с аннотацией idempotency_key
+func (i *IdempotencyInterceptor) buildMethodsCache() {
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for j := 0; j < services.Len(); j++ {
+			service := services.Get(j)
+
+			methods := service.Methods()
+			for k := 0; k < methods.Len(); k++ {
+				method := methods.Get(k)
+
+				options := method.Options().(*descriptorpb.MethodOptions)
+				if options == nil || method.IsStreamingClient() || method.IsStreamingServer() {
+					continue
+				}
+
+				if enabled, ok := proto.GetExtension(options, commonV1.E_IdempotencyKey).(bool); ok && enabled {
+					fullMethod := "/" + string(service.FullName()) + "/" + string(method.Name())
+					i.methodsCache[fullMethod] = true
+				}
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,214 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/idempotency"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+const roleCreateMethod = "/role.v1.RoleService/Create"
+
+type fakeIdempotencyConfig struct {
+	failOpen bool
+}
+
+func (c fakeIdempotencyConfig) IsEnabled() bool            { return true }
+func (c fakeIdempotencyConfig) KeyPrefix() string          { return "idem:" }
+func (c fakeIdempotencyConfig) TTL() time.Duration         { return time.Hour }
+func (c fakeIdempotencyConfig) LockTimeout() time.Duration { return time.Minute }
+func (c fakeIdempotencyConfig) FailOpen() bool             { return c.failOpen }
+
+type failingStore struct {
+	idempotency.Store
+}
+
+func (failingStore) Reserve(context.Context, string, string, time.Duration) (*idempotency.Record, bool, error) {
+	return nil, false, errors.New("redis: connection refused")
+}
+
+// countingHandler создаёт роль и считает вызовы; err возвращается вместо ответа
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (h *countingHandler) handle(_ context.Context, req any) (any, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &roleV1.CreateResponse{RoleId: "role-" + req.(*roleV1.CreateRequest).GetName()}, nil
+}
+
+func idempotentContext(userID, key string) context.Context {
+	ctx := incomingContext(HeaderIdempotencyKey, key)
+	return context.WithValue(ctx, callerContextKey, Caller{UserID: userID})
+}
+
+func callIdempotent(i *IdempotencyInterceptor, ctx context.Context, method string, req proto.Message, h *countingHandler) (any, error) {
+	return i.Unary()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, h.handle)
+}
+
+// TestIdempotencyInterceptorReplay проверяет, что повтор с ключом не выполняет запрос повторно
+func TestIdempotencyInterceptorReplay(t *testing.T) {
+	i := NewIdempotencyInterceptor(idempotency.NewMemoryStore(), fakeIdempotencyConfig{})
+	h := &countingHandler{}
+	req := &roleV1.CreateRequest{Name: "teacher"}
+
+	first, err := callIdempotent(i, idempotentContext("alice", "key-1"), roleCreateMethod, req, h)
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+
+	second, err := callIdempotent(i, idempotentContext("alice", "key-1"), roleCreateMethod, req, h)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+
+	if h.calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", h.calls)
+	}
+	if !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+		t.Fatalf("replayed response %v differs from original %v", second, first)
+	}
+
+	// Ключ действует в пределах пользователя, без ключа и вне аннотированных методов запросы не запоминаются
+	_, _ = callIdempotent(i, idempotentContext("bob", "key-1"), roleCreateMethod, req, h)
+	_, _ = callIdempotent(i, context.Background(), roleCreateMethod, req, h)
+	_, _ = callIdempotent(i, idempotentContext("alice", "key-1"), "/role.v1.RoleService/Update", req, h)
+	if h.calls != 4 {
+		t.Fatalf("handler calls = %d, want 4", h.calls)
+	}
+}
+
+// TestIdempotencyInterceptorAnonymousCallers проверяет, что на публичном методе ключ действует
+// в пределах IP клиента, а подделанный x-user-id не открывает доступ к чужому сохраненному ответу
+func TestIdempotencyInterceptorAnonymousCallers(t *testing.T) {
+	i := NewIdempotencyInterceptor(idempotency.NewMemoryStore(), fakeIdempotencyConfig{})
+	h := &countingHandler{}
+	anonymous := func(ip, userID string) context.Context {
+		return withIdentity(incomingContext(HeaderIdempotencyKey, "key-anon", HeaderForwardedFor, ip, HeaderUserID, userID))
+	}
+
+	_, _ = callIdempotent(i, anonymous("203.0.113.10", ""), roleCreateMethod, &roleV1.CreateRequest{Name: "teacher"}, h)
+
+	// Другой клиент с тем же ключом и другим телом выполняется отдельно, а не получает KeyReused
+	resp, err := callIdempotent(i, anonymous("203.0.113.11", ""), roleCreateMethod, &roleV1.CreateRequest{Name: "admin"}, h)
+	if err != nil {
+		t.Fatalf("other client: %v", err)
+	}
+	if got := resp.(*roleV1.CreateResponse).GetRoleId(); got != "role-admin" {
+		t.Fatalf("other client got response %q", got)
+	}
+
+	// Подделанный x-user-id не переносит ключ в пространство пользователя
+	_, _ = callIdempotent(i, idempotentContext("alice", "key-anon"), roleCreateMethod, &roleV1.CreateRequest{Name: "alice"}, h)
+	resp, err = callIdempotent(i, anonymous("203.0.113.12", "alice"), roleCreateMethod, &roleV1.CreateRequest{Name: "forged"}, h)
+	if err != nil {
+		t.Fatalf("forged user: %v", err)
+	}
+	if got := resp.(*roleV1.CreateResponse).GetRoleId(); got != "role-forged" {
+		t.Fatalf("forged x-user-id got stored response %q", got)
+	}
+
+	if h.calls != 4 {
+		t.Fatalf("handler calls = %d, want 4", h.calls)
+	}
+}
+
+// TestIdempotencyInterceptorKeyReused проверяет отказ для того же ключа с другим телом запроса
+func TestIdempotencyInterceptorKeyReused(t *testing.T) {
+	i := NewIdempotencyInterceptor(idempotency.NewMemoryStore(), fakeIdempotencyConfig{})
+	h := &countingHandler{}
+	ctx := idempotentContext("alice", "key-2")
+
+	_, _ = callIdempotent(i, ctx, roleCreateMethod, &roleV1.CreateRequest{Name: "teacher"}, h)
+	_, err := callIdempotent(i, ctx, roleCreateMethod, &roleV1.CreateRequest{Name: "admin"}, h)
+
+	if code := status.Code(err); code != codes.FailedPrecondition {
+		t.Fatalf("code = %v, want FailedPrecondition", code)
+	}
+	if h.calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", h.calls)
+	}
+}
+
+// TestIdempotencyInterceptorErrors проверяет сохранение окончательных ошибок и повтор после временных
+func TestIdempotencyInterceptorErrors(t *testing.T) {
+	i := NewIdempotencyInterceptor(idempotency.NewMemoryStore(), fakeIdempotencyConfig{})
+	req := &roleV1.CreateRequest{Name: "teacher"}
+
+	conflict := &countingHandler{err: status.Error(codes.AlreadyExists, "role exists")}
+	for n := 0; n < 2; n++ {
+		if _, err := callIdempotent(i, idempotentContext("alice", "key-3"), roleCreateMethod, req, conflict); status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("call %d: code = %v, want AlreadyExists", n, status.Code(err))
+		}
+	}
+	if conflict.calls != 1 {
+		t.Fatalf("final error must be replayed, handler calls = %d", conflict.calls)
+	}
+
+	unavailable := &countingHandler{err: status.Error(codes.Unavailable, "db down")}
+	_, _ = callIdempotent(i, idempotentContext("alice", "key-4"), roleCreateMethod, req, unavailable)
+	_, _ = callIdempotent(i, idempotentContext("alice", "key-4"), roleCreateMethod, req, unavailable)
+	if unavailable.calls != 2 {
+		t.Fatalf("transient error must release key, handler calls = %d", unavailable.calls)
+	}
+}
+
+// TestIdempotencyInterceptorPending проверяет ответ на повтор, пока первый запрос выполняется
+func TestIdempotencyInterceptorPending(t *testing.T) {
+	store := idempotency.NewMemoryStore()
+	i := NewIdempotencyInterceptor(store, fakeIdempotencyConfig{})
+	req := &roleV1.CreateRequest{Name: "teacher"}
+
+	hash, _ := requestHash(req)
+	_, _, _ = store.Reserve(context.Background(), roleCreateMethod+":user:alice:key-5", hash, time.Minute)
+
+	h := &countingHandler{}
+	_, err := callIdempotent(i, idempotentContext("alice", "key-5"), roleCreateMethod, req, h)
+	if code := status.Code(err); code != codes.Aborted {
+		t.Fatalf("code = %v, want Aborted", code)
+	}
+	if h.calls != 0 {
+		t.Fatal("handler must not run while key is reserved")
+	}
+}
+
+// TestIdempotencyInterceptorInvalidKey проверяет ограничение длины ключа
+func TestIdempotencyInterceptorInvalidKey(t *testing.T) {
+	i := NewIdempotencyInterceptor(idempotency.NewMemoryStore(), fakeIdempotencyConfig{})
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(HeaderIdempotencyKey, strings.Repeat("k", maxIdempotencyKeyLen+1)))
+
+	_, err := callIdempotent(i, ctx, roleCreateMethod, &roleV1.CreateRequest{Name: "teacher"}, &countingHandler{})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", code)
+	}
+}
+
+// TestIdempotencyInterceptorStoreFailure проверяет поведение при недоступном хранилище
+func TestIdempotencyInterceptorStoreFailure(t *testing.T) {
+	req := &roleV1.CreateRequest{Name: "teacher"}
+
+	open := NewIdempotencyInterceptor(failingStore{}, fakeIdempotencyConfig{failOpen: true})
+	if _, err := callIdempotent(open, idempotentContext("alice", "k"), roleCreateMethod, req, &countingHandler{}); err != nil {
+		t.Fatalf("fail-open must run handler, got %v", err)
+	}
+
+	closed := NewIdempotencyInterceptor(failingStore{}, fakeIdempotencyConfig{})
+	if _, err := callIdempotent(closed, idempotentContext("alice", "k"), roleCreateMethod, req, &countingHandler{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("fail-closed code = %v, want Unavailable", status.Code(err))
+	}
+}
@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// IdentityInterceptor переносит идентификаторы запроса, сессии и пользователя из Envoy заголовков в контекст.
+// В отличие от AuthInterceptor не требует их наличия: используется для аудита и логирования
+// в сервисах, которые вызываются как через Envoy, так и напрямую другими сервисами
+func IdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(withIdentity(ctx), req)
+	}
+}
+
+// IdentityStreamInterceptor потоковый аналог IdentityInterceptor
+func IdentityStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withIdentity(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// withIdentity переносит идентификаторы из входящих метаданных в контекст
+func withIdentity(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if requestID := firstValue(md, HeaderRequestID); requestID != "" && logger.RequestIDFrom(ctx) == "" {
+		ctx = logger.WithIDs(ctx, "", requestID)
+	}
+
+	if sessionID := firstValue(md, HeaderSessionID); sessionID != "" {
+		ctx = context.WithValue(ctx, sessionIDContextKey, sessionID)
+	}
+
+	if userID := firstValue(md, HeaderUserID); userID != "" {
+		ctx = context.WithValue(ctx, userIDContextKey, userID)
+	}
+
+	return ctx
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
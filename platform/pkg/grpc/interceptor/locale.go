@@ -0,0 +1,59 @@
+package interceptor
+
+import (
+	"context"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+)
+
+// gatewayAcceptLanguageKey Accept-Language, переданный grpc-gateway (постоянные HTTP заголовки получают префикс)
+const gatewayAcceptLanguageKey = "grpcgateway-" + i18n.AcceptLanguageKey
+
+// LocaleInterceptor определяет язык ответов по заголовку Accept-Language
+// (Envoy передаёт его в metadata как есть, встроенный HTTP шлюз - с префиксом grpcgateway-)
+func LocaleInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(withLocale(ctx), req)
+	}
+}
+
+// LocaleStreamInterceptor потоковый аналог LocaleInterceptor
+func LocaleStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withLocale(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// withLocale сохраняет язык клиента в контексте
+func withLocale(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	header := firstValue(md, i18n.AcceptLanguageKey)
+	if header == "" {
+		header = firstValue(md, gatewayAcceptLanguageKey)
+	}
+	if header == "" {
+		return ctx
+	}
+
+	return i18n.WithLocale(ctx, i18n.ParseAcceptLanguage(header))
+}
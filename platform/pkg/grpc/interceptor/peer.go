@@ -0,0 +1,67 @@
+package interceptor
+
+import (
+	"context"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	grpctls "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/tls"
+)
+
+// peerIdentityContextKey ключ для хранения идентичности TLS-собеседника в контексте
+const peerIdentityContextKey contextKey = "peer-identity"
+
+// PeerIdentityInterceptor кладёт в контекст идентичность вызывающего сервиса из клиентского
+// TLS-сертификата (SPIFFE ID или Common Name). Без mTLS контекст не меняется
+func PeerIdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(withPeerIdentity(ctx), req)
+	}
+}
+
+// PeerIdentityStreamInterceptor потоковый аналог PeerIdentityInterceptor
+func PeerIdentityStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withPeerIdentity(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// withPeerIdentity извлекает идентичность из сертификата собеседника TLS-соединения
+func withPeerIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return ctx
+	}
+
+	if id := grpctls.PeerID(tlsInfo.State.PeerCertificates[0]); id != "" {
+		ctx = context.WithValue(ctx, peerIdentityContextKey, id)
+	}
+
+	return ctx
+}
+
+// GetPeerIdentityFromContext извлекает идентичность TLS-собеседника из контекста
+func GetPeerIdentityFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(peerIdentityContextKey).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestPeerIdentityInterceptor(t *testing.T) {
+	spiffeID, _ := url.Parse("spiffe://school.local/ns/default/sa/iam")
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "iam"}, URIs: []*url.URL{spiffeID}}
+
+	tlsCtx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+	})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		wantID string
+		wantOK bool
+	}{
+		{name: "mtls peer", ctx: tlsCtx, wantID: spiffeID.String(), wantOK: true},
+		{name: "plaintext peer", ctx: peer.NewContext(context.Background(), &peer.Peer{}), wantOK: false},
+		{name: "no peer", ctx: context.Background(), wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID string
+			var gotOK bool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				gotID, gotOK = GetPeerIdentityFromContext(ctx)
+				return nil, nil
+			}
+
+			if _, err := PeerIdentityInterceptor()(tt.ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+				t.Fatal(err)
+			}
+			if gotID != tt.wantID || gotOK != tt.wantOK {
+				t.Fatalf("GetPeerIdentityFromContext() = %q, %v; want %q, %v", gotID, gotOK, tt.wantID, tt.wantOK)
+			}
+
+			stream := &fakeServerStream{ctx: tt.ctx}
+			streamHandler := func(_ any, ss grpc.ServerStream) error {
+				gotID, gotOK = GetPeerIdentityFromContext(ss.Context())
+				return nil
+			}
+			if err := PeerIdentityStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{}, streamHandler); err != nil {
+				t.Fatal(err)
+			}
+			if gotID != tt.wantID || gotOK != tt.wantOK {
+				t.Fatalf("stream GetPeerIdentityFromContext() = %q, %v; want %q, %v", gotID, gotOK, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
 	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
 
@@ -35,15 +36,7 @@ func NewPermissionInterceptor() *PermissionInterceptor {
 // UnaryServerInterceptor возвращает unary server interceptor для проверки прав доступа
 func (i *PermissionInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Извлекаем аннотацию permission из кеша
-		permission, exists := i.permissionCache[info.FullMethod]
-		if !exists || permission == "" {
-			// Аннотация не указана, пропускаем проверку
-			return handler(ctx, req)
-		}
-
-		// Проверяем права доступа
-		if err := i.checkPermission(ctx, permission); err != nil {
+		if err := i.authorize(ctx, info.FullMethod, req); err != nil {
 			return nil, err
 		}
 
@@ -51,24 +44,53 @@ func (i *PermissionInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterce
 	}
 }
 
+// StreamServerInterceptor возвращает stream server interceptor для проверки прав доступа.
+// Права проверяются один раз при открытии потока: сообщений еще нет, поэтому условия
+// над атрибутами resource не выполняются и условные разрешения не применяются
+func (i *PermissionInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// authorize проверяет права на метод по аннотации permission из кеша
+func (i *PermissionInterceptor) authorize(ctx context.Context, fullMethod string, req any) error {
+	permission, exists := i.permissionCache[fullMethod]
+	if !exists || permission == "" {
+		// Аннотация не указана, пропускаем проверку
+		return nil
+	}
+
+	return i.checkPermission(ctx, permission, fullMethod, req)
+}
+
 // checkPermission проверяет права доступа пользователя
-func (i *PermissionInterceptor) checkPermission(ctx context.Context, permission string) error {
-	// Получаем роли пользователя из контекста
-	roles, ok := ctx.Value("user-roles-with-permissions").([]*commonV1.RoleWithPermissions)
+func (i *PermissionInterceptor) checkPermission(ctx context.Context, permission, fullMethod string, req any) error {
+	// Получаем права пользователя из контекста (заполняются AuthInterceptor)
+	userPermissions, ok := GetUserPermissionsStringsFromContext(ctx)
 	if !ok {
-		return status.Error(codes.Unauthenticated, "Роли пользователя не найдены в контексте")
+		return apperr.ToStatus(ctx, errPermissionsMissing)
 	}
 
-	// Проверяем права доступа
-	for _, role := range roles {
-		for _, userPermission := range role.Permissions {
-			if userPermission != nil && userPermission.Resource+":"+userPermission.Action == permission {
-				return nil
-			}
-		}
+	permissions := authz.ParseList(userPermissions)
+
+	// Условные права вычисляются по атрибутам запроса; без них атрибуты не собираются
+	var attrs condition.Attributes
+	if conditional := GetUserConditionalPermissionsFromContext(ctx); len(conditional) > 0 {
+		permissions = append(permissions, conditional...)
+		attrs = requestAttributes(ctx, fullMethod, req)
+	}
+
+	// Шаблоны и явные запреты вычисляются общими правилами authz
+	if authz.EvaluateWithAttributes(permissions, permission, attrs) != authz.DecisionAllow {
+		return apperr.ToStatus(ctx, errPermissionDenied.WithPermission(permission))
 	}
 
-	return status.Error(codes.PermissionDenied, "Недостаточно прав доступа")
+	return nil
 }
 
 // buildPermissionCache предварительно заполняет кеш всеми аннотациями из protobuf
@@ -0,0 +1,120 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+const (
+	roleGetMethod = "/role.v1.RoleService/Get"
+
+	ownRoleID   = "11111111-1111-1111-1111-111111111111"
+	otherRoleID = "22222222-2222-2222-2222-222222222222"
+)
+
+// authorizeRoleGet проходит AuthInterceptor и PermissionInterceptor для RoleService/Get
+func authorizeRoleGet(t *testing.T, ctx context.Context, roleID string) error {
+	t.Helper()
+
+	auth := NewAuthInterceptor()
+	permission := NewPermissionInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: roleGetMethod}
+
+	_, err := auth.Unary()(ctx, &roleV1.GetRequest{RoleId: roleID}, info, func(ctx context.Context, req any) (any, error) {
+		return permission.UnaryServerInterceptor()(ctx, req, info, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+	})
+	return err
+}
+
+// TestPermissionInterceptorConditions проверяет вычисление условных прав по атрибутам запроса
+func TestPermissionInterceptorConditions(t *testing.T) {
+	ownRole := authz.Permission{Resource: "role", Action: "read", Condition: `resource.role_id == "` + ownRoleID + `"`}
+	byUser := authz.Permission{Resource: "role", Action: "read", Condition: `subject.id == "user-1"`}
+	denyOwn := authz.Permission{Resource: "role", Action: "read", Effect: authz.EffectDeny, Condition: ownRole.Condition}
+	broken := authz.Permission{Resource: "role", Action: "read", Effect: authz.EffectDeny, Condition: `resource.role_id ==`}
+
+	tests := []struct {
+		name        string
+		permissions string
+		conditional []authz.Permission
+		roleID      string
+		expected    codes.Code
+	}{
+		{
+			name:        "conditional allow applies when resource matches",
+			conditional: []authz.Permission{ownRole},
+			roleID:      ownRoleID,
+			expected:    codes.OK,
+		},
+		{
+			name:        "conditional allow does not apply to other resource",
+			conditional: []authz.Permission{ownRole},
+			roleID:      otherRoleID,
+			expected:    codes.PermissionDenied,
+		},
+		{
+			name:        "subject attributes come from x-user-id",
+			conditional: []authz.Permission{byUser},
+			roleID:      otherRoleID,
+			expected:    codes.OK,
+		},
+		{
+			name:        "conditional deny overrides allow",
+			permissions: "role:read",
+			conditional: []authz.Permission{denyOwn},
+			roleID:      ownRoleID,
+			expected:    codes.PermissionDenied,
+		},
+		{
+			name:        "conditional deny skipped when condition is false",
+			permissions: "role:read",
+			conditional: []authz.Permission{denyOwn},
+			roleID:      otherRoleID,
+			expected:    codes.OK,
+		},
+		{
+			name:        "unevaluable deny applies",
+			permissions: "role:read",
+			conditional: []authz.Permission{broken},
+			roleID:      otherRoleID,
+			expected:    codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := incomingContext(
+				HeaderSessionID, "session-1",
+				HeaderUserID, "user-1",
+				HeaderUserPermissions, tt.permissions,
+				HeaderUserConditions, authz.EncodeConditional(tt.conditional),
+			)
+
+			if code := status.Code(authorizeRoleGet(t, ctx, tt.roleID)); code != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, code)
+			}
+		})
+	}
+}
+
+// TestAuthInterceptorMalformedConditions проверяет отказ при нечитаемом заголовке условных прав
+func TestAuthInterceptorMalformedConditions(t *testing.T) {
+	ctx := incomingContext(
+		HeaderSessionID, "session-1",
+		HeaderUserPermissions, "role:read",
+		HeaderUserConditions, "not base64 json",
+	)
+
+	if code := status.Code(authorizeRoleGet(t, ctx, ownRoleID)); code != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", code)
+	}
+}
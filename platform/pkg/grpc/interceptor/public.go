@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -33,6 +34,7 @@ func NewPublicFilter() *PublicFilter {
 		systemMethods: map[string]bool{
 			"/envoy.service.auth.v3.Authorization/Check":                     true,
 			"/grpc.health.v1.Health/Check":                                   true,
+			"/grpc.health.v1.Health/Watch":                                   true,
 			"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
 		},
 	}
@@ -50,18 +52,36 @@ func (f *PublicFilter) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		if f.systemMethods[info.FullMethod] {
-			ctx = context.WithValue(ctx, publicMethodKey, true)
-			return handler(ctx, req)
-		}
+		return handler(f.markPublic(ctx, info.FullMethod), req)
+	}
+}
 
-		if isPublic, exists := f.publicMethodsCache[info.FullMethod]; exists && isPublic {
-			ctx = context.WithValue(ctx, publicMethodKey, true)
-			return handler(ctx, req)
-		}
+// Stream возвращает stream interceptor для фильтрации публичных методов
+func (f *PublicFilter) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = f.markPublic(ss.Context(), info.FullMethod)
+		return handler(srv, wrapped)
+	}
+}
 
-		return handler(ctx, req)
+// IsPublic сообщает, что метод системный или помечен в proto как публичный
+func (f *PublicFilter) IsPublic(fullMethod string) bool {
+	return f.systemMethods[fullMethod] || f.publicMethodsCache[fullMethod]
+}
+
+// markPublic помечает контекст, если метод системный или публичный
+func (f *PublicFilter) markPublic(ctx context.Context, fullMethod string) context.Context {
+	if f.IsPublic(fullMethod) {
+		return context.WithValue(ctx, publicMethodKey, true)
 	}
+
+	return ctx
 }
 
 // buildPublicMethodsCache заполняет кеш публичными методами из proto аннотаций
@@ -0,0 +1,242 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/ratelimit"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+const (
+	// HeaderAPIKey заголовок с API ключом клиента
+	HeaderAPIKey = "x-api-key"
+	// HeaderForwardedFor цепочка адресов клиента и прокси; последний адрес добавлен ближайшим прокси
+	HeaderForwardedFor = "x-forwarded-for"
+)
+
+// rateLimitKeys соответствие признаков подсчёта из proto аннотации
+var rateLimitKeys = map[commonV1.RateLimitKey]ratelimit.Key{
+	commonV1.RateLimitKey_RATE_LIMIT_KEY_UNSPECIFIED: ratelimit.KeyUser,
+	commonV1.RateLimitKey_RATE_LIMIT_KEY_USER:        ratelimit.KeyUser,
+	commonV1.RateLimitKey_RATE_LIMIT_KEY_SESSION:     ratelimit.KeySession,
+	commonV1.RateLimitKey_RATE_LIMIT_KEY_IP:          ratelimit.KeyIP,
+	commonV1.RateLimitKey_RATE_LIMIT_KEY_API_KEY:     ratelimit.KeyAPIKey,
+}
+
+// rateLimitRule лимит метода и признак, по которому считаются запросы
+type rateLimitRule struct {
+	limit ratelimit.Limit
+	key   ratelimit.Key
+}
+
+// RateLimitInterceptor ограничивает частоту вызовов методов. Правило метода берётся
+// из конфигурации rate_limit, затем из аннотации (common.v1.rate_limit), иначе действует
+// лимит по умолчанию. Превышение лимита возвращает ResourceExhausted с RetryInfo.
+// Подключается после AccessInterceptors, иначе запросы считаются только по IP
+type RateLimitInterceptor struct {
+	limiter     ratelimit.Limiter
+	failOpen    bool
+	defaultRule rateLimitRule
+	// Статический кеш правил методов (заполняется при инициализации)
+	rulesCache map[string]rateLimitRule
+	metrics    *metric.RateLimitMetrics
+}
+
+// NewRateLimitInterceptor создает interceptor ограничения частоты поверх limiter
+func NewRateLimitInterceptor(ctx context.Context, limiter ratelimit.Limiter, cfg contracts.RateLimitConfig) *RateLimitInterceptor {
+	interceptor := &RateLimitInterceptor{
+		limiter:     limiter,
+		failOpen:    cfg.FailOpen(),
+		defaultRule: ruleFromConfig(cfg.Default()),
+		rulesCache:  make(map[string]rateLimitRule),
+		metrics:     metric.NewRateLimitMetrics(ctx),
+	}
+
+	// Предварительно заполняем кеш правилами из аннотаций, конфигурация их перекрывает
+	interceptor.buildRulesCache(cfg)
+
+	return interceptor
+}
+
+// Unary возвращает unary server interceptor ограничения частоты
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := i.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream возвращает stream server interceptor ограничения частоты.
+// Лимит списывается один раз при открытии потока
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// allow списывает токен из корзины вызывающего для метода
+func (i *RateLimitInterceptor) allow(ctx context.Context, fullMethod string) error {
+	rule, exists := i.rulesCache[fullMethod]
+	if !exists {
+		rule = i.defaultRule
+	}
+	if rule.limit.IsZero() {
+		return nil
+	}
+
+	key, subject := rateLimitSubject(ctx, rule.key)
+	result, err := i.limiter.Allow(ctx, fullMethod+":"+string(key)+":"+subject, rule.limit)
+	if err != nil {
+		i.metrics.RecordDecision(ctx, fullMethod, string(key), metric.RateLimitError)
+		if i.failOpen {
+			logger.Warn(ctx, "⚠️ [RateLimit] Хранилище лимитов недоступно, запрос пропущен",
+				zap.String("method", fullMethod), zap.Error(err))
+			return nil
+		}
+
+		logger.Error(ctx, "❌ [RateLimit] Хранилище лимитов недоступно, запрос отклонён",
+			zap.String("method", fullMethod), zap.Error(err))
+		return apperr.ToStatus(ctx, errRateLimitDown)
+	}
+
+	if !result.Allowed {
+		i.metrics.RecordDecision(ctx, fullMethod, string(key), metric.RateLimitRejected)
+		return apperr.ToStatus(ctx, errRateLimited.
+			WithRetryAfter(result.RetryAfter).
+			WithMetadata("method", fullMethod).
+			WithMetadata("key", string(key)))
+	}
+
+	i.metrics.RecordDecision(ctx, fullMethod, string(key), metric.RateLimitAllowed)
+	return nil
+}
+
+// rateLimitSubject возвращает фактический признак и значение, по которому считаются запросы.
+// Пользователь и сессия берутся только у вызывающего, подтвержденного AuthInterceptor: заголовки
+// публичных методов клиент может подделать. Если значение признака отсутствует (анонимный запрос,
+// нет ключа), запросы считаются по IP клиента
+func rateLimitSubject(ctx context.Context, key ratelimit.Key) (ratelimit.Key, string) {
+	caller, _ := GetCallerFromContext(ctx)
+
+	switch key {
+	case ratelimit.KeyUser:
+		if caller.UserID != "" {
+			return key, caller.UserID
+		}
+	case ratelimit.KeySession:
+		if caller.SessionID != "" {
+			return key, caller.SessionID
+		}
+	case ratelimit.KeyAPIKey:
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if apiKey := firstValue(md, HeaderAPIKey); apiKey != "" {
+				// Сам ключ не попадает в хранилище лимитов
+				sum := sha256.Sum256([]byte(apiKey))
+				return key, hex.EncodeToString(sum[:16])
+			}
+		}
+	}
+
+	return ratelimit.KeyIP, clientIP(ctx)
+}
+
+// clientIP возвращает адрес клиента: последний адрес x-forwarded-for, добавленный
+// ближайшим прокси (Envoy или HTTP шлюзом), иначе адрес TCP-собеседника
+func clientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(HeaderForwardedFor); len(values) > 0 {
+			chain := strings.Split(values[len(values)-1], ",")
+			if ip := strings.TrimSpace(chain[len(chain)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+
+	return "unknown"
+}
+
+// ruleFromConfig преобразует правило конфигурации; пустой признак означает пользователя
+func ruleFromConfig(rule contracts.RateLimitRule) rateLimitRule {
+	key := ratelimit.Key(rule.Key())
+	if key == "" {
+		key = ratelimit.KeyUser
+	}
+
+	return rateLimitRule{
+		limit: ratelimit.Limit{Requests: rule.Requests(), Period: rule.Period(), Burst: rule.Burst()},
+		key:   key,
+	}
+}
+
+// buildRulesCache заполняет кеш правилами из proto аннотаций и конфигурации
+func (i *RateLimitInterceptor) buildRulesCache(cfg contracts.RateLimitConfig) {
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for j := 0; j < services.Len(); j++ {
+			service := services.Get(j)
+
+			methods := service.Methods()
+			for k := 0; k < methods.Len(); k++ {
+				method := methods.Get(k)
+
+				options := method.Options().(*descriptorpb.MethodOptions)
+				if options == nil {
+					continue
+				}
+
+				ext, ok := proto.GetExtension(options, commonV1.E_RateLimit).(*commonV1.RateLimit)
+				if !ok || ext == nil || ext.GetRequests() == 0 {
+					continue
+				}
+
+				fullMethod := "/" + string(service.FullName()) + "/" + string(method.Name())
+				i.rulesCache[fullMethod] = rateLimitRule{
+					limit: ratelimit.Limit{
+						Requests: int(ext.GetRequests()),
+						Period:   time.Duration(ext.GetPeriodSeconds()) * time.Second,
+						Burst:    int(ext.GetBurst()),
+					},
+					key: rateLimitKeys[ext.GetKey()],
+				}
+			}
+		}
+		return true
+	})
+
+	// Правила из конфигурации перекрывают аннотации
+	for fullMethod, rule := range cfg.Methods() {
+		i.rulesCache[fullMethod] = ruleFromConfig(rule)
+	}
+}
@@ -0,0 +1,177 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/ratelimit"
+	_ "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/auth/v1"
+)
+
+const loginMethod = "/auth.v1.AuthService/Login"
+
+type fakeRateLimitRule struct {
+	requests int
+	period   time.Duration
+	key      string
+}
+
+func (r fakeRateLimitRule) Requests() int         { return r.requests }
+func (r fakeRateLimitRule) Period() time.Duration { return r.period }
+func (r fakeRateLimitRule) Burst() int            { return 0 }
+func (r fakeRateLimitRule) Key() string           { return r.key }
+
+type fakeRateLimitConfig struct {
+	failOpen bool
+	def      fakeRateLimitRule
+	methods  map[string]contracts.RateLimitRule
+}
+
+func (c fakeRateLimitConfig) IsEnabled() bool                  { return true }
+func (c fakeRateLimitConfig) KeyPrefix() string                { return "rl:" }
+func (c fakeRateLimitConfig) FailOpen() bool                   { return c.failOpen }
+func (c fakeRateLimitConfig) Default() contracts.RateLimitRule { return c.def }
+func (c fakeRateLimitConfig) Methods() map[string]contracts.RateLimitRule {
+	return c.methods
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("redis: connection refused")
+}
+
+func callRateLimited(i *RateLimitInterceptor, ctx context.Context, method string) error {
+	_, err := i.Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(context.Context, any) (any, error) { return nil, nil })
+	return err
+}
+
+// TestRateLimitInterceptorAnnotation проверяет лимит из аннотации Login (10 в минуту по IP)
+func TestRateLimitInterceptorAnnotation(t *testing.T) {
+	i := NewRateLimitInterceptor(context.Background(), ratelimit.NewMemoryLimiter(), fakeRateLimitConfig{})
+	ctx := incomingContext(HeaderForwardedFor, "203.0.113.7")
+
+	for n := 0; n < 10; n++ {
+		if err := callRateLimited(i, ctx, loginMethod); err != nil {
+			t.Fatalf("request %d rejected: %v", n, err)
+		}
+	}
+
+	st := status.Convert(callRateLimited(i, ctx, loginMethod))
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("code = %v, want ResourceExhausted", st.Code())
+	}
+
+	var retry *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil || retry.GetRetryDelay().AsDuration() <= 0 {
+		t.Fatalf("expected positive RetryInfo, got %v", retry)
+	}
+
+	// Другой клиент считается отдельно, методы без правила не ограничены
+	if err := callRateLimited(i, incomingContext(HeaderForwardedFor, "203.0.113.8"), loginMethod); err != nil {
+		t.Fatalf("other IP rejected: %v", err)
+	}
+	if err := callRateLimited(i, ctx, "/auth.v1.AuthService/Whoami"); err != nil {
+		t.Fatalf("method without rule rejected: %v", err)
+	}
+}
+
+// TestRateLimitInterceptorConfigOverride проверяет приоритет конфигурации и лимит по умолчанию по пользователю
+func TestRateLimitInterceptorConfigOverride(t *testing.T) {
+	cfg := fakeRateLimitConfig{
+		def: fakeRateLimitRule{requests: 1, period: time.Minute, key: "user"},
+		methods: map[string]contracts.RateLimitRule{
+			loginMethod: fakeRateLimitRule{requests: 2, period: time.Minute, key: "ip"},
+		},
+	}
+	i := NewRateLimitInterceptor(context.Background(), ratelimit.NewMemoryLimiter(), cfg)
+
+	ctx := incomingContext(HeaderForwardedFor, "198.51.100.1")
+	for n := 0; n < 2; n++ {
+		if err := callRateLimited(i, ctx, loginMethod); err != nil {
+			t.Fatalf("request %d rejected: %v", n, err)
+		}
+	}
+	if status.Code(callRateLimited(i, ctx, loginMethod)) != codes.ResourceExhausted {
+		t.Fatal("config rule must override annotation")
+	}
+
+	alice := context.WithValue(ctx, callerContextKey, Caller{UserID: "alice"})
+	bob := context.WithValue(ctx, callerContextKey, Caller{UserID: "bob"})
+	if err := callRateLimited(i, alice, "/role.v1.RoleService/Get"); err != nil {
+		t.Fatalf("first request rejected: %v", err)
+	}
+	if status.Code(callRateLimited(i, alice, "/role.v1.RoleService/Get")) != codes.ResourceExhausted {
+		t.Fatal("default rule must limit user")
+	}
+	if err := callRateLimited(i, bob, "/role.v1.RoleService/Get"); err != nil {
+		t.Fatalf("users behind one IP must be counted separately: %v", err)
+	}
+}
+
+// TestRateLimitInterceptorForgedUserID проверяет, что на публичном методе x-user-id не меняет корзину:
+// до AuthInterceptor пользователь не подтвержден, и запросы считаются по IP
+func TestRateLimitInterceptorForgedUserID(t *testing.T) {
+	cfg := fakeRateLimitConfig{
+		methods: map[string]contracts.RateLimitRule{
+			loginMethod: fakeRateLimitRule{requests: 1, period: time.Minute, key: "user"},
+		},
+	}
+	i := NewRateLimitInterceptor(context.Background(), ratelimit.NewMemoryLimiter(), cfg)
+
+	forged := func(userID string) context.Context {
+		return withIdentity(incomingContext(HeaderForwardedFor, "203.0.113.50", HeaderUserID, userID))
+	}
+
+	if err := callRateLimited(i, forged("attacker-1"), loginMethod); err != nil {
+		t.Fatalf("first request rejected: %v", err)
+	}
+	if status.Code(callRateLimited(i, forged("attacker-2"), loginMethod)) != codes.ResourceExhausted {
+		t.Fatal("forged x-user-id must not open a new bucket")
+	}
+
+	// Запросы с подделанным ID жертвы не расходуют корзину подтвержденного пользователя
+	for n := 0; n < 2; n++ {
+		_ = callRateLimited(i, forged("victim"), loginMethod)
+	}
+	victim := context.WithValue(incomingContext(HeaderForwardedFor, "198.51.100.9"), callerContextKey, Caller{UserID: "victim"})
+	if err := callRateLimited(i, victim, loginMethod); err != nil {
+		t.Fatalf("verified user bucket drained by forged header: %v", err)
+	}
+}
+
+// TestRateLimitInterceptorStoreFailure проверяет поведение при недоступном хранилище лимитов
+func TestRateLimitInterceptorStoreFailure(t *testing.T) {
+	ctx := incomingContext(HeaderForwardedFor, "192.0.2.1")
+
+	open := NewRateLimitInterceptor(context.Background(), failingLimiter{}, fakeRateLimitConfig{failOpen: true})
+	if err := callRateLimited(open, ctx, loginMethod); err != nil {
+		t.Fatalf("fail-open must pass request, got %v", err)
+	}
+
+	closed := NewRateLimitInterceptor(context.Background(), failingLimiter{}, fakeRateLimitConfig{})
+	if code := status.Code(callRateLimited(closed, ctx, loginMethod)); code != codes.Unavailable {
+		t.Fatalf("fail-closed code = %v, want Unavailable", code)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	ctx := incomingContext(HeaderForwardedFor, "10.0.0.1, 203.0.113.9")
+	if ip := clientIP(ctx); ip != "203.0.113.9" {
+		t.Fatalf("clientIP = %q, want address appended by nearest proxy", ip)
+	}
+}
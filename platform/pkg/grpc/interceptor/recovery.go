@@ -6,8 +6,8 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/status"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 )
 
@@ -20,9 +20,26 @@ func RecoveryInterceptor() grpc.UnaryServerInterceptor {
 					zap.Any("panic", r),
 					zap.ByteString("stacktrace", debug.Stack()),
 				)
-				err = status.Errorf(13, "internal server error")
+				err = apperr.ToStatus(ctx, errPanic)
 			}
 		}()
 		return handler(ctx, req)
 	}
 }
+
+// RecoveryStreamInterceptor перехватывает паники в stream gRPC-обработчиках и возвращает внутр. ошибку.
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(ss.Context(), "💥 [gRPC] Panic в потоковом обработчике",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.ByteString("stacktrace", debug.Stack()),
+				)
+				err = apperr.ToStatus(ss.Context(), errPanic)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -2,8 +2,8 @@ package interceptor
 
 import (
 	"encoding/json"
-	"strings"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
 
@@ -32,22 +32,20 @@ func (p *RoleParser) parsePermissionsMap(roleNames, permissionsList []string) ma
 	permissionsMap := make(map[string][]*commonV1.Permission)
 
 	for _, permStr := range permissionsList {
-		parts := strings.Split(permStr, ":")
-		if len(parts) != 2 {
+		permission, err := authz.Parse(permStr)
+		if err != nil {
 			continue
 		}
 
-		resource := parts[0]
-		action := parts[1]
-
 		for _, roleName := range roleNames {
 			if permissionsMap[roleName] == nil {
 				permissionsMap[roleName] = make([]*commonV1.Permission, 0)
 			}
 			permissionsMap[roleName] = append(permissionsMap[roleName], &commonV1.Permission{
 				Id:       "",
-				Resource: resource,
-				Action:   action,
+				Resource: permission.Resource,
+				Action:   permission.Action,
+				Effect:   authz.EffectToProto(permission.Effect),
 			})
 		}
 	}
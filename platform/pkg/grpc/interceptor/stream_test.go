@@ -0,0 +1,203 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const streamMethod = "/schedule.v1.ScheduleService/WatchSchedule"
+
+// fakeServerStream минимальная реализация grpc.ServerStream для тестов
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	incoming []*fakeMessage
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	if len(s.incoming) == 0 {
+		return io.EOF
+	}
+	*m.(*fakeMessage) = *s.incoming[0]
+	s.incoming = s.incoming[1:]
+	return nil
+}
+
+// fakeMessage сообщение с валидацией по аналогии со сгенерированными protovalidate-типами
+type fakeMessage struct {
+	ID string
+}
+
+func (m *fakeMessage) Validate() error {
+	if m.ID == "" {
+		return errors.New("id is required")
+	}
+	return nil
+}
+
+func incomingContext(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+// TestValidationStreamInterceptor проверяет, что валидируется каждое полученное сообщение
+func TestValidationStreamInterceptor(t *testing.T) {
+	stream := &fakeServerStream{
+		ctx:      context.Background(),
+		incoming: []*fakeMessage{{ID: "1"}, {ID: ""}, {ID: "3"}},
+	}
+
+	var received []string
+	err := ValidationStreamInterceptor()(nil, stream, &grpc.StreamServerInfo{FullMethod: streamMethod},
+		func(_ any, ss grpc.ServerStream) error {
+			for {
+				var msg fakeMessage
+				if err := ss.RecvMsg(&msg); err != nil {
+					return err
+				}
+				received = append(received, msg.ID)
+			}
+		})
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if len(received) != 1 || received[0] != "1" {
+		t.Fatalf("expected only first message to pass validation, got %v", received)
+	}
+}
+
+// TestPermissionStreamInterceptor проверяет поиск аннотации permission для потоковых методов
+func TestPermissionStreamInterceptor(t *testing.T) {
+	permissions := &PermissionInterceptor{permissionCache: map[string]string{streamMethod: "schedule:read"}}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		expected codes.Code
+	}{
+		{
+			name:     "allowed",
+			ctx:      context.WithValue(context.Background(), userPermissionsStringsContextKey, []string{"schedule:*"}),
+			method:   streamMethod,
+			expected: codes.OK,
+		},
+		{
+			name:     "denied",
+			ctx:      context.WithValue(context.Background(), userPermissionsStringsContextKey, []string{"user:read"}),
+			method:   streamMethod,
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "not authenticated",
+			ctx:      context.Background(),
+			method:   streamMethod,
+			expected: codes.Unauthenticated,
+		},
+		{
+			name:     "method without annotation",
+			ctx:      context.Background(),
+			method:   "/schedule.v1.ScheduleService/Other",
+			expected: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			err := permissions.StreamServerInterceptor()(nil, &fakeServerStream{ctx: tt.ctx},
+				&grpc.StreamServerInfo{FullMethod: tt.method},
+				func(_ any, _ grpc.ServerStream) error {
+					called = true
+					return nil
+				})
+
+			if status.Code(err) != tt.expected {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if called != (tt.expected == codes.OK) {
+				t.Fatalf("unexpected handler call: %v", called)
+			}
+		})
+	}
+}
+
+// TestAuthStreamChain проверяет, что контекст, обогащенный интерсепторами, доходит до обработчика потока
+func TestAuthStreamChain(t *testing.T) {
+	filter := &PublicFilter{
+		publicMethodsCache: map[string]bool{},
+		systemMethods:      map[string]bool{},
+	}
+	auth := NewAuthInterceptor()
+
+	t.Run("protected method requires session", func(t *testing.T) {
+		err := filter.Stream()(nil, &fakeServerStream{ctx: incomingContext()},
+			&grpc.StreamServerInfo{FullMethod: streamMethod},
+			func(srv any, ss grpc.ServerStream) error {
+				return auth.Stream()(srv, ss, &grpc.StreamServerInfo{FullMethod: streamMethod},
+					func(_ any, _ grpc.ServerStream) error { return nil })
+			})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("identity and permissions reach handler", func(t *testing.T) {
+		ctx := incomingContext(
+			HeaderSessionID, "session-1",
+			HeaderUserID, "user-1",
+			HeaderUserPermissions, "schedule:read,user:read",
+		)
+		err := auth.Stream()(nil, &fakeServerStream{ctx: ctx},
+			&grpc.StreamServerInfo{FullMethod: streamMethod},
+			func(_ any, ss grpc.ServerStream) error {
+				if userID, _ := GetUserIDFromContext(ss.Context()); userID != "user-1" {
+					t.Errorf("expected user-1, got %q", userID)
+				}
+				if permissions, _ := GetUserPermissionsStringsFromContext(ss.Context()); len(permissions) != 2 {
+					t.Errorf("expected 2 permissions, got %v", permissions)
+				}
+				return nil
+			})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("public method skips authentication", func(t *testing.T) {
+		filter.publicMethodsCache[streamMethod] = true
+		defer delete(filter.publicMethodsCache, streamMethod)
+
+		err := filter.Stream()(nil, &fakeServerStream{ctx: incomingContext()},
+			&grpc.StreamServerInfo{FullMethod: streamMethod},
+			func(srv any, ss grpc.ServerStream) error {
+				return auth.Stream()(srv, ss, &grpc.StreamServerInfo{FullMethod: streamMethod},
+					func(_ any, _ grpc.ServerStream) error { return nil })
+			})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// TestRecoveryStreamInterceptor проверяет перехват паники в потоковом обработчике
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	err := RecoveryStreamInterceptor()(nil, &fakeServerStream{ctx: context.Background()},
+		&grpc.StreamServerInfo{FullMethod: streamMethod},
+		func(_ any, _ grpc.ServerStream) error { panic("boom") })
+
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+}
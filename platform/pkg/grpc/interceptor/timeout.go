@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 )
 
@@ -21,3 +22,26 @@ func TimeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// TimeoutStreamInterceptor ограничивает время жизни серверного потока.
+// Таймаут отсчитывается от открытия потока, а не от каждого сообщения;
+// при timeout <= 0 поток не ограничивается.
+func TimeoutStreamInterceptor(timeout time.Duration) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if timeout <= 0 {
+			return handler(srv, ss)
+		}
+
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
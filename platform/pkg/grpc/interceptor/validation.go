@@ -2,14 +2,18 @@ package interceptor
 
 import (
 	"context"
+	"errors"
+	"regexp"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
 )
 
 // ValidationInterceptor выполняет валидацию входящего запроса, если он
-// реализует интерфейс Validate() error. При ошибке возвращает codes.InvalidArgument.
+// реализует интерфейс Validate() error. При ошибке возвращает codes.InvalidArgument
+// с деталью BadRequest, перечисляющей все нарушенные поля на языке клиента.
 func ValidationInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -17,11 +21,141 @@ func ValidationInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		if v, ok := req.(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "validation error: %v", err)
-			}
+		if err := validate(ctx, req); err != nil {
+			return nil, err
 		}
 		return handler(ctx, req)
 	}
 }
+
+// ValidationStreamInterceptor выполняет валидацию каждого сообщения,
+// полученного из клиентского потока. При ошибке RecvMsg возвращает codes.InvalidArgument.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+// validatingStream проверяет сообщения сразу после их получения
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg получает сообщение и валидирует его
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(s.Context(), m)
+}
+
+// fieldError ошибка protoc-gen-validate по одному полю
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validate вызывает ValidateAll() (или Validate()) у сообщения, если он реализован
+func validate(ctx context.Context, msg interface{}) error {
+	var err error
+	switch v := msg.(type) {
+	case interface{ ValidateAll() error }:
+		err = v.ValidateAll()
+	case interface{ Validate() error }:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+
+	violations := fieldViolations(err, "")
+	if len(violations) == 0 {
+		return apperr.ToStatus(ctx, errRequestValidation.WithField("", err.Error()))
+	}
+
+	return apperr.ToStatus(ctx, errRequestValidation.WithFields(violations...))
+}
+
+// fieldViolations разворачивает ошибки protoc-gen-validate в нарушения полей,
+// собирая путь вложенных сообщений (filter.name)
+func fieldViolations(err error, prefix string) []apperr.FieldViolation {
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		var violations []apperr.FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(e, prefix)...)
+		}
+		return violations
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return nil
+	}
+
+	path := fe.Field()
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+
+	if cause := fe.Cause(); cause != nil {
+		if nested := fieldViolations(cause, path); len(nested) > 0 {
+			return nested
+		}
+	}
+
+	key, args := reasonMessage(fe.Reason())
+	return []apperr.FieldViolation{{Field: path, Description: key, Args: args}}
+}
+
+// reasonRule сопоставляет текст причины protoc-gen-validate ключу каталога i18n
+type reasonRule struct {
+	pattern *regexp.Regexp
+	key     string
+}
+
+// reasonRules шаблоны причин protoc-gen-validate; группы регулярного выражения становятся аргументами сообщения
+var reasonRules = []reasonRule{
+	{regexp.MustCompile(`^value is required$`), i18n.MsgValidateRequired},
+	{regexp.MustCompile(`^value length must be at least (\d+) runes$`), i18n.MsgValidateMinLen},
+	{regexp.MustCompile(`^value length must be at most (\d+) runes$`), i18n.MsgValidateMaxLen},
+	{regexp.MustCompile(`^value length must be between (\d+) and (\d+) runes, inclusive$`), i18n.MsgValidateLenBetween},
+	{regexp.MustCompile(`^value length must be between (\d+) and (\d+) bytes, inclusive$`), i18n.MsgValidateBytes},
+	{regexp.MustCompile(`^value must be a valid UUID`), i18n.MsgValidateUUID},
+	{regexp.MustCompile(`^value must be a valid email address`), i18n.MsgValidateEmail},
+	{regexp.MustCompile(`^value must be in list \[(.*)\]$`), i18n.MsgValidateIn},
+	{regexp.MustCompile(`^value must not be in list \[(.*)\]$`), i18n.MsgValidateNotIn},
+	{regexp.MustCompile(`^value must be inside range \[(.*), (.*)\]$`), i18n.MsgValidateRange},
+	{regexp.MustCompile(`^value must be greater than or equal to (.*)$`), i18n.MsgValidateGTE},
+	{regexp.MustCompile(`^value must be less than or equal to (.*)$`), i18n.MsgValidateLTE},
+	{regexp.MustCompile(`^value must be greater than (.*)$`), i18n.MsgValidateGT},
+	{regexp.MustCompile(`^value must be less than (.*)$`), i18n.MsgValidateLT},
+	{regexp.MustCompile(`^value must be one of the defined enum values$`), i18n.MsgValidateDefinedEnum},
+	{regexp.MustCompile(`^value must contain between (\d+) and (\d+) items, inclusive$`), i18n.MsgValidateItems},
+	{regexp.MustCompile(`^value must contain at least (\d+) item\(s\)$`), i18n.MsgValidateMinItems},
+	{regexp.MustCompile(`^value must contain no more than (\d+) item\(s\)$`), i18n.MsgValidateMaxItems},
+	{regexp.MustCompile(`^repeated value must contain unique items$`), i18n.MsgValidateUnique},
+}
+
+// reasonMessage возвращает ключ и аргументы сообщения для причины protoc-gen-validate;
+// неизвестная причина возвращается как есть
+func reasonMessage(reason string) (string, []any) {
+	for _, rule := range reasonRules {
+		match := rule.pattern.FindStringSubmatch(reason)
+		if match == nil {
+			continue
+		}
+
+		args := make([]any, 0, len(match)-1)
+		for _, group := range match[1:] {
+			args = append(args, group)
+		}
+		return rule.key, args
+	}
+	return reason, nil
+}
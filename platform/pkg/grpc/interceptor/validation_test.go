@@ -0,0 +1,90 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+// TestValidationInterceptorFieldViolations проверяет, что все нарушенные поля попадают в BadRequest
+func TestValidationInterceptorFieldViolations(t *testing.T) {
+	name := "x"
+	req := &roleV1.UpdateRequest{RoleId: "not-a-uuid", Name: &name}
+
+	called := false
+	_, err := ValidationInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/role.v1.RoleService/Update"},
+		func(context.Context, any) (any, error) {
+			called = true
+			return nil, nil
+		})
+
+	if called {
+		t.Fatal("handler must not be called for invalid request")
+	}
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+
+	fields := violationsOf(st)
+	if fields["RoleId"] != "значение должно быть UUID" || fields["Name"] != "длина должна быть от 2 до 50 символов" {
+		t.Fatalf("expected localized violations for RoleId and Name, got %v", fields)
+	}
+}
+
+// TestValidationInterceptorEnglish проверяет перевод нарушений на язык из Accept-Language
+func TestValidationInterceptorEnglish(t *testing.T) {
+	ctx := withLocale(incomingContext(gatewayAcceptLanguageKey, "en-US,en;q=0.9"))
+
+	_, err := ValidationInterceptor()(ctx, &roleV1.GetRequest{RoleId: "bad"}, &grpc.UnaryServerInfo{FullMethod: "/role.v1.RoleService/Get"},
+		func(context.Context, any) (any, error) { return nil, nil })
+
+	st := status.Convert(err)
+	if st.Message() != "request validation failed" {
+		t.Fatalf("message = %q", st.Message())
+	}
+	if fields := violationsOf(st); fields["RoleId"] != "value must be a valid UUID" {
+		t.Fatalf("unexpected violations: %v", fields)
+	}
+}
+
+func TestReasonMessage(t *testing.T) {
+	key, args := reasonMessage("value must contain between 1 and 20 items, inclusive")
+	if key != i18n.MsgValidateItems || len(args) != 2 || args[0] != "1" || args[1] != "20" {
+		t.Fatalf("unexpected message %q %v", key, args)
+	}
+
+	if key, _ = reasonMessage("custom reason"); key != "custom reason" {
+		t.Fatalf("unknown reason must be kept, got %q", key)
+	}
+}
+
+func violationsOf(st *status.Status) map[string]string {
+	fields := map[string]string{}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields[violation.GetField()] = violation.GetDescription()
+			}
+		}
+	}
+	return fields
+}
+
+// TestValidationInterceptorPlainError проверяет ошибки Validate() без сведений о поле
+func TestValidationInterceptorPlainError(t *testing.T) {
+	_, err := ValidationInterceptor()(context.Background(), &fakeMessage{}, &grpc.UnaryServerInfo{FullMethod: streamMethod},
+		func(context.Context, any) (any, error) { return nil, nil })
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
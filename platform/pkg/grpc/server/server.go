@@ -7,14 +7,27 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	grpctls "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/tls"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 )
 
+// Interceptor объединяет unary и stream версии дополнительного интерсептора.
+// Передача пары гарантирует, что проверка не будет подключена только к одному типу RPC.
+// Пустое поле означает, что для соответствующего типа RPC интерсептор не нужен.
+type Interceptor struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
 // BuildUnaryInterceptors строит базовую цепочку Unary-интерсепторов сервера gRPC
-// на основе конфигурации платформы (логирование, recovery, валидация, таймаут).
+// на основе конфигурации платформы (идентификаторы запроса и TLS-собеседника, логирование, recovery, валидация, таймаут).
 func BuildUnaryInterceptors(timeout time.Duration) []grpc.UnaryServerInterceptor {
 	return []grpc.UnaryServerInterceptor{
+		interceptor.LocaleInterceptor(),
+		interceptor.IdentityInterceptor(),
+		interceptor.PeerIdentityInterceptor(),
 		logger.UnaryServerInterceptor(),
 		interceptor.RecoveryInterceptor(),
 		interceptor.ValidationInterceptor(),
@@ -22,19 +35,69 @@ func BuildUnaryInterceptors(timeout time.Duration) []grpc.UnaryServerInterceptor
 	}
 }
 
+// BuildStreamInterceptors строит базовую цепочку Stream-интерсепторов в том же порядке,
+// что и BuildUnaryInterceptors. Валидация выполняется для каждого входящего сообщения.
+func BuildStreamInterceptors(timeout time.Duration) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		interceptor.LocaleStreamInterceptor(),
+		interceptor.IdentityStreamInterceptor(),
+		interceptor.PeerIdentityStreamInterceptor(),
+		logger.StreamServerInterceptor(),
+		interceptor.RecoveryStreamInterceptor(),
+		interceptor.ValidationStreamInterceptor(),
+		interceptor.TimeoutStreamInterceptor(timeout),
+	}
+}
+
+// AccessInterceptors строит интерсепторы доступа в порядке применения: пропуск публичных методов,
+// аутентификация по заголовкам Envoy (или mTLS доверенного сервиса) и проверка прав из аннотаций proto.
+// Общая сборка гарантирует, что все сервисы проверяют доступ одинаково
+func AccessInterceptors(opts ...interceptor.AuthOption) []Interceptor {
+	publicFilter := interceptor.NewPublicFilter()
+	authInterceptor := interceptor.NewAuthInterceptor(opts...)
+	permissionInterceptor := interceptor.NewPermissionInterceptor()
+
+	return []Interceptor{
+		{Unary: publicFilter.Unary(), Stream: publicFilter.Stream()},
+		{Unary: authInterceptor.Unary(), Stream: authInterceptor.Stream()},
+		{
+			Unary:  permissionInterceptor.UnaryServerInterceptor(),
+			Stream: permissionInterceptor.StreamServerInterceptor(),
+		},
+	}
+}
+
 // New создаёт gRPC-сервер с базовыми интерсепторами и опциональными доп. интерсепторами.
+// При включённом TLS сервер принимает только TLS-соединения (с RequireClientCert - mTLS).
 func New(_ context.Context,
 	timeout time.Duration,
 	maxRecvMsgSize, maxSendMsgSize int,
-	extra ...grpc.UnaryServerInterceptor,
-) *grpc.Server {
-	chain := append(BuildUnaryInterceptors(timeout), extra...)
+	tlsCfg contracts.TLSConfig,
+	extra ...Interceptor,
+) (*grpc.Server, error) {
+	creds, err := grpctls.ServerCredentials(tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	unary := BuildUnaryInterceptors(timeout)
+	stream := BuildStreamInterceptors(timeout)
+	for _, i := range extra {
+		if i.Unary != nil {
+			unary = append(unary, i.Unary)
+		}
+		if i.Stream != nil {
+			stream = append(stream, i.Stream)
+		}
+	}
 
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(chain...)),
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unary...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(stream...)),
 		grpc.MaxRecvMsgSize(maxRecvMsgSize),
 		grpc.MaxSendMsgSize(maxSendMsgSize),
 	}
 
-	return grpc.NewServer(opts...)
+	return grpc.NewServer(opts...), nil
 }
@@ -0,0 +1,296 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+const (
+	rbacSPIFFE    = "spiffe://school.local/ns/default/sa/rbac"
+	auditSPIFFE   = "spiffe://school.local/ns/default/sa/audit"
+	unknownSPIFFE = "spiffe://school.local/ns/default/sa/unknown"
+
+	testUserID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+)
+
+// testCA самоподписанный CA для выпуска тестовых сертификатов
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	writeFile(t, ca.caFile(), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	return ca
+}
+
+func (ca *testCA) caFile() string { return filepath.Join(ca.dir, "ca.crt") }
+
+// issue выпускает сертификат и возвращает пути к файлам сертификата и ключа
+func (ca *testCA) issue(t *testing.T, name, spiffeID string, usage x509.ExtKeyUsage) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uri, err := url.Parse(spiffeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{name},
+		URIs:         []*url.URL{uri},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(ca.dir, name+".crt")
+	keyFile := filepath.Join(ca.dir, name+".key")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	return certFile, keyFile
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testTLSConfig реализация contracts.TLSConfig для тестов
+type testTLSConfig struct {
+	certFile, keyFile, caFile string
+	requireClientCert         bool
+	serverName                string
+}
+
+func (c *testTLSConfig) Enabled() bool                 { return true }
+func (c *testTLSConfig) CertFile() string              { return c.certFile }
+func (c *testTLSConfig) KeyFile() string               { return c.keyFile }
+func (c *testTLSConfig) CAFile() string                { return c.caFile }
+func (c *testTLSConfig) RequireClientCert() bool       { return c.requireClientCert }
+func (c *testTLSConfig) AllowedPeerIDs() []string      { return nil }
+func (c *testTLSConfig) ServerName() string            { return c.serverName }
+func (c *testTLSConfig) ReloadInterval() time.Duration { return time.Minute }
+
+// userService отвечает на GetUsers, если вызов прошёл цепочку интерсепторов
+type userService struct {
+	userV1.UnimplementedUserServiceServer
+}
+
+func (userService) GetUsers(context.Context, *userV1.GetUsersRequest) (*userV1.GetUsersResponse, error) {
+	return &userV1.GetUsersResponse{}, nil
+}
+
+// TestTrustedPeerThroughInterceptorChain проверяет вызов сервиса без сессии через mTLS и
+// полную цепочку Public/Auth/Permission: права выдаются только доверенному собеседнику
+func TestTrustedPeerThroughInterceptorChain(t *testing.T) {
+	logger.SetNopLogger()
+
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, "iam", "spiffe://school.local/ns/default/sa/iam", x509.ExtKeyUsageServerAuth)
+
+	srv, err := New(context.Background(), 5*time.Second, 4<<20, 4<<20,
+		&testTLSConfig{certFile: serverCert, keyFile: serverKey, caFile: ca.caFile(), requireClientCert: true},
+		AccessInterceptors(interceptor.WithTrustedPeers(map[string][]string{
+			rbacSPIFFE:  {"user:read"},
+			auditSPIFFE: {"audit:read"},
+		}))...,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userV1.RegisterUserServiceServer(srv, userService{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	getUsers := func(t *testing.T, name, spiffeID string) error {
+		t.Helper()
+
+		certFile, keyFile := ca.issue(t, name, spiffeID, x509.ExtKeyUsageClientAuth)
+		conn, err := grpcclient.NewClient(listener.Addr().String(),
+			&testTLSConfig{certFile: certFile, keyFile: keyFile, caFile: ca.caFile(), serverName: "iam"},
+			4<<20, 4<<20, 5*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = userV1.NewUserServiceClient(conn).GetUsers(context.Background(),
+			&userV1.GetUsersRequest{UserIds: []string{testUserID}})
+		return err
+	}
+
+	t.Run("trusted peer with granted permission", func(t *testing.T) {
+		if err := getUsers(t, "rbac", rbacSPIFFE); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("trusted peer without permission", func(t *testing.T) {
+		if err := getUsers(t, "audit", auditSPIFFE); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("untrusted peer", func(t *testing.T) {
+		if err := getUsers(t, "unknown", unknownSPIFFE); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+}
+
+// plaintextTLS транспорт без TLS, как в конфигурациях k8s
+type plaintextTLS struct{}
+
+func (plaintextTLS) Enabled() bool                 { return false }
+func (plaintextTLS) CertFile() string              { return "" }
+func (plaintextTLS) KeyFile() string               { return "" }
+func (plaintextTLS) CAFile() string                { return "" }
+func (plaintextTLS) RequireClientCert() bool       { return false }
+func (plaintextTLS) AllowedPeerIDs() []string      { return nil }
+func (plaintextTLS) ServerName() string            { return "" }
+func (plaintextTLS) ReloadInterval() time.Duration { return 0 }
+
+// TestServiceTokenThroughInterceptorChain проверяет вызов сервиса без сессии и без mTLS:
+// токен сервиса подтверждает доверенного собеседника, права ограничены выданными ему
+func TestServiceTokenThroughInterceptorChain(t *testing.T) {
+	logger.SetNopLogger()
+
+	srv, err := New(context.Background(), 5*time.Second, 4<<20, 4<<20, plaintextTLS{},
+		AccessInterceptors(
+			interceptor.WithTrustedPeers(map[string][]string{
+				rbacSPIFFE:  {"user:read"},
+				auditSPIFFE: {"audit:read"},
+			}),
+			interceptor.WithServiceTokens(map[string]string{
+				"rbac-token":  rbacSPIFFE,
+				"audit-token": auditSPIFFE,
+			}),
+		)...,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userV1.RegisterUserServiceServer(srv, userService{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	getUsers := func(t *testing.T, token string) error {
+		t.Helper()
+
+		conn, err := grpcclient.NewClient(listener.Addr().String(), plaintextTLS{}, 4<<20, 4<<20, 5*time.Second,
+			grpcclient.ServiceTokenUnaryClientInterceptor(token))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		_, err = userV1.NewUserServiceClient(conn).GetUsers(context.Background(),
+			&userV1.GetUsersRequest{UserIds: []string{testUserID}})
+		return err
+	}
+
+	t.Run("service token with granted permission", func(t *testing.T) {
+		if err := getUsers(t, "rbac-token"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("service token without permission", func(t *testing.T) {
+		if err := getUsers(t, "audit-token"); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		if err := getUsers(t, "forged-token"); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		if err := getUsers(t, ""); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package grpctls
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// spiffeScheme схема URI SAN для SPIFFE ID (spiffe://trust-domain/workload)
+const spiffeScheme = "spiffe"
+
+// PeerID возвращает идентичность владельца сертификата: SPIFFE ID из URI SAN,
+// а при его отсутствии - Common Name
+func PeerID(cert *x509.Certificate) string {
+	for _, uri := range cert.URIs {
+		if uri.Scheme == spiffeScheme {
+			return uri.String()
+		}
+	}
+
+	return cert.Subject.CommonName
+}
+
+// verifyPeer проверяет цепочку сертификата собеседника по CA-пулу (nil - системные CA),
+// назначение ключа и идентичность: SPIFFE ID из списка allowed, а при пустом списке -
+// имя хоста serverName (если задано)
+func verifyPeer(certs []*x509.Certificate, roots *x509.CertPool, usage x509.ExtKeyUsage, serverName string, allowed []string) error {
+	if len(certs) == 0 {
+		return errors.New("peer certificate required")
+	}
+
+	leaf := certs[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	}); err != nil {
+		return fmt.Errorf("verify peer certificate: %w", err)
+	}
+
+	if len(allowed) > 0 {
+		if id := PeerID(leaf); !slices.Contains(allowed, id) {
+			return fmt.Errorf("peer identity %q is not allowed", id)
+		}
+		return nil
+	}
+
+	if serverName != "" {
+		if err := leaf.VerifyHostname(serverName); err != nil {
+			return fmt.Errorf("verify peer hostname: %w", err)
+		}
+	}
+
+	return nil
+}
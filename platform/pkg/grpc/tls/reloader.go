@@ -0,0 +1,124 @@
+package grpctls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// reloader хранит сертификат и CA-пул и перечитывает их с диска при изменении файлов.
+// Проверка выполняется лениво при TLS-рукопожатии не чаще interval,
+// поэтому ротация сертификатов (cert-manager, SPIRE) не требует перезапуска сервиса
+type reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	interval time.Duration
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  [3]time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+func newReloader(certFile, keyFile, caFile string, interval time.Duration) (*reloader, error) {
+	r := &reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		interval: interval,
+	}
+
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.load(modTimes); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// current возвращает актуальные сертификат и CA-пул.
+// Ошибка перечитывания логируется, а в работе остаются предыдущие значения
+func (r *reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.interval > 0 && time.Since(r.checkedAt) >= r.interval {
+		r.checkedAt = time.Now()
+
+		modTimes, err := r.stat()
+		if err == nil && modTimes != r.modTimes {
+			err = r.load(modTimes)
+			if err == nil {
+				logger.Info(context.Background(), "🔐 [TLS] Сертификаты перечитаны с диска",
+					zap.String("cert_file", r.certFile),
+					zap.String("ca_file", r.caFile),
+				)
+			}
+		}
+		if err != nil {
+			logger.Error(context.Background(), "❌ [TLS] Ошибка перечитывания сертификатов", zap.Error(err))
+		}
+	}
+
+	return r.cert, r.pool
+}
+
+// stat возвращает время изменения файлов (нулевое для незаданных)
+func (r *reloader) stat() ([3]time.Time, error) {
+	var modTimes [3]time.Time
+	for i, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file == "" {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return modTimes, fmt.Errorf("stat %s: %w", file, err)
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+// load загружает пару ключей и CA-пул; заменяет текущие значения только при успехе
+func (r *reloader) load(modTimes [3]time.Time) error {
+	var cert *tls.Certificate
+	if r.certFile != "" || r.keyFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair: %w", err)
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("read CA bundle: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("CA bundle %s contains no certificates", r.caFile)
+		}
+	}
+
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	return nil
+}
@@ -0,0 +1,339 @@
+package grpctls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	serverSPIFFE = "spiffe://school.local/ns/default/sa/rbac"
+	clientSPIFFE = "spiffe://school.local/ns/default/sa/iam"
+)
+
+// testCA самоподписанный CA для выпуска тестовых сертификатов
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue выпускает конечный сертификат и возвращает его вместе с PEM сертификата и ключа
+func (ca *testCA) issue(t *testing.T, cn, spiffeID string, usage x509.ExtKeyUsage, dnsNames ...string) (*x509.Certificate, []byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+	}
+	if spiffeID != "" {
+		uri, err := url.Parse(spiffeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl.URIs = []*url.URL{uri}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testTLSConfig реализация contracts.TLSConfig для тестов
+type testTLSConfig struct {
+	certFile, keyFile, caFile string
+	requireClientCert         bool
+	allowedPeerIDs            []string
+	serverName                string
+}
+
+func (c *testTLSConfig) Enabled() bool                 { return true }
+func (c *testTLSConfig) CertFile() string              { return c.certFile }
+func (c *testTLSConfig) KeyFile() string               { return c.keyFile }
+func (c *testTLSConfig) CAFile() string                { return c.caFile }
+func (c *testTLSConfig) RequireClientCert() bool       { return c.requireClientCert }
+func (c *testTLSConfig) AllowedPeerIDs() []string      { return c.allowedPeerIDs }
+func (c *testTLSConfig) ServerName() string            { return c.serverName }
+func (c *testTLSConfig) ReloadInterval() time.Duration { return time.Millisecond }
+
+func TestPeerID(t *testing.T) {
+	ca := newTestCA(t, "ca")
+
+	withSPIFFE, _, _ := ca.issue(t, "rbac", serverSPIFFE, x509.ExtKeyUsageServerAuth)
+	if got := PeerID(withSPIFFE); got != serverSPIFFE {
+		t.Fatalf("PeerID = %q, want %q", got, serverSPIFFE)
+	}
+
+	withoutSPIFFE, _, _ := ca.issue(t, "rbac", "", x509.ExtKeyUsageServerAuth)
+	if got := PeerID(withoutSPIFFE); got != "rbac" {
+		t.Fatalf("PeerID = %q, want common name", got)
+	}
+}
+
+func TestVerifyPeer(t *testing.T) {
+	ca := newTestCA(t, "ca")
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+
+	server, _, _ := ca.issue(t, "rbac", serverSPIFFE, x509.ExtKeyUsageServerAuth, "rbac")
+	client, _, _ := ca.issue(t, "iam", clientSPIFFE, x509.ExtKeyUsageClientAuth)
+	foreign, _, _ := newTestCA(t, "other").issue(t, "rbac", serverSPIFFE, x509.ExtKeyUsageServerAuth, "rbac")
+
+	tests := []struct {
+		name       string
+		cert       *x509.Certificate
+		usage      x509.ExtKeyUsage
+		serverName string
+		allowed    []string
+		wantErr    bool
+	}{
+		{name: "allowed spiffe id", cert: client, usage: x509.ExtKeyUsageClientAuth, allowed: []string{clientSPIFFE}},
+		{name: "unknown spiffe id", cert: client, usage: x509.ExtKeyUsageClientAuth, allowed: []string{serverSPIFFE}, wantErr: true},
+		{name: "hostname match", cert: server, usage: x509.ExtKeyUsageServerAuth, serverName: "rbac"},
+		{name: "hostname mismatch", cert: server, usage: x509.ExtKeyUsageServerAuth, serverName: "iam", wantErr: true},
+		{name: "spiffe id overrides hostname", cert: server, usage: x509.ExtKeyUsageServerAuth, serverName: "iam", allowed: []string{serverSPIFFE}},
+		{name: "wrong key usage", cert: client, usage: x509.ExtKeyUsageServerAuth, wantErr: true},
+		{name: "untrusted ca", cert: foreign, usage: x509.ExtKeyUsageServerAuth, serverName: "rbac", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyPeer([]*x509.Certificate{tt.cert}, pool, tt.usage, tt.serverName, tt.allowed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyPeer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := verifyPeer(nil, pool, x509.ExtKeyUsageClientAuth, "", nil); err == nil {
+		t.Fatal("verifyPeer() without certificate must fail")
+	}
+}
+
+func TestReloaderPicksUpRotatedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	caFile := filepath.Join(dir, "ca.crt")
+
+	ca := newTestCA(t, "ca")
+	_, certPEM, keyPEM := ca.issue(t, "rbac-old", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+	writeFile(t, caFile, ca.pem)
+
+	r, err := newReloader(certFile, keyFile, caFile, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, _ := r.current()
+	if cert.Leaf.Subject.CommonName != "rbac-old" {
+		t.Fatalf("initial certificate = %q", cert.Leaf.Subject.CommonName)
+	}
+
+	_, certPEM, keyPEM = ca.issue(t, "rbac-new", "", x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+	future := time.Now().Add(time.Minute)
+	for _, f := range []string{certFile, keyFile} {
+		if err = os.Chtimes(f, future, future); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(2 * time.Millisecond)
+
+	cert, _ = r.current()
+	if cert.Leaf.Subject.CommonName != "rbac-new" {
+		t.Fatalf("rotated certificate = %q, want rbac-new", cert.Leaf.Subject.CommonName)
+	}
+
+	// Битый файл не должен заменять рабочий сертификат
+	writeFile(t, certFile, []byte("broken"))
+	future = future.Add(time.Minute)
+	if err = os.Chtimes(certFile, future, future); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * time.Millisecond)
+
+	cert, _ = r.current()
+	if cert.Leaf.Subject.CommonName != "rbac-new" {
+		t.Fatalf("certificate after failed reload = %q, want rbac-new", cert.Leaf.Subject.CommonName)
+	}
+}
+
+func TestMutualTLSHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	caFile := filepath.Join(dir, "ca.crt")
+	writeFile(t, caFile, ca.pem)
+
+	_, serverCert, serverKey := ca.issue(t, "rbac", serverSPIFFE, x509.ExtKeyUsageServerAuth, "rbac")
+	writeFile(t, filepath.Join(dir, "server.crt"), serverCert)
+	writeFile(t, filepath.Join(dir, "server.key"), serverKey)
+
+	_, clientCert, clientKey := ca.issue(t, "iam", clientSPIFFE, x509.ExtKeyUsageClientAuth)
+	writeFile(t, filepath.Join(dir, "client.crt"), clientCert)
+	writeFile(t, filepath.Join(dir, "client.key"), clientKey)
+
+	serverCfg := &testTLSConfig{
+		certFile:          filepath.Join(dir, "server.crt"),
+		keyFile:           filepath.Join(dir, "server.key"),
+		caFile:            caFile,
+		requireClientCert: true,
+	}
+
+	handshake := func(t *testing.T, serverCfg, clientCfg *testTLSConfig) error {
+		t.Helper()
+
+		serverCreds, err := ServerCredentials(serverCfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clientCreds, err := ClientCredentials(clientCfg, "rbac:50052")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer listener.Close()
+
+		serverErr := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				serverErr <- err
+				return
+			}
+			defer conn.Close()
+
+			_, _, err = serverCreds.ServerHandshake(conn)
+			serverErr <- err
+		}()
+
+		clientConn, err := net.Dial("tcp", listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer clientConn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// В TLS 1.3 клиент завершает рукопожатие раньше, чем сервер проверит его сертификат,
+		// поэтому итог определяется ошибкой сервера
+		_, _, clientErr := clientCreds.ClientHandshake(ctx, "rbac:50052", clientConn)
+		if err = <-serverErr; err != nil {
+			return err
+		}
+		return clientErr
+	}
+
+	t.Run("valid client certificate", func(t *testing.T) {
+		clientCfg := &testTLSConfig{
+			certFile:       filepath.Join(dir, "client.crt"),
+			keyFile:        filepath.Join(dir, "client.key"),
+			caFile:         caFile,
+			allowedPeerIDs: []string{serverSPIFFE},
+		}
+		if err := handshake(t, serverCfg, clientCfg); err != nil {
+			t.Fatalf("handshake failed: %v", err)
+		}
+	})
+
+	t.Run("missing client certificate", func(t *testing.T) {
+		if err := handshake(t, serverCfg, &testTLSConfig{caFile: caFile}); err == nil {
+			t.Fatal("handshake without client certificate must fail")
+		}
+	})
+
+	t.Run("client identity not allowed", func(t *testing.T) {
+		restricted := *serverCfg
+		restricted.allowedPeerIDs = []string{"spiffe://school.local/ns/default/sa/envoy"}
+		clientCfg := &testTLSConfig{
+			certFile: filepath.Join(dir, "client.crt"),
+			keyFile:  filepath.Join(dir, "client.key"),
+			caFile:   caFile,
+		}
+		if err := handshake(t, &restricted, clientCfg); err == nil {
+			t.Fatal("handshake with unexpected client identity must fail")
+		}
+	})
+}
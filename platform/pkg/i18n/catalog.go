@@ -0,0 +1,51 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// catalog каталог сообщений: язык -> ключ -> шаблон в формате fmt
+var catalog = struct {
+	sync.RWMutex
+	messages map[Locale]map[string]string
+}{messages: make(map[Locale]map[string]string)}
+
+// Register добавляет сообщения языка в каталог. Сервисы регистрируют свои ключи
+// при инициализации пакета модели; повторная регистрация ключа заменяет шаблон
+func Register(locale Locale, messages map[string]string) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if catalog.messages[locale] == nil {
+		catalog.messages[locale] = make(map[string]string, len(messages))
+	}
+	for key, message := range messages {
+		catalog.messages[locale][key] = message
+	}
+}
+
+// Translate возвращает сообщение по ключу на языке из контекста
+func Translate(ctx context.Context, key string, args ...any) string {
+	return T(FromContext(ctx), key, args...)
+}
+
+// T возвращает сообщение по ключу на указанном языке. Если перевода нет, используется
+// DefaultLocale, а при отсутствии ключа в каталоге - сам ключ (так можно передавать готовый текст)
+func T(locale Locale, key string, args ...any) string {
+	catalog.RLock()
+	message, ok := catalog.messages[locale][key]
+	if !ok {
+		message, ok = catalog.messages[DefaultLocale][key]
+	}
+	catalog.RUnlock()
+
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
@@ -0,0 +1,48 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Locale
+	}{
+		{header: "", want: DefaultLocale},
+		{header: "en", want: EN},
+		{header: "en-US,en;q=0.9", want: EN},
+		{header: "de-DE,en;q=0.5,ru;q=0.8", want: RU},
+		{header: "fr, de", want: DefaultLocale},
+		{header: "ru;q=0, en;q=0.1", want: EN},
+		{header: "EN-gb", want: EN},
+	}
+
+	for _, tt := range tests {
+		if got := ParseAcceptLanguage(tt.header); got != tt.want {
+			t.Errorf("ParseAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestTranslateFallback(t *testing.T) {
+	Register(RU, map[string]string{"test.only_ru": "только %s"})
+	Register(EN, map[string]string{"test.hello": "hello %s"})
+	Register(RU, map[string]string{"test.hello": "привет %s"})
+
+	ctx := WithLocale(context.Background(), EN)
+
+	if got := Translate(ctx, "test.hello", "world"); got != "hello world" {
+		t.Errorf("english message = %q", got)
+	}
+	if got := Translate(context.Background(), "test.hello", "мир"); got != "привет мир" {
+		t.Errorf("default locale message = %q", got)
+	}
+	if got := Translate(ctx, "test.only_ru", "ru"); got != "только ru" {
+		t.Errorf("missing translation must fall back to default locale, got %q", got)
+	}
+	if got := T(EN, "raw text"); got != "raw text" {
+		t.Errorf("unknown key must be returned as is, got %q", got)
+	}
+}
@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale язык пользовательских сообщений
+type Locale string
+
+const (
+	// RU русский язык, язык по умолчанию
+	RU Locale = "ru"
+	// EN английский язык
+	EN Locale = "en"
+
+	// DefaultLocale язык, если клиент не указал поддерживаемый
+	DefaultLocale = RU
+)
+
+// AcceptLanguageKey заголовок HTTP и ключ gRPC metadata с предпочтениями языка клиента
+const AcceptLanguageKey = "accept-language"
+
+// supported поддерживаемые языки
+var supported = map[Locale]struct{}{RU: {}, EN: {}}
+
+type localeContextKey struct{}
+
+// WithLocale сохраняет язык в контексте
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// FromContext возвращает язык из контекста или DefaultLocale
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeContextKey{}).(Locale); ok {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// ParseAcceptLanguage выбирает поддерживаемый язык с наибольшим весом из значения Accept-Language
+// ("en-US,en;q=0.9,ru;q=0.8"). Регион отбрасывается, при отсутствии совпадений возвращается DefaultLocale
+func ParseAcceptLanguage(header string) Locale {
+	type candidate struct {
+		locale Locale
+		weight float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+
+		base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if _, ok := supported[Locale(base)]; ok && weight > 0 {
+			candidates = append(candidates, candidate{locale: Locale(base), weight: weight})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight > candidates[j].weight
+	})
+	return candidates[0].locale
+}
@@ -0,0 +1,109 @@
+package i18n
+
+// Ключи сообщений платформы
+const (
+	MsgInternal            = "platform.internal"
+	MsgMissingMetadata     = "platform.auth.missing_metadata"
+	MsgMissingSession      = "platform.auth.missing_session"
+	MsgPermissionsMissing  = "platform.auth.permissions_missing"
+	MsgPermissionDenied    = "platform.auth.permission_denied"
+	MsgValidationFailed    = "platform.validation.failed"
+	MsgRateLimited         = "platform.rate_limit.exceeded"
+	MsgRateLimitDown       = "platform.rate_limit.unavailable"
+	MsgIdempotencyKey      = "platform.idempotency.invalid_key"
+	MsgIdempotencyReused   = "platform.idempotency.key_reused"
+	MsgIdempotencyPending  = "platform.idempotency.in_progress"
+	MsgIdempotencyDown     = "platform.idempotency.unavailable"
+	MsgValidateRequired    = "validate.required"
+	MsgValidateMinLen      = "validate.min_len"
+	MsgValidateMaxLen      = "validate.max_len"
+	MsgValidateLenBetween  = "validate.len_between"
+	MsgValidateBytes       = "validate.bytes_between"
+	MsgValidateUUID        = "validate.uuid"
+	MsgValidateEmail       = "validate.email"
+	MsgValidateIn          = "validate.in"
+	MsgValidateNotIn       = "validate.not_in"
+	MsgValidateRange       = "validate.range"
+	MsgValidateGTE         = "validate.gte"
+	MsgValidateLTE         = "validate.lte"
+	MsgValidateGT          = "validate.gt"
+	MsgValidateLT          = "validate.lt"
+	MsgValidateDefinedEnum = "validate.defined_enum"
+	MsgValidateItems       = "validate.items_between"
+	MsgValidateMinItems    = "validate.min_items"
+	MsgValidateMaxItems    = "validate.max_items"
+	MsgValidateUnique      = "validate.unique"
+	MsgValidateRule        = "validate.rule"
+)
+
+func init() {
+	Register(RU, map[string]string{
+		MsgInternal:            "внутренняя ошибка сервера",
+		MsgMissingMetadata:     "отсутствуют метаданные запроса",
+		MsgMissingSession:      "отсутствует идентификатор сессии",
+		MsgPermissionsMissing:  "права пользователя не найдены в контексте",
+		MsgPermissionDenied:    "недостаточно прав доступа",
+		MsgValidationFailed:    "ошибка валидации запроса",
+		MsgRateLimited:         "слишком много запросов, повторите позже",
+		MsgRateLimitDown:       "проверка частоты запросов временно недоступна",
+		MsgIdempotencyKey:      "ключ идемпотентности должен быть не длиннее %d символов",
+		MsgIdempotencyReused:   "ключ идемпотентности уже использован для другого запроса",
+		MsgIdempotencyPending:  "запрос с этим ключом идемпотентности ещё выполняется",
+		MsgIdempotencyDown:     "проверка ключа идемпотентности временно недоступна",
+		MsgValidateRequired:    "обязательное поле",
+		MsgValidateMinLen:      "длина должна быть не меньше %s символов",
+		MsgValidateMaxLen:      "длина должна быть не больше %s символов",
+		MsgValidateLenBetween:  "длина должна быть от %s до %s символов",
+		MsgValidateBytes:       "размер должен быть от %s до %s байт",
+		MsgValidateUUID:        "значение должно быть UUID",
+		MsgValidateEmail:       "значение должно быть адресом электронной почты",
+		MsgValidateIn:          "значение должно быть одним из: %s",
+		MsgValidateNotIn:       "недопустимое значение: %s",
+		MsgValidateRange:       "значение должно быть в диапазоне [%s, %s]",
+		MsgValidateGTE:         "значение должно быть не меньше %s",
+		MsgValidateLTE:         "значение должно быть не больше %s",
+		MsgValidateGT:          "значение должно быть больше %s",
+		MsgValidateLT:          "значение должно быть меньше %s",
+		MsgValidateDefinedEnum: "неизвестное значение перечисления",
+		MsgValidateItems:       "количество элементов должно быть от %s до %s",
+		MsgValidateMinItems:    "количество элементов должно быть не меньше %s",
+		MsgValidateMaxItems:    "количество элементов должно быть не больше %s",
+		MsgValidateUnique:      "элементы не должны повторяться",
+		MsgValidateRule:        "нарушено правило %s",
+	})
+
+	Register(EN, map[string]string{
+		MsgInternal:            "internal server error",
+		MsgMissingMetadata:     "request metadata is missing",
+		MsgMissingSession:      "session ID is missing",
+		MsgPermissionsMissing:  "user permissions are missing in context",
+		MsgPermissionDenied:    "permission denied",
+		MsgValidationFailed:    "request validation failed",
+		MsgRateLimited:         "too many requests, retry later",
+		MsgRateLimitDown:       "rate limiting is temporarily unavailable",
+		MsgIdempotencyKey:      "idempotency key must be at most %d characters",
+		MsgIdempotencyReused:   "idempotency key was already used for a different request",
+		MsgIdempotencyPending:  "request with this idempotency key is still in progress",
+		MsgIdempotencyDown:     "idempotency key check is temporarily unavailable",
+		MsgValidateRequired:    "value is required",
+		MsgValidateMinLen:      "length must be at least %s characters",
+		MsgValidateMaxLen:      "length must be at most %s characters",
+		MsgValidateLenBetween:  "length must be between %s and %s characters",
+		MsgValidateBytes:       "size must be between %s and %s bytes",
+		MsgValidateUUID:        "value must be a valid UUID",
+		MsgValidateEmail:       "value must be a valid email address",
+		MsgValidateIn:          "value must be one of: %s",
+		MsgValidateNotIn:       "value is not allowed: %s",
+		MsgValidateRange:       "value must be inside range [%s, %s]",
+		MsgValidateGTE:         "value must be greater than or equal to %s",
+		MsgValidateLTE:         "value must be less than or equal to %s",
+		MsgValidateGT:          "value must be greater than %s",
+		MsgValidateLT:          "value must be less than %s",
+		MsgValidateDefinedEnum: "value must be one of the defined enum values",
+		MsgValidateItems:       "must contain between %s and %s items",
+		MsgValidateMinItems:    "must contain at least %s items",
+		MsgValidateMaxItems:    "must contain at most %s items",
+		MsgValidateUnique:      "items must be unique",
+		MsgValidateRule:        "violates rule %s",
+	})
+}
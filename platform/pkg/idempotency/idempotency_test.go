@@ -0,0 +1,82 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache"
+)
+
+func TestMemoryStoreLifecycle(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, acquired, _ := s.Reserve(ctx, "k", "h1", time.Minute); !acquired {
+		t.Fatal("first request must reserve key")
+	}
+
+	record, acquired, _ := s.Reserve(ctx, "k", "h2", time.Minute)
+	if acquired || record == nil || record.Done || record.Hash != "h1" {
+		t.Fatalf("retry must see pending record of first request, got acquired=%v record=%+v", acquired, record)
+	}
+
+	_ = s.Save(ctx, "k", Record{Hash: "h1", Done: true, Response: []byte("resp")}, time.Hour)
+	record, _, _ = s.Reserve(ctx, "k", "h1", time.Minute)
+	if !record.Done || string(record.Response) != "resp" {
+		t.Fatalf("retry must see saved result, got %+v", record)
+	}
+
+	_ = s.Release(ctx, "k")
+	if _, acquired, _ = s.Reserve(ctx, "k", "h1", time.Minute); !acquired {
+		t.Fatal("released key must be reserved again")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, acquired, _ = s.Reserve(ctx, "k", "h1", time.Minute); !acquired {
+		t.Fatal("expired reservation must be reserved again")
+	}
+}
+
+// evalClient подменяет методы RedisClient, которые использует RedisStore
+type evalClient struct {
+	cache.RedisClient
+	reply any
+	set   map[string]any
+}
+
+func (c *evalClient) Eval(context.Context, string, []string, ...any) (any, error) {
+	return c.reply, nil
+}
+
+func (c *evalClient) Set(_ context.Context, key string, value any, _ time.Duration) error {
+	c.set[key] = value
+	return nil
+}
+
+func TestRedisStoreReserve(t *testing.T) {
+	client := &evalClient{reply: "", set: map[string]any{}}
+	s := NewRedisStore(client, "idem:")
+
+	if _, acquired, err := s.Reserve(context.Background(), "k", "h", time.Minute); err != nil || !acquired {
+		t.Fatalf("empty reply must mean reserved, got acquired=%v err=%v", acquired, err)
+	}
+
+	saved, _ := json.Marshal(Record{Hash: "h", Done: true, Status: []byte{1}})
+	client.reply = string(saved)
+
+	record, acquired, err := s.Reserve(context.Background(), "k", "h", time.Minute)
+	if err != nil || acquired || !record.Done || len(record.Status) != 1 {
+		t.Fatalf("stored record must be returned, got %+v acquired=%v err=%v", record, acquired, err)
+	}
+
+	if err = s.Save(context.Background(), "k", Record{Hash: "h", Done: true}, time.Hour); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if _, ok := client.set["idem:k"]; !ok {
+		t.Fatal("Save must write prefixed key")
+	}
+}
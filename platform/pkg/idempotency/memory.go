@@ -0,0 +1,85 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval как часто MemoryStore удаляет истёкшие записи
+const sweepInterval = time.Minute
+
+// Компиляционная проверка
+var _ Store = (*MemoryStore)(nil)
+
+// entry запись с моментом истечения
+type entry struct {
+	record  Record
+	expires time.Time
+}
+
+// MemoryStore хранит записи в памяти процесса. Повтор, попавший на другую реплику,
+// выполнится заново, поэтому хранилище применяется как запасной вариант при выключенном Redis
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]entry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore создает хранилище в памяти процесса
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Reserve резервирует ключ или возвращает его запись
+func (s *MemoryStore) Reserve(_ context.Context, key, hash string, ttl time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	if current, ok := s.entries[key]; ok && now.Before(current.expires) {
+		record := current.record
+		return &record, false, nil
+	}
+
+	s.entries[key] = entry{record: Record{Hash: hash}, expires: now.Add(ttl)}
+	return nil, true, nil
+}
+
+// Save сохраняет результат выполненного запроса
+func (s *MemoryStore) Save(_ context.Context, key string, record Record, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = entry{record: record, expires: s.now().Add(ttl)}
+	return nil
+}
+
+// Release освобождает ключ
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep удаляет истёкшие записи
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, e := range s.entries {
+		if !now.Before(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+}
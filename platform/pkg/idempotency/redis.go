@@ -0,0 +1,79 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache"
+)
+
+// reserveScript возвращает запись ключа KEYS[1], а если её нет - записывает ARGV[1]
+// с временем жизни ARGV[2] мс и возвращает пустую строку
+const reserveScript = `
+local current = redis.call('GET', KEYS[1])
+if current then
+  return current
+end
+
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return ''
+`
+
+// Компиляционная проверка
+var _ Store = (*RedisStore)(nil)
+
+// RedisStore хранит записи в Redis: все реплики сервиса видят один ключ
+type RedisStore struct {
+	client cache.RedisClient
+	prefix string
+}
+
+// NewRedisStore создает хранилище поверх Redis; prefix отделяет записи от данных сервиса
+func NewRedisStore(client cache.RedisClient, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// Reserve резервирует ключ или возвращает его запись
+func (s *RedisStore) Reserve(ctx context.Context, key, hash string, ttl time.Duration) (*Record, bool, error) {
+	pending, err := json.Marshal(Record{Hash: hash})
+	if err != nil {
+		return nil, false, fmt.Errorf("marshal idempotency record: %w", err)
+	}
+
+	raw, err := s.client.Eval(ctx, reserveScript, []string{s.prefix + key}, pending, ttl.Milliseconds())
+	if err != nil {
+		return nil, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+
+	current, ok := raw.(string)
+	if !ok {
+		return nil, false, fmt.Errorf("reserve idempotency key: unexpected reply %v", raw)
+	}
+	if current == "" {
+		return nil, true, nil
+	}
+
+	var record Record
+	if err = json.Unmarshal([]byte(current), &record); err != nil {
+		return nil, false, fmt.Errorf("unmarshal idempotency record: %w", err)
+	}
+
+	return &record, false, nil
+}
+
+// Save сохраняет результат выполненного запроса
+func (s *RedisStore) Save(ctx context.Context, key string, record Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal idempotency record: %w", err)
+	}
+
+	return s.client.Set(ctx, s.prefix+key, data, ttl)
+}
+
+// Release освобождает ключ
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+key)
+}
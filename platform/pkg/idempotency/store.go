@@ -0,0 +1,41 @@
+// Package idempotency хранит результаты запросов с ключом идемпотентности.
+//
+// Первый запрос с ключом резервирует его (запись без результата), после выполнения
+// в запись сохраняется ответ или статус ошибки. Повтор с тем же ключом получает
+// сохранённый результат, не выполняя запрос заново; хеш тела запроса в записи
+// позволяет отличить повтор от другого запроса с тем же ключом.
+//
+// Реализации:
+//   - RedisStore: записи в Redis, ключ резервируется атомарно Lua-скриптом
+//   - MemoryStore: записи в памяти процесса, используется когда Redis выключен
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Record состояние запроса с ключом идемпотентности
+type Record struct {
+	// Hash хеш тела запроса
+	Hash string `json:"hash"`
+	// Done запрос выполнен; до этого ключ зарезервирован выполняющимся запросом
+	Done bool `json:"done"`
+	// Response ответ в google.protobuf.Any (proto wire формат)
+	Response []byte `json:"response,omitempty"`
+	// Status ошибка в google.rpc.Status (proto wire формат)
+	Status []byte `json:"status,omitempty"`
+}
+
+// Store хранилище записей ключей идемпотентности
+type Store interface {
+	// Reserve резервирует ключ под выполняющийся запрос с хешем hash на время ttl.
+	// Если ключ уже занят, возвращает его запись и false
+	Reserve(ctx context.Context, key, hash string, ttl time.Duration) (*Record, bool, error)
+
+	// Save сохраняет результат выполненного запроса на время ttl
+	Save(ctx context.Context, key string, record Record, ttl time.Duration) error
+
+	// Release освобождает ключ, чтобы запрос можно было выполнить повторно
+	Release(ctx context.Context, key string) error
+}
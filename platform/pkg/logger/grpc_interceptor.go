@@ -35,3 +35,32 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// StreamServerInterceptor создает gRPC stream interceptor для логирования потоков.
+// Логируются открытие и закрытие потока, отдельные сообщения не логируются.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := ss.Context()
+		Info(ctx, " [gRPC] Поток открыт", zap.String("method", info.FullMethod))
+
+		err := handler(srv, ss)
+
+		if err != nil {
+			st, _ := status.FromError(err)
+			Error(ctx, "❌ [gRPC] Поток завершился ошибкой",
+				zap.String("method", info.FullMethod),
+				zap.String("grpc_code", st.Code().String()),
+				zap.Error(err),
+			)
+		} else {
+			Info(ctx, "✅ [gRPC] Поток закрыт", zap.String("method", info.FullMethod))
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,98 @@
+package metric
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// GRPCClientMetrics инструменты метрик устойчивого gRPC-клиента:
+// состояние автоматического выключателя, повторы и опережающие запросы.
+// Методы безопасны для nil-инструментов (ошибка создания не ломает клиента)
+type GRPCClientMetrics struct {
+	breakerState       metric.Int64Gauge
+	breakerTransitions metric.Int64Counter
+	retries            metric.Int64Counter
+	hedges             metric.Int64Counter
+}
+
+// NewGRPCClientMetrics создает инструменты метрик gRPC-клиента
+func NewGRPCClientMetrics(ctx context.Context) *GRPCClientMetrics {
+	meter := GetMeterProvider().Meter("grpc-client")
+	m := &GRPCClientMetrics{}
+
+	var err error
+	if m.breakerState, err = meter.Int64Gauge(
+		getMetricName("grpc_client_circuit_breaker_state"),
+		metric.WithDescription("Состояние выключателя: 0 - замкнут, 1 - полуоткрыт, 2 - разомкнут"),
+	); err != nil {
+		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC circuit breaker gauge", zap.Error(err))
+	}
+
+	if m.breakerTransitions, err = meter.Int64Counter(
+		getMetricName("grpc_client_circuit_breaker_transitions_total"),
+		metric.WithDescription("Количество переключений выключателя gRPC-клиента"),
+	); err != nil {
+		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC circuit breaker counter", zap.Error(err))
+	}
+
+	if m.retries, err = meter.Int64Counter(
+		getMetricName("grpc_client_retries_total"),
+		metric.WithDescription("Количество повторных gRPC запросов"),
+	); err != nil {
+		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC retry counter", zap.Error(err))
+	}
+
+	if m.hedges, err = meter.Int64Counter(
+		getMetricName("grpc_client_hedged_requests_total"),
+		metric.WithDescription("Количество опережающих копий gRPC запросов"),
+	); err != nil {
+		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC hedging counter", zap.Error(err))
+	}
+
+	return m
+}
+
+// RecordBreakerState фиксирует новое состояние выключателя цели
+func (m *GRPCClientMetrics) RecordBreakerState(ctx context.Context, target, state string, value int64) {
+	if m == nil {
+		return
+	}
+
+	if m.breakerState != nil {
+		m.breakerState.Record(ctx, value, metric.WithAttributes(attribute.String("target", target)))
+	}
+	if m.breakerTransitions != nil {
+		m.breakerTransitions.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("target", target),
+			attribute.String("state", state),
+		))
+	}
+}
+
+// RecordRetry фиксирует повтор запроса
+func (m *GRPCClientMetrics) RecordRetry(ctx context.Context, target, method, code string) {
+	if m == nil || m.retries == nil {
+		return
+	}
+
+	m.retries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("target", target),
+		attribute.String("method", method),
+		attribute.String("code", code),
+	))
+}
+
+// RecordHedge фиксирует отправку опережающей копии запроса
+func (m *GRPCClientMetrics) RecordHedge(ctx context.Context, target, method string) {
+	if m == nil || m.hedges == nil {
+		return
+	}
+
+	m.hedges.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("target", target),
+		attribute.String("method", method),
+	))
+}
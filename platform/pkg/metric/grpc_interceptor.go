@@ -10,11 +10,67 @@ import (
 	"google.golang.org/grpc"
 )
 
+// grpcServerInstruments инструменты метрик gRPC-сервера, общие для unary и stream интерсепторов
+type grpcServerInstruments struct {
+	requestCounter        metric.Int64Counter
+	responseCounter       metric.Int64Counter
+	responseTimeHistogram metric.Float64Histogram
+}
+
 // UnaryServerInterceptor создает gRPC unary interceptor для сбора метрик.
 // Interceptor собирает метрики запросов, ответов и времени выполнения.
 // bucketBoundaries - настраиваемые границы для гистограммы времени ответа
 func UnaryServerInterceptor(ctx context.Context, bucketBoundaries []float64) grpc.UnaryServerInterceptor {
 	// Создаем инструменты метрик один раз при инициализации интерсептора
+	instruments, ok := newGRPCServerInstruments(ctx, bucketBoundaries)
+	if !ok {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(ctx, req)
+		}
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// Увеличиваем счетчик входящих запросов
+		instruments.requestCounter.Add(ctx, 1)
+
+		// Засекаем время начала обработки
+		startTime := time.Now()
+
+		// Выполняем обработчик
+		resp, err := handler(ctx, req)
+
+		instruments.record(ctx, info.FullMethod, time.Since(startTime), err)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor создает gRPC stream interceptor для сбора метрик.
+// Поток учитывается как один запрос, время ответа - время жизни потока.
+func StreamServerInterceptor(ctx context.Context, bucketBoundaries []float64) grpc.StreamServerInterceptor {
+	instruments, ok := newGRPCServerInstruments(ctx, bucketBoundaries)
+	if !ok {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+	}
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		instruments.requestCounter.Add(ctx, 1)
+
+		startTime := time.Now()
+		err := handler(srv, ss)
+
+		instruments.record(ctx, info.FullMethod, time.Since(startTime), err)
+
+		return err
+	}
+}
+
+// newGRPCServerInstruments создает инструменты метрик gRPC-сервера.
+// При ошибке логирует её и возвращает false: интерсептор работает без сбора метрик
+func newGRPCServerInstruments(ctx context.Context, bucketBoundaries []float64) (*grpcServerInstruments, bool) {
 	meter := GetMeterProvider().Meter("grpc-server")
 
 	requestCounter, err := meter.Int64Counter(
@@ -23,9 +79,7 @@ func UnaryServerInterceptor(ctx context.Context, bucketBoundaries []float64) grp
 	)
 	if err != nil {
 		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC request counter", zap.Error(err))
-		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			return handler(ctx, req)
-		}
+		return nil, false
 	}
 
 	responseCounter, err := meter.Int64Counter(
@@ -34,9 +88,7 @@ func UnaryServerInterceptor(ctx context.Context, bucketBoundaries []float64) grp
 	)
 	if err != nil {
 		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC response counter", zap.Error(err))
-		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			return handler(ctx, req)
-		}
+		return nil, false
 	}
 
 	responseTimeHistogram, err := meter.Float64Histogram(
@@ -47,43 +99,36 @@ func UnaryServerInterceptor(ctx context.Context, bucketBoundaries []float64) grp
 	)
 	if err != nil {
 		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC response time histogram", zap.Error(err))
-		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-			return handler(ctx, req)
-		}
+		return nil, false
 	}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Увеличиваем счетчик входящих запросов
-		requestCounter.Add(ctx, 1)
-
-		// Засекаем время начала обработки
-		startTime := time.Now()
-
-		// Выполняем обработчик
-		resp, err := handler(ctx, req)
-		duration := time.Since(startTime)
+	return &grpcServerInstruments{
+		requestCounter:        requestCounter,
+		responseCounter:       responseCounter,
+		responseTimeHistogram: responseTimeHistogram,
+	}, true
+}
 
-		// Определяем статус ответа
-		status := "success"
-		if err != nil {
-			status = "error"
-		}
+// record фиксирует ответ и время его выполнения
+func (m *grpcServerInstruments) record(ctx context.Context, method string, duration time.Duration, err error) {
+	// Определяем статус ответа
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
 
-		// Увеличиваем счетчик ответов с атрибутами
-		responseCounter.Add(ctx, 1,
-			metric.WithAttributes(
-				attribute.String("status", status),
-				attribute.String("method", info.FullMethod),
-			),
-		)
-
-		// Записываем время выполнения в гистограмму
-		responseTimeHistogram.Record(ctx, duration.Seconds(),
-			metric.WithAttributes(
-				attribute.String("status", status),
-			),
-		)
+	// Увеличиваем счетчик ответов с атрибутами
+	m.responseCounter.Add(ctx, 1,
+		metric.WithAttributes(
+			attribute.String("status", status),
+			attribute.String("method", method),
+		),
+	)
 
-		return resp, err
-	}
+	// Записываем время выполнения в гистограмму
+	m.responseTimeHistogram.Record(ctx, duration.Seconds(),
+		metric.WithAttributes(
+			attribute.String("status", status),
+		),
+	)
 }
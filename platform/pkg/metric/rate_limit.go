@@ -0,0 +1,51 @@
+package metric
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// Результаты проверки лимита для атрибута result
+const (
+	RateLimitAllowed  = "allowed"
+	RateLimitRejected = "rejected"
+	RateLimitError    = "error"
+)
+
+// RateLimitMetrics инструменты метрик ограничения частоты gRPC-запросов.
+// Методы безопасны для nil-инструментов (ошибка создания не ломает интерсептор)
+type RateLimitMetrics struct {
+	decisions metric.Int64Counter
+}
+
+// NewRateLimitMetrics создает инструменты метрик ограничения частоты
+func NewRateLimitMetrics(ctx context.Context) *RateLimitMetrics {
+	meter := GetMeterProvider().Meter("grpc-rate-limit")
+	m := &RateLimitMetrics{}
+
+	var err error
+	if m.decisions, err = meter.Int64Counter(
+		getMetricName("grpc_rate_limit_decisions_total"),
+		metric.WithDescription("Количество проверок лимита частоты gRPC запросов по результату"),
+	); err != nil {
+		globalMetrics.logger.Error(ctx, "❌ [Metrics] Ошибка создания gRPC rate limit counter", zap.Error(err))
+	}
+
+	return m
+}
+
+// RecordDecision фиксирует результат проверки лимита метода
+func (m *RateLimitMetrics) RecordDecision(ctx context.Context, method, key, result string) {
+	if m == nil || m.decisions == nil {
+		return
+	}
+
+	m.decisions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("method", method),
+		attribute.String("key", key),
+		attribute.String("result", result),
+	))
+}
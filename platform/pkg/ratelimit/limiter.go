@@ -0,0 +1,70 @@
+// Package ratelimit реализует ограничение частоты запросов по алгоритму token bucket.
+//
+// Корзина ключа вмещает Burst токенов и пополняется со скоростью Requests/Period.
+// Каждый запрос забирает один токен; пустая корзина означает превышение лимита,
+// а время до появления следующего токена возвращается как RetryAfter.
+//
+// Реализации:
+//   - RedisLimiter: распределённый лимит, состояние корзины обновляется атомарно Lua-скриптом
+//   - MemoryLimiter: лимит в памяти процесса, используется когда Redis выключен
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Key признак, по которому считаются запросы
+type Key string
+
+const (
+	// KeyUser идентификатор пользователя; для анонимных запросов - IP клиента
+	KeyUser Key = "user"
+	// KeySession идентификатор сессии; без сессии - IP клиента
+	KeySession Key = "session"
+	// KeyIP IP адрес клиента
+	KeyIP Key = "ip"
+	// KeyAPIKey API ключ из заголовка x-api-key; без ключа - IP клиента
+	KeyAPIKey Key = "api_key"
+)
+
+// Limit параметры корзины: Requests запросов за Period со всплеском до Burst
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	// Burst емкость корзины; 0 - равна Requests
+	Burst int
+}
+
+// IsZero сообщает, что лимит не задан и запросы не ограничиваются
+func (l Limit) IsZero() bool {
+	return l.Requests <= 0 || l.Period <= 0
+}
+
+// Capacity возвращает емкость корзины
+func (l Limit) Capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// Rate возвращает скорость пополнения корзины в токенах за секунду
+func (l Limit) Rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result результат проверки лимита
+type Result struct {
+	// Allowed запрос укладывается в лимит
+	Allowed bool
+	// Remaining сколько запросов ещё доступно без ожидания
+	Remaining int
+	// RetryAfter через сколько появится токен для следующего запроса (для отклонённых)
+	RetryAfter time.Duration
+}
+
+// Limiter списывает токен из корзины ключа
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
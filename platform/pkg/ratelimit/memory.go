@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval как часто MemoryLimiter удаляет заполненные корзины
+const sweepInterval = time.Minute
+
+// Компиляционная проверка
+var _ Limiter = (*MemoryLimiter)(nil)
+
+// bucket состояние корзины ключа
+type bucket struct {
+	tokens float64
+	ts     time.Time
+	// full момент, когда корзина снова заполнится и её можно удалить
+	full time.Time
+}
+
+// MemoryLimiter token bucket в памяти процесса. Лимит действует на каждую реплику
+// сервиса отдельно, поэтому применяется как запасной вариант при выключенном Redis
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryLimiter создает лимитер в памяти процесса
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow списывает токен из корзины ключа
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	if limit.IsZero() {
+		return Result{Allowed: true}, nil
+	}
+
+	rate := limit.Rate()
+	capacity := float64(limit.Capacity())
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, ts: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.ts).Seconds()*rate)
+	b.ts = now
+
+	result := Result{}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration(math.Ceil((1-b.tokens)/rate*1000)) * time.Millisecond
+	}
+	result.Remaining = int(b.tokens)
+	b.full = now.Add(time.Duration((capacity - b.tokens) / rate * float64(time.Second)))
+
+	return result, nil
+}
+
+// sweep удаляет корзины, которые успели заполниться: их состояние совпадает с новой корзиной
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if !now.Before(b.full) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache"
+)
+
+func TestMemoryLimiterTokenBucket(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return now }
+
+	limit := Limit{Requests: 2, Period: time.Second, Burst: 3}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, err := l.Allow(ctx, "user:1", limit)
+		if err != nil || !res.Allowed {
+			t.Fatalf("request %d: allowed=%v err=%v, want allowed within burst", i, res.Allowed, err)
+		}
+	}
+
+	res, _ := l.Allow(ctx, "user:1", limit)
+	if res.Allowed {
+		t.Fatal("request over burst must be rejected")
+	}
+	if res.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("RetryAfter = %v, want 500ms at 2 req/s", res.RetryAfter)
+	}
+
+	// Другой ключ считается отдельно
+	if res, _ = l.Allow(ctx, "user:2", limit); !res.Allowed {
+		t.Fatal("separate key must have its own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if res, _ = l.Allow(ctx, "user:1", limit); !res.Allowed {
+		t.Fatal("bucket must refill one token after 500ms")
+	}
+}
+
+func TestMemoryLimiterSweepsFullBuckets(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return now }
+
+	limit := Limit{Requests: 10, Period: time.Second}
+	_, _ = l.Allow(context.Background(), "ip:10.0.0.1", limit)
+
+	now = now.Add(2 * sweepInterval)
+	_, _ = l.Allow(context.Background(), "ip:10.0.0.2", limit)
+
+	if _, ok := l.buckets["ip:10.0.0.1"]; ok {
+		t.Fatal("refilled bucket must be removed by sweep")
+	}
+	if len(l.buckets) != 1 {
+		t.Fatalf("buckets = %d, want 1", len(l.buckets))
+	}
+}
+
+func TestZeroLimitAllows(t *testing.T) {
+	res, err := NewMemoryLimiter().Allow(context.Background(), "k", Limit{})
+	if err != nil || !res.Allowed {
+		t.Fatalf("zero limit must allow, got allowed=%v err=%v", res.Allowed, err)
+	}
+}
+
+// evalClient подменяет Eval, остальные методы RedisClient в тесте не вызываются
+type evalClient struct {
+	cache.RedisClient
+	reply any
+	err   error
+	keys  []string
+	args  []any
+}
+
+func (c *evalClient) Eval(_ context.Context, _ string, keys []string, args ...any) (any, error) {
+	c.keys, c.args = keys, args
+	return c.reply, c.err
+}
+
+func TestRedisLimiterParsesReply(t *testing.T) {
+	client := &evalClient{reply: []any{int64(0), int64(0), int64(1500)}}
+	l := NewRedisLimiter(client, "rl:")
+
+	res, err := l.Allow(context.Background(), "login:ip:10.0.0.1", Limit{Requests: 10, Period: time.Minute})
+	if err != nil {
+		t.Fatalf("Allow: %v", err)
+	}
+	if res.Allowed || res.RetryAfter != 1500*time.Millisecond {
+		t.Fatalf("got %+v, want rejected with RetryAfter 1.5s", res)
+	}
+	if client.keys[0] != "rl:login:ip:10.0.0.1" {
+		t.Fatalf("key = %q, want prefixed key", client.keys[0])
+	}
+	if client.args[1] != 10 {
+		t.Fatalf("capacity arg = %v, want 10", client.args[1])
+	}
+}
+
+func TestRedisLimiterError(t *testing.T) {
+	l := NewRedisLimiter(&evalClient{err: errors.New("connection refused")}, "rl:")
+
+	if _, err := l.Allow(context.Background(), "k", Limit{Requests: 1, Period: time.Second}); err == nil {
+		t.Fatal("Redis error must be returned")
+	}
+}
@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache"
+)
+
+// tokenBucketScript списывает токен из корзины KEYS[1].
+// ARGV[1] - скорость пополнения (токенов в секунду), ARGV[2] - емкость корзины.
+// Время берётся с сервера Redis, чтобы расхождение часов реплик сервиса не влияло на лимит.
+// Возвращает {разрешено (0/1), остаток токенов, задержку до следующего токена в мс}
+const tokenBucketScript = `
+if redis.replicate_commands then
+  redis.replicate_commands()
+end
+
+local rate = tonumber(ARGV[1])
+local capacity = tonumber(ARGV[2])
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+  tokens = capacity
+  ts = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry_after = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity / rate * 1000))
+
+return {allowed, math.floor(tokens), retry_after}
+`
+
+// Компиляционная проверка
+var _ Limiter = (*RedisLimiter)(nil)
+
+// RedisLimiter распределённый token bucket: все реплики сервиса делят одну корзину ключа
+type RedisLimiter struct {
+	client cache.RedisClient
+	prefix string
+}
+
+// NewRedisLimiter создает лимитер поверх Redis; prefix отделяет ключи лимитов от данных сервиса
+func NewRedisLimiter(client cache.RedisClient, prefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, prefix: prefix}
+}
+
+// Allow списывает токен из корзины ключа
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if limit.IsZero() {
+		return Result{Allowed: true}, nil
+	}
+
+	raw, err := l.client.Eval(ctx, tokenBucketScript, []string{l.prefix + key},
+		strconv.FormatFloat(limit.Rate(), 'f', -1, 64),
+		limit.Capacity(),
+	)
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit script: %w", err)
+	}
+
+	values, ok := raw.([]any)
+	if !ok || len(values) != 3 {
+		return Result{}, fmt.Errorf("rate limit script: unexpected reply %v", raw)
+	}
+
+	allowed, _ := values[0].(int64)
+	remaining, _ := values[1].(int64)
+	retryAfter, _ := values[2].(int64)
+
+	return Result{
+		Allowed:    allowed == 1,
+		Remaining:  int(remaining),
+		RetryAfter: time.Duration(retryAfter) * time.Millisecond,
+	}, nil
+}
@@ -3,6 +3,7 @@ package tracing
 import (
 	"context"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -71,6 +72,41 @@ func UnaryServerInterceptor(serviceName string) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamServerInterceptor создает gRPC stream interceptor для трассировки входящих потоков.
+// Спан охватывает весь поток от открытия до завершения обработчика.
+func StreamServerInterceptor(serviceName string) grpc.StreamServerInterceptor {
+	tracer := GetTracerProvider().Tracer(serviceName)
+	propagator := otel.GetTextMapPropagator()
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+
+		ctx, span := tracer.Start(
+			ctx,
+			info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+		)
+		defer span.End()
+
+		// Контекст потока подменяется, чтобы обработчик создавал дочерние спаны
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = AddTraceIDToResponse(ctx)
+
+		err := handler(srv, wrapped)
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	}
+}
+
 // UnaryClientInterceptor создает gRPC unary interceptor для трассировки исходящих запросов.
 // Interceptor добавляет контекст трассировки в исходящий запрос.
 //
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
@@ -32,6 +33,16 @@ func main() {
 
 	closer.Configure(syscall.SIGINT, syscall.SIGTERM)
 
+	// rbac policy export|plan|apply — работа с политикой как кодом без запуска сервера
+	if len(os.Args) > 1 && os.Args[1] == app.PolicyCommand {
+		if err = app.RunPolicyCommand(appCtx, cfg, os.Args[2:], os.Stdout); err != nil {
+			logger.Error(appCtx, "❌ Ошибка выполнения команды policy", zap.Error(err))
+			gracefulShutdown()
+			os.Exit(1)
+		}
+		return
+	}
+
 	a, err := app.New(appCtx, cfg)
 	if err != nil {
 		logger.Error(appCtx, "❌ Не удалось инициализировать приложение", zap.Error(err))
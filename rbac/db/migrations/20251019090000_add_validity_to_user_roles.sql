@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Срок действия назначения роли (временные роли: замены, дежурства на экзаменах)
+ALTER TABLE user_roles
+    ADD COLUMN valid_from TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ADD COLUMN valid_until TIMESTAMP WITH TIME ZONE;
+
+-- Существующие назначения считаем действующими с момента назначения
+UPDATE user_roles SET valid_from = COALESCE(assigned_at, valid_from);
+
+ALTER TABLE user_roles
+    ADD CONSTRAINT user_roles_validity_check CHECK (valid_until IS NULL OR valid_until > valid_from);
+
+-- Индекс для фонового отзыва истекших назначений
+CREATE INDEX idx_user_roles_valid_until ON user_roles (valid_until) WHERE valid_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_user_roles_valid_until;
+ALTER TABLE user_roles
+    DROP CONSTRAINT IF EXISTS user_roles_validity_check,
+    DROP COLUMN IF EXISTS valid_until,
+    DROP COLUMN IF EXISTS valid_from;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Эффект назначения права роли: разрешение или явный запрет (запрет имеет приоритет)
+ALTER TABLE role_permissions
+    ADD COLUMN effect VARCHAR(10) NOT NULL DEFAULT 'allow',
+    ADD CONSTRAINT role_permissions_effect_check CHECK (effect IN ('allow', 'deny'));
+
+-- Пара resource:action должна быть уникальной (в том числе для шаблонов)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_permissions_resource_action ON permissions (resource, action);
+
+-- Шаблонные права: все действия над ресурсом (schedule:*)
+INSERT INTO permissions (resource, action)
+SELECT DISTINCT resource, '*' FROM permissions WHERE resource <> '*'
+ON CONFLICT (resource, action) DO NOTHING;
+
+-- Шаблонные права: действие над любым ресурсом (*:read, *:write) и полный доступ (*:*)
+INSERT INTO permissions (resource, action) VALUES
+('*', 'read'),
+('*', 'write'),
+('*', '*')
+ON CONFLICT (resource, action) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = '*' OR action = '*';
+DROP INDEX IF EXISTS idx_permissions_resource_action;
+ALTER TABLE role_permissions
+    DROP CONSTRAINT IF EXISTS role_permissions_effect_check,
+    DROP COLUMN IF EXISTS effect;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Условие (ABAC), при котором назначение права действует; NULL — без условия
+ALTER TABLE role_permissions
+    ADD COLUMN condition TEXT,
+    ADD CONSTRAINT role_permissions_condition_length_check CHECK (condition IS NULL OR char_length(condition) BETWEEN 1 AND 1000);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE role_permissions
+    DROP CONSTRAINT IF EXISTS role_permissions_condition_length_check,
+    DROP COLUMN IF EXISTS condition;
+-- +goose StatementEnd
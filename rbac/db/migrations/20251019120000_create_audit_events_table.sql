@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Журнал административных изменений (только добавление записей)
+CREATE TABLE audit_events (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(100),
+    actor_session_id VARCHAR(100),
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50) NOT NULL,
+    target_id VARCHAR(100) NOT NULL,
+    before JSONB,
+    after JSONB,
+    request_id VARCHAR(100)
+);
+
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at);
+CREATE INDEX idx_audit_events_actor ON audit_events (actor_id, seq);
+CREATE INDEX idx_audit_events_target ON audit_events (target_type, target_id, seq);
+CREATE INDEX idx_audit_events_action ON audit_events (action, seq);
+
+-- Запрещаем изменение и удаление записей журнала
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+-- Право на чтение журнала аудита для администратора
+INSERT INTO permissions (resource, action) VALUES ('audit', 'read')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440001', id FROM permissions WHERE resource = 'audit' AND action = 'read'
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'audit' AND action = 'read';
+DROP TRIGGER IF EXISTS trg_audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_append_only();
+DROP TABLE IF EXISTS audit_events;
+-- +goose StatementEnd
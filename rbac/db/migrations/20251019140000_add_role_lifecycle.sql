@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Встроенные роли нельзя удалить или переименовать
+ALTER TABLE roles ADD COLUMN is_system BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE roles SET is_system = TRUE WHERE id IN (
+    '650e8400-e29b-41d4-a716-446655440001',
+    '650e8400-e29b-41d4-a716-446655440002',
+    '650e8400-e29b-41d4-a716-446655440003',
+    '650e8400-e29b-41d4-a716-446655440004',
+    '650e8400-e29b-41d4-a716-446655440005'
+);
+
+-- Имя уникально только среди активных ролей, чтобы удаленную роль можно было создать заново
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS roles_name_key;
+CREATE UNIQUE INDEX roles_name_active_key ON roles (name) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS roles_name_active_key;
+ALTER TABLE roles ADD CONSTRAINT roles_name_key UNIQUE (name);
+ALTER TABLE roles DROP COLUMN IF EXISTS is_system;
+-- +goose StatementEnd
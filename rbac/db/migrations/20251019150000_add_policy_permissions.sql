@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO permissions (resource, action) VALUES
+    ('policy', 'read'),
+    ('policy', 'write')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440001', id FROM permissions WHERE resource = 'policy'
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'policy';
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Роли, назначаемые только через согласованную заявку, и роль согласующих
+ALTER TABLE roles
+    ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN approver_role_id UUID REFERENCES roles(id) ON DELETE SET NULL;
+
+UPDATE roles
+SET requires_approval = TRUE, approver_role_id = '650e8400-e29b-41d4-a716-446655440001'
+WHERE id IN (
+    '650e8400-e29b-41d4-a716-446655440001',
+    '650e8400-e29b-41d4-a716-446655440005'
+);
+
+-- Заявки на назначение ролей
+CREATE TABLE access_requests (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    requested_by UUID,
+    justification TEXT NOT NULL,
+    valid_until TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    decided_by UUID,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    decision_comment TEXT,
+    CONSTRAINT access_requests_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'expired'))
+);
+
+-- Одновременно может существовать только одна ожидающая заявка пользователя на роль
+CREATE UNIQUE INDEX access_requests_pending_key ON access_requests (user_id, role_id) WHERE status = 'pending';
+CREATE INDEX idx_access_requests_expires_at ON access_requests (expires_at) WHERE status = 'pending';
+CREATE INDEX idx_access_requests_user ON access_requests (user_id, seq);
+CREATE INDEX idx_access_requests_role ON access_requests (role_id, seq);
+
+INSERT INTO permissions (resource, action) VALUES
+    ('access_request', 'read'),
+    ('access_request', 'create'),
+    ('access_request', 'approve')
+ON CONFLICT (resource, action) DO NOTHING;
+
+-- Администратор работает со всеми заявками
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440001', id FROM permissions WHERE resource = 'access_request'
+ON CONFLICT DO NOTHING;
+
+-- Остальные встроенные роли могут подавать заявки
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.id IN (
+    '650e8400-e29b-41d4-a716-446655440002',
+    '650e8400-e29b-41d4-a716-446655440003',
+    '650e8400-e29b-41d4-a716-446655440004',
+    '650e8400-e29b-41d4-a716-446655440005'
+) AND p.resource = 'access_request' AND p.action = 'create'
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'access_request';
+DROP TABLE IF EXISTS access_requests;
+ALTER TABLE roles DROP COLUMN IF EXISTS approver_role_id;
+ALTER TABLE roles DROP COLUMN IF EXISTS requires_approval;
+-- +goose StatementEnd
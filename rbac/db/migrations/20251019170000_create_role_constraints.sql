@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ограничения разделения обязанностей: пользователь может иметь не более одной роли из набора
+CREATE TABLE role_constraints (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE role_constraint_roles (
+    constraint_id UUID NOT NULL REFERENCES role_constraints(id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    PRIMARY KEY (constraint_id, role_id)
+);
+
+-- Поиск ограничений по назначаемой роли
+CREATE INDEX idx_role_constraint_roles_role ON role_constraint_roles (role_id);
+
+INSERT INTO role_constraints (id, name, description) VALUES
+    ('750e8400-e29b-41d4-a716-446655440001', 'student_teacher', 'Ученик не может быть учителем'),
+    ('750e8400-e29b-41d4-a716-446655440002', 'admin_parent', 'Учетная запись родителя не может быть администратором');
+
+INSERT INTO role_constraint_roles (constraint_id, role_id) VALUES
+    ('750e8400-e29b-41d4-a716-446655440001', '650e8400-e29b-41d4-a716-446655440003'),
+    ('750e8400-e29b-41d4-a716-446655440001', '650e8400-e29b-41d4-a716-446655440002'),
+    ('750e8400-e29b-41d4-a716-446655440002', '650e8400-e29b-41d4-a716-446655440001'),
+    ('750e8400-e29b-41d4-a716-446655440002', '650e8400-e29b-41d4-a716-446655440004');
+
+INSERT INTO permissions (resource, action) VALUES
+    ('role_constraint', 'read'),
+    ('role_constraint', 'write')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440001', id FROM permissions WHERE resource = 'role_constraint'
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'role_constraint';
+DROP TABLE IF EXISTS role_constraint_roles;
+DROP TABLE IF EXISTS role_constraints;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO permissions (resource, action) VALUES
+    ('access', 'explain')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.role_id, p.id
+FROM permissions p
+CROSS JOIN (VALUES
+    ('650e8400-e29b-41d4-a716-446655440001'::uuid),
+    ('650e8400-e29b-41d4-a716-446655440005'::uuid)
+) AS r(role_id)
+WHERE p.resource = 'access' AND p.action = 'explain'
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'access' AND action = 'explain';
+-- +goose StatementEnd
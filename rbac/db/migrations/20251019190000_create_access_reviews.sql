@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Кампании пересмотра доступа
+CREATE TABLE access_review_campaigns (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    name VARCHAR(200) NOT NULL,
+    description TEXT,
+    role_ids UUID[] NOT NULL,
+    reviewer_ids UUID[] NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    due_at TIMESTAMP WITH TIME ZONE,
+    closed_by UUID,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT access_review_campaigns_status_check CHECK (status IN ('open', 'closed'))
+);
+
+-- Назначения ролей, зафиксированные при создании кампании, и решения проверяющих
+CREATE TABLE access_review_items (
+    seq BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    campaign_id UUID NOT NULL REFERENCES access_review_campaigns(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    decision VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by UUID,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    comment TEXT,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT access_review_items_decision_check CHECK (decision IN ('pending', 'keep', 'revoke')),
+    CONSTRAINT access_review_items_binding_key UNIQUE (campaign_id, user_id, role_id)
+);
+
+CREATE INDEX idx_access_review_items_campaign ON access_review_items (campaign_id, seq);
+-- Отзывы, которые еще предстоит применить
+CREATE INDEX idx_access_review_items_pending_revoke ON access_review_items (campaign_id)
+    WHERE decision = 'revoke' AND revoked_at IS NULL;
+
+INSERT INTO permissions (resource, action) VALUES
+    ('access_review', 'read'),
+    ('access_review', 'manage'),
+    ('access_review', 'review')
+ON CONFLICT (resource, action) DO NOTHING;
+
+-- Администратор ведет кампании
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440001', id FROM permissions WHERE resource = 'access_review'
+ON CONFLICT DO NOTHING;
+
+-- Модератор может быть проверяющим
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT '650e8400-e29b-41d4-a716-446655440005', id FROM permissions
+WHERE resource = 'access_review' AND action IN ('read', 'review')
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE resource = 'access_review';
+DROP TABLE IF EXISTS access_review_items;
+DROP TABLE IF EXISTS access_review_campaigns;
+-- +goose StatementEnd
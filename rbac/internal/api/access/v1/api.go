@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+)
+
+var _ accessV1.AccessServiceServer = (*API)(nil)
+
+type API struct {
+	accessV1.UnimplementedAccessServiceServer
+	accessExplainService service.AccessExplainServiceInterface
+}
+
+func NewAPI(accessExplainService service.AccessExplainServiceInterface) *API {
+	return &API{
+		accessExplainService: accessExplainService,
+	}
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+)
+
+func (api *API) Explain(ctx context.Context, req *accessV1.ExplainRequest) (*accessV1.ExplainResponse, error) {
+	explanation, err := api.accessExplainService.Explain(ctx, converter.AccessCheckToDomain(req.GetCheck()))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка объяснения решения по праву", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessV1.ExplainResponse{Explanation: converter.AccessExplanationToProto(explanation)}, nil
+}
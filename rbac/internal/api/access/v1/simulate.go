@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+)
+
+func (api *API) Simulate(ctx context.Context, req *accessV1.SimulateRequest) (*accessV1.SimulateResponse, error) {
+	simulation, err := api.accessExplainService.Simulate(ctx, converter.AccessCheckToDomain(req.GetCheck()), converter.RoleChangesToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка моделирования изменений ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return converter.AccessSimulationToProto(simulation), nil
+}
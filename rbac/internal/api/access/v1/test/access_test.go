@@ -0,0 +1,82 @@
+package access_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+func (s *APISuite) TestExplain() {
+	userID := uuid.NewString()
+	role := model.Role{ID: uuid.New(), Name: "teacher"}
+	explanation := &model.AccessExplanation{
+		Decision:   authz.DecisionDeny,
+		Permission: "grade:write",
+		Roles:      []*model.Role{&role},
+		Grants: []*model.GrantTrace{{
+			RoleID:     role.ID.String(),
+			RoleName:   role.Name,
+			Permission: &model.Permission{Resource: "grade", Action: "*", Effect: authz.EffectDeny, Condition: "request.time.hour > 18"},
+			Applied:    true,
+		}},
+	}
+
+	s.accessExplainService.On("Explain", mock.Anything, &model.AccessCheck{UserID: userID, Permission: "grade:write"}).
+		Return(explanation, nil).Once()
+
+	resp, err := s.api.Explain(s.ctx, &accessV1.ExplainRequest{Check: &accessV1.AccessCheck{UserId: userID, Permission: "grade:write"}})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessV1.AccessDecision_ACCESS_DECISION_DENY, resp.Explanation.Decision)
+	assert.Equal(s.T(), "teacher", resp.Explanation.Roles[0].Name)
+	assert.Equal(s.T(), "grade:*", resp.Explanation.Grants[0].Permission)
+	assert.Equal(s.T(), commonV1.PermissionEffect_PERMISSION_EFFECT_DENY, resp.Explanation.Grants[0].Effect)
+	assert.Equal(s.T(), "request.time.hour > 18", resp.Explanation.Grants[0].GetCondition())
+}
+
+func (s *APISuite) TestExplainPassesAttributes() {
+	resource, err := structpb.NewStruct(map[string]any{"owner_id": "teacher-1"})
+	assert.NoError(s.T(), err)
+
+	s.accessExplainService.On("Explain", mock.Anything, mock.MatchedBy(func(check *model.AccessCheck) bool {
+		return check.Attributes != nil && check.Attributes["resource"].(map[string]any)["owner_id"] == "teacher-1"
+	})).Return(&model.AccessExplanation{Decision: authz.DecisionAllow}, nil).Once()
+
+	resp, err := s.api.Explain(s.ctx, &accessV1.ExplainRequest{Check: &accessV1.AccessCheck{
+		UserId:     uuid.NewString(),
+		Permission: "grade:write",
+		Resource:   resource,
+	}})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessV1.AccessDecision_ACCESS_DECISION_ALLOW, resp.Explanation.Decision)
+}
+
+func (s *APISuite) TestExplainInvalidPermission() {
+	s.accessExplainService.On("Explain", mock.Anything, mock.Anything).Return(nil, model.ErrInvalidPermission).Once()
+
+	_, err := s.api.Explain(s.ctx, &accessV1.ExplainRequest{Check: &accessV1.AccessCheck{UserId: uuid.NewString(), Permission: "grade:*"}})
+
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestSimulateUnknownRole() {
+	roleID := uuid.NewString()
+	s.accessExplainService.On("Simulate", mock.Anything, mock.Anything, &model.RoleChanges{AddRoleIDs: []string{roleID}}).
+		Return(nil, model.ErrRoleNotFound).Once()
+
+	_, err := s.api.Simulate(s.ctx, &accessV1.SimulateRequest{
+		Check:      &accessV1.AccessCheck{UserId: uuid.NewString(), Permission: "grade:write"},
+		AddRoleIds: []string{roleID},
+	})
+
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,37 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	api "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/access/v1"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	accessExplainService *mocks.AccessExplainServiceInterface
+	api                  *api.API
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.accessExplainService = mocks.NewAccessExplainServiceInterface(s.T())
+	s.api = api.NewAPI(s.accessExplainService)
+}
+
+func (s *APISuite) TearDownTest() {}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+var _ accessRequestV1.AccessRequestServiceServer = (*API)(nil)
+
+type API struct {
+	accessRequestV1.UnimplementedAccessRequestServiceServer
+	accessRequestService service.AccessRequestServiceInterface
+}
+
+func NewAPI(accessRequestService service.AccessRequestServiceInterface) *API {
+	return &API{
+		accessRequestService: accessRequestService,
+	}
+}
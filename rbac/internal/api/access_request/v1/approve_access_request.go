@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+func (api *API) ApproveAccessRequest(ctx context.Context, req *accessRequestV1.DecideAccessRequestRequest) (*accessRequestV1.DecideAccessRequestResponse, error) {
+	request, err := api.accessRequestService.Approve(ctx, req.GetRequestId(), req.Comment)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка согласования заявки на доступ", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessRequestV1.DecideAccessRequestResponse{Request: converter.AccessRequestToProto(request)}, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+func (api *API) CreateAccessRequest(ctx context.Context, req *accessRequestV1.CreateAccessRequestRequest) (*accessRequestV1.CreateAccessRequestResponse, error) {
+	request, err := api.accessRequestService.Create(ctx, converter.CreateAccessRequestToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка создания заявки на доступ", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessRequestV1.CreateAccessRequestResponse{Request: converter.AccessRequestToProto(request)}, nil
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+// defaultLimit — размер страницы списка заявок по умолчанию
+const defaultLimit int32 = 50
+
+func (api *API) ListAccessRequests(ctx context.Context, req *accessRequestV1.ListAccessRequestsRequest) (*accessRequestV1.ListAccessRequestsResponse, error) {
+	filter := converter.AccessRequestFilterToDomain(req)
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+
+	requests, nextCursor, err := api.accessRequestService.List(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения списка заявок на доступ", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessRequestV1.ListAccessRequestsResponse{
+		Requests:   converter.AccessRequestsToProto(requests),
+		Limit:      filter.Limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}, nil
+}
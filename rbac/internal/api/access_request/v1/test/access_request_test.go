@@ -0,0 +1,98 @@
+package access_request_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+func (s *APISuite) TestCreateAccessRequest() {
+	roleID := uuid.NewString()
+	validUntil := time.Now().Add(24 * time.Hour).UTC()
+	created := &model.AccessRequest{
+		ID:        uuid.New(),
+		UserID:    uuid.NewString(),
+		RoleID:    roleID,
+		Status:    model.AccessRequestStatusPending,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(72 * time.Hour),
+	}
+
+	s.accessRequestService.On("Create", mock.Anything, mock.MatchedBy(func(request *model.CreateAccessRequest) bool {
+		return request.RoleID == roleID && request.UserID == "" &&
+			request.ValidUntil != nil && request.ValidUntil.Equal(validUntil)
+	})).Return(created, nil).Once()
+
+	resp, err := s.api.CreateAccessRequest(s.ctx, &accessRequestV1.CreateAccessRequestRequest{
+		RoleId:        roleID,
+		Justification: "нужен доступ к журналам",
+		ValidUntil:    timestamppb.New(validUntil),
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), created.ID.String(), resp.Request.Id)
+	assert.Equal(s.T(), accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_PENDING, resp.Request.Status)
+
+	s.accessRequestService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestListAccessRequestsDefaults() {
+	status := accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_PENDING
+	nextCursor := "42"
+
+	s.accessRequestService.On("List", mock.Anything, mock.MatchedBy(func(filter *model.AccessRequestFilter) bool {
+		return filter.Limit == 50 &&
+			filter.Status != nil && *filter.Status == model.AccessRequestStatusPending
+	})).Return([]*model.AccessRequest{{ID: uuid.New(), Status: model.AccessRequestStatusPending}}, &nextCursor, nil).Once()
+
+	resp, err := s.api.ListAccessRequests(s.ctx, &accessRequestV1.ListAccessRequestsRequest{Status: &status})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Requests, 1)
+	assert.Equal(s.T(), int32(50), resp.Limit)
+	assert.True(s.T(), resp.HasMore)
+	assert.Equal(s.T(), nextCursor, resp.GetNextCursor())
+}
+
+func (s *APISuite) TestApproveAccessRequestErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{model.ErrAccessRequestNotFound, codes.NotFound},
+		{model.ErrAccessRequestNotPending, codes.FailedPrecondition},
+		{model.ErrNotApprover, codes.PermissionDenied},
+		{model.ErrSelfApproval, codes.PermissionDenied},
+	}
+
+	for _, tc := range cases {
+		id := uuid.NewString()
+		s.accessRequestService.On("Approve", mock.Anything, id, (*string)(nil)).Return(nil, tc.err).Once()
+
+		_, err := s.api.ApproveAccessRequest(s.ctx, &accessRequestV1.DecideAccessRequestRequest{RequestId: id})
+
+		assert.Equal(s.T(), tc.code, status.Code(err), tc.err.Error())
+	}
+}
+
+func (s *APISuite) TestRejectAccessRequest() {
+	id := uuid.New()
+	comment := "не требуется"
+
+	s.accessRequestService.On("Reject", mock.Anything, id.String(), &comment).
+		Return(&model.AccessRequest{ID: id, Status: model.AccessRequestStatusRejected, DecisionComment: &comment}, nil).Once()
+
+	resp, err := s.api.RejectAccessRequest(s.ctx, &accessRequestV1.DecideAccessRequestRequest{RequestId: id.String(), Comment: &comment})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_REJECTED, resp.Request.Status)
+	assert.Equal(s.T(), comment, resp.Request.GetDecisionComment())
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+var _ accessReviewV1.AccessReviewServiceServer = (*API)(nil)
+
+// defaultLimit — размер страницы списков по умолчанию
+const defaultLimit int32 = 50
+
+type API struct {
+	accessReviewV1.UnimplementedAccessReviewServiceServer
+	accessReviewService service.AccessReviewServiceInterface
+}
+
+func NewAPI(accessReviewService service.AccessReviewServiceInterface) *API {
+	return &API{
+		accessReviewService: accessReviewService,
+	}
+}
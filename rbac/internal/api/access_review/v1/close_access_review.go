@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) CloseAccessReview(ctx context.Context, req *accessReviewV1.CloseAccessReviewRequest) (*accessReviewV1.CloseAccessReviewResponse, error) {
+	campaign, err := api.accessReviewService.Close(ctx, req.GetCampaignId(), req.GetRevokeUnreviewed())
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка закрытия кампании пересмотра доступа", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.CloseAccessReviewResponse{Campaign: converter.AccessReviewToProto(campaign)}, nil
+}
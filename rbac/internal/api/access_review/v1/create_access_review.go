@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) CreateAccessReview(ctx context.Context, req *accessReviewV1.CreateAccessReviewRequest) (*accessReviewV1.CreateAccessReviewResponse, error) {
+	campaign, err := api.accessReviewService.Create(ctx, converter.CreateAccessReviewToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка создания кампании пересмотра доступа", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.CreateAccessReviewResponse{Campaign: converter.AccessReviewToProto(campaign)}, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) DecideAccessReviewItem(ctx context.Context, req *accessReviewV1.DecideAccessReviewItemRequest) (*accessReviewV1.DecideAccessReviewItemResponse, error) {
+	item, err := api.accessReviewService.Decide(ctx, converter.DecideAccessReviewItemToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка решения по назначению кампании пересмотра", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.DecideAccessReviewItemResponse{Item: converter.AccessReviewItemToProto(item)}, nil
+}
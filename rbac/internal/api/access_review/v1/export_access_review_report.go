@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) ExportAccessReviewReport(ctx context.Context, req *accessReviewV1.ExportAccessReviewReportRequest) (*accessReviewV1.ExportAccessReviewReportResponse, error) {
+	report, err := api.accessReviewService.Report(ctx, req.GetCampaignId())
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения отчета кампании пересмотра", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	format := converter.AccessReviewReportFormatToDomain(req.GetFormat())
+	data, err := converter.EncodeAccessReviewReport(report, format)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка сериализации отчета кампании пересмотра", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.ExportAccessReviewReportResponse{
+		Report: data,
+		Format: converter.AccessReviewReportFormatToProto(format),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) GetAccessReview(ctx context.Context, req *accessReviewV1.GetAccessReviewRequest) (*accessReviewV1.GetAccessReviewResponse, error) {
+	campaign, err := api.accessReviewService.Get(ctx, req.GetCampaignId())
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения кампании пересмотра доступа", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.GetAccessReviewResponse{Campaign: converter.AccessReviewToProto(campaign)}, nil
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) ListAccessReviewItems(ctx context.Context, req *accessReviewV1.ListAccessReviewItemsRequest) (*accessReviewV1.ListAccessReviewItemsResponse, error) {
+	filter := converter.AccessReviewItemFilterToDomain(req)
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+
+	items, nextCursor, err := api.accessReviewService.ListItems(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения назначений кампании пересмотра", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.ListAccessReviewItemsResponse{
+		Items:      converter.AccessReviewItemsToProto(items),
+		Limit:      filter.Limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}, nil
+}
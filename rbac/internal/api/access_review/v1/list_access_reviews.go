@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (api *API) ListAccessReviews(ctx context.Context, req *accessReviewV1.ListAccessReviewsRequest) (*accessReviewV1.ListAccessReviewsResponse, error) {
+	filter := converter.AccessReviewFilterToDomain(req)
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+
+	campaigns, nextCursor, err := api.accessReviewService.List(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения списка кампаний пересмотра доступа", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &accessReviewV1.ListAccessReviewsResponse{
+		Campaigns:  converter.AccessReviewsToProto(campaigns),
+		Limit:      filter.Limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package access_review_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+func (s *APISuite) TestCreateAccessReview() {
+	roleIDs := []string{uuid.NewString()}
+	reviewerIDs := []string{uuid.NewString()}
+	campaign := &model.AccessReviewCampaign{
+		ID:        uuid.New(),
+		Name:      "Осень 2025",
+		RoleIDs:   roleIDs,
+		Status:    model.AccessReviewStatusOpen,
+		CreatedAt: time.Now(),
+		Summary:   model.AccessReviewSummary{Total: 4, Pending: 4},
+	}
+
+	s.accessReviewService.On("Create", mock.Anything, &model.CreateAccessReview{
+		Name: "Осень 2025", RoleIDs: roleIDs, ReviewerIDs: reviewerIDs,
+	}).Return(campaign, nil).Once()
+
+	resp, err := s.api.CreateAccessReview(s.ctx, &accessReviewV1.CreateAccessReviewRequest{
+		Name: "Осень 2025", RoleIds: roleIDs, ReviewerIds: reviewerIDs,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessReviewV1.AccessReviewStatus_ACCESS_REVIEW_STATUS_OPEN, resp.Campaign.Status)
+	assert.Equal(s.T(), int32(4), resp.Campaign.Summary.Pending)
+}
+
+func (s *APISuite) TestDecideAccessReviewItem() {
+	campaignID := uuid.NewString()
+	itemID := uuid.NewString()
+	item := &model.AccessReviewItem{ID: uuid.MustParse(itemID), CampaignID: campaignID, Decision: model.AccessReviewDecisionKeep}
+
+	s.accessReviewService.On("Decide", mock.Anything, &model.AccessReviewItemDecision{
+		CampaignID: campaignID, ItemID: itemID, Decision: model.AccessReviewDecisionKeep,
+	}).Return(item, nil).Once()
+
+	resp, err := s.api.DecideAccessReviewItem(s.ctx, &accessReviewV1.DecideAccessReviewItemRequest{
+		CampaignId: campaignID,
+		ItemId:     itemID,
+		Decision:   accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP, resp.Item.Decision)
+}
+
+func (s *APISuite) TestDecideAccessReviewItemErrors() {
+	cases := map[error]codes.Code{
+		model.ErrNotReviewer:        codes.PermissionDenied,
+		model.ErrSelfReview:         codes.PermissionDenied,
+		model.ErrAccessReviewClosed: codes.FailedPrecondition,
+	}
+	for serviceErr, code := range cases {
+		s.accessReviewService.On("Decide", mock.Anything, mock.Anything).Return(nil, serviceErr).Once()
+
+		_, err := s.api.DecideAccessReviewItem(s.ctx, &accessReviewV1.DecideAccessReviewItemRequest{
+			CampaignId: uuid.NewString(),
+			ItemId:     uuid.NewString(),
+			Decision:   accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE,
+		})
+
+		assert.Equal(s.T(), code, status.Code(err), serviceErr.Error())
+	}
+}
+
+func (s *APISuite) TestListAccessReviewItemsDefaultLimit() {
+	campaignID := uuid.NewString()
+	decision := accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING
+
+	s.accessReviewService.On("ListItems", mock.Anything, mock.MatchedBy(func(filter *model.AccessReviewItemFilter) bool {
+		return filter.CampaignID == campaignID && filter.Limit == 50 &&
+			filter.Decision != nil && *filter.Decision == model.AccessReviewDecisionPending
+	})).Return([]*model.AccessReviewItem{}, nil, nil).Once()
+
+	resp, err := s.api.ListAccessReviewItems(s.ctx, &accessReviewV1.ListAccessReviewItemsRequest{
+		CampaignId: campaignID,
+		Decision:   &decision,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), resp.HasMore)
+}
+
+func (s *APISuite) TestExportAccessReviewReportCSV() {
+	campaign := &model.AccessReviewCampaign{ID: uuid.New(), Name: "Осень 2025"}
+	reviewerID := uuid.NewString()
+	comment := "уволен, доступ не нужен"
+	reviewedAt := time.Date(2025, 10, 20, 9, 30, 0, 0, time.UTC)
+	report := &model.AccessReviewReport{
+		Campaign: campaign,
+		Items: []*model.AccessReviewItem{{
+			ID:         uuid.New(),
+			UserID:     uuid.NewString(),
+			RoleID:     uuid.NewString(),
+			Decision:   model.AccessReviewDecisionRevoke,
+			ReviewedBy: &reviewerID,
+			ReviewedAt: &reviewedAt,
+			Comment:    &comment,
+		}},
+	}
+
+	s.accessReviewService.On("Report", mock.Anything, campaign.ID.String()).Return(report, nil).Once()
+
+	resp, err := s.api.ExportAccessReviewReport(s.ctx, &accessReviewV1.ExportAccessReviewReportRequest{CampaignId: campaign.ID.String()})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), accessReviewV1.AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_CSV, resp.Format)
+
+	records, err := csv.NewReader(strings.NewReader(string(resp.Report))).ReadAll()
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), records, 2)
+	assert.Equal(s.T(), "decision", records[0][4])
+	assert.Equal(s.T(), []string{
+		campaign.ID.String(), "Осень 2025", report.Items[0].UserID, report.Items[0].RoleID, "revoke",
+		reviewerID, "2025-10-20T09:30:00Z", comment, "",
+	}, records[1])
+}
+
+func (s *APISuite) TestCloseAccessReviewNotFound() {
+	id := uuid.NewString()
+	s.accessReviewService.On("Close", mock.Anything, id, true).Return(nil, model.ErrAccessReviewNotFound).Once()
+
+	_, err := s.api.CloseAccessReview(s.ctx, &accessReviewV1.CloseAccessReviewRequest{CampaignId: id, RevokeUnreviewed: true})
+
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+}
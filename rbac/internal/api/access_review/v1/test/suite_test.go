@@ -0,0 +1,37 @@
+package access_review_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	api "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/access_review/v1"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	accessReviewService *mocks.AccessReviewServiceInterface
+	api                 *api.API
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.accessReviewService = mocks.NewAccessReviewServiceInterface(s.T())
+	s.api = api.NewAPI(s.accessReviewService)
+}
+
+func (s *APISuite) TearDownTest() {}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	auditV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/audit/v1"
+)
+
+var _ auditV1.AuditServiceServer = (*API)(nil)
+
+type API struct {
+	auditV1.UnimplementedAuditServiceServer
+	auditService service.AuditServiceInterface
+}
+
+func NewAPI(auditService service.AuditServiceInterface) *API {
+	return &API{
+		auditService: auditService,
+	}
+}
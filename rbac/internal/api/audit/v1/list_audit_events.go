@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	auditV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/audit/v1"
+)
+
+// defaultLimit — размер страницы журнала по умолчанию
+const defaultLimit int32 = 50
+
+func (api *API) ListAuditEvents(ctx context.Context, req *auditV1.ListAuditEventsRequest) (*auditV1.ListAuditEventsResponse, error) {
+	filter := converter.AuditEventFilterToDomain(req)
+	if filter.Limit == 0 {
+		filter.Limit = defaultLimit
+	}
+
+	events, nextCursor, err := api.auditService.List(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения журнала аудита", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &auditV1.ListAuditEventsResponse{
+		Events:     converter.AuditEventsToProto(events),
+		Limit:      filter.Limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
 	permissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/permission/v1"
@@ -14,7 +15,7 @@ func (api *API) List(ctx context.Context, req *permissionV1.ListRequest) (*permi
 	permissions, err := api.permissionService.List(ctx)
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка получения списка прав доступа", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &permissionV1.ListResponse{
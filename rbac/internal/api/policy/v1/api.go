@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+var _ policyV1.PolicyServiceServer = (*API)(nil)
+
+type API struct {
+	policyV1.UnimplementedPolicyServiceServer
+	policyService service.PolicyServiceInterface
+}
+
+func NewAPI(srv service.PolicyServiceInterface) *API {
+	return &API{
+		policyService: srv,
+	}
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+func (api *API) ApplyPolicy(ctx context.Context, req *policyV1.ApplyPolicyRequest) (*policyV1.ApplyPolicyResponse, error) {
+	document, err := converter.DecodePolicyDocument(req.Document, converter.PolicyFormatToDomain(req.Format))
+	if err != nil {
+		return nil, mapError(ctx, err)
+	}
+
+	plan, err := api.policyService.Apply(ctx, document, req.Prune)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка применения политики", zap.Error(err))
+		return nil, mapError(ctx, err)
+	}
+
+	return &policyV1.ApplyPolicyResponse{Changes: converter.PolicyChangesToProto(plan)}, nil
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+func (api *API) ExportPolicy(ctx context.Context, req *policyV1.ExportPolicyRequest) (*policyV1.ExportPolicyResponse, error) {
+	document, err := api.policyService.Export(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка выгрузки политики", zap.Error(err))
+		return nil, mapError(ctx, err)
+	}
+
+	format := converter.PolicyFormatToDomain(req.Format)
+	data, err := converter.EncodePolicyDocument(document, format)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка сериализации политики", zap.Error(err))
+		return nil, mapError(ctx, err)
+	}
+
+	return &policyV1.ExportPolicyResponse{
+		Document: data,
+		Format:   converter.PolicyFormatToProto(format),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// mapError преобразует ошибки применения политики в gRPC статусы.
+// Конфликт имени роли внутри транзакции означает конкурентное изменение, клиент может повторить запрос
+func mapError(ctx context.Context, err error) error {
+	if errors.Is(err, model.ErrRoleAlreadyExists) {
+		return apperr.ToStatus(ctx, model.ErrPolicyConflict)
+	}
+	return apperr.ToStatus(ctx, err)
+}
@@ -0,0 +1,105 @@
+package policy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	platformgrpc "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/server"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+// plaintextTLS транспорт без TLS для сервера в памяти
+type plaintextTLS struct{}
+
+func (plaintextTLS) Enabled() bool                 { return false }
+func (plaintextTLS) CertFile() string              { return "" }
+func (plaintextTLS) KeyFile() string               { return "" }
+func (plaintextTLS) CAFile() string                { return "" }
+func (plaintextTLS) RequireClientCert() bool       { return false }
+func (plaintextTLS) AllowedPeerIDs() []string      { return nil }
+func (plaintextTLS) ServerName() string            { return "" }
+func (plaintextTLS) ReloadInterval() time.Duration { return 0 }
+
+// serveWithAccessChain поднимает сервер политик с интерсепторами доступа, которые подключает RBAC
+func (s *APISuite) serveWithAccessChain() policyV1.PolicyServiceClient {
+	srv, err := platformgrpc.New(s.ctx, 5*time.Second, 4<<20, 4<<20, plaintextTLS{},
+		platformgrpc.AccessInterceptors()...)
+	s.Require().NoError(err)
+	policyV1.RegisterPolicyServiceServer(srv, s.api)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(listener) }()
+	s.T().Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { _ = conn.Close() })
+
+	return policyV1.NewPolicyServiceClient(conn)
+}
+
+func (s *APISuite) applyPolicyRequest() *policyV1.ApplyPolicyRequest {
+	document, err := json.Marshal(exportedDocument())
+	s.Require().NoError(err)
+
+	return &policyV1.ApplyPolicyRequest{Document: document, Format: policyV1.PolicyFormat_POLICY_FORMAT_JSON}
+}
+
+func (s *APISuite) TestApplyPolicyWithoutPermissionDenied() {
+	client := s.serveWithAccessChain()
+	ctx := metadata.AppendToOutgoingContext(s.ctx,
+		interceptor.HeaderSessionID, "session-1",
+		interceptor.HeaderUserID, "user-1",
+		interceptor.HeaderUserPermissions, "policy:read",
+	)
+
+	resp, err := client.ApplyPolicy(ctx, s.applyPolicyRequest())
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	s.policyService.AssertNotCalled(s.T(), "Apply", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestApplyPolicyWithoutSessionUnauthenticated() {
+	client := s.serveWithAccessChain()
+
+	resp, err := client.ApplyPolicy(s.ctx, s.applyPolicyRequest())
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	s.policyService.AssertNotCalled(s.T(), "Apply", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestApplyPolicyWithPermissionAllowed() {
+	client := s.serveWithAccessChain()
+	ctx := metadata.AppendToOutgoingContext(s.ctx,
+		interceptor.HeaderSessionID, "session-1",
+		interceptor.HeaderUserID, "user-1",
+		interceptor.HeaderUserPermissions, "policy:write",
+	)
+	s.policyService.On("Apply", mock.Anything, exportedDocument(), false).
+		Return(&model.PolicyPlan{}, nil).Once()
+
+	_, err := client.ApplyPolicy(ctx, s.applyPolicyRequest())
+
+	assert.NoError(s.T(), err)
+	s.policyService.AssertExpectations(s.T())
+}
@@ -0,0 +1,132 @@
+package policy_test
+
+import (
+	"encoding/json"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+const policyYAML = `version: 1
+permissions:
+  - resource: schedule
+    action: read
+roles:
+  - name: teacher
+    description: Учитель
+    grants:
+      - permission: schedule:read
+        condition: subject.school_id == resource.school_id
+`
+
+func exportedDocument() *model.PolicyDocument {
+	return &model.PolicyDocument{
+		Version:     model.PolicyDocumentVersion,
+		Permissions: []*model.PolicyPermission{{Resource: "schedule", Action: "read"}},
+		Roles: []*model.PolicyRole{{
+			Name:        "teacher",
+			Description: "Учитель",
+			Grants: []*model.PolicyGrant{{
+				Permission: "schedule:read",
+				Condition:  "subject.school_id == resource.school_id",
+			}},
+		}},
+	}
+}
+
+func (s *APISuite) TestExportPolicyYAML() {
+	s.policyService.On("Export", mock.Anything).Return(exportedDocument(), nil).Once()
+
+	resp, err := s.api.ExportPolicy(s.ctx, &policyV1.ExportPolicyRequest{})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), policyV1.PolicyFormat_POLICY_FORMAT_YAML, resp.Format)
+	assert.Equal(s.T(), policyYAML, string(resp.Document))
+
+	s.policyService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestExportPolicyJSON() {
+	s.policyService.On("Export", mock.Anything).Return(exportedDocument(), nil).Once()
+
+	resp, err := s.api.ExportPolicy(s.ctx, &policyV1.ExportPolicyRequest{Format: policyV1.PolicyFormat_POLICY_FORMAT_JSON})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), policyV1.PolicyFormat_POLICY_FORMAT_JSON, resp.Format)
+
+	var document model.PolicyDocument
+	assert.NoError(s.T(), json.Unmarshal(resp.Document, &document))
+	assert.Equal(s.T(), exportedDocument(), &document)
+
+	s.policyService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestPlanPolicySuccess() {
+	plan := &model.PolicyPlan{Changes: []*model.PolicyChange{
+		{Type: model.PolicyChangeCreateRole, Role: "teacher", Description: "Учитель"},
+		{Type: model.PolicyChangeAddGrant, Role: "teacher", Permission: "schedule:read", Effect: authz.EffectDeny,
+			Condition: "subject.school_id == resource.school_id"},
+	}}
+	s.policyService.On("Plan", mock.Anything, exportedDocument(), true).Return(plan, nil).Once()
+
+	resp, err := s.api.PlanPolicy(s.ctx, &policyV1.PlanPolicyRequest{Document: []byte(policyYAML), Prune: true})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Changes, 2)
+	assert.Equal(s.T(), policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_CREATE_ROLE, resp.Changes[0].Type)
+	assert.Nil(s.T(), resp.Changes[0].Condition)
+	assert.Equal(s.T(), policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_ADD_GRANT, resp.Changes[1].Type)
+	assert.Equal(s.T(), commonV1.PermissionEffect_PERMISSION_EFFECT_DENY, resp.Changes[1].Effect)
+	assert.Equal(s.T(), "subject.school_id == resource.school_id", resp.Changes[1].GetCondition())
+
+	s.policyService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestPlanPolicyUnknownField() {
+	document := []byte("version: 1\nrole:\n  - name: teacher\n")
+
+	resp, err := s.api.PlanPolicy(s.ctx, &policyV1.PlanPolicyRequest{Document: document})
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestApplyPolicyJSON() {
+	document, err := json.Marshal(exportedDocument())
+	assert.NoError(s.T(), err)
+
+	plan := &model.PolicyPlan{Changes: []*model.PolicyChange{
+		{Type: model.PolicyChangeCreatePermission, Permission: "schedule:read"},
+	}}
+	s.policyService.On("Apply", mock.Anything, exportedDocument(), false).Return(plan, nil).Once()
+
+	resp, err := s.api.ApplyPolicy(s.ctx, &policyV1.ApplyPolicyRequest{
+		Document: document,
+		Format:   policyV1.PolicyFormat_POLICY_FORMAT_JSON,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Changes, 1)
+	assert.Equal(s.T(), "schedule:read", resp.Changes[0].Permission)
+
+	s.policyService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestApplyPolicyInvalidDocument() {
+	s.policyService.On("Apply", mock.Anything, mock.Anything, false).
+		Return(nil, model.ErrInvalidPolicy).Once()
+
+	resp, err := s.api.ApplyPolicy(s.ctx, &policyV1.ApplyPolicyRequest{Document: []byte("version: 7\n")})
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	s.policyService.AssertExpectations(s.T())
+}
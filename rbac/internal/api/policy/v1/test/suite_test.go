@@ -0,0 +1,37 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	api "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/policy/v1"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	policyService *mocks.PolicyServiceInterface
+	api           *api.API
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.policyService = mocks.NewPolicyServiceInterface(s.T())
+	s.api = api.NewAPI(s.policyService)
+}
+
+func (s *APISuite) TearDownTest() {}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
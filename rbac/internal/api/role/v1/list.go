@@ -5,19 +5,34 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
 	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
 )
 
+// defaultListLimit — размер страницы списка ролей по умолчанию
+const defaultListLimit int32 = 50
+
 func (api *API) List(ctx context.Context, req *roleV1.ListRequest) (*roleV1.ListResponse, error) {
-	roles, err := api.roleService.List(ctx)
+	filter := converter.RoleFilterToDomain(req)
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	roles, nextCursor, err := api.roleService.List(ctx, filter)
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка получения списка ролей", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
+	data, userCounts := converter.RoleListItemsToProto(roles)
+
 	return &roleV1.ListResponse{
-		Data: converter.RolesToProto(roles),
+		Data:       data,
+		UserCounts: userCounts,
+		Limit:      filter.Limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
 	}, nil
 }
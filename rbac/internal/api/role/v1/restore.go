@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+func (api *API) Restore(ctx context.Context, req *roleV1.RestoreRequest) (*emptypb.Empty, error) {
+	if err := api.roleService.Restore(ctx, req.RoleId); err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка восстановления роли", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,47 @@
+package role_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+func (s *APISuite) TestFlushCacheSuccess() {
+	roleIDs := []string{uuid.NewString(), uuid.NewString()}
+
+	s.roleService.On("FlushCache", mock.Anything, roleIDs).Return(2, nil).Once()
+
+	resp, err := s.api.FlushCache(s.ctx, &roleV1.FlushCacheRequest{RoleIds: roleIDs})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(2), resp.Count)
+
+	s.roleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestFlushCacheError() {
+	s.roleService.On("FlushCache", mock.Anything, mock.Anything).Return(0, model.ErrInternal).Once()
+
+	resp, err := s.api.FlushCache(s.ctx, &roleV1.FlushCacheRequest{})
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.Internal, status.Code(err))
+
+	s.roleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestWarmCacheSuccess() {
+	s.roleService.On("WarmCache", mock.Anything, []string(nil)).Return(5, nil).Once()
+
+	resp, err := s.api.WarmCache(s.ctx, &roleV1.WarmCacheRequest{})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(5), resp.Count)
+
+	s.roleService.AssertExpectations(s.T())
+}
@@ -88,3 +88,18 @@ func (s *APISuite) TestDeleteValidation_ValidRequest() {
 	err := req.Validate()
 	assert.NoError(s.T(), err)
 }
+
+func (s *APISuite) TestDeleteSystemRole() {
+	req := &roleV1.DeleteRequest{
+		RoleId: uuid.New().String(),
+	}
+
+	s.roleService.On("Delete", mock.Anything, req.RoleId).Return(model.ErrSystemRoleProtected).Once()
+
+	resp, err := s.api.Delete(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.FailedPrecondition, status.Code(err))
+
+	s.roleService.AssertExpectations(s.T())
+}
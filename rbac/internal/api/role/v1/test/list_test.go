@@ -27,17 +27,20 @@ func (s *APISuite) TestListSuccess() {
 		CreatedAt:   time.Now(),
 	}
 
-	expectedRoles := []*model.Role{role1, role2}
+	expectedRoles := []*model.RoleListItem{{Role: role1, UserCount: 4}, {Role: role2, UserCount: 0}}
 
 	req := &roleV1.ListRequest{}
 
-	s.roleService.On("List", mock.Anything).Return(expectedRoles, nil).Once()
+	s.roleService.On("List", mock.Anything, &model.RoleFilter{Status: model.RoleStatusActive, Limit: 50}).Return(expectedRoles, nil, nil).Once()
 
 	resp, err := s.api.List(s.ctx, req)
 
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), resp)
 	assert.Len(s.T(), resp.Data, 2)
+	assert.Equal(s.T(), int32(50), resp.Limit)
+	assert.False(s.T(), resp.HasMore)
+	assert.Nil(s.T(), resp.NextCursor)
 
 	// Проверяем первую роль
 	assert.Equal(s.T(), role1.ID.String(), resp.Data[0].Id)
@@ -49,14 +52,53 @@ func (s *APISuite) TestListSuccess() {
 	assert.Equal(s.T(), role2.Name, resp.Data[1].Name)
 	assert.Equal(s.T(), role2.Description, resp.Data[1].Description)
 
+	// Количество пользователей по ID роли
+	assert.Equal(s.T(), map[string]int32{role1.ID.String(): 4, role2.ID.String(): 0}, resp.UserCounts)
+
+	s.roleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestListWithFilters() {
+	limit := int32(1)
+	cursor := "cursor123"
+	nameQuery := "teach"
+	permissionID := uuid.New().String()
+	nextCursor := "cursor456"
+
+	req := &roleV1.ListRequest{
+		Limit:         &limit,
+		Cursor:        &cursor,
+		NameQuery:     &nameQuery,
+		PermissionIds: []string{permissionID},
+	}
+
+	expectedFilter := &model.RoleFilter{
+		Status:        model.RoleStatusActive,
+		NameQuery:     nameQuery,
+		PermissionIDs: []string{permissionID},
+		Limit:         limit,
+		Cursor:        cursor,
+	}
+	role := &model.Role{ID: uuid.New(), Name: "teacher", CreatedAt: time.Now()}
+
+	s.roleService.On("List", mock.Anything, expectedFilter).Return([]*model.RoleListItem{{Role: role, UserCount: 12}}, &nextCursor, nil).Once()
+
+	resp, err := s.api.List(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Data, 1)
+	assert.Equal(s.T(), limit, resp.Limit)
+	assert.True(s.T(), resp.HasMore)
+	assert.Equal(s.T(), nextCursor, resp.GetNextCursor())
+	assert.Equal(s.T(), int32(12), resp.UserCounts[role.ID.String()])
+
 	s.roleService.AssertExpectations(s.T())
 }
 
 func (s *APISuite) TestListEmptyResult() {
-	expectedRoles := []*model.Role{}
 	req := &roleV1.ListRequest{}
 
-	s.roleService.On("List", mock.Anything).Return(expectedRoles, nil).Once()
+	s.roleService.On("List", mock.Anything, mock.Anything).Return([]*model.RoleListItem{}, nil, nil).Once()
 
 	resp, err := s.api.List(s.ctx, req)
 
@@ -70,7 +112,7 @@ func (s *APISuite) TestListEmptyResult() {
 func (s *APISuite) TestListInternalError() {
 	req := &roleV1.ListRequest{}
 
-	s.roleService.On("List", mock.Anything).Return(nil, model.ErrInternal).Once()
+	s.roleService.On("List", mock.Anything, mock.Anything).Return(nil, nil, model.ErrInternal).Once()
 
 	resp, err := s.api.List(s.ctx, req)
 
@@ -84,9 +126,52 @@ func (s *APISuite) TestListInternalError() {
 	s.roleService.AssertExpectations(s.T())
 }
 
+func (s *APISuite) TestListInvalidCursor() {
+	cursor := "broken"
+	req := &roleV1.ListRequest{Cursor: &cursor}
+
+	s.roleService.On("List", mock.Anything, mock.Anything).Return(nil, nil, model.ErrInvalidCursor).Once()
+
+	resp, err := s.api.List(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+
+	s.roleService.AssertExpectations(s.T())
+}
+
 func (s *APISuite) TestListValidation_ValidRequest() {
 	req := &roleV1.ListRequest{}
 
 	err := req.Validate()
 	assert.NoError(s.T(), err)
 }
+
+func (s *APISuite) TestListValidation_InvalidPermissionID() {
+	req := &roleV1.ListRequest{PermissionIds: []string{"not-a-uuid"}}
+
+	err := req.Validate()
+	assert.Error(s.T(), err)
+}
+
+func (s *APISuite) TestListDeletedStatus() {
+	deletedAt := time.Now()
+	deleted := &model.Role{
+		ID:        uuid.New(),
+		Name:      "archived",
+		CreatedAt: time.Now(),
+		DeletedAt: &deletedAt,
+	}
+
+	req := &roleV1.ListRequest{Status: roleV1.RoleStatus_ROLE_STATUS_DELETED}
+
+	s.roleService.On("List", mock.Anything, &model.RoleFilter{Status: model.RoleStatusDeleted, Limit: 50}).Return([]*model.RoleListItem{{Role: deleted}}, nil, nil).Once()
+
+	resp, err := s.api.List(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Data, 1)
+	assert.NotNil(s.T(), resp.Data[0].DeletedAt)
+
+	s.roleService.AssertExpectations(s.T())
+}
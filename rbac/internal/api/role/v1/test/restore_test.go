@@ -0,0 +1,57 @@
+package role_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+func (s *APISuite) TestRestoreSuccess() {
+	req := &roleV1.RestoreRequest{
+		RoleId: uuid.New().String(),
+	}
+
+	s.roleService.On("Restore", mock.Anything, req.RoleId).Return(nil).Once()
+
+	resp, err := s.api.Restore(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), resp)
+
+	s.roleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestRestoreNameConflict() {
+	req := &roleV1.RestoreRequest{
+		RoleId: uuid.New().String(),
+	}
+
+	s.roleService.On("Restore", mock.Anything, req.RoleId).Return(model.ErrRoleAlreadyExists).Once()
+
+	resp, err := s.api.Restore(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.AlreadyExists, status.Code(err))
+
+	s.roleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestRestoreNotFound() {
+	req := &roleV1.RestoreRequest{
+		RoleId: uuid.New().String(),
+	}
+
+	s.roleService.On("Restore", mock.Anything, req.RoleId).Return(model.ErrRoleNotFound).Once()
+
+	resp, err := s.api.Restore(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+
+	s.roleService.AssertExpectations(s.T())
+}
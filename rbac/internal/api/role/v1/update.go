@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
 	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
@@ -15,13 +16,13 @@ func (api *API) Update(ctx context.Context, req *roleV1.UpdateRequest) (*emptypb
 	role, err := converter.UpdateRoleToDomain(req)
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка парсинга параметров запроса", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	err = api.roleService.Update(ctx, role)
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка обновления роли", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+)
+
+func (api *API) WarmCache(ctx context.Context, req *roleV1.WarmCacheRequest) (*roleV1.WarmCacheResponse, error) {
+	count, err := api.roleService.WarmCache(ctx, req.RoleIds)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка прогрева кэша ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &roleV1.WarmCacheResponse{
+		Count: int32(count), //nolint:gosec // не превышает количества ролей
+	}, nil
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+var _ roleConstraintV1.RoleConstraintServiceServer = (*API)(nil)
+
+type API struct {
+	roleConstraintV1.UnimplementedRoleConstraintServiceServer
+	roleConstraintService service.RoleConstraintServiceInterface
+}
+
+func NewAPI(roleConstraintService service.RoleConstraintServiceInterface) *API {
+	return &API{
+		roleConstraintService: roleConstraintService,
+	}
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+func (api *API) CreateRoleConstraint(ctx context.Context, req *roleConstraintV1.CreateRoleConstraintRequest) (*roleConstraintV1.CreateRoleConstraintResponse, error) {
+	constraint, violations, err := api.roleConstraintService.Create(ctx, converter.CreateRoleConstraintToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка создания ограничения ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &roleConstraintV1.CreateRoleConstraintResponse{
+		Constraint: converter.RoleConstraintToProto(constraint),
+		Violations: converter.ConstraintViolationsToProto(violations),
+	}, nil
+}
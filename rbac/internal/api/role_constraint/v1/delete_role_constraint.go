@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+func (api *API) DeleteRoleConstraint(ctx context.Context, req *roleConstraintV1.DeleteRoleConstraintRequest) (*emptypb.Empty, error) {
+	if err := api.roleConstraintService.Delete(ctx, req.GetConstraintId()); err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка удаления ограничения ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
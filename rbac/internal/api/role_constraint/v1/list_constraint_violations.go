@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+func (api *API) ListConstraintViolations(ctx context.Context, req *roleConstraintV1.ListConstraintViolationsRequest) (*roleConstraintV1.ListConstraintViolationsResponse, error) {
+	violations, err := api.roleConstraintService.ListViolations(ctx, req.ConstraintId)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения нарушений ограничений ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &roleConstraintV1.ListConstraintViolationsResponse{Violations: converter.ConstraintViolationsToProto(violations)}, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+func (api *API) ListRoleConstraints(ctx context.Context, _ *roleConstraintV1.ListRoleConstraintsRequest) (*roleConstraintV1.ListRoleConstraintsResponse, error) {
+	constraints, err := api.roleConstraintService.List(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения списка ограничений ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &roleConstraintV1.ListRoleConstraintsResponse{Constraints: converter.RoleConstraintsToProto(constraints)}, nil
+}
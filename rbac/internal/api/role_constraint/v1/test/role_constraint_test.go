@@ -0,0 +1,58 @@
+package role_constraint_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+func (s *APISuite) TestCreateRoleConstraint() {
+	roleIDs := []string{uuid.NewString(), uuid.NewString()}
+	userID := uuid.NewString()
+	constraint := &model.RoleConstraint{ID: uuid.New(), Name: "student_teacher", RoleIDs: roleIDs, CreatedAt: time.Now()}
+
+	s.roleConstraintService.On("Create", mock.Anything, &model.CreateRoleConstraint{Name: "student_teacher", RoleIDs: roleIDs}).
+		Return(constraint, []*model.ConstraintViolation{{ConstraintID: constraint.ID.String(), UserID: userID, RoleIDs: roleIDs}}, nil).Once()
+
+	resp, err := s.api.CreateRoleConstraint(s.ctx, &roleConstraintV1.CreateRoleConstraintRequest{Name: "student_teacher", RoleIds: roleIDs})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), constraint.ID.String(), resp.Constraint.Id)
+	assert.Len(s.T(), resp.Violations, 1)
+	assert.Equal(s.T(), userID, resp.Violations[0].UserId)
+}
+
+func (s *APISuite) TestCreateRoleConstraintExists() {
+	s.roleConstraintService.On("Create", mock.Anything, mock.Anything).Return(nil, nil, model.ErrRoleConstraintExists).Once()
+
+	_, err := s.api.CreateRoleConstraint(s.ctx, &roleConstraintV1.CreateRoleConstraintRequest{Name: "student_teacher"})
+
+	assert.Equal(s.T(), codes.AlreadyExists, status.Code(err))
+}
+
+func (s *APISuite) TestListConstraintViolationsFilter() {
+	constraintID := uuid.NewString()
+
+	s.roleConstraintService.On("ListViolations", mock.Anything, &constraintID).Return([]*model.ConstraintViolation{}, nil).Once()
+
+	resp, err := s.api.ListConstraintViolations(s.ctx, &roleConstraintV1.ListConstraintViolationsRequest{ConstraintId: &constraintID})
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), resp.Violations)
+}
+
+func (s *APISuite) TestDeleteRoleConstraintNotFound() {
+	id := uuid.NewString()
+	s.roleConstraintService.On("Delete", mock.Anything, id).Return(model.ErrRoleConstraintNotFound).Once()
+
+	_, err := s.api.DeleteRoleConstraint(s.ctx, &roleConstraintV1.DeleteRoleConstraintRequest{ConstraintId: id})
+
+	assert.Equal(s.T(), codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
+)
+
+func (api *API) EvaluateCondition(ctx context.Context, req *rolePermissionV1.EvaluateConditionRequest) (*rolePermissionV1.EvaluateConditionResponse, error) {
+	attrs := condition.NewAttributes(req.GetSubject().AsMap(), req.GetResource().AsMap(), req.GetRequest().AsMap())
+
+	result, err := api.rolePermissionService.EvaluateCondition(ctx, req.Condition, attrs)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка вычисления условия", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &rolePermissionV1.EvaluateConditionResponse{Result: result}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
 )
@@ -13,7 +14,7 @@ import (
 func (api *API) Revoke(ctx context.Context, req *rolePermissionV1.RevokeRequest) (*emptypb.Empty, error) {
 	if err := api.rolePermissionService.Revoke(ctx, req.RoleId, req.PermissionId); err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка отзыва права у роли", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
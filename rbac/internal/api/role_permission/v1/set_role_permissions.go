@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
+)
+
+func (api *API) SetRolePermissions(ctx context.Context, req *rolePermissionV1.SetRolePermissionsRequest) (*rolePermissionV1.SetRolePermissionsResponse, error) {
+	diff, err := api.rolePermissionService.SetRolePermissions(ctx, req.RoleId, req.PermissionIds)
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка замены набора прав роли", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return converter.RolePermissionsDiffToProto(diff), nil
+}
@@ -6,15 +6,17 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
 )
 
 func (api *API) Assign(ctx context.Context, req *userRoleV1.AssignRequest) (*emptypb.Empty, error) {
-	err := api.userRoleService.Assign(ctx, req.UserId, req.RoleId, req.AssignedBy)
+	err := api.userRoleService.Assign(ctx, converter.AssignUserRoleToDomain(req))
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка назначения роли пользователю", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
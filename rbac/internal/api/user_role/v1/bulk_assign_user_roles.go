@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+func (api *API) BulkAssignUserRoles(ctx context.Context, req *userRoleV1.BulkAssignUserRolesRequest) (*userRoleV1.BulkAssignUserRolesResponse, error) {
+	results, err := api.userRoleService.BulkAssign(ctx, converter.BulkAssignUserRolesToDomain(req))
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка пакетного назначения ролей", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return converter.BulkAssignResultsToProto(results), nil
+}
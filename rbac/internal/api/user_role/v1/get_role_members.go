@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+func (api *API) GetRoleMembers(ctx context.Context, req *userRoleV1.GetRoleMembersRequest) (*userRoleV1.GetRoleMembersResponse, error) {
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = 10
+	}
+
+	members, nextCursor, err := api.userRoleService.GetRoleMembers(ctx, req.RoleId, limit, req.GetCursor())
+	if err != nil {
+		logger.Error(ctx, "❌ [API] Ошибка получения участников роли", zap.Error(err))
+		return nil, apperr.ToStatus(ctx, err)
+	}
+
+	return &userRoleV1.GetRoleMembersResponse{
+		Members:    converter.RoleMembersToProto(members),
+		Limit:      limit,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
 )
@@ -18,7 +19,7 @@ func (api *API) GetRoleUsers(ctx context.Context, req *userRoleV1.GetRoleUsersRe
 	userIDs, nextCursor, err := api.userRoleService.GetRoleUsers(ctx, req.RoleId, limit, req.GetCursor())
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка получения пользователей роли", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &userRoleV1.GetRoleUsersResponse{
@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
@@ -14,7 +15,7 @@ func (api *API) GetUserRoles(ctx context.Context, req *userRoleV1.GetUserRolesRe
 	roles, err := api.userRoleService.GetUserRoles(ctx, req.UserId)
 	if err != nil {
 		logger.Error(ctx, "❌ [API] Ошибка получения ролей пользователя", zap.Error(err))
-		return nil, mapError(err)
+		return nil, apperr.ToStatus(ctx, err)
 	}
 
 	return &userRoleV1.GetUserRolesResponse{
@@ -1,16 +1,21 @@
 package user_role_test
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
 )
 
+// TestAssignSuccess проверяет, что assigned_by из запроса не передается в сервис
 func (s *APISuite) TestAssignSuccess() {
 	userID := "user123"
 	roleID := "role456"
@@ -22,7 +27,7 @@ func (s *APISuite) TestAssignSuccess() {
 		AssignedBy: &assignedBy,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(nil).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -42,7 +47,7 @@ func (s *APISuite) TestAssignSuccessWithoutAssignedBy() {
 		AssignedBy: nil,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, (*string)(nil)).Return(nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(nil).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -63,7 +68,7 @@ func (s *APISuite) TestAssignRoleAlreadyAssigned() {
 		AssignedBy: &assignedBy,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrRoleAlreadyAssigned).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(model.ErrRoleAlreadyAssigned).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -88,7 +93,7 @@ func (s *APISuite) TestAssignUserNotFound() {
 		AssignedBy: &assignedBy,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrRoleNotFound).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(model.ErrRoleNotFound).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -113,7 +118,7 @@ func (s *APISuite) TestAssignRoleNotFound() {
 		AssignedBy: &assignedBy,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrRoleNotFound).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(model.ErrRoleNotFound).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -138,7 +143,7 @@ func (s *APISuite) TestAssignInternalError() {
 		AssignedBy: &assignedBy,
 	}
 
-	s.userRoleService.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrInternal).Once()
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(model.ErrInternal).Once()
 
 	resp, err := s.api.Assign(s.ctx, req)
 
@@ -152,6 +157,65 @@ func (s *APISuite) TestAssignInternalError() {
 	s.userRoleService.AssertExpectations(s.T())
 }
 
+func (s *APISuite) TestAssignWithValidityPeriod() {
+	userID := uuid.New().String()
+	roleID := uuid.New().String()
+	validFrom := time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC)
+	validUntil := validFrom.Add(7 * 24 * time.Hour)
+
+	req := &userRoleV1.AssignRequest{
+		UserId:     userID,
+		RoleId:     roleID,
+		ValidFrom:  timestamppb.New(validFrom),
+		ValidUntil: timestamppb.New(validUntil),
+	}
+
+	expected := &model.AssignUserRole{
+		UserID:     userID,
+		RoleID:     roleID,
+		ValidFrom:  &validFrom,
+		ValidUntil: &validUntil,
+	}
+
+	s.userRoleService.On("Assign", mock.Anything, expected).Return(nil).Once()
+
+	resp, err := s.api.Assign(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), resp)
+
+	s.userRoleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestAssignInvalidValidityPeriod() {
+	userID := uuid.New().String()
+	roleID := uuid.New().String()
+	validUntil := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	req := &userRoleV1.AssignRequest{
+		UserId:     userID,
+		RoleId:     roleID,
+		ValidUntil: timestamppb.New(validUntil),
+	}
+
+	s.userRoleService.On("Assign", mock.Anything, &model.AssignUserRole{
+		UserID:     userID,
+		RoleID:     roleID,
+		ValidUntil: &validUntil,
+	}).Return(model.ErrInvalidValidityPeriod).Once()
+
+	resp, err := s.api.Assign(s.ctx, req)
+
+	assert.Error(s.T(), err)
+	assert.Nil(s.T(), resp)
+
+	grpcErr, ok := status.FromError(err)
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), codes.InvalidArgument, grpcErr.Code())
+
+	s.userRoleService.AssertExpectations(s.T())
+}
+
 func (s *APISuite) TestAssignValidation_InvalidUserID() {
 	req := &userRoleV1.AssignRequest{
 		UserId: "invalid-uuid",
@@ -216,3 +280,31 @@ func (s *APISuite) TestAssignValidation_ValidRequestWithoutAssignedBy() {
 	err := req.Validate()
 	assert.NoError(s.T(), err)
 }
+
+func (s *APISuite) TestAssignSoDViolationDetails() {
+	violation := &model.SoDViolationError{
+		ConstraintID:      uuid.NewString(),
+		ConstraintName:    "student_teacher",
+		RoleID:            uuid.NewString(),
+		ConflictingRoleID: uuid.NewString(),
+	}
+
+	s.userRoleService.On("Assign", mock.Anything, mock.Anything).Return(violation).Once()
+
+	_, err := s.api.Assign(s.ctx, &userRoleV1.AssignRequest{UserId: uuid.NewString(), RoleId: violation.RoleID})
+
+	st := status.Convert(err)
+	assert.Equal(s.T(), codes.FailedPrecondition, st.Code())
+
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = d
+		}
+	}
+	if assert.NotNil(s.T(), info) {
+		assert.Equal(s.T(), "SOD_VIOLATION", info.Reason)
+		assert.Equal(s.T(), violation.ConstraintName, info.Metadata["constraint_name"])
+		assert.Equal(s.T(), violation.ConflictingRoleID, info.Metadata["conflicting_role_id"])
+	}
+}
@@ -0,0 +1,61 @@
+package user_role_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+func (s *APISuite) TestBulkAssignUserRolesSuccess() {
+	roleID := uuid.New().String()
+	firstUser, secondUser := uuid.New().String(), uuid.New().String()
+
+	req := &userRoleV1.BulkAssignUserRolesRequest{
+		Assignments: []*userRoleV1.AssignRequest{
+			{UserId: firstUser, RoleId: roleID},
+			{UserId: secondUser, RoleId: roleID},
+		},
+	}
+
+	s.userRoleService.On("BulkAssign", mock.Anything, mock.MatchedBy(func(assignments []*model.AssignUserRole) bool {
+		return len(assignments) == 2 && assignments[0].UserID == firstUser && assignments[1].UserID == secondUser
+	})).Return([]*model.BulkAssignResult{
+		{Assignment: &model.AssignUserRole{UserID: firstUser, RoleID: roleID}, Status: model.BulkAssignStatusAssigned},
+		{Assignment: &model.AssignUserRole{UserID: secondUser, RoleID: roleID}, Status: model.BulkAssignStatusNotFound},
+	}, nil).Once()
+
+	resp, err := s.api.BulkAssignUserRoles(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(1), resp.AssignedCount)
+	assert.Len(s.T(), resp.Results, 2)
+	assert.Equal(s.T(), userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_ASSIGNED, resp.Results[0].Status)
+	assert.Equal(s.T(), secondUser, resp.Results[1].UserId)
+	assert.Equal(s.T(), userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_NOT_FOUND, resp.Results[1].Status)
+
+	s.userRoleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestBulkAssignUserRolesError() {
+	req := &userRoleV1.BulkAssignUserRolesRequest{
+		Assignments: []*userRoleV1.AssignRequest{{UserId: uuid.New().String(), RoleId: uuid.New().String()}},
+	}
+
+	s.userRoleService.On("BulkAssign", mock.Anything, mock.Anything).Return(nil, model.ErrInternal).Once()
+
+	resp, err := s.api.BulkAssignUserRoles(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.Internal, status.Code(err))
+
+	s.userRoleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestBulkAssignUserRolesValidation() {
+	assert.Error(s.T(), (&userRoleV1.BulkAssignUserRolesRequest{}).Validate())
+}
@@ -0,0 +1,53 @@
+package user_role_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+func (s *APISuite) TestGetRoleMembersSuccess() {
+	roleID := uuid.New().String()
+	nextCursor := "cursor456"
+	members := []*model.RoleMember{
+		{UserID: "user1", Profile: &model.UserProfile{ID: "user1", Login: "ivanov", Email: "ivanov@example.com"}},
+		{UserID: "user2"},
+	}
+
+	req := &userRoleV1.GetRoleMembersRequest{RoleId: roleID}
+
+	s.userRoleService.On("GetRoleMembers", mock.Anything, roleID, int32(10), "").Return(members, &nextCursor, nil).Once()
+
+	resp, err := s.api.GetRoleMembers(s.ctx, req)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), resp.Members, 2)
+	assert.Equal(s.T(), "user1", resp.Members[0].UserId)
+	assert.Equal(s.T(), "ivanov", resp.Members[0].GetLogin())
+	assert.Equal(s.T(), "ivanov@example.com", resp.Members[0].GetEmail())
+	assert.Nil(s.T(), resp.Members[1].Login)
+	assert.Nil(s.T(), resp.Members[1].Email)
+	assert.Equal(s.T(), int32(10), resp.Limit)
+	assert.True(s.T(), resp.HasMore)
+	assert.Equal(s.T(), nextCursor, resp.GetNextCursor())
+
+	s.userRoleService.AssertExpectations(s.T())
+}
+
+func (s *APISuite) TestGetRoleMembersError() {
+	req := &userRoleV1.GetRoleMembersRequest{RoleId: uuid.New().String()}
+
+	s.userRoleService.On("GetRoleMembers", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, model.ErrInternal).Once()
+
+	resp, err := s.api.GetRoleMembers(s.ctx, req)
+
+	assert.Nil(s.T(), resp)
+	assert.Equal(s.T(), codes.Internal, status.Code(err))
+
+	s.userRoleService.AssertExpectations(s.T())
+}
@@ -13,13 +13,21 @@ import (
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/closer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/gateway"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/health"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
 	platformgrpc "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/server"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/metric"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+	auditV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/audit/v1"
 	permissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/permission/v1"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
 	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
 	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
 )
@@ -28,6 +36,7 @@ type App struct {
 	cfg         contracts.Provider
 	diContainer *diContainer
 	grpcServer  *grpc.Server
+	gateway     *gateway.Server
 	listener    net.Listener
 }
 
@@ -51,18 +60,44 @@ func (app *App) Run(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	go func() {
+		if err := app.diContainer.HealthRegistry().Run(ctx); err != nil {
+			errCh <- fmt.Errorf("health registry crashed: %w", err)
+		}
+	}()
+
 	go func() {
 		if err := app.runKafkaConsumer(ctx); err != nil {
 			errCh <- fmt.Errorf("kafka consumer crashed: %w", err)
 		}
 	}()
 
+	go func() {
+		if err := app.runUserRoleExpiry(ctx); err != nil {
+			errCh <- fmt.Errorf("user role expiry crashed: %w", err)
+		}
+	}()
+
+	go func() {
+		if err := app.runAccessRequestExpiry(ctx); err != nil {
+			errCh <- fmt.Errorf("access request expiry crashed: %w", err)
+		}
+	}()
+
 	go func() {
 		if err := app.runGRPCServer(ctx); err != nil {
 			errCh <- fmt.Errorf("gRPC server crashed: %w", err)
 		}
 	}()
 
+	if app.gateway != nil {
+		go func() {
+			if err := app.gateway.Serve(ctx); err != nil {
+				errCh <- fmt.Errorf("http gateway crashed: %w", err)
+			}
+		}()
+	}
+
 	select {
 	case <-ctx.Done():
 		logger.Info(ctx, "Shutdown signal received")
@@ -91,6 +126,36 @@ func (app *App) runKafkaConsumer(ctx context.Context) error {
 	return nil
 }
 
+func (app *App) runUserRoleExpiry(ctx context.Context) error {
+	logger.Info(ctx, "🚀 [Expiry] Запуск фонового отзыва истекших назначений ролей")
+
+	expiryService, err := app.diContainer.UserRoleExpiryService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get user role expiry service: %w", err)
+	}
+
+	if err = expiryService.Run(ctx); err != nil {
+		return fmt.Errorf("failed to run user role expiry: %w", err)
+	}
+
+	return nil
+}
+
+func (app *App) runAccessRequestExpiry(ctx context.Context) error {
+	logger.Info(ctx, "🚀 [Expiry] Запуск фонового закрытия просроченных заявок на доступ")
+
+	expiryService, err := app.diContainer.AccessRequestExpiryService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get access request expiry service: %w", err)
+	}
+
+	if err = expiryService.Run(ctx); err != nil {
+		return fmt.Errorf("failed to run access request expiry: %w", err)
+	}
+
+	return nil
+}
+
 func (app *App) initDeps(ctx context.Context) error {
 	steps := []func(context.Context) error{
 		app.initDI,
@@ -102,6 +167,7 @@ func (app *App) initDeps(ctx context.Context) error {
 		app.initMigrations,
 		app.initListener,
 		app.initGRPCServer,
+		app.initGateway,
 	}
 	for _, step := range steps {
 		if err := step(ctx); err != nil {
@@ -230,14 +296,79 @@ func (app *App) initListener(_ context.Context) error {
 	return nil
 }
 
+// rateLimitInterceptor возвращает интерсептор ограничения частоты запросов.
+// Выключенное ограничение даёт пустой Interceptor, который сервер пропускает
+func (app *App) rateLimitInterceptor(ctx context.Context) (platformgrpc.Interceptor, error) {
+	if !app.cfg.RateLimit().IsEnabled() {
+		return platformgrpc.Interceptor{}, nil
+	}
+
+	limiter, err := app.diContainer.RateLimiter(ctx)
+	if err != nil {
+		return platformgrpc.Interceptor{}, fmt.Errorf("create rate limiter: %w", err)
+	}
+
+	rateLimit := interceptor.NewRateLimitInterceptor(ctx, limiter, app.cfg.RateLimit())
+	return platformgrpc.Interceptor{Unary: rateLimit.Unary(), Stream: rateLimit.Stream()}, nil
+}
+
+// idempotencyInterceptor возвращает интерсептор ключей идемпотентности.
+// Выключенная обработка даёт пустой Interceptor, который сервер пропускает
+func (app *App) idempotencyInterceptor(ctx context.Context) (platformgrpc.Interceptor, error) {
+	if !app.cfg.Idempotency().IsEnabled() {
+		return platformgrpc.Interceptor{}, nil
+	}
+
+	store, err := app.diContainer.IdempotencyStore(ctx)
+	if err != nil {
+		return platformgrpc.Interceptor{}, fmt.Errorf("create idempotency store: %w", err)
+	}
+
+	idempotencyKeys := interceptor.NewIdempotencyInterceptor(store, app.cfg.Idempotency())
+	return platformgrpc.Interceptor{Unary: idempotencyKeys.Unary()}, nil
+}
+
 func (app *App) initGRPCServer(ctx context.Context) error {
-	app.grpcServer = platformgrpc.New(ctx,
+	rateLimitInterceptor, err := app.rateLimitInterceptor(ctx)
+	if err != nil {
+		return err
+	}
+
+	idempotencyInterceptor, err := app.idempotencyInterceptor(ctx)
+	if err != nil {
+		return err
+	}
+
+	interceptors := []platformgrpc.Interceptor{
+		{
+			Unary:  tracing.UnaryServerInterceptor(app.cfg.App().Name()),
+			Stream: tracing.StreamServerInterceptor(app.cfg.App().Name()),
+		},
+		{
+			Unary:  metric.UnaryServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
+			Stream: metric.StreamServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
+		},
+	}
+	// Проверка доступа идет до ограничения частоты и идемпотентности: лимит считается
+	// по подтвержденному пользователю, а сохраненный ответ возвращается только прошедшему авторизацию вызову
+	interceptors = append(interceptors,
+		platformgrpc.AccessInterceptors(
+			interceptor.WithTrustedPeers(app.cfg.GRPC().TrustedPeers()),
+			interceptor.WithServiceTokens(app.cfg.GRPC().ServiceTokens()),
+		)...)
+	interceptors = append(interceptors, rateLimitInterceptor, idempotencyInterceptor)
+
+	grpcServer, err := platformgrpc.New(ctx,
 		app.cfg.GRPC().Timeout(),
 		app.cfg.GRPC().MaxRecvMsgSize(),
 		app.cfg.GRPC().MaxSendMsgSize(),
-		tracing.UnaryServerInterceptor(app.cfg.App().Name()),
-		metric.UnaryServerInterceptor(ctx, app.cfg.Metric().BucketBoundaries()),
+		app.cfg.GRPC().TLS(),
+		interceptors...,
 	)
+	if err != nil {
+		return fmt.Errorf("create grpc server: %w", err)
+	}
+	app.grpcServer = grpcServer
 
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		logger.Info(ctx, "⚡ [Shutdown] Остановка gRPC сервера")
@@ -265,22 +396,108 @@ func (app *App) initGRPCServer(ctx context.Context) error {
 		return fmt.Errorf("create user_role v1 api: %w", err)
 	}
 
+	auditAPI, err := app.diContainer.AuditV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create audit v1 api: %w", err)
+	}
+
+	policyAPI, err := app.diContainer.PolicyV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create policy v1 api: %w", err)
+	}
+
+	accessRequestAPI, err := app.diContainer.AccessRequestV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create access_request v1 api: %w", err)
+	}
+
+	roleConstraintAPI, err := app.diContainer.RoleConstraintV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create role_constraint v1 api: %w", err)
+	}
+
+	accessAPI, err := app.diContainer.AccessV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create access v1 api: %w", err)
+	}
+
+	accessReviewAPI, err := app.diContainer.AccessReviewV1API(ctx)
+	if err != nil {
+		return fmt.Errorf("create access_review v1 api: %w", err)
+	}
+
 	reflection.Register(app.grpcServer)
-	health.RegisterService(app.grpcServer)
+	health.RegisterService(app.grpcServer, app.diContainer.HealthRegistry())
 	roleV1.RegisterRoleServiceServer(app.grpcServer, roleAPI)
 	permissionV1.RegisterPermissionServiceServer(app.grpcServer, permissionAPI)
 	rolePermissionV1.RegisterRolePermissionServiceServer(app.grpcServer, rolePermissionAPI)
 	userRoleV1.RegisterUserRoleServiceServer(app.grpcServer, userRoleAPI)
+	auditV1.RegisterAuditServiceServer(app.grpcServer, auditAPI)
+	policyV1.RegisterPolicyServiceServer(app.grpcServer, policyAPI)
+	accessRequestV1.RegisterAccessRequestServiceServer(app.grpcServer, accessRequestAPI)
+	roleConstraintV1.RegisterRoleConstraintServiceServer(app.grpcServer, roleConstraintAPI)
+	accessV1.RegisterAccessServiceServer(app.grpcServer, accessAPI)
+	accessReviewV1.RegisterAccessReviewServiceServer(app.grpcServer, accessReviewAPI)
 
 	logger.Info(ctx, "✅ [App] Role API инициализирован")
 	logger.Info(ctx, "✅ [App] Permission API инициализирован")
 	logger.Info(ctx, "✅ [App] RolePermission API инициализирован")
 	logger.Info(ctx, "✅ [App] UserRole API инициализирован")
+	logger.Info(ctx, "✅ [App] Audit API инициализирован")
+	logger.Info(ctx, "✅ [App] Policy API инициализирован")
+	logger.Info(ctx, "✅ [App] AccessRequest API инициализирован")
+	logger.Info(ctx, "✅ [App] RoleConstraint API инициализирован")
+	logger.Info(ctx, "✅ [App] Access API инициализирован")
+	logger.Info(ctx, "✅ [App] AccessReview API инициализирован")
 	logger.Info(ctx, "✅ [gRPC] Сервер успешно инициализирован")
 
 	return nil
 }
 
+// initGateway создаёт HTTP/JSON шлюз для работы без Envoy; аутентификация выполняется
+// через External Auth API сервиса IAM, как у Envoy
+func (app *App) initGateway(ctx context.Context) error {
+	if !app.cfg.Gateway().IsEnabled() {
+		return nil
+	}
+
+	authorizer, err := app.diContainer.GatewayAuthorizer(ctx)
+	if err != nil {
+		return fmt.Errorf("create gateway authorizer: %w", err)
+	}
+
+	gw, err := gateway.New(ctx,
+		app.cfg.Gateway(),
+		app.cfg.GRPC(),
+		app.cfg.Metric().BucketBoundaries(),
+		authorizer,
+		gateway.Service{Register: roleV1.RegisterRoleServiceHandler, OpenAPI: roleV1.RoleOpenAPISpec},
+		gateway.Service{Register: rolePermissionV1.RegisterRolePermissionServiceHandler, OpenAPI: rolePermissionV1.RolePermissionOpenAPISpec},
+		gateway.Service{Register: userRoleV1.RegisterUserRoleServiceHandler, OpenAPI: userRoleV1.UserRoleOpenAPISpec},
+		gateway.Service{Register: auditV1.RegisterAuditServiceHandler, OpenAPI: auditV1.AuditOpenAPISpec},
+		gateway.Service{Register: policyV1.RegisterPolicyServiceHandler, OpenAPI: policyV1.PolicyOpenAPISpec},
+		gateway.Service{Register: accessRequestV1.RegisterAccessRequestServiceHandler, OpenAPI: accessRequestV1.AccessRequestOpenAPISpec},
+		gateway.Service{Register: roleConstraintV1.RegisterRoleConstraintServiceHandler, OpenAPI: roleConstraintV1.RoleConstraintOpenAPISpec},
+		gateway.Service{Register: accessV1.RegisterAccessServiceHandler, OpenAPI: accessV1.AccessOpenAPISpec},
+		gateway.Service{Register: accessReviewV1.RegisterAccessReviewServiceHandler, OpenAPI: accessReviewV1.AccessReviewOpenAPISpec},
+	)
+	if err != nil {
+		return fmt.Errorf("create http gateway: %w", err)
+	}
+
+	closer.AddNamed("HTTP gateway", func(ctx context.Context) error {
+		logger.Info(ctx, "🌐 [Shutdown] Остановка HTTP шлюза")
+		ctx, cancel := context.WithTimeout(ctx, app.cfg.Gateway().ShutdownTimeout())
+		defer cancel()
+		return gw.Shutdown(ctx)
+	})
+
+	app.gateway = gw
+	logger.Info(ctx, "✅ [Gateway] HTTP шлюз инициализирован", zap.String("address", app.cfg.Gateway().Address()))
+
+	return nil
+}
+
 func (app *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx,
 		"🚀 [gRPC] RBAC сервис слушает адрес",
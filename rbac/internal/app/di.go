@@ -4,35 +4,74 @@ import (
 	"context"
 	"fmt"
 
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache"
 	cacheBuilder "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/cache/builder"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/closer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/gateway"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/health"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/idempotency"
 	consumerBuilder "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka/consumer"
+	producerBuilder "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka/producer"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/migrator"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/ratelimit"
+	accessAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/access/v1"
+	accessRequestAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/access_request/v1"
+	accessReviewAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/access_review/v1"
+	auditAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/audit/v1"
 	permissionAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/permission/v1"
+	policyAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/policy/v1"
 	roleAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/role/v1"
+	roleConstraintAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/role_constraint/v1"
 	rolePermissionAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/role_permission/v1"
 	userRoleAPI "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/api/user_role/v1"
+	grpcClient "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc"
+	iamV1 "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc/iam"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	accessRequestRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/access_request"
+	accessReviewRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/access_review"
+	auditEventRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/audit_event"
 	enrichedRoleRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/enriched_role"
 	permissionRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/permission"
+	policyRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/policy"
 	roleRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/role"
+	roleConstraintRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/role_constraint"
 	rolePermissionRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/role_permission"
 	userRoleRepo "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/user_role"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+	accessExplainService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_explain"
+	accessRequestService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_request"
+	accessRequestExpiryService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_request_expiry"
+	accessReviewService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_review"
+	auditService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/audit"
+	auditProducerService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/audit_producer"
+	domainEventProducerService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/domain_event_producer"
 	permissionService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/permission"
+	policyService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/policy"
 	roleService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role"
+	roleConstraintService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role_constraint"
 	rolePermissionService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role_permission"
 	userConsumerService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_consumer"
 	userRoleService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role"
+	userRoleExpiryService "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role_expiry"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+	auditV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/audit/v1"
 	permissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/permission/v1"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
 	roleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
 	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
 	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
 )
 
@@ -43,23 +82,50 @@ type diContainer struct {
 	permissionV1     permissionV1.PermissionServiceServer
 	rolePermissionV1 rolePermissionV1.RolePermissionServiceServer
 	userRoleV1       userRoleV1.UserRoleServiceServer
+	auditV1          auditV1.AuditServiceServer
+	policyV1         policyV1.PolicyServiceServer
+	accessRequestV1  accessRequestV1.AccessRequestServiceServer
+	roleConstraintV1 roleConstraintV1.RoleConstraintServiceServer
+	accessV1         accessV1.AccessServiceServer
+	accessReviewV1   accessReviewV1.AccessReviewServiceServer
 
 	roleService           service.RoleServiceInterface
 	permissionService     service.PermissionServiceInterface
 	rolePermissionService service.RolePermissionServiceInterface
 	userRoleService       service.UserRoleServiceInterface
 	userConsumerService   service.UserConsumerService
+	domainEventProducer   service.DomainEventProducerService
+	userRoleExpiryService service.UserRoleExpiryService
+	auditService          service.AuditServiceInterface
+	policyService         service.PolicyServiceInterface
+	accessRequestService  service.AccessRequestServiceInterface
+	accessRequestExpiry   service.AccessRequestExpiryService
+	roleConstraintService service.RoleConstraintServiceInterface
+	accessExplainService  service.AccessExplainServiceInterface
+	accessReviewService   service.AccessReviewServiceInterface
+	auditProducer         service.AuditProducerService
 
 	roleRepository           repository.RoleRepository
 	permissionRepository     repository.PermissionRepository
 	userRoleRepository       repository.UserRoleRepository
 	rolePermissionRepository repository.RolePermissionRepository
 	enrichedRoleRepository   repository.EnrichedRoleRepository
+	auditEventRepository     repository.AuditEventRepository
+	policyRepository         repository.PolicyRepository
+	accessRequestRepository  repository.AccessRequestRepository
+	roleConstraintRepository repository.RoleConstraintRepository
+	accessReviewRepository   repository.AccessReviewRepository
 
 	postgresWritePool *pgxpool.Pool
 	postgresReadPool  *pgxpool.Pool
 	redisClient       cache.RedisClient
+	rateLimiter       ratelimit.Limiter
+	idempotencyStore  idempotency.Store
+	healthRegistry    *health.Registry
 	migrator          *migrator.Migrator
+
+	iamConn   *grpc.ClientConn
+	iamClient grpcClient.IAMClient
 }
 
 func NewDiContainer(cfg contracts.Provider) *diContainer {
@@ -105,6 +171,84 @@ func (d *diContainer) RolePermissionV1API(ctx context.Context) (rolePermissionV1
 	return d.rolePermissionV1, nil
 }
 
+func (d *diContainer) AuditV1API(ctx context.Context) (auditV1.AuditServiceServer, error) {
+	if d.auditV1 == nil {
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.auditV1 = auditAPI.NewAPI(auditService)
+	}
+
+	return d.auditV1, nil
+}
+
+func (d *diContainer) PolicyV1API(ctx context.Context) (policyV1.PolicyServiceServer, error) {
+	if d.policyV1 == nil {
+		policyService, err := d.PolicyService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.policyV1 = policyAPI.NewAPI(policyService)
+	}
+
+	return d.policyV1, nil
+}
+
+func (d *diContainer) AccessRequestV1API(ctx context.Context) (accessRequestV1.AccessRequestServiceServer, error) {
+	if d.accessRequestV1 == nil {
+		accessRequestService, err := d.AccessRequestService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessRequestV1 = accessRequestAPI.NewAPI(accessRequestService)
+	}
+
+	return d.accessRequestV1, nil
+}
+
+func (d *diContainer) RoleConstraintV1API(ctx context.Context) (roleConstraintV1.RoleConstraintServiceServer, error) {
+	if d.roleConstraintV1 == nil {
+		roleConstraintService, err := d.RoleConstraintService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.roleConstraintV1 = roleConstraintAPI.NewAPI(roleConstraintService)
+	}
+
+	return d.roleConstraintV1, nil
+}
+
+func (d *diContainer) AccessV1API(ctx context.Context) (accessV1.AccessServiceServer, error) {
+	if d.accessV1 == nil {
+		accessExplainService, err := d.AccessExplainService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessV1 = accessAPI.NewAPI(accessExplainService)
+	}
+
+	return d.accessV1, nil
+}
+
+func (d *diContainer) AccessReviewV1API(ctx context.Context) (accessReviewV1.AccessReviewServiceServer, error) {
+	if d.accessReviewV1 == nil {
+		accessReviewService, err := d.AccessReviewService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessReviewV1 = accessReviewAPI.NewAPI(accessReviewService)
+	}
+
+	return d.accessReviewV1, nil
+}
+
 func (d *diContainer) UserRoleV1API(ctx context.Context) (userRoleV1.UserRoleServiceServer, error) {
 	if d.userRoleV1 == nil {
 		userRoleService, err := d.UserRoleService(ctx)
@@ -135,9 +279,19 @@ func (d *diContainer) RoleService(ctx context.Context) (service.RoleServiceInter
 			return nil, err
 		}
 
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
 		enrichedRoleTTL := d.cfg.Session().TTL()
 
-		d.roleService = roleService.NewService(roleRepo, rolePermissionRepo, enrichedRoleRepo, enrichedRoleTTL)
+		d.roleService = roleService.NewService(roleRepo, rolePermissionRepo, enrichedRoleRepo, enrichedRoleTTL, auditService, eventProducer)
 	}
 
 	return d.roleService, nil
@@ -163,12 +317,174 @@ func (d *diContainer) RolePermissionService(ctx context.Context) (service.RolePe
 			return nil, err
 		}
 
-		d.rolePermissionService = rolePermissionService.NewService(rolePermissionRepo)
+		enrichedRoleRepo, err := d.EnrichedRoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		d.rolePermissionService = rolePermissionService.NewService(rolePermissionRepo, enrichedRoleRepo, auditService, eventProducer)
 	}
 
 	return d.rolePermissionService, nil
 }
 
+func (d *diContainer) PolicyService(ctx context.Context) (service.PolicyServiceInterface, error) {
+	if d.policyService == nil {
+		policyRepo, err := d.PolicyRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		enrichedRoleRepo, err := d.EnrichedRoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		d.policyService = policyService.NewService(policyRepo, enrichedRoleRepo, auditService, eventProducer)
+	}
+
+	return d.policyService, nil
+}
+
+func (d *diContainer) AccessRequestService(ctx context.Context) (service.AccessRequestServiceInterface, error) {
+	if d.accessRequestService == nil {
+		accessRequestRepo, err := d.AccessRequestRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		roleRepo, err := d.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		userRoleRepo, err := d.UserRoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		userRoleService, err := d.UserRoleService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		d.accessRequestService = accessRequestService.NewService(
+			accessRequestRepo, roleRepo, userRoleRepo, userRoleService, auditService, eventProducer)
+	}
+
+	return d.accessRequestService, nil
+}
+
+func (d *diContainer) RoleConstraintService(ctx context.Context) (service.RoleConstraintServiceInterface, error) {
+	if d.roleConstraintService == nil {
+		roleConstraintRepo, err := d.RoleConstraintRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		d.roleConstraintService = roleConstraintService.NewService(roleConstraintRepo, auditService, eventProducer)
+	}
+
+	return d.roleConstraintService, nil
+}
+
+func (d *diContainer) AccessExplainService(ctx context.Context) (service.AccessExplainServiceInterface, error) {
+	if d.accessExplainService == nil {
+		userRoleService, err := d.UserRoleService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		roleService, err := d.RoleService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		roleConstraintRepo, err := d.RoleConstraintRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessExplainService = accessExplainService.NewService(userRoleService, roleService, roleConstraintRepo)
+	}
+
+	return d.accessExplainService, nil
+}
+
+func (d *diContainer) AccessReviewService(ctx context.Context) (service.AccessReviewServiceInterface, error) {
+	if d.accessReviewService == nil {
+		accessReviewRepo, err := d.AccessReviewRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		roleRepo, err := d.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		userRoleService, err := d.UserRoleService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		d.accessReviewService = accessReviewService.NewService(
+			accessReviewRepo, roleRepo, userRoleService, auditService, eventProducer)
+	}
+
+	return d.accessReviewService, nil
+}
+
 func (d *diContainer) UserRoleService(ctx context.Context) (service.UserRoleServiceInterface, error) {
 	if d.userRoleService == nil {
 		userRoleRepo, err := d.UserRoleRepository(ctx)
@@ -176,17 +492,57 @@ func (d *diContainer) UserRoleService(ctx context.Context) (service.UserRoleServ
 			return nil, err
 		}
 
-		roleService, err := d.RoleService(ctx)
+		enrichedRoleRepo, err := d.EnrichedRoleRepository(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		d.userRoleService = userRoleService.NewService(userRoleRepo, roleService)
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		iamClient, err := d.IAMClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get iam client: %w", err)
+		}
+
+		roleRepo, err := d.RoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		enrichedRoleTTL := d.cfg.Session().TTL()
+
+		d.userRoleService = userRoleService.NewService(userRoleRepo, roleRepo, enrichedRoleRepo, enrichedRoleTTL, auditService, eventProducer, iamClient)
 	}
 
 	return d.userRoleService, nil
 }
 
+func (d *diContainer) AuditService(ctx context.Context) (service.AuditServiceInterface, error) {
+	if d.auditService == nil {
+		auditEventRepo, err := d.AuditEventRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		auditProducer, err := d.AuditProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get audit producer service: %w", err)
+		}
+
+		d.auditService = auditService.NewService(auditEventRepo, auditProducer)
+	}
+
+	return d.auditService, nil
+}
+
 func (d *diContainer) RoleRepository(ctx context.Context) (repository.RoleRepository, error) {
 	if d.roleRepository == nil {
 		writePool, err := d.PostgresWritePool(ctx)
@@ -254,6 +610,96 @@ func (d *diContainer) RolePermissionRepository(ctx context.Context) (repository.
 	return d.rolePermissionRepository, nil
 }
 
+func (d *diContainer) PolicyRepository(ctx context.Context) (repository.PolicyRepository, error) {
+	if d.policyRepository == nil {
+		writePool, err := d.PostgresWritePool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		readPool, err := d.PostgresReadPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.policyRepository = policyRepo.NewRepository(writePool, readPool)
+	}
+
+	return d.policyRepository, nil
+}
+
+func (d *diContainer) AccessRequestRepository(ctx context.Context) (repository.AccessRequestRepository, error) {
+	if d.accessRequestRepository == nil {
+		writePool, err := d.PostgresWritePool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		readPool, err := d.PostgresReadPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessRequestRepository = accessRequestRepo.NewRepository(writePool, readPool)
+	}
+
+	return d.accessRequestRepository, nil
+}
+
+func (d *diContainer) RoleConstraintRepository(ctx context.Context) (repository.RoleConstraintRepository, error) {
+	if d.roleConstraintRepository == nil {
+		writePool, err := d.PostgresWritePool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		readPool, err := d.PostgresReadPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.roleConstraintRepository = roleConstraintRepo.NewRepository(writePool, readPool)
+	}
+
+	return d.roleConstraintRepository, nil
+}
+
+func (d *diContainer) AccessReviewRepository(ctx context.Context) (repository.AccessReviewRepository, error) {
+	if d.accessReviewRepository == nil {
+		writePool, err := d.PostgresWritePool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		readPool, err := d.PostgresReadPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessReviewRepository = accessReviewRepo.NewRepository(writePool, readPool)
+	}
+
+	return d.accessReviewRepository, nil
+}
+
+func (d *diContainer) AuditEventRepository(ctx context.Context) (repository.AuditEventRepository, error) {
+	if d.auditEventRepository == nil {
+		writePool, err := d.PostgresWritePool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		readPool, err := d.PostgresReadPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.auditEventRepository = auditEventRepo.NewRepository(writePool, readPool)
+	}
+
+	return d.auditEventRepository, nil
+}
+
 func (d *diContainer) EnrichedRoleRepository(ctx context.Context) (repository.EnrichedRoleRepository, error) {
 	if d.enrichedRoleRepository == nil {
 		redisClient, err := d.RedisClient(ctx)
@@ -290,6 +736,8 @@ func (d *diContainer) PostgresWritePool(ctx context.Context) (*pgxpool.Pool, err
 			return nil
 		})
 
+		d.HealthRegistry().Register("postgres_write", health.Readiness, health.PingProbe(pool))
+
 		logger.Info(ctx, "✅ [Database] PostgreSQL write pool создан")
 		d.postgresWritePool = pool
 	}
@@ -325,6 +773,8 @@ func (d *diContainer) PostgresReadPool(ctx context.Context) (*pgxpool.Pool, erro
 			return nil
 		})
 
+		d.HealthRegistry().Register("postgres_read", health.Readiness, health.PingProbe(pool))
+
 		logger.Info(ctx, "✅ [Database] PostgreSQL read pool создан")
 		d.postgresReadPool = pool
 	}
@@ -336,6 +786,21 @@ func (d *diContainer) PostgresPool(ctx context.Context) (*pgxpool.Pool, error) {
 	return d.PostgresWritePool(ctx)
 }
 
+func (d *diContainer) HealthRegistry() *health.Registry {
+	if d.healthRegistry == nil {
+		registry := health.NewRegistry()
+
+		closer.OnShutdown(func(ctx context.Context) {
+			logger.Info(ctx, "🩺 [Shutdown] Перевод readiness в NOT_SERVING")
+			registry.Shutdown()
+		})
+
+		d.healthRegistry = registry
+	}
+
+	return d.healthRegistry
+}
+
 func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error) {
 	if d.redisClient == nil {
 		redisBuilder := cacheBuilder.NewRedisBuilder(d.cfg.Redis())
@@ -353,6 +818,8 @@ func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error
 			return nil // Redis client закрывается автоматически
 		})
 
+		d.HealthRegistry().Register("redis", health.Readiness, health.PingProbe(client))
+
 		logger.Info(ctx, "✅ [Cache] Redis клиент создан")
 		d.redisClient = client
 	}
@@ -360,6 +827,48 @@ func (d *diContainer) RedisClient(ctx context.Context) (cache.RedisClient, error
 	return d.redisClient, nil
 }
 
+// RateLimiter возвращает хранилище лимитов частоты: Redis при включённом кластере,
+// иначе лимиты считаются в памяти каждой реплики
+func (d *diContainer) RateLimiter(ctx context.Context) (ratelimit.Limiter, error) {
+	if d.rateLimiter == nil {
+		if !d.cfg.Redis().Cluster().IsEnabled() {
+			logger.Warn(ctx, "⚠️ [RateLimit] Redis выключен, лимиты считаются в памяти процесса")
+			d.rateLimiter = ratelimit.NewMemoryLimiter()
+			return d.rateLimiter, nil
+		}
+
+		client, err := d.RedisClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.rateLimiter = ratelimit.NewRedisLimiter(client, d.cfg.RateLimit().KeyPrefix())
+	}
+
+	return d.rateLimiter, nil
+}
+
+// IdempotencyStore возвращает хранилище ключей идемпотентности: Redis при включённом кластере,
+// иначе записи хранятся в памяти каждой реплики
+func (d *diContainer) IdempotencyStore(ctx context.Context) (idempotency.Store, error) {
+	if d.idempotencyStore == nil {
+		if !d.cfg.Redis().Cluster().IsEnabled() {
+			logger.Warn(ctx, "⚠️ [Idempotency] Redis выключен, ключи идемпотентности хранятся в памяти процесса")
+			d.idempotencyStore = idempotency.NewMemoryStore()
+			return d.idempotencyStore, nil
+		}
+
+		client, err := d.RedisClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.idempotencyStore = idempotency.NewRedisStore(client, d.cfg.Idempotency().KeyPrefix())
+	}
+
+	return d.idempotencyStore, nil
+}
+
 func (d *diContainer) RunMigrations(ctx context.Context) error {
 	if d.migrator != nil {
 		return nil
@@ -403,9 +912,15 @@ func (d *diContainer) UserConsumerService(ctx context.Context) (service.UserCons
 			return nil, fmt.Errorf("get user role service: %w", err)
 		}
 
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get audit service: %w", err)
+		}
+
 		d.userConsumerService = userConsumerService.NewService(
 			userCreatedConsumer,
 			userRoleService,
+			auditService,
 		)
 
 		closer.AddNamed("Kafka user_created consumer", func(ctx context.Context) error {
@@ -413,8 +928,186 @@ func (d *diContainer) UserConsumerService(ctx context.Context) (service.UserCons
 			return nil // Consumer закрывается автоматически
 		})
 
+		d.HealthRegistry().Register("kafka_user_created_consumer", health.Readiness, health.DialProbe(d.cfg.Kafka().Brokers()))
+
 		logger.Info(ctx, "✅ [Kafka] UserCreated consumer создан")
 	}
 
 	return d.userConsumerService, nil
 }
+
+func (d *diContainer) DomainEventProducerService(ctx context.Context) (service.DomainEventProducerService, error) {
+	if d.domainEventProducer == nil {
+		if !d.cfg.Kafka().IsEnabled() {
+			logger.Info(ctx, "⚠️ [Kafka] Kafka отключен, создаем no-op producer")
+			d.domainEventProducer = domainEventProducerService.NewNoOpService()
+			return d.domainEventProducer, nil
+		}
+
+		builder := producerBuilder.NewBuilder(d.cfg.Kafka())
+		builder.WithLogger(logger.Logger())
+		rbacEventsProducer, err := builder.BuildProducer("rbac_events")
+		if err != nil {
+			return nil, fmt.Errorf("failed to build rbac_events producer: %w", err)
+		}
+
+		d.domainEventProducer = domainEventProducerService.NewService(rbacEventsProducer)
+
+		closer.AddNamed("Kafka rbac_events producer", func(ctx context.Context) error {
+			logger.Info(ctx, "📤 [Shutdown] Закрытие Kafka rbac_events producer")
+			return rbacEventsProducer.Close()
+		})
+
+		d.HealthRegistry().Register("kafka_rbac_events_producer", health.Readiness, health.DialProbe(d.cfg.Kafka().Brokers()))
+
+		logger.Info(ctx, "✅ [Kafka] Domain events producer создан")
+	}
+
+	return d.domainEventProducer, nil
+}
+
+func (d *diContainer) UserRoleExpiryService(ctx context.Context) (service.UserRoleExpiryService, error) {
+	if d.userRoleExpiryService == nil {
+		userRoleRepo, err := d.UserRoleRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.userRoleExpiryService = userRoleExpiryService.NewService(userRoleRepo, eventProducer, auditService)
+	}
+
+	return d.userRoleExpiryService, nil
+}
+
+func (d *diContainer) AccessRequestExpiryService(ctx context.Context) (service.AccessRequestExpiryService, error) {
+	if d.accessRequestExpiry == nil {
+		accessRequestRepo, err := d.AccessRequestRepository(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		eventProducer, err := d.DomainEventProducerService(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get domain event producer service: %w", err)
+		}
+
+		auditService, err := d.AuditService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.accessRequestExpiry = accessRequestExpiryService.NewService(accessRequestRepo, eventProducer, auditService)
+	}
+
+	return d.accessRequestExpiry, nil
+}
+
+func (d *diContainer) AuditProducerService(ctx context.Context) (service.AuditProducerService, error) {
+	if d.auditProducer == nil {
+		if !d.cfg.Kafka().IsEnabled() {
+			logger.Info(ctx, "⚠️ [Kafka] Kafka отключен, создаем no-op producer")
+			d.auditProducer = auditProducerService.NewNoOpService()
+			return d.auditProducer, nil
+		}
+
+		builder := producerBuilder.NewBuilder(d.cfg.Kafka())
+		builder.WithLogger(logger.Logger())
+		auditEventsProducer, err := builder.BuildProducer("audit_events")
+		if err != nil {
+			return nil, fmt.Errorf("failed to build audit_events producer: %w", err)
+		}
+
+		d.auditProducer = auditProducerService.NewService(auditEventsProducer)
+
+		closer.AddNamed("Kafka audit_events producer", func(ctx context.Context) error {
+			logger.Info(ctx, "📤 [Shutdown] Закрытие Kafka audit_events producer")
+			return auditEventsProducer.Close()
+		})
+
+		d.HealthRegistry().Register("kafka_audit_events_producer", health.Readiness, health.DialProbe(d.cfg.Kafka().Brokers()))
+
+		logger.Info(ctx, "✅ [Kafka] AuditEvent producer создан")
+	}
+
+	return d.auditProducer, nil
+}
+
+// IAMConn возвращает общее gRPC-соединение с IAM для клиента пользователей и ext-auth
+func (d *diContainer) IAMConn(ctx context.Context) (*grpc.ClientConn, error) {
+	if d.iamConn == nil {
+		conn, addr, err := d.dialServiceConn(ctx, "iam")
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial iam service: %w", err)
+		}
+
+		d.iamConn = conn
+
+		closer.AddNamed("gRPC IAM conn", func(ctx context.Context) error {
+			logger.Info(ctx, "🔐 [Shutdown] Закрытие gRPC IAM соединения")
+			return conn.Close()
+		})
+
+		d.HealthRegistry().Register("grpc_iam", health.Readiness, health.ClientConnProbe(conn))
+
+		logger.Info(ctx, "✅ [gRPC] Подключение к IAM установлено", zap.String("address", addr))
+	}
+
+	return d.iamConn, nil
+}
+
+func (d *diContainer) IAMClient(ctx context.Context) (grpcClient.IAMClient, error) {
+	if d.iamClient == nil {
+		conn, err := d.IAMConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.iamClient = iamV1.NewClient(userV1.NewUserServiceClient(conn))
+	}
+
+	return d.iamClient, nil
+}
+
+// GatewayAuthorizer возвращает ext-auth IAM для HTTP шлюза (та же проверка, что у Envoy)
+func (d *diContainer) GatewayAuthorizer(ctx context.Context) (gateway.Authorizer, error) {
+	conn, err := d.IAMConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return gateway.AuthorizerFromClient(authv3.NewAuthorizationClient(conn)), nil
+}
+
+func (d *diContainer) dialServiceConn(ctx context.Context, serviceName string) (*grpc.ClientConn, string, error) {
+	svc, ok := d.cfg.Services().Get(serviceName)
+	if !ok {
+		logger.Error(ctx, "❌ [Config] Сервис не настроен", zap.String("service", serviceName))
+		return nil, "", fmt.Errorf("%s service not configured", serviceName)
+	}
+
+	addr := svc.Address()
+	limits := d.cfg.GRPC()
+
+	extra := append(grpcclient.ResilienceInterceptors(ctx, serviceName, svc),
+		grpcclient.ServiceTokenUnaryClientInterceptor(svc.Token()))
+
+	conn, err := grpcclient.NewClient(addr, svc.TLS(), limits.MaxRecvMsgSize(), limits.MaxSendMsgSize(), limits.Timeout(),
+		extra...,
+	)
+	if err != nil {
+		logger.Error(ctx, "❌ [gRPC] Не удалось подключиться к сервису", zap.String("service", serviceName), zap.String("address", addr), zap.Error(err))
+		return nil, "", fmt.Errorf("connect to %s failed: %w", serviceName, err)
+	}
+
+	return conn, addr, nil
+}
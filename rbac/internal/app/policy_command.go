@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/config/contracts"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+const (
+	// PolicyCommand имя подкоманды бинарника для работы с политикой как кодом
+	PolicyCommand = "policy"
+	// policyActorName имя системного инициатора в журнале аудита при запуске из CLI
+	policyActorName = "rbac_policy_cli"
+)
+
+const policyUsage = `Использование:
+  rbac policy export [-format yaml|json] [-o файл]
+  rbac policy plan   -f файл [-format yaml|json] [-prune]
+  rbac policy apply  -f файл [-format yaml|json] [-prune]`
+
+// RunPolicyCommand выполняет подкоманду policy: выгрузку, расчет плана или применение документа.
+// Работает напрямую с базой и кэшем сервиса без запуска gRPC сервера.
+func RunPolicyCommand(ctx context.Context, cfg contracts.Provider, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(policyUsage)
+	}
+
+	action := args[0]
+	flags := flag.NewFlagSet(PolicyCommand+" "+action, flag.ContinueOnError)
+	flags.SetOutput(out)
+	file := flags.String("f", "", "файл документа политики")
+	output := flags.String("o", "", "файл для выгрузки (по умолчанию stdout)")
+	formatName := flags.String("format", "", "формат документа: yaml или json (по умолчанию по расширению файла)")
+	prune := flags.Bool("prune", false, "удалить роли, отсутствующие в документе (кроме системных)")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	format, err := policyFormat(*formatName, *file+*output)
+	if err != nil {
+		return err
+	}
+
+	policyService, err := NewDiContainer(cfg).PolicyService(ctx)
+	if err != nil {
+		return fmt.Errorf("create policy service: %w", err)
+	}
+
+	ctx = audit.WithSystemActor(ctx, policyActorName)
+
+	switch action {
+	case "export":
+		document, err := policyService.Export(ctx)
+		if err != nil {
+			return err
+		}
+		data, err := converter.EncodePolicyDocument(document, format)
+		if err != nil {
+			return err
+		}
+		if *output == "" {
+			_, err = out.Write(data)
+			return err
+		}
+		return os.WriteFile(*output, data, 0o644)
+
+	case "plan", "apply":
+		if *file == "" {
+			return errors.New(policyUsage)
+		}
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			return err
+		}
+		document, err := converter.DecodePolicyDocument(data, format)
+		if err != nil {
+			return err
+		}
+
+		var plan *model.PolicyPlan
+		if action == "plan" {
+			plan, err = policyService.Plan(ctx, document, *prune)
+		} else {
+			plan, err = policyService.Apply(ctx, document, *prune)
+		}
+		if err != nil {
+			return err
+		}
+		return printPolicyPlan(out, plan, action == "apply")
+
+	default:
+		return errors.New(policyUsage)
+	}
+}
+
+// policyFormat выбирает формат явно или по расширению файла
+func policyFormat(name, file string) (model.PolicyFormat, error) {
+	if name != "" {
+		return converter.PolicyFormatFromString(name)
+	}
+	if filepath.Ext(file) == ".json" {
+		return model.PolicyFormatJSON, nil
+	}
+	return model.PolicyFormatYAML, nil
+}
+
+func printPolicyPlan(out io.Writer, plan *model.PolicyPlan, applied bool) error {
+	if plan.Empty() {
+		_, err := fmt.Fprintln(out, "Изменений нет")
+		return err
+	}
+
+	for _, change := range plan.Changes {
+		if _, err := fmt.Fprintln(out, formatPolicyChange(change)); err != nil {
+			return err
+		}
+	}
+
+	verb := "Запланировано"
+	if applied {
+		verb = "Применено"
+	}
+	_, err := fmt.Fprintf(out, "%s изменений: %d\n", verb, len(plan.Changes))
+	return err
+}
+
+func formatPolicyChange(change *model.PolicyChange) string {
+	grant := func(sign string) string {
+		line := fmt.Sprintf("%s grant %s -> %s (%s)", sign, change.Role, change.Permission, change.Effect)
+		if change.Condition != "" {
+			line += " if " + change.Condition
+		}
+		return line
+	}
+
+	switch change.Type {
+	case model.PolicyChangeCreatePermission:
+		return "+ permission " + change.Permission
+	case model.PolicyChangeCreateRole:
+		return "+ role " + change.Role
+	case model.PolicyChangeUpdateRole:
+		return fmt.Sprintf("~ role %s: description %q, requires_approval %t, approver %q",
+			change.Role, change.Description, change.RequiresApproval, change.Approver)
+	case model.PolicyChangeDeleteRole:
+		return "- role " + change.Role
+	case model.PolicyChangeAddGrant:
+		return grant("+")
+	case model.PolicyChangeUpdateGrant:
+		return grant("~")
+	case model.PolicyChangeRemoveGrant:
+		return grant("-")
+	default:
+		return string(change.Type)
+	}
+}
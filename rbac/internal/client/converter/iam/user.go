@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+// UserToDomain конвертирует protobuf User в профиль пользователя
+func UserToDomain(u *commonV1.User) *model.UserProfile {
+	if u == nil {
+		return nil
+	}
+
+	return &model.UserProfile{
+		ID:    u.GetId(),
+		Login: u.GetInfo().GetLogin(),
+		Email: u.GetInfo().GetEmail(),
+	}
+}
+
+// UsersToDomain конвертирует список protobuf User в профили пользователей
+func UsersToDomain(users []*commonV1.User) []*model.UserProfile {
+	result := make([]*model.UserProfile, 0, len(users))
+	for _, u := range users {
+		if profile := UserToDomain(u); profile != nil {
+			result = append(result, profile)
+		}
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+type IAMClient interface {
+	// GetUsers возвращает профили найденных пользователей; отсутствующие в IAM ID пропускаются
+	GetUsers(ctx context.Context, userIDs []string) ([]*model.UserProfile, error)
+}
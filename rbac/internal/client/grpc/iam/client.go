@@ -0,0 +1,21 @@
+package iam
+
+import (
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+var _ def.IAMClient = (*client)(nil)
+
+// batchSize — максимальное число ID в одном запросе GetUsers
+const batchSize = 100
+
+type client struct {
+	generatedClient userV1.UserServiceClient
+}
+
+func NewClient(generatedClient userV1.UserServiceClient) *client {
+	return &client{
+		generatedClient: generatedClient,
+	}
+}
@@ -0,0 +1,28 @@
+package iam
+
+import (
+	"context"
+
+	converter "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/converter/iam"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+func (c *client) GetUsers(ctx context.Context, userIDs []string) ([]*model.UserProfile, error) {
+	result := make([]*model.UserProfile, 0, len(userIDs))
+
+	for start := 0; start < len(userIDs); start += batchSize {
+		end := min(start+batchSize, len(userIDs))
+
+		res, err := c.generatedClient.GetUsers(ctx, &userV1.GetUsersRequest{
+			UserIds: userIDs[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, converter.UsersToDomain(res.Users)...)
+	}
+
+	return result, nil
+}
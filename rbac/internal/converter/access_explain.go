@@ -0,0 +1,80 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access/v1"
+)
+
+// AccessCheckToDomain преобразует protobuf проверку права в доменную модель.
+// Атрибуты заполняются, только если передан хотя бы один набор.
+func AccessCheckToDomain(check *accessV1.AccessCheck) *model.AccessCheck {
+	result := &model.AccessCheck{
+		UserID:     check.GetUserId(),
+		Permission: check.GetPermission(),
+	}
+	if check.Subject != nil || check.Resource != nil || check.Request != nil {
+		result.Attributes = condition.NewAttributes(check.GetSubject().AsMap(), check.GetResource().AsMap(), check.GetRequest().AsMap())
+	}
+	return result
+}
+
+// RoleChangesToDomain преобразует protobuf запрос моделирования в изменения ролей
+func RoleChangesToDomain(req *accessV1.SimulateRequest) *model.RoleChanges {
+	return &model.RoleChanges{
+		AddRoleIDs:    req.GetAddRoleIds(),
+		RemoveRoleIDs: req.GetRemoveRoleIds(),
+	}
+}
+
+// AccessExplanationToProto преобразует объяснение решения в protobuf
+func AccessExplanationToProto(explanation *model.AccessExplanation) *accessV1.Explanation {
+	roles := make([]*accessV1.RoleRef, 0, len(explanation.Roles))
+	for _, role := range explanation.Roles {
+		roles = append(roles, &accessV1.RoleRef{Id: role.ID.String(), Name: role.Name})
+	}
+
+	grants := make([]*accessV1.GrantTrace, 0, len(explanation.Grants))
+	for _, grant := range explanation.Grants {
+		trace := &accessV1.GrantTrace{
+			RoleId:     grant.RoleID,
+			RoleName:   grant.RoleName,
+			Permission: authz.Permission{Resource: grant.Permission.Resource, Action: grant.Permission.Action}.Key(),
+			Effect:     authz.EffectToProto(grant.Permission.Effect),
+			Applied:    grant.Applied,
+		}
+		if grant.Permission.Condition != "" {
+			trace.Condition = &grant.Permission.Condition
+		}
+		grants = append(grants, trace)
+	}
+
+	return &accessV1.Explanation{
+		Decision:   decisionToProto(explanation.Decision),
+		Permission: explanation.Permission,
+		Roles:      roles,
+		Grants:     grants,
+		Reason:     explanation.Reason,
+	}
+}
+
+// AccessSimulationToProto преобразует результат моделирования в protobuf
+func AccessSimulationToProto(simulation *model.AccessSimulation) *accessV1.SimulateResponse {
+	return &accessV1.SimulateResponse{
+		Current:             AccessExplanationToProto(simulation.Current),
+		Simulated:           AccessExplanationToProto(simulation.Simulated),
+		ViolatedConstraints: simulation.ViolatedConstraints,
+	}
+}
+
+func decisionToProto(decision authz.Decision) accessV1.AccessDecision {
+	switch decision {
+	case authz.DecisionAllow:
+		return accessV1.AccessDecision_ACCESS_DECISION_ALLOW
+	case authz.DecisionDeny:
+		return accessV1.AccessDecision_ACCESS_DECISION_DENY
+	default:
+		return accessV1.AccessDecision_ACCESS_DECISION_NOT_APPLICABLE
+	}
+}
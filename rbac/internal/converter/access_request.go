@@ -0,0 +1,88 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessRequestV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1"
+)
+
+var accessRequestStatusToProto = map[model.AccessRequestStatus]accessRequestV1.AccessRequestStatus{
+	model.AccessRequestStatusPending:  accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_PENDING,
+	model.AccessRequestStatusApproved: accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_APPROVED,
+	model.AccessRequestStatusRejected: accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_REJECTED,
+	model.AccessRequestStatusExpired:  accessRequestV1.AccessRequestStatus_ACCESS_REQUEST_STATUS_EXPIRED,
+}
+
+// CreateAccessRequestToDomain преобразует protobuf запрос в данные новой заявки
+func CreateAccessRequestToDomain(req *accessRequestV1.CreateAccessRequestRequest) *model.CreateAccessRequest {
+	request := &model.CreateAccessRequest{
+		UserID:        req.GetUserId(),
+		RoleID:        req.GetRoleId(),
+		Justification: req.GetJustification(),
+	}
+
+	if req.ValidUntil != nil {
+		validUntil := req.ValidUntil.AsTime()
+		request.ValidUntil = &validUntil
+	}
+
+	return request
+}
+
+// AccessRequestFilterToDomain преобразует protobuf запрос в фильтр списка заявок
+func AccessRequestFilterToDomain(req *accessRequestV1.ListAccessRequestsRequest) *model.AccessRequestFilter {
+	filter := &model.AccessRequestFilter{
+		UserID: req.UserId,
+		RoleID: req.RoleId,
+		Limit:  req.GetLimit(),
+		Cursor: req.GetCursor(),
+	}
+
+	if req.Status != nil {
+		for status, protoStatus := range accessRequestStatusToProto {
+			if protoStatus == *req.Status {
+				filter.Status = &status
+				break
+			}
+		}
+	}
+
+	return filter
+}
+
+// AccessRequestsToProto преобразует заявки в protobuf
+func AccessRequestsToProto(requests []*model.AccessRequest) []*accessRequestV1.AccessRequest {
+	result := make([]*accessRequestV1.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		result = append(result, AccessRequestToProto(request))
+	}
+	return result
+}
+
+// AccessRequestToProto преобразует заявку в protobuf
+func AccessRequestToProto(request *model.AccessRequest) *accessRequestV1.AccessRequest {
+	return &accessRequestV1.AccessRequest{
+		Id:              request.ID.String(),
+		UserId:          request.UserID,
+		RoleId:          request.RoleID,
+		RequestedBy:     request.RequestedBy,
+		Justification:   request.Justification,
+		ValidUntil:      timestampToProto(request.ValidUntil),
+		Status:          accessRequestStatusToProto[request.Status],
+		CreatedAt:       timestamppb.New(request.CreatedAt.In(time.UTC)),
+		ExpiresAt:       timestamppb.New(request.ExpiresAt.In(time.UTC)),
+		DecidedBy:       request.DecidedBy,
+		DecidedAt:       timestampToProto(request.DecidedAt),
+		DecisionComment: request.DecisionComment,
+	}
+}
+
+func timestampToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.In(time.UTC))
+}
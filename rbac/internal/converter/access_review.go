@@ -0,0 +1,227 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	accessReviewV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1"
+)
+
+var accessReviewStatusToProto = map[model.AccessReviewStatus]accessReviewV1.AccessReviewStatus{
+	model.AccessReviewStatusOpen:   accessReviewV1.AccessReviewStatus_ACCESS_REVIEW_STATUS_OPEN,
+	model.AccessReviewStatusClosed: accessReviewV1.AccessReviewStatus_ACCESS_REVIEW_STATUS_CLOSED,
+}
+
+var accessReviewDecisionToProto = map[model.AccessReviewDecision]accessReviewV1.AccessReviewDecision{
+	model.AccessReviewDecisionPending: accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING,
+	model.AccessReviewDecisionKeep:    accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP,
+	model.AccessReviewDecisionRevoke:  accessReviewV1.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE,
+}
+
+// accessReviewReportHeader колонки CSV-отчета
+var accessReviewReportHeader = []string{
+	"campaign_id", "campaign_name", "user_id", "role_id", "decision",
+	"reviewed_by", "reviewed_at", "comment", "revoked_at",
+}
+
+// CreateAccessReviewToDomain преобразует protobuf запрос в данные новой кампании
+func CreateAccessReviewToDomain(req *accessReviewV1.CreateAccessReviewRequest) *model.CreateAccessReview {
+	campaign := &model.CreateAccessReview{
+		Name:        req.GetName(),
+		Description: req.Description,
+		RoleIDs:     req.GetRoleIds(),
+		ReviewerIDs: req.GetReviewerIds(),
+	}
+
+	if req.DueAt != nil {
+		dueAt := req.DueAt.AsTime()
+		campaign.DueAt = &dueAt
+	}
+
+	return campaign
+}
+
+// AccessReviewFilterToDomain преобразует protobuf запрос в фильтр списка кампаний
+func AccessReviewFilterToDomain(req *accessReviewV1.ListAccessReviewsRequest) *model.AccessReviewFilter {
+	filter := &model.AccessReviewFilter{
+		Limit:  req.GetLimit(),
+		Cursor: req.GetCursor(),
+	}
+
+	if req.Status != nil {
+		for status, protoStatus := range accessReviewStatusToProto {
+			if protoStatus == *req.Status {
+				filter.Status = &status
+				break
+			}
+		}
+	}
+
+	return filter
+}
+
+// AccessReviewItemFilterToDomain преобразует protobuf запрос в фильтр назначений кампании
+func AccessReviewItemFilterToDomain(req *accessReviewV1.ListAccessReviewItemsRequest) *model.AccessReviewItemFilter {
+	filter := &model.AccessReviewItemFilter{
+		CampaignID: req.GetCampaignId(),
+		Limit:      req.GetLimit(),
+		Cursor:     req.GetCursor(),
+	}
+
+	if req.Decision != nil {
+		decision := AccessReviewDecisionToDomain(*req.Decision)
+		filter.Decision = &decision
+	}
+
+	return filter
+}
+
+// AccessReviewDecisionToDomain преобразует protobuf решение
+func AccessReviewDecisionToDomain(decision accessReviewV1.AccessReviewDecision) model.AccessReviewDecision {
+	for domain, protoDecision := range accessReviewDecisionToProto {
+		if protoDecision == decision {
+			return domain
+		}
+	}
+	return model.AccessReviewDecisionPending
+}
+
+// DecideAccessReviewItemToDomain преобразует protobuf запрос в решение проверяющего
+func DecideAccessReviewItemToDomain(req *accessReviewV1.DecideAccessReviewItemRequest) *model.AccessReviewItemDecision {
+	return &model.AccessReviewItemDecision{
+		CampaignID: req.GetCampaignId(),
+		ItemID:     req.GetItemId(),
+		Decision:   AccessReviewDecisionToDomain(req.GetDecision()),
+		Comment:    req.Comment,
+	}
+}
+
+// AccessReviewsToProto преобразует кампании в protobuf
+func AccessReviewsToProto(campaigns []*model.AccessReviewCampaign) []*accessReviewV1.AccessReview {
+	result := make([]*accessReviewV1.AccessReview, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		result = append(result, AccessReviewToProto(campaign))
+	}
+	return result
+}
+
+// AccessReviewToProto преобразует кампанию в protobuf
+func AccessReviewToProto(campaign *model.AccessReviewCampaign) *accessReviewV1.AccessReview {
+	return &accessReviewV1.AccessReview{
+		Id:          campaign.ID.String(),
+		Name:        campaign.Name,
+		Description: campaign.Description,
+		RoleIds:     campaign.RoleIDs,
+		ReviewerIds: campaign.ReviewerIDs,
+		Status:      accessReviewStatusToProto[campaign.Status],
+		CreatedBy:   campaign.CreatedBy,
+		CreatedAt:   timestamppb.New(campaign.CreatedAt.In(time.UTC)),
+		DueAt:       timestampToProto(campaign.DueAt),
+		ClosedBy:    campaign.ClosedBy,
+		ClosedAt:    timestampToProto(campaign.ClosedAt),
+		Summary: &accessReviewV1.AccessReviewSummary{
+			Total:   campaign.Summary.Total,
+			Pending: campaign.Summary.Pending,
+			Keep:    campaign.Summary.Keep,
+			Revoke:  campaign.Summary.Revoke,
+			Revoked: campaign.Summary.Revoked,
+		},
+	}
+}
+
+// AccessReviewItemsToProto преобразует назначения кампании в protobuf
+func AccessReviewItemsToProto(items []*model.AccessReviewItem) []*accessReviewV1.AccessReviewItem {
+	result := make([]*accessReviewV1.AccessReviewItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, AccessReviewItemToProto(item))
+	}
+	return result
+}
+
+// AccessReviewItemToProto преобразует назначение кампании в protobuf
+func AccessReviewItemToProto(item *model.AccessReviewItem) *accessReviewV1.AccessReviewItem {
+	return &accessReviewV1.AccessReviewItem{
+		Id:         item.ID.String(),
+		CampaignId: item.CampaignID,
+		UserId:     item.UserID,
+		RoleId:     item.RoleID,
+		Decision:   accessReviewDecisionToProto[item.Decision],
+		ReviewedBy: item.ReviewedBy,
+		ReviewedAt: timestampToProto(item.ReviewedAt),
+		Comment:    item.Comment,
+		RevokedAt:  timestampToProto(item.RevokedAt),
+	}
+}
+
+// AccessReviewReportFormatToDomain преобразует protobuf формат отчета; по умолчанию CSV
+func AccessReviewReportFormatToDomain(format accessReviewV1.AccessReviewReportFormat) model.AccessReviewReportFormat {
+	if format == accessReviewV1.AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_JSON {
+		return model.AccessReviewReportFormatJSON
+	}
+	return model.AccessReviewReportFormatCSV
+}
+
+// AccessReviewReportFormatToProto преобразует формат отчета в protobuf
+func AccessReviewReportFormatToProto(format model.AccessReviewReportFormat) accessReviewV1.AccessReviewReportFormat {
+	if format == model.AccessReviewReportFormatJSON {
+		return accessReviewV1.AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_JSON
+	}
+	return accessReviewV1.AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_CSV
+}
+
+// EncodeAccessReviewReport сериализует отчет: CSV — строка на назначение, JSON — кампания со сводкой и назначениями
+func EncodeAccessReviewReport(report *model.AccessReviewReport, format model.AccessReviewReportFormat) ([]byte, error) {
+	if format == model.AccessReviewReportFormatJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(accessReviewReportHeader); err != nil {
+		return nil, err
+	}
+	for _, item := range report.Items {
+		record := []string{
+			report.Campaign.ID.String(),
+			report.Campaign.Name,
+			item.UserID,
+			item.RoleID,
+			string(item.Decision),
+			stringValue(item.ReviewedBy),
+			timeValue(item.ReviewedAt),
+			stringValue(item.Comment),
+			timeValue(item.RevokedAt),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func timeValue(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,78 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	auditV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/audit/v1"
+)
+
+// AuditEventFilterToDomain преобразует protobuf запрос в фильтр журнала аудита
+func AuditEventFilterToDomain(req *auditV1.ListAuditEventsRequest) *model.AuditEventFilter {
+	filter := &model.AuditEventFilter{
+		ActorID:    req.ActorId,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		Limit:      req.GetLimit(),
+		Cursor:     req.GetCursor(),
+	}
+
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	return filter
+}
+
+// AuditEventsToProto преобразует события аудита в protobuf
+func AuditEventsToProto(events []*model.AuditEvent) []*auditV1.AuditEvent {
+	result := make([]*auditV1.AuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, AuditEventToProto(event))
+	}
+	return result
+}
+
+// AuditEventToProto преобразует событие аудита в protobuf
+func AuditEventToProto(event *model.AuditEvent) *auditV1.AuditEvent {
+	return &auditV1.AuditEvent{
+		Id:         event.ID.String(),
+		OccurredAt: timestamppb.New(event.OccurredAt.In(time.UTC)),
+		Actor: &auditV1.AuditActor{
+			Type:      string(event.Actor.Type),
+			Id:        event.Actor.ID,
+			SessionId: event.Actor.SessionID,
+		},
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetID,
+		Before:     stateToProto(event.Before),
+		After:      stateToProto(event.After),
+		RequestId:  event.RequestID,
+	}
+}
+
+// stateToProto преобразует JSON состояния объекта в Struct; не-объекты пропускаются
+func stateToProto(state []byte) *structpb.Struct {
+	if len(state) == 0 {
+		return nil
+	}
+
+	result := &structpb.Struct{}
+	if err := protojson.Unmarshal(state, result); err != nil {
+		return nil
+	}
+
+	return result
+}
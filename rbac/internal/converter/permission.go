@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
@@ -9,9 +10,11 @@ func PermissionsToProto(permissions []*model.Permission) []*commonV1.Permission
 	result := make([]*commonV1.Permission, len(permissions))
 	for i, permission := range permissions {
 		result[i] = &commonV1.Permission{
-			Id:       permission.ID.String(),
-			Resource: permission.Resource,
-			Action:   permission.Action,
+			Id:        permission.ID.String(),
+			Resource:  permission.Resource,
+			Action:    permission.Action,
+			Effect:    authz.EffectToProto(permission.Effect),
+			Condition: permission.Condition,
 		}
 	}
 	return result
@@ -0,0 +1,117 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	policyV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1"
+)
+
+// EncodePolicyDocument сериализует документ политики в YAML или JSON
+func EncodePolicyDocument(document *model.PolicyDocument, format model.PolicyFormat) ([]byte, error) {
+	if format == model.PolicyFormatJSON {
+		data, err := json.MarshalIndent(document, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodePolicyDocument разбирает документ политики; неизвестные поля считаются ошибкой,
+// чтобы опечатка в ключе не превращалась в молчаливое удаление назначений
+func DecodePolicyDocument(data []byte, format model.PolicyFormat) (*model.PolicyDocument, error) {
+	document := &model.PolicyDocument{}
+
+	var err error
+	if format == model.PolicyFormatJSON {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(document)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(document)
+	}
+	if err != nil {
+		return nil, model.ErrInvalidPolicy.WithMessage(model.MsgPolicyParseFailed, err.Error())
+	}
+
+	return document, nil
+}
+
+// PolicyFormatFromString разбирает название формата: yaml, yml или json
+func PolicyFormatFromString(format string) (model.PolicyFormat, error) {
+	switch strings.ToLower(format) {
+	case "yaml", "yml":
+		return model.PolicyFormatYAML, nil
+	case "json":
+		return model.PolicyFormatJSON, nil
+	default:
+		return model.PolicyFormatYAML, fmt.Errorf("неизвестный формат документа %q", format)
+	}
+}
+
+// PolicyFormatToDomain преобразует protobuf формат документа; по умолчанию YAML
+func PolicyFormatToDomain(format policyV1.PolicyFormat) model.PolicyFormat {
+	if format == policyV1.PolicyFormat_POLICY_FORMAT_JSON {
+		return model.PolicyFormatJSON
+	}
+	return model.PolicyFormatYAML
+}
+
+// PolicyFormatToProto преобразует формат документа в protobuf
+func PolicyFormatToProto(format model.PolicyFormat) policyV1.PolicyFormat {
+	if format == model.PolicyFormatJSON {
+		return policyV1.PolicyFormat_POLICY_FORMAT_JSON
+	}
+	return policyV1.PolicyFormat_POLICY_FORMAT_YAML
+}
+
+var policyChangeTypesToProto = map[model.PolicyChangeType]policyV1.PolicyChangeType{
+	model.PolicyChangeCreatePermission: policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_CREATE_PERMISSION,
+	model.PolicyChangeCreateRole:       policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_CREATE_ROLE,
+	model.PolicyChangeUpdateRole:       policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_UPDATE_ROLE,
+	model.PolicyChangeDeleteRole:       policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_DELETE_ROLE,
+	model.PolicyChangeAddGrant:         policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_ADD_GRANT,
+	model.PolicyChangeUpdateGrant:      policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_UPDATE_GRANT,
+	model.PolicyChangeRemoveGrant:      policyV1.PolicyChangeType_POLICY_CHANGE_TYPE_REMOVE_GRANT,
+}
+
+// PolicyChangesToProto преобразует план применения политики в protobuf
+func PolicyChangesToProto(plan *model.PolicyPlan) []*policyV1.PolicyChange {
+	result := make([]*policyV1.PolicyChange, 0, len(plan.Changes))
+	for _, change := range plan.Changes {
+		pbChange := &policyV1.PolicyChange{
+			Type:             policyChangeTypesToProto[change.Type],
+			Role:             change.Role,
+			Permission:       change.Permission,
+			Description:      change.Description,
+			Effect:           authz.EffectToProto(change.Effect),
+			RequiresApproval: change.RequiresApproval,
+			Approver:         change.Approver,
+		}
+		if change.Condition != "" {
+			pbChange.Condition = &change.Condition
+		}
+		result = append(result, pbChange)
+	}
+	return result
+}
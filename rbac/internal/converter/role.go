@@ -11,9 +11,11 @@ import (
 // UpdateRoleToDomain преобразует protobuf запрос в доменную модель обновления роли
 func UpdateRoleToDomain(req *roleV1.UpdateRequest) (*model.UpdateRole, error) {
 	updateRole := &model.UpdateRole{
-		ID:          req.RoleId,
-		Name:        req.Name,
-		Description: req.Description,
+		ID:               req.RoleId,
+		Name:             req.Name,
+		Description:      req.Description,
+		RequiresApproval: req.RequiresApproval,
+		ApproverRoleID:   req.ApproverRoleId,
 	}
 
 	return updateRole, nil
@@ -26,12 +28,21 @@ func RoleToProto(role *model.Role) *commonV1.Role {
 		updatedAt = timestamppb.New(*role.UpdatedAt)
 	}
 
+	var deletedAt *timestamppb.Timestamp
+	if role.DeletedAt != nil {
+		deletedAt = timestamppb.New(*role.DeletedAt)
+	}
+
 	return &commonV1.Role{
-		Id:          role.ID.String(),
-		Name:        role.Name,
-		Description: role.Description,
-		CreatedAt:   timestamppb.New(role.CreatedAt),
-		UpdatedAt:   updatedAt,
+		Id:               role.ID.String(),
+		Name:             role.Name,
+		Description:      role.Description,
+		CreatedAt:        timestamppb.New(role.CreatedAt),
+		UpdatedAt:        updatedAt,
+		IsSystem:         role.IsSystem,
+		DeletedAt:        deletedAt,
+		RequiresApproval: role.RequiresApproval,
+		ApproverRoleId:   role.ApproverRoleID,
 	}
 }
 
@@ -60,3 +71,37 @@ func EnrichedRolesToProto(enrichedRoles []*model.EnrichedRole) []*commonV1.RoleW
 	}
 	return result
 }
+
+// RoleFilterToDomain преобразует protobuf запрос в фильтр списка ролей
+func RoleFilterToDomain(req *roleV1.ListRequest) *model.RoleFilter {
+	return &model.RoleFilter{
+		Status:        RoleStatusToDomain(req.GetStatus()),
+		NameQuery:     req.GetNameQuery(),
+		PermissionIDs: req.GetPermissionIds(),
+		Limit:         req.GetLimit(),
+		Cursor:        req.GetCursor(),
+	}
+}
+
+// RoleListItemsToProto преобразует страницу списка ролей в protobuf и количество пользователей по ID роли
+func RoleListItemsToProto(items []*model.RoleListItem) ([]*commonV1.Role, map[string]int32) {
+	roles := make([]*commonV1.Role, len(items))
+	userCounts := make(map[string]int32, len(items))
+	for i, item := range items {
+		roles[i] = RoleToProto(item.Role)
+		userCounts[item.Role.ID.String()] = item.UserCount
+	}
+	return roles, userCounts
+}
+
+// RoleStatusToDomain преобразует фильтр состояния ролей из protobuf
+func RoleStatusToDomain(status roleV1.RoleStatus) model.RoleStatus {
+	switch status {
+	case roleV1.RoleStatus_ROLE_STATUS_DELETED:
+		return model.RoleStatusDeleted
+	case roleV1.RoleStatus_ROLE_STATUS_ALL:
+		return model.RoleStatusAll
+	default:
+		return model.RoleStatusActive
+	}
+}
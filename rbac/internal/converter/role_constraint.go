@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	roleConstraintV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1"
+)
+
+// CreateRoleConstraintToDomain преобразует protobuf запрос в данные нового ограничения
+func CreateRoleConstraintToDomain(req *roleConstraintV1.CreateRoleConstraintRequest) *model.CreateRoleConstraint {
+	return &model.CreateRoleConstraint{
+		Name:        req.GetName(),
+		Description: req.Description,
+		RoleIDs:     req.GetRoleIds(),
+	}
+}
+
+// RoleConstraintsToProto преобразует ограничения в protobuf
+func RoleConstraintsToProto(constraints []*model.RoleConstraint) []*roleConstraintV1.RoleConstraint {
+	result := make([]*roleConstraintV1.RoleConstraint, 0, len(constraints))
+	for _, constraint := range constraints {
+		result = append(result, RoleConstraintToProto(constraint))
+	}
+	return result
+}
+
+// RoleConstraintToProto преобразует ограничение в protobuf
+func RoleConstraintToProto(constraint *model.RoleConstraint) *roleConstraintV1.RoleConstraint {
+	return &roleConstraintV1.RoleConstraint{
+		Id:          constraint.ID.String(),
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		RoleIds:     constraint.RoleIDs,
+		CreatedAt:   timestamppb.New(constraint.CreatedAt.In(time.UTC)),
+	}
+}
+
+// ConstraintViolationsToProto преобразует нарушения ограничений в protobuf
+func ConstraintViolationsToProto(violations []*model.ConstraintViolation) []*roleConstraintV1.ConstraintViolation {
+	result := make([]*roleConstraintV1.ConstraintViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, &roleConstraintV1.ConstraintViolation{
+			ConstraintId:   violation.ConstraintID,
+			ConstraintName: violation.ConstraintName,
+			UserId:         violation.UserID,
+			RoleIds:        violation.RoleIDs,
+		})
+	}
+	return result
+}
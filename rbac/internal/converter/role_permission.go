@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	rolePermissionV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1"
+)
+
+// AssignRolePermissionToDomain преобразует protobuf запрос в доменную модель назначения права роли
+func AssignRolePermissionToDomain(req *rolePermissionV1.AssignRequest) *model.AssignRolePermission {
+	return &model.AssignRolePermission{
+		RoleID:       req.RoleId,
+		PermissionID: req.PermissionId,
+		Effect:       authz.EffectFromProto(req.Effect),
+		Condition:    req.Condition,
+	}
+}
+
+// RolePermissionsDiffToProto преобразует изменения набора прав роли в protobuf
+func RolePermissionsDiffToProto(diff *model.RolePermissionsDiff) *rolePermissionV1.SetRolePermissionsResponse {
+	return &rolePermissionV1.SetRolePermissionsResponse{
+		Added:     diff.Added,
+		Removed:   diff.Removed,
+		Unchanged: diff.Unchanged,
+	}
+}
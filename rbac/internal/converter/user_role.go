@@ -0,0 +1,89 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	userRoleV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1"
+)
+
+// AssignUserRoleToDomain преобразует protobuf запрос в доменную модель назначения роли.
+// assigned_by из запроса не переносится: инициатор определяется по сессии
+func AssignUserRoleToDomain(req *userRoleV1.AssignRequest) *model.AssignUserRole {
+	var validFrom, validUntil *time.Time
+	if req.ValidFrom != nil {
+		t := req.ValidFrom.AsTime()
+		validFrom = &t
+	}
+	if req.ValidUntil != nil {
+		t := req.ValidUntil.AsTime()
+		validUntil = &t
+	}
+
+	return &model.AssignUserRole{
+		UserID:     req.UserId,
+		RoleID:     req.RoleId,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+// RoleMembersToProto преобразует участников роли в protobuf
+func RoleMembersToProto(members []*model.RoleMember) []*userRoleV1.RoleMember {
+	result := make([]*userRoleV1.RoleMember, 0, len(members))
+	for _, member := range members {
+		pb := &userRoleV1.RoleMember{UserId: member.UserID}
+		if member.Profile != nil {
+			pb.Login = &member.Profile.Login
+			pb.Email = &member.Profile.Email
+		}
+		result = append(result, pb)
+	}
+	return result
+}
+
+// BulkAssignUserRolesToDomain преобразует пакетный запрос в доменные модели назначений
+func BulkAssignUserRolesToDomain(req *userRoleV1.BulkAssignUserRolesRequest) []*model.AssignUserRole {
+	result := make([]*model.AssignUserRole, 0, len(req.Assignments))
+	for _, assignment := range req.Assignments {
+		result = append(result, AssignUserRoleToDomain(assignment))
+	}
+	return result
+}
+
+// BulkAssignResultsToProto преобразует результаты пакетного назначения в protobuf
+func BulkAssignResultsToProto(results []*model.BulkAssignResult) *userRoleV1.BulkAssignUserRolesResponse {
+	resp := &userRoleV1.BulkAssignUserRolesResponse{
+		Results: make([]*userRoleV1.BulkAssignResult, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Status == model.BulkAssignStatusAssigned {
+			resp.AssignedCount++
+		}
+		resp.Results = append(resp.Results, &userRoleV1.BulkAssignResult{
+			UserId: result.Assignment.UserID,
+			RoleId: result.Assignment.RoleID,
+			Status: bulkAssignStatusToProto(result.Status),
+		})
+	}
+	return resp
+}
+
+func bulkAssignStatusToProto(status model.BulkAssignStatus) userRoleV1.BulkAssignStatus {
+	switch status {
+	case model.BulkAssignStatusAssigned:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_ASSIGNED
+	case model.BulkAssignStatusAlreadyAssigned:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_ALREADY_ASSIGNED
+	case model.BulkAssignStatusNotFound:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_NOT_FOUND
+	case model.BulkAssignStatusInvalidValidityPeriod:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_INVALID_VALIDITY_PERIOD
+	case model.BulkAssignStatusRequiresApproval:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_REQUIRES_APPROVAL
+	case model.BulkAssignStatusSoDViolation:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_SOD_VIOLATION
+	default:
+		return userRoleV1.BulkAssignStatus_BULK_ASSIGN_STATUS_UNSPECIFIED
+	}
+}
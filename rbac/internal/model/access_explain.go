@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+)
+
+// AccessCheck проверка права пользователя.
+// Attributes nil означает вычисление без атрибутов, как в PermissionInterceptor.
+type AccessCheck struct {
+	UserID     string
+	Permission string
+	Attributes condition.Attributes
+}
+
+// RoleChanges гипотетические изменения ролей пользователя
+type RoleChanges struct {
+	AddRoleIDs    []string
+	RemoveRoleIDs []string
+}
+
+// GrantTrace назначение права роли, покрывающее проверяемое право
+type GrantTrace struct {
+	RoleID     string
+	RoleName   string
+	Permission *Permission
+	// Applied false, если условие не выполнено или не вычислено
+	Applied bool
+}
+
+// AccessExplanation решение по праву и назначения, повлиявшие на него
+type AccessExplanation struct {
+	Decision   authz.Decision
+	Permission string
+	Roles      []*Role
+	Grants     []*GrantTrace
+	Reason     string
+}
+
+// AccessSimulation решения до и после гипотетических изменений ролей
+type AccessSimulation struct {
+	Current   *AccessExplanation
+	Simulated *AccessExplanation
+	// ViolatedConstraints имена ограничений, нарушаемых набором ролей после изменений
+	ViolatedConstraints []string
+}
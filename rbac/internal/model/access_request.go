@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessRequestStatus состояние заявки на доступ
+type AccessRequestStatus string
+
+const (
+	AccessRequestStatusPending  AccessRequestStatus = "pending"
+	AccessRequestStatusApproved AccessRequestStatus = "approved"
+	AccessRequestStatusRejected AccessRequestStatus = "rejected"
+	AccessRequestStatusExpired  AccessRequestStatus = "expired"
+)
+
+// AccessRequest заявка на назначение роли, требующей согласования
+type AccessRequest struct {
+	ID              uuid.UUID           `json:"id"`
+	UserID          string              `json:"user_id"`
+	RoleID          string              `json:"role_id"`
+	RequestedBy     *string             `json:"requested_by,omitempty"`
+	Justification   string              `json:"justification"`
+	ValidUntil      *time.Time          `json:"valid_until,omitempty"`
+	Status          AccessRequestStatus `json:"status"`
+	CreatedAt       time.Time           `json:"created_at"`
+	ExpiresAt       time.Time           `json:"expires_at"`
+	DecidedBy       *string             `json:"decided_by,omitempty"`
+	DecidedAt       *time.Time          `json:"decided_at,omitempty"`
+	DecisionComment *string             `json:"decision_comment,omitempty"`
+}
+
+// CreateAccessRequest данные новой заявки
+type CreateAccessRequest struct {
+	UserID        string
+	RoleID        string
+	RequestedBy   *string
+	Justification string
+	ValidUntil    *time.Time
+	ExpiresAt     time.Time
+}
+
+// AccessRequestDecision решение по заявке
+type AccessRequestDecision struct {
+	ID        string
+	Status    AccessRequestStatus
+	DecidedBy *string
+	Comment   *string
+}
+
+// AccessRequestFilter фильтры и пагинация списка заявок
+type AccessRequestFilter struct {
+	Status *AccessRequestStatus
+	UserID *string
+	RoleID *string
+	Limit  int32
+	Cursor string // seq последней заявки предыдущей страницы
+}
+
+// AccessRequestEvent данные событий жизненного цикла заявки.
+// ApproverRoleID позволяет обработчику уведомлений адресовать новую заявку согласующим.
+type AccessRequestEvent struct {
+	Request        *AccessRequest `json:"request"`
+	ApproverRoleID *string        `json:"approver_role_id,omitempty"`
+}
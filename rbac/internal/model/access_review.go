@@ -0,0 +1,123 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessReviewStatus состояние кампании пересмотра доступа
+type AccessReviewStatus string
+
+const (
+	AccessReviewStatusOpen   AccessReviewStatus = "open"
+	AccessReviewStatusClosed AccessReviewStatus = "closed"
+)
+
+// AccessReviewDecision решение проверяющего по назначению
+type AccessReviewDecision string
+
+const (
+	AccessReviewDecisionPending AccessReviewDecision = "pending"
+	AccessReviewDecisionKeep    AccessReviewDecision = "keep"
+	AccessReviewDecisionRevoke  AccessReviewDecision = "revoke"
+)
+
+// AccessReviewReportFormat формат отчета по кампании
+type AccessReviewReportFormat int
+
+const (
+	AccessReviewReportFormatCSV AccessReviewReportFormat = iota
+	AccessReviewReportFormatJSON
+)
+
+// AccessReviewSummary сводка решений кампании
+type AccessReviewSummary struct {
+	Total   int32 `json:"total"`
+	Pending int32 `json:"pending"`
+	Keep    int32 `json:"keep"`
+	Revoke  int32 `json:"revoke"`
+	// Revoked отзывы, примененные после закрытия
+	Revoked int32 `json:"revoked"`
+}
+
+// AccessReviewCampaign кампания пересмотра доступа к набору ролей
+type AccessReviewCampaign struct {
+	ID          uuid.UUID           `json:"id"`
+	Name        string              `json:"name"`
+	Description *string             `json:"description,omitempty"`
+	RoleIDs     []string            `json:"role_ids"`
+	ReviewerIDs []string            `json:"reviewer_ids"`
+	Status      AccessReviewStatus  `json:"status"`
+	CreatedBy   *string             `json:"created_by,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	DueAt       *time.Time          `json:"due_at,omitempty"`
+	ClosedBy    *string             `json:"closed_by,omitempty"`
+	ClosedAt    *time.Time          `json:"closed_at,omitempty"`
+	Summary     AccessReviewSummary `json:"summary"`
+}
+
+// AccessReviewBinding назначение роли пользователю
+type AccessReviewBinding struct {
+	UserID string
+	RoleID string
+}
+
+// CreateAccessReview данные новой кампании вместе со снимком назначений
+type CreateAccessReview struct {
+	Name        string
+	Description *string
+	RoleIDs     []string
+	ReviewerIDs []string
+	DueAt       *time.Time
+	CreatedBy   *string
+	Bindings    []*AccessReviewBinding
+}
+
+// AccessReviewItem назначение, зафиксированное кампанией, и решение по нему
+type AccessReviewItem struct {
+	ID         uuid.UUID            `json:"id"`
+	CampaignID string               `json:"campaign_id"`
+	UserID     string               `json:"user_id"`
+	RoleID     string               `json:"role_id"`
+	Decision   AccessReviewDecision `json:"decision"`
+	ReviewedBy *string              `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time           `json:"reviewed_at,omitempty"`
+	Comment    *string              `json:"comment,omitempty"`
+	RevokedAt  *time.Time           `json:"revoked_at,omitempty"`
+}
+
+// AccessReviewItemDecision решение проверяющего
+type AccessReviewItemDecision struct {
+	CampaignID string
+	ItemID     string
+	Decision   AccessReviewDecision
+	ReviewedBy *string
+	Comment    *string
+}
+
+// AccessReviewFilter фильтры и пагинация списка кампаний
+type AccessReviewFilter struct {
+	Status *AccessReviewStatus
+	Limit  int32
+	Cursor string // seq последней кампании предыдущей страницы
+}
+
+// AccessReviewItemFilter фильтры и пагинация назначений кампании
+type AccessReviewItemFilter struct {
+	CampaignID string
+	Decision   *AccessReviewDecision
+	Limit      int32
+	Cursor     string // seq последнего назначения предыдущей страницы
+}
+
+// AccessReviewReport отчет по кампании
+type AccessReviewReport struct {
+	Campaign *AccessReviewCampaign `json:"campaign"`
+	Items    []*AccessReviewItem   `json:"items"`
+}
+
+// AccessReviewEvent данные событий жизненного цикла кампании
+type AccessReviewEvent struct {
+	Campaign *AccessReviewCampaign `json:"campaign"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+)
+
+// AssignRolePermission представляет данные для назначения права роли
+type AssignRolePermission struct {
+	RoleID       string       `json:"role_id"`
+	PermissionID string       `json:"permission_id"`
+	Effect       authz.Effect `json:"effect"`
+	// Condition необязательное условие (ABAC), см. platform/pkg/authz/condition
+	Condition *string `json:"condition,omitempty"`
+}
+
+// RolePermissionsDiff изменения набора прав роли после замены
+type RolePermissionsDiff struct {
+	RoleID    string   `json:"role_id"`
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged []string `json:"unchanged"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// AssignUserRole представляет данные для назначения роли пользователю
+type AssignUserRole struct {
+	UserID     string     `json:"user_id"`
+	RoleID     string     `json:"role_id"`
+	AssignedBy *string    `json:"assigned_by,omitempty"`
+	ValidFrom  *time.Time `json:"valid_from,omitempty"`
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// AccessRequestID заявка, по которой выполняется назначение; только такие назначения
+	// допускаются для ролей, требующих согласования
+	AccessRequestID *string `json:"access_request_id,omitempty"`
+}
+
+// BulkAssignStatus результат назначения одного элемента пакета
+type BulkAssignStatus int
+
+const (
+	BulkAssignStatusAssigned BulkAssignStatus = iota + 1
+	BulkAssignStatusAlreadyAssigned
+	BulkAssignStatusNotFound
+	BulkAssignStatusInvalidValidityPeriod
+	BulkAssignStatusRequiresApproval
+	BulkAssignStatusSoDViolation
+)
+
+// BulkAssignResult результат по элементу пакетного назначения
+type BulkAssignResult struct {
+	Assignment *AssignUserRole
+	Status     BulkAssignStatus
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+)
+
+// Действия, фиксируемые в журнале аудита
+const (
+	AuditActionRoleCreate           = "role.create"
+	AuditActionRoleUpdate           = "role.update"
+	AuditActionRoleDelete           = "role.delete"
+	AuditActionRoleRestore          = "role.restore"
+	AuditActionRolePermissionAssign = "role_permission.assign"
+	AuditActionRolePermissionRevoke = "role_permission.revoke"
+	AuditActionRolePermissionSet    = "role_permission.set"
+	AuditActionUserRoleAssign       = "user_role.assign"
+	AuditActionUserRoleRevoke       = "user_role.revoke"
+	AuditActionUserRoleExpire       = "user_role.expire"
+	AuditActionPolicyApply          = "policy.apply"
+	AuditActionAccessRequestCreate  = "access_request.create"
+	AuditActionAccessRequestApprove = "access_request.approve"
+	AuditActionAccessRequestReject  = "access_request.reject"
+	AuditActionAccessRequestExpire  = "access_request.expire"
+	AuditActionRoleConstraintCreate = "role_constraint.create"
+	AuditActionRoleConstraintDelete = "role_constraint.delete"
+	AuditActionAccessReviewCreate   = "access_review.create"
+	AuditActionAccessReviewDecide   = "access_review.decide"
+	AuditActionAccessReviewClose    = "access_review.close"
+	AuditActionUserRegister         = "user.register"
+)
+
+// Типы объектов изменения
+const (
+	AuditTargetRole           = "role"
+	AuditTargetUserRole       = "user_role"
+	AuditTargetPolicy         = "policy"
+	AuditTargetAccessRequest  = "access_request"
+	AuditTargetRoleConstraint = "role_constraint"
+	AuditTargetAccessReview   = "access_review"
+	AuditTargetUser           = "user"
+)
+
+// AuditRecord данные изменения, передаваемые сервисами для записи в журнал.
+// Before и After сериализуются в JSON.
+type AuditRecord struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     any
+	After      any
+}
+
+// AuditEvent запись журнала аудита
+type AuditEvent struct {
+	ID         uuid.UUID       `json:"id"`
+	Sequence   int64           `json:"-"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Actor      audit.Actor     `json:"actor"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   string          `json:"target_id"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+}
+
+// AuditEventFilter фильтры и пагинация журнала аудита
+type AuditEventFilter struct {
+	ActorID    *string
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	From       *time.Time
+	To         *time.Time
+	Limit      int32
+	Cursor     string // seq последнего события предыдущей страницы
+}
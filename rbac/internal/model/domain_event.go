@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+)
+
+// DomainEventVersion версия формата конверта доменных событий
+const DomainEventVersion = 1
+
+// Типы доменных событий RBAC
+const (
+	EventTypeRoleCreated               = "RoleCreated"
+	EventTypeRoleUpdated               = "RoleUpdated"
+	EventTypeRoleDeleted               = "RoleDeleted"
+	EventTypeRoleRestored              = "RoleRestored"
+	EventTypePermissionGrantedToRole   = "PermissionGrantedToRole"
+	EventTypePermissionRevokedFromRole = "PermissionRevokedFromRole"
+	EventTypeRolePermissionsReplaced   = "RolePermissionsReplaced"
+	EventTypeUserRoleAssigned          = "UserRoleAssigned"
+	EventTypeUserRolesBulkAssigned     = "UserRolesBulkAssigned"
+	EventTypeUserRoleRevoked           = "UserRoleRevoked"
+	EventTypePolicyApplied             = "PolicyApplied"
+	EventTypeAccessRequestCreated      = "AccessRequestCreated"
+	EventTypeAccessRequestApproved     = "AccessRequestApproved"
+	EventTypeAccessRequestRejected     = "AccessRequestRejected"
+	EventTypeAccessRequestExpired      = "AccessRequestExpired"
+	EventTypeRoleConstraintCreated     = "RoleConstraintCreated"
+	EventTypeRoleConstraintDeleted     = "RoleConstraintDeleted"
+	EventTypeAccessReviewStarted       = "AccessReviewStarted"
+	EventTypeAccessReviewClosed        = "AccessReviewClosed"
+)
+
+// Причины отзыва роли у пользователя
+const (
+	RevokeReasonManual  = "manual"
+	RevokeReasonExpired = "expired"
+)
+
+// DomainEvent конверт доменного события, публикуемого в Kafka
+type DomainEvent struct {
+	EventID    uuid.UUID   `json:"event_id"`
+	Type       string      `json:"type"`
+	Version    int         `json:"version"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Actor      audit.Actor `json:"actor"`
+	Payload    any         `json:"payload"`
+}
+
+// RolePermissionRevoked данные события отзыва права у роли
+type RolePermissionRevoked struct {
+	RoleID       string `json:"role_id"`
+	PermissionID string `json:"permission_id"`
+}
+
+// UserRoleRevoked данные события отзыва роли у пользователя
+type UserRoleRevoked struct {
+	UserID string `json:"user_id"`
+	RoleID string `json:"role_id"`
+	Reason string `json:"reason"`
+}
+
+// UserRolesBulkAssigned данные события пакетного назначения ролей
+type UserRolesBulkAssigned struct {
+	BatchID     string            `json:"batch_id"`
+	Assignments []*AssignUserRole `json:"assignments"`
+}
+
+// PolicyApplied данные события применения документа политики
+type PolicyApplied struct {
+	ApplyID string          `json:"apply_id"`
+	Changes []*PolicyChange `json:"changes"`
+}
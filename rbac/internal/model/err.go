@@ -1,18 +1,49 @@
 package model
 
-import "errors"
+import "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/apperr"
+
+// ErrorDomain домен причин ошибок RBAC (google.rpc.ErrorInfo.Domain)
+const ErrorDomain apperr.Domain = "rbac"
 
 // Ошибки доменной модели
 var (
-	ErrRoleNotFound              = errors.New("роль не найдена")
-	ErrRoleAlreadyExists         = errors.New("роль с таким именем уже существует")
-	ErrPermissionNotFound        = errors.New("право доступа не найдено")
-	ErrUserRoleNotFound          = errors.New("связь пользователь-роль не найдена")
-	ErrRolePermissionNotFound    = errors.New("связь роль-право не найдена")
-	ErrPermissionAlreadyAssigned = errors.New("право уже назначено роли")
-	ErrPermissionNotAssigned     = errors.New("право не назначено роли")
-	ErrRoleAlreadyAssigned       = errors.New("роль уже назначена пользователю")
-	ErrRoleNotAssigned           = errors.New("роль не назначена пользователю")
-	ErrFailedToCreateRole        = errors.New("не удалось создать роль")
-	ErrInternal                  = errors.New("внутренняя ошибка")
+	ErrRoleNotFound              = ErrorDomain.NotFound("ROLE_NOT_FOUND", "rbac.role_not_found")
+	ErrRoleAlreadyExists         = ErrorDomain.Conflict("ROLE_ALREADY_EXISTS", "rbac.role_already_exists")
+	ErrSystemRoleProtected       = ErrorDomain.FailedPrecondition("SYSTEM_ROLE_PROTECTED", "rbac.system_role_protected")
+	ErrPermissionNotFound        = ErrorDomain.NotFound("PERMISSION_NOT_FOUND", "rbac.permission_not_found")
+	ErrUserRoleNotFound          = ErrorDomain.NotFound("USER_ROLE_NOT_FOUND", "rbac.user_role_not_found")
+	ErrRolePermissionNotFound    = ErrorDomain.NotFound("ROLE_PERMISSION_NOT_FOUND", "rbac.role_permission_not_found")
+	ErrPermissionAlreadyAssigned = ErrorDomain.Conflict("PERMISSION_ALREADY_ASSIGNED", "rbac.permission_already_assigned")
+	ErrPermissionNotAssigned     = ErrorDomain.FailedPrecondition("PERMISSION_NOT_ASSIGNED", "rbac.permission_not_assigned")
+	ErrRoleAlreadyAssigned       = ErrorDomain.Conflict("ROLE_ALREADY_ASSIGNED", "rbac.role_already_assigned")
+	ErrRoleNotAssigned           = ErrorDomain.FailedPrecondition("ROLE_NOT_ASSIGNED", "rbac.role_not_assigned")
+	ErrInvalidValidityPeriod     = ErrorDomain.Validation("INVALID_VALIDITY_PERIOD", "rbac.invalid_validity_period")
+	ErrInvalidCondition          = ErrorDomain.Validation("INVALID_CONDITION", "rbac.invalid_condition")
+	ErrInvalidCursor             = ErrorDomain.Validation("INVALID_CURSOR", "rbac.invalid_cursor")
+	ErrInvalidPolicy             = ErrorDomain.Validation("INVALID_POLICY", "rbac.invalid_policy")
+	ErrPolicyConflict            = ErrorDomain.Aborted("POLICY_CONFLICT", "rbac.policy_conflict")
+	ErrInvalidPermission         = ErrorDomain.Validation("INVALID_PERMISSION", "rbac.invalid_permission")
+	ErrRoleRequiresApproval      = ErrorDomain.FailedPrecondition("ROLE_REQUIRES_APPROVAL", "rbac.role_requires_approval")
+	ErrApprovalNotRequired       = ErrorDomain.FailedPrecondition("APPROVAL_NOT_REQUIRED", "rbac.approval_not_required")
+	ErrAccessRequestNotFound     = ErrorDomain.NotFound("ACCESS_REQUEST_NOT_FOUND", "rbac.access_request_not_found")
+	ErrAccessRequestExists       = ErrorDomain.Conflict("ACCESS_REQUEST_EXISTS", "rbac.access_request_exists")
+	ErrAccessRequestNotPending   = ErrorDomain.FailedPrecondition("ACCESS_REQUEST_NOT_PENDING", "rbac.access_request_not_pending")
+	ErrNotApprover               = ErrorDomain.PermissionDenied("NOT_APPROVER", "rbac.not_approver")
+	ErrSelfApproval              = ErrorDomain.PermissionDenied("SELF_APPROVAL", "rbac.self_approval")
+	ErrApproverUnknown           = ErrorDomain.Unauthenticated("APPROVER_UNKNOWN", "rbac.approver_unknown")
+	ErrApproverRoleMissing       = ErrorDomain.FailedPrecondition("APPROVER_ROLE_MISSING", "rbac.approver_role_missing")
+	ErrAccessRequestUserMissing  = ErrorDomain.Validation("ACCESS_REQUEST_USER_MISSING", "rbac.access_request_user_missing")
+	ErrSoDViolation              = ErrorDomain.FailedPrecondition("SOD_VIOLATION", "rbac.sod_violation")
+	ErrRoleConstraintNotFound    = ErrorDomain.NotFound("ROLE_CONSTRAINT_NOT_FOUND", "rbac.role_constraint_not_found")
+	ErrRoleConstraintExists      = ErrorDomain.Conflict("ROLE_CONSTRAINT_EXISTS", "rbac.role_constraint_exists")
+	ErrInvalidRoleConstraint     = ErrorDomain.Validation("INVALID_ROLE_CONSTRAINT", "rbac.invalid_role_constraint")
+	ErrAccessReviewNotFound      = ErrorDomain.NotFound("ACCESS_REVIEW_NOT_FOUND", "rbac.access_review_not_found")
+	ErrAccessReviewItemNotFound  = ErrorDomain.NotFound("ACCESS_REVIEW_ITEM_NOT_FOUND", "rbac.access_review_item_not_found")
+	ErrAccessReviewClosed        = ErrorDomain.FailedPrecondition("ACCESS_REVIEW_CLOSED", "rbac.access_review_closed")
+	ErrNotReviewer               = ErrorDomain.PermissionDenied("NOT_REVIEWER", "rbac.not_reviewer")
+	ErrSelfReview                = ErrorDomain.PermissionDenied("SELF_REVIEW", "rbac.self_review")
+	ErrInvalidDueDate            = ErrorDomain.Validation("INVALID_DUE_DATE", "rbac.invalid_due_date")
+	ErrCacheMiss                 = ErrorDomain.Internal("CACHE_MISS", "rbac.cache_miss")
+	ErrFailedToCreateRole        = ErrorDomain.Internal("ROLE_CREATE_FAILED", "rbac.role_create_failed")
+	ErrInternal                  = ErrorDomain.Internal("INTERNAL", "rbac.internal")
 )
@@ -0,0 +1,191 @@
+package model
+
+import "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+
+// Ключи уточняющих сообщений ошибок (apperr.Error.WithMessage)
+const (
+	MsgPolicyEmpty                = "rbac.policy.empty"
+	MsgPolicyUnsupportedVersion   = "rbac.policy.unsupported_version"
+	MsgPolicyParseFailed          = "rbac.policy.parse_failed"
+	MsgPolicyPermissionEmpty      = "rbac.policy.permission_empty"
+	MsgPolicyPermissionDuplicate  = "rbac.policy.permission_duplicate"
+	MsgPolicyResourceEmpty        = "rbac.policy.resource_empty"
+	MsgPolicyResourceTooLong      = "rbac.policy.resource_too_long"
+	MsgPolicyResourceInvalidChars = "rbac.policy.resource_invalid_chars"
+	MsgPolicyActionEmpty          = "rbac.policy.action_empty"
+	MsgPolicyActionTooLong        = "rbac.policy.action_too_long"
+	MsgPolicyActionInvalidChars   = "rbac.policy.action_invalid_chars"
+	MsgPolicyRoleEmpty            = "rbac.policy.role_empty"
+	MsgPolicyRoleNameLength       = "rbac.policy.role_name_length"
+	MsgPolicyRoleDuplicate        = "rbac.policy.role_duplicate"
+	MsgPolicyRoleInherits         = "rbac.policy.role_inherits"
+	MsgPolicyRoleSystem           = "rbac.policy.role_system"
+	MsgPolicyApproverUndeclared   = "rbac.policy.approver_undeclared"
+	MsgPolicyGrantEmpty           = "rbac.policy.grant_empty"
+	MsgPolicyGrantUndeclared      = "rbac.policy.grant_undeclared"
+	MsgPolicyGrantDuplicate       = "rbac.policy.grant_duplicate"
+	MsgPolicyGrantEffect          = "rbac.policy.grant_effect"
+	MsgPolicyGrantCondition       = "rbac.policy.grant_condition"
+	MsgConditionDetails           = "rbac.condition.details"
+	MsgPermissionValue            = "rbac.permission.value"
+	MsgSoDConflictingRole         = "rbac.sod.conflicting_role"
+)
+
+// Ключи причин решения в объяснении доступа (AccessExplanation.Reason)
+const (
+	MsgExplainDenied           = "rbac.explain.denied"
+	MsgExplainAllowed          = "rbac.explain.allowed"
+	MsgExplainNoRoles          = "rbac.explain.no_roles"
+	MsgExplainNotGranted       = "rbac.explain.not_granted"
+	MsgExplainConditionsNotMet = "rbac.explain.conditions_not_met"
+)
+
+func init() {
+	i18n.Register(i18n.RU, map[string]string{
+		"rbac.role_not_found":               "роль не найдена",
+		"rbac.role_already_exists":          "роль с таким именем уже существует",
+		"rbac.system_role_protected":        "системную роль нельзя удалить или переименовать",
+		"rbac.permission_not_found":         "право доступа не найдено",
+		"rbac.user_role_not_found":          "связь пользователь-роль не найдена",
+		"rbac.role_permission_not_found":    "связь роль-право не найдена",
+		"rbac.permission_already_assigned":  "право уже назначено роли",
+		"rbac.permission_not_assigned":      "право не назначено роли",
+		"rbac.role_already_assigned":        "роль уже назначена пользователю",
+		"rbac.role_not_assigned":            "роль не назначена пользователю",
+		"rbac.invalid_validity_period":      "окончание действия назначения должно быть позже начала",
+		"rbac.invalid_condition":            "некорректное условие назначения права",
+		"rbac.invalid_cursor":               "некорректный курсор пагинации",
+		"rbac.invalid_policy":               "некорректный документ политики",
+		"rbac.policy_conflict":              "состояние ролей изменилось во время применения политики",
+		"rbac.invalid_permission":           "некорректное право, ожидается формат resource:action",
+		"rbac.role_requires_approval":       "роль назначается только через согласованную заявку",
+		"rbac.approval_not_required":        "роль не требует согласования",
+		"rbac.access_request_not_found":     "заявка на доступ не найдена",
+		"rbac.access_request_exists":        "заявка пользователя на роль уже ожидает решения",
+		"rbac.access_request_not_pending":   "заявка уже рассмотрена или истекла",
+		"rbac.not_approver":                 "пользователь не входит в число согласующих роли",
+		"rbac.self_approval":                "нельзя согласовать собственную заявку",
+		"rbac.approver_unknown":             "решение по заявке принимает только пользователь",
+		"rbac.approver_role_missing":        "для роли не задана роль согласующих",
+		"rbac.access_request_user_missing":  "не указан пользователь заявки",
+		"rbac.sod_violation":                "назначение нарушает ограничение разделения обязанностей",
+		"rbac.role_constraint_not_found":    "ограничение ролей не найдено",
+		"rbac.role_constraint_exists":       "ограничение ролей с таким именем уже существует",
+		"rbac.invalid_role_constraint":      "ограничение должно содержать не менее двух разных ролей",
+		"rbac.access_review_not_found":      "кампания пересмотра доступа не найдена",
+		"rbac.access_review_item_not_found": "назначение кампании пересмотра не найдено",
+		"rbac.access_review_closed":         "кампания пересмотра доступа закрыта",
+		"rbac.not_reviewer":                 "пользователь не входит в число проверяющих кампании",
+		"rbac.self_review":                  "нельзя пересматривать собственное назначение",
+		"rbac.invalid_due_date":             "срок рассмотрения должен быть в будущем",
+		"rbac.cache_miss":                   "роль отсутствует в кэше",
+		"rbac.role_create_failed":           "не удалось создать роль",
+		"rbac.internal":                     "внутренняя ошибка",
+
+		MsgPolicyEmpty:                "некорректный документ политики: документ пуст",
+		MsgPolicyUnsupportedVersion:   "некорректный документ политики: неподдерживаемая версия %d",
+		MsgPolicyParseFailed:          "некорректный документ политики: %s",
+		MsgPolicyPermissionEmpty:      "некорректный документ политики: пустое право",
+		MsgPolicyPermissionDuplicate:  "некорректный документ политики: право %q объявлено повторно",
+		MsgPolicyResourceEmpty:        "некорректный документ политики: ресурс права %q не может быть пустым",
+		MsgPolicyResourceTooLong:      "некорректный документ политики: длина ресурса права %q превышает %d символов",
+		MsgPolicyResourceInvalidChars: "некорректный документ политики: ресурс права %q содержит недопустимые символы %q или %q",
+		MsgPolicyActionEmpty:          "некорректный документ политики: действие права %q не может быть пустым",
+		MsgPolicyActionTooLong:        "некорректный документ политики: длина действия права %q превышает %d символов",
+		MsgPolicyActionInvalidChars:   "некорректный документ политики: действие права %q содержит недопустимые символы %q или %q",
+		MsgPolicyRoleEmpty:            "некорректный документ политики: пустая роль",
+		MsgPolicyRoleNameLength:       "некорректный документ политики: имя роли %q должно содержать от %d до %d символов",
+		MsgPolicyRoleDuplicate:        "некорректный документ политики: роль %q объявлена повторно",
+		MsgPolicyRoleInherits:         "некорректный документ политики: роль %q наследует роли, но иерархия ролей не поддерживается",
+		MsgPolicyRoleSystem:           "некорректный документ политики: роль %q не является системной, признак system импортом не меняется",
+		MsgPolicyApproverUndeclared:   "некорректный документ политики: роль %q ссылается на необъявленную роль согласующих %q",
+		MsgPolicyGrantEmpty:           "некорректный документ политики: пустое назначение у роли %q",
+		MsgPolicyGrantUndeclared:      "некорректный документ политики: роль %q ссылается на необъявленное право %q",
+		MsgPolicyGrantDuplicate:       "некорректный документ политики: право %q назначено роли %q повторно",
+		MsgPolicyGrantEffect:          "некорректный документ политики: неизвестный эффект %q у права %q роли %q",
+		MsgPolicyGrantCondition:       "некорректный документ политики: условие права %q роли %q: %s",
+		MsgConditionDetails:           "некорректное условие назначения права: %s",
+		MsgPermissionValue:            "некорректное право %q, ожидается конкретное разрешение resource:action",
+		MsgSoDConflictingRole:         "пользователь уже имеет роль %s из того же набора",
+
+		MsgExplainDenied:           "право %s явно запрещено назначением %s роли %s",
+		MsgExplainAllowed:          "право %s разрешено назначением %s роли %s",
+		MsgExplainNoRoles:          "у пользователя нет действующих ролей",
+		MsgExplainNotGranted:       "право %s не назначено ни одной роли пользователя",
+		MsgExplainConditionsNotMet: "условия назначений права %s не выполнены",
+	})
+
+	i18n.Register(i18n.EN, map[string]string{
+		"rbac.role_not_found":               "role not found",
+		"rbac.role_already_exists":          "role with this name already exists",
+		"rbac.system_role_protected":        "system role cannot be deleted or renamed",
+		"rbac.permission_not_found":         "permission not found",
+		"rbac.user_role_not_found":          "user role assignment not found",
+		"rbac.role_permission_not_found":    "role permission assignment not found",
+		"rbac.permission_already_assigned":  "permission is already assigned to the role",
+		"rbac.permission_not_assigned":      "permission is not assigned to the role",
+		"rbac.role_already_assigned":        "role is already assigned to the user",
+		"rbac.role_not_assigned":            "role is not assigned to the user",
+		"rbac.invalid_validity_period":      "assignment end must be after its start",
+		"rbac.invalid_condition":            "invalid permission condition",
+		"rbac.invalid_cursor":               "invalid pagination cursor",
+		"rbac.invalid_policy":               "invalid policy document",
+		"rbac.policy_conflict":              "roles changed while the policy was being applied",
+		"rbac.invalid_permission":           "invalid permission, expected resource:action",
+		"rbac.role_requires_approval":       "role can only be assigned through an approved access request",
+		"rbac.approval_not_required":        "role does not require approval",
+		"rbac.access_request_not_found":     "access request not found",
+		"rbac.access_request_exists":        "user already has a pending request for this role",
+		"rbac.access_request_not_pending":   "access request is already decided or expired",
+		"rbac.not_approver":                 "user is not an approver of the role",
+		"rbac.self_approval":                "you cannot decide your own access request",
+		"rbac.approver_unknown":             "access request can only be decided by a user",
+		"rbac.approver_role_missing":        "role has no approver role configured",
+		"rbac.access_request_user_missing":  "access request user is not specified",
+		"rbac.sod_violation":                "assignment violates a separation of duties constraint",
+		"rbac.role_constraint_not_found":    "role constraint not found",
+		"rbac.role_constraint_exists":       "role constraint with this name already exists",
+		"rbac.invalid_role_constraint":      "constraint must contain at least two different roles",
+		"rbac.access_review_not_found":      "access review campaign not found",
+		"rbac.access_review_item_not_found": "access review item not found",
+		"rbac.access_review_closed":         "access review campaign is closed",
+		"rbac.not_reviewer":                 "user is not a reviewer of the campaign",
+		"rbac.self_review":                  "you cannot review your own assignment",
+		"rbac.invalid_due_date":             "review due date must be in the future",
+		"rbac.cache_miss":                   "role is missing in cache",
+		"rbac.role_create_failed":           "failed to create role",
+		"rbac.internal":                     "internal error",
+
+		MsgPolicyEmpty:                "invalid policy document: document is empty",
+		MsgPolicyUnsupportedVersion:   "invalid policy document: unsupported version %d",
+		MsgPolicyParseFailed:          "invalid policy document: %s",
+		MsgPolicyPermissionEmpty:      "invalid policy document: empty permission",
+		MsgPolicyPermissionDuplicate:  "invalid policy document: permission %q is declared twice",
+		MsgPolicyResourceEmpty:        "invalid policy document: resource of permission %q must not be empty",
+		MsgPolicyResourceTooLong:      "invalid policy document: resource of permission %q exceeds %d characters",
+		MsgPolicyResourceInvalidChars: "invalid policy document: resource of permission %q contains forbidden characters %q or %q",
+		MsgPolicyActionEmpty:          "invalid policy document: action of permission %q must not be empty",
+		MsgPolicyActionTooLong:        "invalid policy document: action of permission %q exceeds %d characters",
+		MsgPolicyActionInvalidChars:   "invalid policy document: action of permission %q contains forbidden characters %q or %q",
+		MsgPolicyRoleEmpty:            "invalid policy document: empty role",
+		MsgPolicyRoleNameLength:       "invalid policy document: role name %q must be %d to %d characters long",
+		MsgPolicyRoleDuplicate:        "invalid policy document: role %q is declared twice",
+		MsgPolicyRoleInherits:         "invalid policy document: role %q inherits roles, but role hierarchy is not supported",
+		MsgPolicyRoleSystem:           "invalid policy document: role %q is not a system role, import does not change the system flag",
+		MsgPolicyApproverUndeclared:   "invalid policy document: role %q references undeclared approver role %q",
+		MsgPolicyGrantEmpty:           "invalid policy document: empty grant in role %q",
+		MsgPolicyGrantUndeclared:      "invalid policy document: role %q refers to undeclared permission %q",
+		MsgPolicyGrantDuplicate:       "invalid policy document: permission %q is granted to role %q twice",
+		MsgPolicyGrantEffect:          "invalid policy document: unknown effect %q of permission %q in role %q",
+		MsgPolicyGrantCondition:       "invalid policy document: condition of permission %q in role %q: %s",
+		MsgConditionDetails:           "invalid permission condition: %s",
+		MsgPermissionValue:            "invalid permission %q, expected a concrete resource:action",
+		MsgSoDConflictingRole:         "user already has role %s from the same set",
+
+		MsgExplainDenied:           "permission %s is explicitly denied by grant %s of role %s",
+		MsgExplainAllowed:          "permission %s is allowed by grant %s of role %s",
+		MsgExplainNoRoles:          "user has no active roles",
+		MsgExplainNotGranted:       "permission %s is not granted to any of the user's roles",
+		MsgExplainConditionsNotMet: "conditions of grants for permission %s are not met",
+	})
+}
@@ -2,11 +2,16 @@ package model
 
 import (
 	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 )
 
-// Permission представляет право доступа
+// Permission представляет право доступа.
+// Resource и Action могут быть шаблоном "*", Effect и Condition заполняются для прав в составе роли.
 type Permission struct {
-	ID       uuid.UUID
-	Resource string
-	Action   string
+	ID        uuid.UUID
+	Resource  string
+	Action    string
+	Effect    authz.Effect
+	Condition string
 }
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+)
+
+// PolicyDocumentVersion версия формата документа политики
+const PolicyDocumentVersion = 1
+
+// PolicyFormat формат сериализации документа политики
+type PolicyFormat int
+
+const (
+	PolicyFormatYAML PolicyFormat = iota
+	PolicyFormatJSON
+)
+
+// PolicyDocument декларативное описание состояния RBAC.
+// Права идентифицируются строкой resource:action, роли — именем,
+// поэтому документ переносим между окружениями.
+// Роли плоские: права роли задаются только ее назначениями, наследования между ролями нет.
+type PolicyDocument struct {
+	Version     int                 `yaml:"version" json:"version"`
+	Permissions []*PolicyPermission `yaml:"permissions" json:"permissions"`
+	Roles       []*PolicyRole       `yaml:"roles" json:"roles"`
+}
+
+// PolicyPermission право доступа в документе политики
+type PolicyPermission struct {
+	Resource string `yaml:"resource" json:"resource"`
+	Action   string `yaml:"action" json:"action"`
+}
+
+// Key возвращает право в формате resource:action
+func (p *PolicyPermission) Key() string {
+	return p.Resource + authz.Separator + p.Action
+}
+
+// PolicyRole роль с назначенными правами в документе политики
+type PolicyRole struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// System заполняется при выгрузке. При импорте признак не меняется:
+	// system: true допустимо только для существующей системной роли
+	System bool `yaml:"system,omitempty" json:"system,omitempty"`
+	// RequiresApproval роль назначается только через согласованную заявку
+	RequiresApproval bool `yaml:"requires_approval,omitempty" json:"requires_approval,omitempty"`
+	// Approver имя роли, участники которой согласуют заявки; роль должна быть объявлена в документе
+	Approver string `yaml:"approver,omitempty" json:"approver,omitempty"`
+	// Inherits иерархия ролей не поддерживается; поле объявлено, чтобы документ
+	// с наследованием отклонялся явной ошибкой, а не молча терял родительские роли
+	Inherits []string       `yaml:"inherits,omitempty" json:"inherits,omitempty"`
+	Grants   []*PolicyGrant `yaml:"grants,omitempty" json:"grants,omitempty"`
+}
+
+// PolicyGrant назначение права роли в документе политики
+type PolicyGrant struct {
+	// Permission право в формате resource:action
+	Permission string `yaml:"permission" json:"permission"`
+	// Effect allow или deny; пустое значение означает allow
+	Effect    string `yaml:"effect,omitempty" json:"effect,omitempty"`
+	Condition string `yaml:"condition,omitempty" json:"condition,omitempty"`
+}
+
+// PolicyState текущее состояние RBAC вместе с идентификаторами ролей и прав
+type PolicyState struct {
+	Document *PolicyDocument
+	// RoleIDs идентификаторы активных ролей по имени
+	RoleIDs map[string]string
+	// PermissionIDs идентификаторы прав по ключу resource:action
+	PermissionIDs map[string]string
+}
+
+// PolicyChangeType тип изменения в плане применения политики
+type PolicyChangeType string
+
+const (
+	PolicyChangeCreatePermission PolicyChangeType = "create_permission"
+	PolicyChangeCreateRole       PolicyChangeType = "create_role"
+	PolicyChangeUpdateRole       PolicyChangeType = "update_role"
+	PolicyChangeDeleteRole       PolicyChangeType = "delete_role"
+	PolicyChangeAddGrant         PolicyChangeType = "add_grant"
+	PolicyChangeUpdateGrant      PolicyChangeType = "update_grant"
+	PolicyChangeRemoveGrant      PolicyChangeType = "remove_grant"
+)
+
+// PolicyChange изменение в плане применения политики
+type PolicyChange struct {
+	Type PolicyChangeType `json:"type"`
+	Role string           `json:"role,omitempty"`
+	// RoleID заполняется при применении плана
+	RoleID      string       `json:"role_id,omitempty"`
+	Permission  string       `json:"permission,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Effect      authz.Effect `json:"effect"`
+	Condition   string       `json:"condition,omitempty"`
+	// RequiresApproval и Approver настройки согласования роли после изменения
+	RequiresApproval bool   `json:"requires_approval,omitempty"`
+	Approver         string `json:"approver,omitempty"`
+}
+
+// PolicyPlan упорядоченный список изменений, приводящих текущее состояние к документу
+type PolicyPlan struct {
+	Changes []*PolicyChange `json:"changes"`
+}
+
+// Empty сообщает, что документ совпадает с текущим состоянием
+func (p *PolicyPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
@@ -8,10 +8,42 @@ import (
 
 // Role представляет роль пользователя
 type Role struct {
-	ID          uuid.UUID
-	Name        string
-	Description string
-	CreatedAt   time.Time
-	UpdatedAt   *time.Time
-	DeletedAt   *time.Time
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IsSystem    bool      `json:"is_system"`
+	// RequiresApproval роль назначается только через согласованную заявку
+	RequiresApproval bool `json:"requires_approval"`
+	// ApproverRoleID роль, участники которой согласуют заявки
+	ApproverRoleID *string    `json:"approver_role_id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+}
+
+// RoleStatus фильтр списка ролей по состоянию удаления
+type RoleStatus int
+
+const (
+	// RoleStatusActive только активные роли
+	RoleStatusActive RoleStatus = iota
+	// RoleStatusDeleted только удаленные роли
+	RoleStatusDeleted
+	// RoleStatusAll все роли
+	RoleStatusAll
+)
+
+// RoleFilter фильтры и пагинация списка ролей
+type RoleFilter struct {
+	Status        RoleStatus
+	NameQuery     string   // подстрока имени без учета регистра
+	PermissionIDs []string // роли, которым назначены все перечисленные права
+	Limit         int32    // 0 — без ограничения
+	Cursor        string
+}
+
+// RoleListItem роль в списке с количеством пользователей с действующим назначением
+type RoleListItem struct {
+	Role      *Role
+	UserCount int32
 }
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleConstraint ограничение разделения обязанностей:
+// пользователь может иметь не более одной роли из набора
+type RoleConstraint struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	RoleIDs     []string  `json:"role_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateRoleConstraint данные нового ограничения
+type CreateRoleConstraint struct {
+	Name        string
+	Description *string
+	RoleIDs     []string
+}
+
+// ConstraintViolation пользователь, уже имеющий несколько ролей из набора
+type ConstraintViolation struct {
+	ConstraintID   string   `json:"constraint_id"`
+	ConstraintName string   `json:"constraint_name"`
+	UserID         string   `json:"user_id"`
+	RoleIDs        []string `json:"role_ids"`
+}
+
+// SoDViolationError отказ в назначении роли из-за ограничения разделения обязанностей.
+// Сравнивается с ErrSoDViolation через errors.Is, в gRPC статус попадает с деталями
+// ErrorInfo (идентификаторы ограничения и ролей) и PreconditionFailure.
+type SoDViolationError struct {
+	ConstraintID   string
+	ConstraintName string
+	// RoleID назначаемая роль
+	RoleID string
+	// ConflictingRoleID уже имеющаяся у пользователя роль из того же набора
+	ConflictingRoleID string
+}
+
+func (e *SoDViolationError) Error() string {
+	return fmt.Sprintf("%s: ограничение %s, роль %s конфликтует с ролью %s",
+		ErrSoDViolation, e.ConstraintName, e.RoleID, e.ConflictingRoleID)
+}
+
+func (e *SoDViolationError) Unwrap() error {
+	return ErrSoDViolation.
+		WithMetadata("constraint_id", e.ConstraintID).
+		WithMetadata("constraint_name", e.ConstraintName).
+		WithMetadata("role_id", e.RoleID).
+		WithMetadata("conflicting_role_id", e.ConflictingRoleID).
+		WithViolation(ErrSoDViolation.Reason(), e.ConstraintName, MsgSoDConflictingRole, e.ConflictingRoleID)
+}
@@ -0,0 +1,14 @@
+package model
+
+// UserProfile данные пользователя из IAM, нужные для отображения участников роли
+type UserProfile struct {
+	ID    string
+	Login string
+	Email string
+}
+
+// RoleMember участник роли. Profile равен nil, если IAM не вернул пользователя
+type RoleMember struct {
+	UserID  string
+	Profile *UserProfile
+}
@@ -5,4 +5,8 @@ type UpdateRole struct {
 	ID          string
 	Name        *string
 	Description *string
+	// RequiresApproval включает или отключает согласование назначений роли
+	RequiresApproval *bool
+	// ApproverRoleID роль согласующих; пустая строка снимает ограничение
+	ApproverRoleID *string
 }
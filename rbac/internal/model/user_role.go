@@ -8,8 +8,10 @@ import (
 
 // UserRole представляет связь пользователь-роль
 type UserRole struct {
-	UserID     uuid.UUID
-	RoleID     uuid.UUID
-	AssignedBy *uuid.UUID
-	AssignedAt time.Time
+	UserID     uuid.UUID  `json:"user_id"`
+	RoleID     uuid.UUID  `json:"role_id"`
+	AssignedBy *uuid.UUID `json:"assigned_by,omitempty"`
+	AssignedAt time.Time  `json:"assigned_at"`
+	ValidFrom  time.Time  `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
 }
@@ -0,0 +1,43 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessRequestRepository) Create(ctx context.Context, request *model.CreateAccessRequest) (*model.AccessRequest, error) {
+	query := `
+		INSERT INTO access_requests (user_id, role_id, requested_by, justification, valid_until, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + accessRequestColumns
+
+	rows, err := r.writePool.Query(ctx, query,
+		request.UserID, request.RoleID, request.RequestedBy, request.Justification, request.ValidUntil, request.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: create access request failed: %w", model.ErrInternal, err)
+	}
+
+	created, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessRequest])
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505": // Unique constraint violation
+				return nil, model.ErrAccessRequestExists
+			case "23503": // Foreign key constraint violation
+				return nil, model.ErrRoleNotFound
+			}
+		}
+		return nil, fmt.Errorf("%w: create access request failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessRequestToDomain(&created), nil
+}
@@ -0,0 +1,38 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// Decide фиксирует решение только для ожидающей и не истекшей заявки,
+// поэтому два согласующих не могут рассмотреть одну заявку одновременно
+func (r *accessRequestRepository) Decide(ctx context.Context, decision *model.AccessRequestDecision) (*model.AccessRequest, error) {
+	query := `
+		UPDATE access_requests
+		SET status = $2, decided_by = $3, decided_at = NOW(), decision_comment = $4
+		WHERE id = $1 AND status = 'pending' AND expires_at > NOW()
+		RETURNING ` + accessRequestColumns
+
+	rows, err := r.writePool.Query(ctx, query, decision.ID, string(decision.Status), decision.DecidedBy, decision.Comment)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decide access request failed: %w", model.ErrInternal, err)
+	}
+
+	request, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessRequest])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAccessRequestNotPending
+		}
+		return nil, fmt.Errorf("%w: decide access request failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessRequestToDomain(&request), nil
+}
@@ -0,0 +1,40 @@
+package access_request
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// ExpirePending переводит пачку просроченных ожидающих заявок в expired и возвращает их
+func (r *accessRequestRepository) ExpirePending(ctx context.Context, limit int32) ([]*model.AccessRequest, error) {
+	query := `
+		UPDATE access_requests
+		SET status = 'expired'
+		WHERE id IN (
+			SELECT id FROM access_requests
+			WHERE status = 'pending' AND expires_at <= NOW()
+			ORDER BY expires_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + accessRequestColumns
+
+	rows, err := r.writePool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: expire access requests failed: %w", model.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	expired, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AccessRequest])
+	if err != nil {
+		return nil, fmt.Errorf("%w: collect expired access requests failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessRequestsToDomain(expired), nil
+}
@@ -0,0 +1,32 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessRequestRepository) Get(ctx context.Context, id string) (*model.AccessRequest, error) {
+	query := `SELECT ` + accessRequestColumns + ` FROM access_requests WHERE id = $1`
+
+	rows, err := r.readPool.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: get access request failed: %w", model.ErrInternal, err)
+	}
+
+	request, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessRequest])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAccessRequestNotFound
+		}
+		return nil, fmt.Errorf("%w: get access request failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessRequestToDomain(&request), nil
+}
@@ -0,0 +1,65 @@
+package access_request
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessRequestRepository) List(ctx context.Context, filter *model.AccessRequestFilter) ([]*model.AccessRequest, *string, error) {
+	builder := sq.StatementBuilder.
+		Select(accessRequestColumns).
+		From("access_requests").
+		OrderBy("seq DESC").
+		Limit(uint64(filter.Limit) + 1).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != "" {
+		seq, err := strconv.ParseInt(filter.Cursor, 10, 64)
+		if err != nil {
+			return nil, nil, model.ErrInvalidCursor
+		}
+		builder = builder.Where(sq.Lt{"seq": seq})
+	}
+	if filter.Status != nil {
+		builder = builder.Where(sq.Eq{"status": string(*filter.Status)})
+	}
+	if filter.UserID != nil {
+		builder = builder.Where(sq.Eq{"user_id": *filter.UserID})
+	}
+	if filter.RoleID != nil {
+		builder = builder.Where(sq.Eq{"role_id": *filter.RoleID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed to build select query: %w", model.ErrInternal, err)
+	}
+
+	rows, err := r.readPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list access requests failed: %w", err)
+	}
+	defer rows.Close()
+
+	requests, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AccessRequest])
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect access requests failed: %w", err)
+	}
+
+	var nextCursor *string
+	if len(requests) > int(filter.Limit) {
+		requests = requests[:filter.Limit]
+		next := strconv.FormatInt(requests[len(requests)-1].Seq, 10)
+		nextCursor = &next
+	}
+
+	return converter.AccessRequestsToDomain(requests), nextCursor, nil
+}
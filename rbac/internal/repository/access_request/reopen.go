@@ -0,0 +1,22 @@
+package access_request
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Reopen возвращает согласованную заявку в ожидание, если роль назначить не удалось
+func (r *accessRequestRepository) Reopen(ctx context.Context, id string) error {
+	query := `
+		UPDATE access_requests
+		SET status = 'pending', decided_by = NULL, decided_at = NULL, decision_comment = NULL
+		WHERE id = $1 AND status = 'approved'`
+
+	if _, err := r.writePool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("%w: reopen access request failed: %w", model.ErrInternal, err)
+	}
+
+	return nil
+}
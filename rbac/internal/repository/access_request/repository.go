@@ -0,0 +1,25 @@
+package access_request
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+)
+
+var _ def.AccessRequestRepository = (*accessRequestRepository)(nil)
+
+// accessRequestColumns колонки заявки в порядке repoModel.AccessRequest
+const accessRequestColumns = `seq, id, user_id, role_id, requested_by, justification, valid_until,
+	status, created_at, expires_at, decided_by, decided_at, decision_comment`
+
+type accessRequestRepository struct {
+	writePool *pgxpool.Pool // Primary - для записи (INSERT, UPDATE)
+	readPool  *pgxpool.Pool // Replica - для чтения (SELECT)
+}
+
+func NewRepository(writePool, readPool *pgxpool.Pool) *accessRequestRepository {
+	return &accessRequestRepository{
+		writePool: writePool,
+		readPool:  readPool,
+	}
+}
@@ -0,0 +1,52 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Close закрывает открытую кампанию. Назначения без решения при revokeUnreviewed
+// помечаются к отзыву от имени закрывающего в той же транзакции.
+func (r *accessReviewRepository) Close(ctx context.Context, id string, closedBy *string, revokeUnreviewed bool) (*model.AccessReviewCampaign, error) {
+	var closed *model.AccessReviewCampaign
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `
+			UPDATE access_review_campaigns
+			SET status = 'closed', closed_by = $2, closed_at = NOW()
+			WHERE id = $1 AND status = 'open'`,
+			id, closedBy)
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected() == 0 {
+			return model.ErrAccessReviewClosed
+		}
+
+		if revokeUnreviewed {
+			_, err = tx.Exec(ctx, `
+				UPDATE access_review_items
+				SET decision = 'revoke', reviewed_by = $2, reviewed_at = NOW()
+				WHERE campaign_id = $1 AND decision = 'pending'`,
+				id, closedBy)
+			if err != nil {
+				return err
+			}
+		}
+
+		closed, err = getCampaign(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrAccessReviewClosed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: close access review failed: %w", model.ErrInternal, err)
+	}
+
+	return closed, nil
+}
@@ -0,0 +1,56 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (r *accessReviewRepository) Create(ctx context.Context, campaign *model.CreateAccessReview) (*model.AccessReviewCampaign, error) {
+	userIDs := make([]string, 0, len(campaign.Bindings))
+	roleIDs := make([]string, 0, len(campaign.Bindings))
+	for _, binding := range campaign.Bindings {
+		userIDs = append(userIDs, binding.UserID)
+		roleIDs = append(roleIDs, binding.RoleID)
+	}
+
+	var created *model.AccessReviewCampaign
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		var id string
+		err := tx.QueryRow(ctx, `
+			INSERT INTO access_review_campaigns (name, description, role_ids, reviewer_ids, created_by, due_at)
+			VALUES ($1, $2, $3::uuid[], $4::uuid[], $5, $6)
+			RETURNING id::text`,
+			campaign.Name, campaign.Description, campaign.RoleIDs, campaign.ReviewerIDs, campaign.CreatedBy, campaign.DueAt).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO access_review_items (campaign_id, user_id, role_id)
+			SELECT $1, u.user_id, u.role_id
+			FROM unnest($2::uuid[], $3::uuid[]) AS u(user_id, role_id)
+			ON CONFLICT (campaign_id, user_id, role_id) DO NOTHING`,
+			id, userIDs, roleIDs)
+		if err != nil {
+			return err
+		}
+
+		created, err = getCampaign(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // Foreign key constraint violation
+			return nil, model.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("%w: create access review failed: %w", model.ErrInternal, err)
+	}
+
+	return created, nil
+}
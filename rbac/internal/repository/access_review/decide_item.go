@@ -0,0 +1,40 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// DecideItem фиксирует решение только в открытой кампании, поэтому решение,
+// принятое одновременно с закрытием, не останется без применения
+func (r *accessReviewRepository) DecideItem(ctx context.Context, decision *model.AccessReviewItemDecision) (*model.AccessReviewItem, error) {
+	query := `
+		UPDATE access_review_items
+		SET decision = $3, reviewed_by = $4, reviewed_at = NOW(), comment = $5
+		WHERE id = $1 AND campaign_id = $2
+			AND EXISTS (SELECT 1 FROM access_review_campaigns c WHERE c.id = $2 AND c.status = 'open' FOR SHARE)
+		RETURNING ` + itemColumns
+
+	rows, err := r.writePool.Query(ctx, query,
+		decision.ItemID, decision.CampaignID, string(decision.Decision), decision.ReviewedBy, decision.Comment)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decide access review item failed: %w", model.ErrInternal, err)
+	}
+
+	item, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessReviewItem])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAccessReviewClosed
+		}
+		return nil, fmt.Errorf("%w: decide access review item failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessReviewItemToDomain(&item), nil
+}
@@ -0,0 +1,36 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessReviewRepository) Get(ctx context.Context, id string) (*model.AccessReviewCampaign, error) {
+	return getCampaign(ctx, r.readPool, id)
+}
+
+func getCampaign(ctx context.Context, q querier, id string) (*model.AccessReviewCampaign, error) {
+	query := `SELECT ` + campaignColumns + ` FROM ` + campaignFrom + ` WHERE c.id = $1 GROUP BY c.seq`
+
+	rows, err := q.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: get access review failed: %w", model.ErrInternal, err)
+	}
+
+	campaign, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessReviewCampaign])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAccessReviewNotFound
+		}
+		return nil, fmt.Errorf("%w: get access review failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessReviewCampaignToDomain(&campaign), nil
+}
@@ -0,0 +1,32 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessReviewRepository) GetItem(ctx context.Context, campaignID, itemID string) (*model.AccessReviewItem, error) {
+	query := `SELECT ` + itemColumns + ` FROM access_review_items WHERE id = $1 AND campaign_id = $2`
+
+	rows, err := r.readPool.Query(ctx, query, itemID, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: get access review item failed: %w", model.ErrInternal, err)
+	}
+
+	item, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.AccessReviewItem])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrAccessReviewItemNotFound
+		}
+		return nil, fmt.Errorf("%w: get access review item failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessReviewItemToDomain(&item), nil
+}
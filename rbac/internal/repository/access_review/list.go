@@ -0,0 +1,60 @@
+package access_review
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessReviewRepository) List(ctx context.Context, filter *model.AccessReviewFilter) ([]*model.AccessReviewCampaign, *string, error) {
+	builder := sq.StatementBuilder.
+		Select(campaignColumns).
+		From(campaignFrom).
+		GroupBy("c.seq").
+		OrderBy("c.seq DESC").
+		Limit(uint64(filter.Limit) + 1).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != "" {
+		seq, err := strconv.ParseInt(filter.Cursor, 10, 64)
+		if err != nil {
+			return nil, nil, model.ErrInvalidCursor
+		}
+		builder = builder.Where(sq.Lt{"c.seq": seq})
+	}
+	if filter.Status != nil {
+		builder = builder.Where(sq.Eq{"c.status": string(*filter.Status)})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed to build select query: %w", model.ErrInternal, err)
+	}
+
+	rows, err := r.readPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list access reviews failed: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AccessReviewCampaign])
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect access reviews failed: %w", err)
+	}
+
+	var nextCursor *string
+	if len(campaigns) > int(filter.Limit) {
+		campaigns = campaigns[:filter.Limit]
+		next := strconv.FormatInt(campaigns[len(campaigns)-1].Seq, 10)
+		nextCursor = &next
+	}
+
+	return converter.AccessReviewCampaignsToDomain(campaigns), nextCursor, nil
+}
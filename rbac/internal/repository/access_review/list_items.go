@@ -0,0 +1,60 @@
+package access_review
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *accessReviewRepository) ListItems(ctx context.Context, filter *model.AccessReviewItemFilter) ([]*model.AccessReviewItem, *string, error) {
+	builder := sq.StatementBuilder.
+		Select(itemColumns).
+		From("access_review_items").
+		Where(sq.Eq{"campaign_id": filter.CampaignID}).
+		OrderBy("seq").
+		Limit(uint64(filter.Limit) + 1).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != "" {
+		seq, err := strconv.ParseInt(filter.Cursor, 10, 64)
+		if err != nil {
+			return nil, nil, model.ErrInvalidCursor
+		}
+		builder = builder.Where(sq.Gt{"seq": seq})
+	}
+	if filter.Decision != nil {
+		builder = builder.Where(sq.Eq{"decision": string(*filter.Decision)})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed to build select query: %w", model.ErrInternal, err)
+	}
+
+	rows, err := r.readPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list access review items failed: %w", err)
+	}
+	defer rows.Close()
+
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AccessReviewItem])
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect access review items failed: %w", err)
+	}
+
+	var nextCursor *string
+	if len(items) > int(filter.Limit) {
+		items = items[:filter.Limit]
+		next := strconv.FormatInt(items[len(items)-1].Seq, 10)
+		nextCursor = &next
+	}
+
+	return converter.AccessReviewItemsToDomain(items), nextCursor, nil
+}
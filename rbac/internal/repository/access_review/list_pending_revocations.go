@@ -0,0 +1,33 @@
+package access_review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// ListPendingRevocations читает из primary: вызывается сразу после закрытия кампании
+func (r *accessReviewRepository) ListPendingRevocations(ctx context.Context, campaignID string) ([]*model.AccessReviewItem, error) {
+	query := `SELECT ` + itemColumns + `
+		FROM access_review_items
+		WHERE campaign_id = $1 AND decision = 'revoke' AND revoked_at IS NULL
+		ORDER BY seq`
+
+	rows, err := r.writePool.Query(ctx, query, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: list pending revocations failed: %w", model.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AccessReviewItem])
+	if err != nil {
+		return nil, fmt.Errorf("%w: collect pending revocations failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.AccessReviewItemsToDomain(items), nil
+}
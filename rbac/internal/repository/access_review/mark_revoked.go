@@ -0,0 +1,19 @@
+package access_review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (r *accessReviewRepository) MarkRevoked(ctx context.Context, itemID string) error {
+	_, err := r.writePool.Exec(ctx, `
+		UPDATE access_review_items SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL`,
+		itemID)
+	if err != nil {
+		return fmt.Errorf("%w: mark access review item revoked failed: %w", model.ErrInternal, err)
+	}
+	return nil
+}
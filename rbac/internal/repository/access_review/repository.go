@@ -0,0 +1,47 @@
+package access_review
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+)
+
+var _ def.AccessReviewRepository = (*accessReviewRepository)(nil)
+
+// campaignColumns колонки кампании со сводкой решений в порядке repoModel.AccessReviewCampaign
+const campaignColumns = `c.seq, c.id, c.name, c.description,
+	c.role_ids::text[] AS role_ids, c.reviewer_ids::text[] AS reviewer_ids,
+	c.status, c.created_by, c.created_at, c.due_at, c.closed_by, c.closed_at,
+	COUNT(i.id) AS total,
+	COUNT(i.id) FILTER (WHERE i.decision = 'pending') AS pending,
+	COUNT(i.id) FILTER (WHERE i.decision = 'keep') AS keep,
+	COUNT(i.id) FILTER (WHERE i.decision = 'revoke') AS revoke,
+	COUNT(i.id) FILTER (WHERE i.revoked_at IS NOT NULL) AS revoked`
+
+// campaignFrom источник выборки кампаний; требует GROUP BY c.seq
+const campaignFrom = `access_review_campaigns c
+	LEFT JOIN access_review_items i ON i.campaign_id = c.id`
+
+// itemColumns колонки назначения в порядке repoModel.AccessReviewItem
+const itemColumns = `seq, id, campaign_id, user_id, role_id, decision,
+	reviewed_by, reviewed_at, comment, revoked_at`
+
+// querier общий интерфейс пула и транзакции для чтения кампании
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+type accessReviewRepository struct {
+	writePool *pgxpool.Pool // Primary - для записи (INSERT, UPDATE)
+	readPool  *pgxpool.Pool // Replica - для чтения (SELECT)
+}
+
+func NewRepository(writePool, readPool *pgxpool.Pool) *accessReviewRepository {
+	return &accessReviewRepository{
+		writePool: writePool,
+		readPool:  readPool,
+	}
+}
@@ -0,0 +1,39 @@
+package audit_event
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+)
+
+func (r *auditEventRepository) Create(ctx context.Context, event *model.AuditEvent) error {
+	query, args, err := sq.StatementBuilder.
+		Insert("audit_events").
+		Columns(
+			"id", "occurred_at", "actor_type", "actor_id", "actor_session_id",
+			"action", "target_type", "target_id", "before", "after", "request_id",
+		).
+		Values(
+			event.ID, event.OccurredAt, string(event.Actor.Type),
+			converter.NullableString(event.Actor.ID), converter.NullableString(event.Actor.SessionID),
+			event.Action, event.TargetType, event.TargetID,
+			converter.NullableJSON(event.Before), converter.NullableJSON(event.After),
+			converter.NullableString(event.RequestID),
+		).
+		Suffix("RETURNING seq").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: failed to build insert query: %w", model.ErrInternal, err)
+	}
+
+	if err = r.writePool.QueryRow(ctx, query, args...).Scan(&event.Sequence); err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+
+	return nil
+}
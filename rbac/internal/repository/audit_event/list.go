@@ -0,0 +1,77 @@
+package audit_event
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *auditEventRepository) List(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, *string, error) {
+	builder := sq.StatementBuilder.
+		Select(
+			"seq", "id", "occurred_at", "actor_type", "actor_id", "actor_session_id",
+			"action", "target_type", "target_id", "before", "after", "request_id",
+		).
+		From("audit_events").
+		OrderBy("seq DESC").
+		Limit(uint64(filter.Limit) + 1).
+		PlaceholderFormat(sq.Dollar)
+
+	if filter.Cursor != "" {
+		seq, err := strconv.ParseInt(filter.Cursor, 10, 64)
+		if err != nil {
+			return nil, nil, model.ErrInvalidCursor
+		}
+		builder = builder.Where(sq.Lt{"seq": seq})
+	}
+	if filter.ActorID != nil {
+		builder = builder.Where(sq.Eq{"actor_id": *filter.ActorID})
+	}
+	if filter.Action != nil {
+		builder = builder.Where(sq.Eq{"action": *filter.Action})
+	}
+	if filter.TargetType != nil {
+		builder = builder.Where(sq.Eq{"target_type": *filter.TargetType})
+	}
+	if filter.TargetID != nil {
+		builder = builder.Where(sq.Eq{"target_id": *filter.TargetID})
+	}
+	if filter.From != nil {
+		builder = builder.Where(sq.GtOrEq{"occurred_at": *filter.From})
+	}
+	if filter.To != nil {
+		builder = builder.Where(sq.Lt{"occurred_at": *filter.To})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: failed to build select query: %w", model.ErrInternal, err)
+	}
+
+	rows, err := r.readPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list audit events failed: %w", err)
+	}
+	defer rows.Close()
+
+	events, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.AuditEvent])
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect audit events failed: %w", err)
+	}
+
+	var nextCursor *string
+	if len(events) > int(filter.Limit) {
+		events = events[:filter.Limit]
+		next := strconv.FormatInt(events[len(events)-1].Seq, 10)
+		nextCursor = &next
+	}
+
+	return converter.AuditEventsToDomain(events), nextCursor, nil
+}
@@ -0,0 +1,21 @@
+package audit_event
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+)
+
+var _ def.AuditEventRepository = (*auditEventRepository)(nil)
+
+type auditEventRepository struct {
+	writePool *pgxpool.Pool
+	readPool  *pgxpool.Pool
+}
+
+func NewRepository(writePool, readPool *pgxpool.Pool) *auditEventRepository {
+	return &auditEventRepository{
+		writePool: writePool,
+		readPool:  readPool,
+	}
+}
@@ -0,0 +1,43 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// AccessRequestToDomain преобразует модель репозитория в доменную модель
+func AccessRequestToDomain(repoRequest *repoModel.AccessRequest) *model.AccessRequest {
+	return &model.AccessRequest{
+		ID:              repoRequest.ID,
+		UserID:          repoRequest.UserID.String(),
+		RoleID:          repoRequest.RoleID.String(),
+		RequestedBy:     uuidString(repoRequest.RequestedBy),
+		Justification:   repoRequest.Justification,
+		ValidUntil:      repoRequest.ValidUntil,
+		Status:          model.AccessRequestStatus(repoRequest.Status),
+		CreatedAt:       repoRequest.CreatedAt,
+		ExpiresAt:       repoRequest.ExpiresAt,
+		DecidedBy:       uuidString(repoRequest.DecidedBy),
+		DecidedAt:       repoRequest.DecidedAt,
+		DecisionComment: repoRequest.DecisionComment,
+	}
+}
+
+// AccessRequestsToDomain преобразует массив моделей репозитория в доменные модели
+func AccessRequestsToDomain(repoRequests []repoModel.AccessRequest) []*model.AccessRequest {
+	result := make([]*model.AccessRequest, 0, len(repoRequests))
+	for i := range repoRequests {
+		result = append(result, AccessRequestToDomain(&repoRequests[i]))
+	}
+	return result
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// AccessReviewCampaignToDomain преобразует модель репозитория в доменную модель
+func AccessReviewCampaignToDomain(repoCampaign *repoModel.AccessReviewCampaign) *model.AccessReviewCampaign {
+	return &model.AccessReviewCampaign{
+		ID:          repoCampaign.ID,
+		Name:        repoCampaign.Name,
+		Description: repoCampaign.Description,
+		RoleIDs:     repoCampaign.RoleIDs,
+		ReviewerIDs: repoCampaign.ReviewerIDs,
+		Status:      model.AccessReviewStatus(repoCampaign.Status),
+		CreatedBy:   uuidString(repoCampaign.CreatedBy),
+		CreatedAt:   repoCampaign.CreatedAt,
+		DueAt:       repoCampaign.DueAt,
+		ClosedBy:    uuidString(repoCampaign.ClosedBy),
+		ClosedAt:    repoCampaign.ClosedAt,
+		Summary: model.AccessReviewSummary{
+			Total:   int32(repoCampaign.Total),
+			Pending: int32(repoCampaign.Pending),
+			Keep:    int32(repoCampaign.Keep),
+			Revoke:  int32(repoCampaign.Revoke),
+			Revoked: int32(repoCampaign.Revoked),
+		},
+	}
+}
+
+// AccessReviewCampaignsToDomain преобразует массив моделей репозитория в доменные модели
+func AccessReviewCampaignsToDomain(repoCampaigns []repoModel.AccessReviewCampaign) []*model.AccessReviewCampaign {
+	result := make([]*model.AccessReviewCampaign, 0, len(repoCampaigns))
+	for i := range repoCampaigns {
+		result = append(result, AccessReviewCampaignToDomain(&repoCampaigns[i]))
+	}
+	return result
+}
+
+// AccessReviewItemToDomain преобразует модель репозитория в доменную модель
+func AccessReviewItemToDomain(repoItem *repoModel.AccessReviewItem) *model.AccessReviewItem {
+	return &model.AccessReviewItem{
+		ID:         repoItem.ID,
+		CampaignID: repoItem.CampaignID.String(),
+		UserID:     repoItem.UserID.String(),
+		RoleID:     repoItem.RoleID.String(),
+		Decision:   model.AccessReviewDecision(repoItem.Decision),
+		ReviewedBy: uuidString(repoItem.ReviewedBy),
+		ReviewedAt: repoItem.ReviewedAt,
+		Comment:    repoItem.Comment,
+		RevokedAt:  repoItem.RevokedAt,
+	}
+}
+
+// AccessReviewItemsToDomain преобразует массив моделей репозитория в доменные модели
+func AccessReviewItemsToDomain(repoItems []repoModel.AccessReviewItem) []*model.AccessReviewItem {
+	result := make([]*model.AccessReviewItem, 0, len(repoItems))
+	for i := range repoItems {
+		result = append(result, AccessReviewItemToDomain(&repoItems[i]))
+	}
+	return result
+}
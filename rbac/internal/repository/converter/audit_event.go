@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// AuditEventToDomain преобразует модель репозитория в доменную модель
+func AuditEventToDomain(repoEvent *repoModel.AuditEvent) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:         repoEvent.ID,
+		Sequence:   repoEvent.Seq,
+		OccurredAt: repoEvent.OccurredAt,
+		Actor: audit.Actor{
+			Type:      audit.ActorType(repoEvent.ActorType),
+			ID:        stringValue(repoEvent.ActorID),
+			SessionID: stringValue(repoEvent.ActorSessionID),
+		},
+		Action:     repoEvent.Action,
+		TargetType: repoEvent.TargetType,
+		TargetID:   repoEvent.TargetID,
+		Before:     json.RawMessage(repoEvent.Before),
+		After:      json.RawMessage(repoEvent.After),
+		RequestID:  stringValue(repoEvent.RequestID),
+	}
+}
+
+// AuditEventsToDomain преобразует массив моделей репозитория в доменные модели
+func AuditEventsToDomain(repoEvents []repoModel.AuditEvent) []*model.AuditEvent {
+	result := make([]*model.AuditEvent, 0, len(repoEvents))
+	for _, event := range repoEvents {
+		result = append(result, AuditEventToDomain(&event))
+	}
+	return result
+}
+
+// NullableString возвращает nil для пустой строки
+func NullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// NullableJSON возвращает nil для пустого JSON, чтобы в колонку записывался NULL
+func NullableJSON(value json.RawMessage) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+	return value
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
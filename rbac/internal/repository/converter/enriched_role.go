@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	commonv1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
@@ -25,10 +26,13 @@ func (c *EnrichedRoleCacheConverter) ToCache(enrichedRole *model.EnrichedRole) (
 
 	pbRole := &commonv1.RoleWithPermissions{
 		Role: &commonv1.Role{
-			Id:          enrichedRole.Role.ID.String(),
-			Name:        enrichedRole.Role.Name,
-			Description: enrichedRole.Role.Description,
-			CreatedAt:   timestamppb.New(enrichedRole.Role.CreatedAt),
+			Id:               enrichedRole.Role.ID.String(),
+			Name:             enrichedRole.Role.Name,
+			Description:      enrichedRole.Role.Description,
+			IsSystem:         enrichedRole.Role.IsSystem,
+			RequiresApproval: enrichedRole.Role.RequiresApproval,
+			ApproverRoleId:   enrichedRole.Role.ApproverRoleID,
+			CreatedAt:        timestamppb.New(enrichedRole.Role.CreatedAt),
 			UpdatedAt: func() *timestamppb.Timestamp {
 				if enrichedRole.Role.UpdatedAt != nil {
 					return timestamppb.New(*enrichedRole.Role.UpdatedAt)
@@ -76,11 +80,14 @@ func (c *EnrichedRoleCacheConverter) FromCache(data []byte) (*model.EnrichedRole
 
 	enrichedRole := &model.EnrichedRole{
 		Role: model.Role{
-			ID:          roleID,
-			Name:        pbRole.Role.Name,
-			Description: pbRole.Role.Description,
-			CreatedAt:   pbRole.Role.CreatedAt.AsTime(),
-			UpdatedAt:   updatedAt,
+			ID:               roleID,
+			Name:             pbRole.Role.Name,
+			Description:      pbRole.Role.Description,
+			IsSystem:         pbRole.Role.IsSystem,
+			RequiresApproval: pbRole.Role.RequiresApproval,
+			ApproverRoleID:   pbRole.Role.ApproverRoleId,
+			CreatedAt:        pbRole.Role.CreatedAt.AsTime(),
+			UpdatedAt:        updatedAt,
 		},
 		Permissions: permissions,
 	}
@@ -96,9 +103,11 @@ func PermissionsToCache(permissions []*model.Permission) []*commonv1.Permission
 	pbPermissions := make([]*commonv1.Permission, len(permissions))
 	for i, p := range permissions {
 		pbPermissions[i] = &commonv1.Permission{
-			Id:       p.ID.String(),
-			Resource: p.Resource,
-			Action:   p.Action,
+			Id:        p.ID.String(),
+			Resource:  p.Resource,
+			Action:    p.Action,
+			Effect:    authz.EffectToProto(p.Effect),
+			Condition: p.Condition,
 		}
 	}
 	return pbPermissions
@@ -117,9 +126,11 @@ func PermissionsFromCache(pbPermissions []*commonv1.Permission) []*model.Permiss
 		}
 
 		permissions[i] = &model.Permission{
-			ID:       permissionID,
-			Resource: pbp.Resource,
-			Action:   pbp.Action,
+			ID:        permissionID,
+			Resource:  pbp.Resource,
+			Action:    pbp.Action,
+			Effect:    authz.EffectFromProto(pbp.Effect),
+			Condition: pbp.Condition,
 		}
 	}
 	return permissions
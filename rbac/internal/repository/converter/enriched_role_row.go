@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// EnrichedRoleRowToDomain преобразует строку роли с агрегированными правами в доменную модель
+func EnrichedRoleRowToDomain(row *repoModel.EnrichedRoleRow) (*model.EnrichedRole, error) {
+	var permissions []repoModel.Permission
+	if err := json.Unmarshal(row.PermissionsJSON, &permissions); err != nil {
+		return nil, fmt.Errorf("failed to decode role permissions: %w", err)
+	}
+
+	return &model.EnrichedRole{
+		Role:        *RoleToDomain(&row.Role),
+		Permissions: PermissionsToDomain(permissions),
+	}, nil
+}
+
+// EnrichedRoleRowsToDomain преобразует строки ролей с правами в доменные модели
+func EnrichedRoleRowsToDomain(rows []repoModel.EnrichedRoleRow) ([]*model.EnrichedRole, error) {
+	result := make([]*model.EnrichedRole, 0, len(rows))
+	for i := range rows {
+		enrichedRole, err := EnrichedRoleRowToDomain(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, enrichedRole)
+	}
+	return result, nil
+}
@@ -1,17 +1,23 @@
 package converter
 
 import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
 )
 
 // PermissionToDomain преобразует модель репозитория в доменную модель
 func PermissionToDomain(repoPermission *repoModel.Permission) *model.Permission {
-	return &model.Permission{
+	permission := &model.Permission{
 		ID:       repoPermission.ID,
 		Resource: repoPermission.Resource,
 		Action:   repoPermission.Action,
+		Effect:   EffectToDomain(repoPermission.Effect),
 	}
+	if repoPermission.Condition != nil {
+		permission.Condition = *repoPermission.Condition
+	}
+	return permission
 }
 
 // PermissionsToDomain преобразует массив моделей репозитория в доменные модели
@@ -22,3 +28,19 @@ func PermissionsToDomain(repoPermissions []repoModel.Permission) []*model.Permis
 	}
 	return result
 }
+
+// EffectToDomain преобразует значение колонки effect в доменный эффект
+func EffectToDomain(effect string) authz.Effect {
+	if effect == repoModel.EffectDeny {
+		return authz.EffectDeny
+	}
+	return authz.EffectAllow
+}
+
+// EffectToRepo преобразует доменный эффект в значение колонки effect
+func EffectToRepo(effect authz.Effect) string {
+	if effect == authz.EffectDeny {
+		return repoModel.EffectDeny
+	}
+	return repoModel.EffectAllow
+}
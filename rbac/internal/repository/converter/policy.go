@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// PolicyStateToDomain собирает документ политики из строк прав, ролей и назначений
+func PolicyStateToDomain(permissions []repoModel.Permission, roles []repoModel.Role, grants []repoModel.PolicyGrant) *model.PolicyState {
+	state := &model.PolicyState{
+		Document: &model.PolicyDocument{
+			Version:     model.PolicyDocumentVersion,
+			Permissions: make([]*model.PolicyPermission, 0, len(permissions)),
+			Roles:       make([]*model.PolicyRole, 0, len(roles)),
+		},
+		RoleIDs:       make(map[string]string, len(roles)),
+		PermissionIDs: make(map[string]string, len(permissions)),
+	}
+
+	for _, p := range permissions {
+		permission := &model.PolicyPermission{Resource: p.Resource, Action: p.Action}
+		state.Document.Permissions = append(state.Document.Permissions, permission)
+		state.PermissionIDs[permission.Key()] = p.ID.String()
+	}
+
+	rolesByID := make(map[string]*model.PolicyRole, len(roles))
+	for _, r := range roles {
+		role := &model.PolicyRole{
+			Name:             r.Name,
+			Description:      r.Description,
+			System:           r.IsSystem,
+			RequiresApproval: r.RequiresApproval,
+			Grants:           []*model.PolicyGrant{},
+		}
+		state.Document.Roles = append(state.Document.Roles, role)
+		state.RoleIDs[r.Name] = r.ID.String()
+		rolesByID[r.ID.String()] = role
+	}
+
+	// Согласующих документ хранит по имени роли; удаленная роль согласующих в документ не попадает
+	for _, r := range roles {
+		if r.ApproverRoleID == nil {
+			continue
+		}
+		if approver, ok := rolesByID[r.ApproverRoleID.String()]; ok {
+			rolesByID[r.ID.String()].Approver = approver.Name
+		}
+	}
+
+	for _, g := range grants {
+		role, ok := rolesByID[g.RoleID.String()]
+		if !ok {
+			continue
+		}
+		grant := &model.PolicyGrant{
+			Permission: g.Resource + authz.Separator + g.Action,
+			Effect:     EffectToDomain(g.Effect).String(),
+		}
+		if g.Condition != nil {
+			grant.Condition = *g.Condition
+		}
+		role.Grants = append(role.Grants, grant)
+	}
+
+	return state
+}
+
+// ConditionToRepo преобразует пустое условие в NULL
+func ConditionToRepo(condition string) *string {
+	if condition == "" {
+		return nil
+	}
+	return &condition
+}
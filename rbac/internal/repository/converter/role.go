@@ -9,19 +9,27 @@ import (
 
 // RoleToDomain преобразует модель репозитория в доменную модель
 func RoleToDomain(repoRole *repoModel.Role) *model.Role {
-	return &model.Role{
-		ID:          repoRole.ID,
-		Name:        repoRole.Name,
-		Description: repoRole.Description,
-		CreatedAt:   repoRole.CreatedAt,
-		UpdatedAt:   repoRole.UpdatedAt,
-		DeletedAt:   repoRole.DeletedAt,
+	role := &model.Role{
+		ID:               repoRole.ID,
+		Name:             repoRole.Name,
+		Description:      repoRole.Description,
+		IsSystem:         repoRole.IsSystem,
+		RequiresApproval: repoRole.RequiresApproval,
+		CreatedAt:        repoRole.CreatedAt,
+		UpdatedAt:        repoRole.UpdatedAt,
+		DeletedAt:        repoRole.DeletedAt,
 	}
+	if repoRole.ApproverRoleID != nil {
+		approverRoleID := repoRole.ApproverRoleID.String()
+		role.ApproverRoleID = &approverRoleID
+	}
+	return role
 }
 
 // UpdateRoleToRepo преобразует параметры обновления роли в модель репозитория
-func UpdateRoleToRepo(name, description *string) (map[string]interface{}, error) {
+func UpdateRoleToRepo(updateRole *model.UpdateRole) (map[string]interface{}, error) {
 	updates := make(map[string]interface{})
+	name, description := updateRole.Name, updateRole.Description
 
 	if name != nil {
 		updates["name"] = strings.ToLower(*name)
@@ -31,6 +39,19 @@ func UpdateRoleToRepo(name, description *string) (map[string]interface{}, error)
 		updates["description"] = *description
 	}
 
+	if updateRole.RequiresApproval != nil {
+		updates["requires_approval"] = *updateRole.RequiresApproval
+	}
+
+	// Пустая строка снимает ограничение на согласующих
+	if updateRole.ApproverRoleID != nil {
+		if *updateRole.ApproverRoleID == "" {
+			updates["approver_role_id"] = nil
+		} else {
+			updates["approver_role_id"] = *updateRole.ApproverRoleID
+		}
+	}
+
 	return updates, nil
 }
 
@@ -43,3 +64,15 @@ func RolesToDomain(rows []repoModel.Role) ([]*model.Role, error) {
 	}
 	return result, nil
 }
+
+// RoleListRowsToDomain преобразует строки списка ролей в доменные модели
+func RoleListRowsToDomain(rows []repoModel.RoleListRow) []*model.RoleListItem {
+	result := make([]*model.RoleListItem, 0, len(rows))
+	for i := range rows {
+		result = append(result, &model.RoleListItem{
+			Role:      RoleToDomain(&rows[i].Role),
+			UserCount: rows[i].UserCount,
+		})
+	}
+	return result
+}
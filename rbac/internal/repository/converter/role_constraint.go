@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// RoleConstraintToDomain преобразует модель репозитория в доменную модель
+func RoleConstraintToDomain(repoConstraint *repoModel.RoleConstraint) *model.RoleConstraint {
+	return &model.RoleConstraint{
+		ID:          repoConstraint.ID,
+		Name:        repoConstraint.Name,
+		Description: repoConstraint.Description,
+		RoleIDs:     repoConstraint.RoleIDs,
+		CreatedAt:   repoConstraint.CreatedAt,
+	}
+}
+
+// RoleConstraintsToDomain преобразует массив моделей репозитория в доменные модели
+func RoleConstraintsToDomain(repoConstraints []repoModel.RoleConstraint) []*model.RoleConstraint {
+	result := make([]*model.RoleConstraint, 0, len(repoConstraints))
+	for i := range repoConstraints {
+		result = append(result, RoleConstraintToDomain(&repoConstraints[i]))
+	}
+	return result
+}
+
+// ConstraintViolationsToDomain преобразует строки отчета в доменные модели
+func ConstraintViolationsToDomain(repoViolations []repoModel.ConstraintViolation) []*model.ConstraintViolation {
+	result := make([]*model.ConstraintViolation, 0, len(repoViolations))
+	for _, violation := range repoViolations {
+		result = append(result, &model.ConstraintViolation{
+			ConstraintID:   violation.ConstraintID,
+			ConstraintName: violation.ConstraintName,
+			UserID:         violation.UserID,
+			RoleIDs:        violation.RoleIDs,
+		})
+	}
+	return result
+}
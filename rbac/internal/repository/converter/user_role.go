@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// UserRoleToDomain преобразует модель репозитория в доменную модель
+func UserRoleToDomain(repoUserRole *repoModel.UserRole) *model.UserRole {
+	return &model.UserRole{
+		UserID:     repoUserRole.UserID,
+		RoleID:     repoUserRole.RoleID,
+		AssignedBy: repoUserRole.AssignedBy,
+		AssignedAt: repoUserRole.AssignedAt,
+		ValidFrom:  repoUserRole.ValidFrom,
+		ValidUntil: repoUserRole.ValidUntil,
+	}
+}
+
+// UserRolesToDomain преобразует массив моделей репозитория в доменные модели
+func UserRolesToDomain(repoUserRoles []repoModel.UserRole) []*model.UserRole {
+	result := make([]*model.UserRole, 0, len(repoUserRoles))
+	for _, userRole := range repoUserRoles {
+		result = append(result, UserRoleToDomain(&userRole))
+	}
+	return result
+}
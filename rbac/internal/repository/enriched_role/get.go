@@ -11,19 +11,23 @@ import (
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
 )
 
-func (r *repository) Get(ctx context.Context, id string) (*model.EnrichedRole, error) {
-	cacheKey := r.getCacheKey(id)
+func (r *repository) Get(ctx context.Context, id string, version int64) (*model.EnrichedRole, error) {
+	cacheKey := r.getCacheKey(id, version)
 
 	data, err := r.redis.Get(ctx, cacheKey)
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			return nil, fmt.Errorf("role not found in cache")
+			return nil, model.ErrCacheMiss
 		}
 		return nil, fmt.Errorf("failed to get role from cache: %w", err)
 	}
 
 	if len(data) == 0 {
-		return nil, fmt.Errorf("role not found in cache")
+		return nil, model.ErrCacheMiss
+	}
+
+	if string(data) == notFoundMarker {
+		return nil, model.ErrRoleNotFound
 	}
 
 	roleConverter := converter.NewEnrichedRoleCacheConverter()
@@ -2,8 +2,18 @@ package enriched_role
 
 import "fmt"
 
+// enrichedRoleCachePrefix префикс ключей кэша ролей
 const enrichedRoleCachePrefix = "enriched_role"
 
-func (r *repository) getCacheKey(roleID string) string {
-	return fmt.Sprintf("%s:%s", enrichedRoleCachePrefix, roleID)
+// notFoundMarker значение отрицательной записи для несуществующей роли
+const notFoundMarker = "-"
+
+// Hash tag по роли держит версию и записи одной роли в одном слоте Redis Cluster,
+// а разные роли распределяет по шардам
+func (r *repository) getCacheKey(roleID string, version int64) string {
+	return fmt.Sprintf("{%s:%s}:v%d", enrichedRoleCachePrefix, roleID, version)
+}
+
+func (r *repository) getVersionKey(roleID string) string {
+	return fmt.Sprintf("{%s:%s}:version", enrichedRoleCachePrefix, roleID)
 }
@@ -0,0 +1,61 @@
+package enriched_role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+)
+
+// GetMany читает версии и роли двумя конвейерами чтения.
+// Отсутствующие, отрицательные и поврежденные записи считаются промахом и не попадают в результат.
+func (r *repository) GetMany(ctx context.Context, ids []string) (map[string]*model.EnrichedRole, map[string]int64, error) {
+	if len(ids) == 0 {
+		return map[string]*model.EnrichedRole{}, map[string]int64{}, nil
+	}
+
+	versionKeys := make([]string, len(ids))
+	for i, id := range ids {
+		versionKeys[i] = r.getVersionKey(id)
+	}
+
+	rawVersions, err := r.redis.MGet(ctx, versionKeys...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get role cache versions: %w", err)
+	}
+
+	versions := make(map[string]int64, len(ids))
+	cacheKeys := make([]string, len(ids))
+	for i, id := range ids {
+		version, err := parseVersion(rawVersions[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		versions[id] = version
+		cacheKeys[i] = r.getCacheKey(id, version)
+	}
+
+	data, err := r.redis.MGet(ctx, cacheKeys...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get roles from cache: %w", err)
+	}
+
+	roleConverter := converter.NewEnrichedRoleCacheConverter()
+	roles := make(map[string]*model.EnrichedRole, len(ids))
+	for i, id := range ids {
+		if len(data[i]) == 0 || string(data[i]) == notFoundMarker {
+			continue
+		}
+
+		enrichedRole, err := roleConverter.FromCache(data[i])
+		if err != nil {
+			continue
+		}
+
+		roles[id] = enrichedRole
+	}
+
+	return roles, versions, nil
+}
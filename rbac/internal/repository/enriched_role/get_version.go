@@ -0,0 +1,30 @@
+package enriched_role
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+func (r *repository) GetVersion(ctx context.Context, id string) (int64, error) {
+	data, err := r.redis.Get(ctx, r.getVersionKey(id))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get role cache version: %w", err)
+	}
+
+	return parseVersion(data)
+}
+
+func parseVersion(data []byte) (int64, error) {
+	// Роль ещё не изменялась
+	if len(data) == 0 {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid role cache version: %w", err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,15 @@
+package enriched_role
+
+import (
+	"context"
+	"fmt"
+)
+
+// Invalidate увеличивает версию роли; записи прежних версий больше не читаются и истекают по TTL
+func (r *repository) Invalidate(ctx context.Context, id string) error {
+	if _, err := r.redis.Incr(ctx, r.getVersionKey(id)); err != nil {
+		return fmt.Errorf("failed to invalidate role cache: %w", err)
+	}
+
+	return nil
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
 )
 
-func (r *repository) Set(ctx context.Context, role *model.EnrichedRole, expiresAt time.Time) error {
-	cacheKey := r.getCacheKey(role.Role.ID.String())
+func (r *repository) Set(ctx context.Context, role *model.EnrichedRole, version int64, expiresAt time.Time) error {
+	cacheKey := r.getCacheKey(role.Role.ID.String(), version)
 
 	ttl := time.Until(expiresAt)
 	if ttl <= 0 {
@@ -0,0 +1,42 @@
+package enriched_role
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+)
+
+// SetMany сохраняет роли под указанными версиями за один обмен с Redis.
+// Роли без версии пропускаются.
+func (r *repository) SetMany(ctx context.Context, roles []*model.EnrichedRole, versions map[string]int64, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("expiration time is in the past")
+	}
+
+	roleConverter := converter.NewEnrichedRoleCacheConverter()
+	values := make(map[string]any, len(roles))
+	for _, role := range roles {
+		roleID := role.Role.ID.String()
+		version, ok := versions[roleID]
+		if !ok {
+			continue
+		}
+
+		data, err := roleConverter.ToCache(role)
+		if err != nil {
+			return fmt.Errorf("failed to convert to protobuf: %w", err)
+		}
+
+		values[r.getCacheKey(roleID, version)] = data
+	}
+
+	if err := r.redis.SetMany(ctx, values, ttl); err != nil {
+		return fmt.Errorf("failed to store roles in cache: %w", err)
+	}
+
+	return nil
+}
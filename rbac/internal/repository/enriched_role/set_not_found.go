@@ -0,0 +1,20 @@
+package enriched_role
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *repository) SetNotFound(ctx context.Context, id string, version int64, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("expiration time is in the past")
+	}
+
+	if err := r.redis.Set(ctx, r.getCacheKey(id, version), notFoundMarker, ttl); err != nil {
+		return fmt.Errorf("failed to store missing role in cache: %w", err)
+	}
+
+	return nil
+}
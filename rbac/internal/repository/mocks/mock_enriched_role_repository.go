@@ -24,72 +24,151 @@ func (_m *EnrichedRoleRepository) EXPECT() *EnrichedRoleRepository_Expecter {
 	return &EnrichedRoleRepository_Expecter{mock: &_m.Mock}
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *EnrichedRoleRepository) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Get provides a mock function with given fields: ctx, id, version
+func (_m *EnrichedRoleRepository) Get(ctx context.Context, id string, version int64) (*model.EnrichedRole, error) {
+	ret := _m.Called(ctx, id, version)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Get")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	var r0 *model.EnrichedRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*model.EnrichedRole, error)); ok {
+		return rf(ctx, id, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *model.EnrichedRole); ok {
+		r0 = rf(ctx, id, version)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EnrichedRole)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, id, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// EnrichedRoleRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type EnrichedRoleRepository_Delete_Call struct {
+// EnrichedRoleRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type EnrichedRoleRepository_Get_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Get is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *EnrichedRoleRepository_Expecter) Delete(ctx interface{}, id interface{}) *EnrichedRoleRepository_Delete_Call {
-	return &EnrichedRoleRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - version int64
+func (_e *EnrichedRoleRepository_Expecter) Get(ctx interface{}, id interface{}, version interface{}) *EnrichedRoleRepository_Get_Call {
+	return &EnrichedRoleRepository_Get_Call{Call: _e.mock.On("Get", ctx, id, version)}
 }
 
-func (_c *EnrichedRoleRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *EnrichedRoleRepository_Delete_Call {
+func (_c *EnrichedRoleRepository_Get_Call) Run(run func(ctx context.Context, id string, version int64)) *EnrichedRoleRepository_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *EnrichedRoleRepository_Delete_Call) Return(_a0 error) *EnrichedRoleRepository_Delete_Call {
-	_c.Call.Return(_a0)
+func (_c *EnrichedRoleRepository_Get_Call) Return(_a0 *model.EnrichedRole, _a1 error) *EnrichedRoleRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_Get_Call) RunAndReturn(run func(context.Context, string, int64) (*model.EnrichedRole, error)) *EnrichedRoleRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMany provides a mock function with given fields: ctx, ids
+func (_m *EnrichedRoleRepository) GetMany(ctx context.Context, ids []string) (map[string]*model.EnrichedRole, map[string]int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMany")
+	}
+
+	var r0 map[string]*model.EnrichedRole
+	var r1 map[string]int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*model.EnrichedRole, map[string]int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*model.EnrichedRole); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.EnrichedRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) map[string]int64); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string) error); ok {
+		r2 = rf(ctx, ids)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnrichedRoleRepository_GetMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMany'
+type EnrichedRoleRepository_GetMany_Call struct {
+	*mock.Call
+}
+
+// GetMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *EnrichedRoleRepository_Expecter) GetMany(ctx interface{}, ids interface{}) *EnrichedRoleRepository_GetMany_Call {
+	return &EnrichedRoleRepository_GetMany_Call{Call: _e.mock.On("GetMany", ctx, ids)}
+}
+
+func (_c *EnrichedRoleRepository_GetMany_Call) Run(run func(ctx context.Context, ids []string)) *EnrichedRoleRepository_GetMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
 	return _c
 }
 
-func (_c *EnrichedRoleRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *EnrichedRoleRepository_Delete_Call {
+func (_c *EnrichedRoleRepository_GetMany_Call) Return(_a0 map[string]*model.EnrichedRole, _a1 map[string]int64, _a2 error) *EnrichedRoleRepository_GetMany_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_GetMany_Call) RunAndReturn(run func(context.Context, []string) (map[string]*model.EnrichedRole, map[string]int64, error)) *EnrichedRoleRepository_GetMany_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function with given fields: ctx, id
-func (_m *EnrichedRoleRepository) Get(ctx context.Context, id string) (*model.EnrichedRole, error) {
+// GetVersion provides a mock function with given fields: ctx, id
+func (_m *EnrichedRoleRepository) GetVersion(ctx context.Context, id string) (int64, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GetVersion")
 	}
 
-	var r0 *model.EnrichedRole
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.EnrichedRole, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.EnrichedRole); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
 		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.EnrichedRole)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
@@ -101,46 +180,93 @@ func (_m *EnrichedRoleRepository) Get(ctx context.Context, id string) (*model.En
 	return r0, r1
 }
 
-// EnrichedRoleRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type EnrichedRoleRepository_Get_Call struct {
+// EnrichedRoleRepository_GetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersion'
+type EnrichedRoleRepository_GetVersion_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
+// GetVersion is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *EnrichedRoleRepository_Expecter) Get(ctx interface{}, id interface{}) *EnrichedRoleRepository_Get_Call {
-	return &EnrichedRoleRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+func (_e *EnrichedRoleRepository_Expecter) GetVersion(ctx interface{}, id interface{}) *EnrichedRoleRepository_GetVersion_Call {
+	return &EnrichedRoleRepository_GetVersion_Call{Call: _e.mock.On("GetVersion", ctx, id)}
 }
 
-func (_c *EnrichedRoleRepository_Get_Call) Run(run func(ctx context.Context, id string)) *EnrichedRoleRepository_Get_Call {
+func (_c *EnrichedRoleRepository_GetVersion_Call) Run(run func(ctx context.Context, id string)) *EnrichedRoleRepository_GetVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *EnrichedRoleRepository_Get_Call) Return(_a0 *model.EnrichedRole, _a1 error) *EnrichedRoleRepository_Get_Call {
+func (_c *EnrichedRoleRepository_GetVersion_Call) Return(_a0 int64, _a1 error) *EnrichedRoleRepository_GetVersion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *EnrichedRoleRepository_Get_Call) RunAndReturn(run func(context.Context, string) (*model.EnrichedRole, error)) *EnrichedRoleRepository_Get_Call {
+func (_c *EnrichedRoleRepository_GetVersion_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *EnrichedRoleRepository_GetVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, id
+func (_m *EnrichedRoleRepository) Invalidate(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrichedRoleRepository_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type EnrichedRoleRepository_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *EnrichedRoleRepository_Expecter) Invalidate(ctx interface{}, id interface{}) *EnrichedRoleRepository_Invalidate_Call {
+	return &EnrichedRoleRepository_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, id)}
+}
+
+func (_c *EnrichedRoleRepository_Invalidate_Call) Run(run func(ctx context.Context, id string)) *EnrichedRoleRepository_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_Invalidate_Call) Return(_a0 error) *EnrichedRoleRepository_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_Invalidate_Call) RunAndReturn(run func(context.Context, string) error) *EnrichedRoleRepository_Invalidate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Set provides a mock function with given fields: ctx, role, expiresAt
-func (_m *EnrichedRoleRepository) Set(ctx context.Context, role *model.EnrichedRole, expiresAt time.Time) error {
-	ret := _m.Called(ctx, role, expiresAt)
+// Set provides a mock function with given fields: ctx, role, version, expiresAt
+func (_m *EnrichedRoleRepository) Set(ctx context.Context, role *model.EnrichedRole, version int64, expiresAt time.Time) error {
+	ret := _m.Called(ctx, role, version, expiresAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Set")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.EnrichedRole, time.Time) error); ok {
-		r0 = rf(ctx, role, expiresAt)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.EnrichedRole, int64, time.Time) error); ok {
+		r0 = rf(ctx, role, version, expiresAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -156,14 +282,15 @@ type EnrichedRoleRepository_Set_Call struct {
 // Set is a helper method to define mock.On call
 //   - ctx context.Context
 //   - role *model.EnrichedRole
+//   - version int64
 //   - expiresAt time.Time
-func (_e *EnrichedRoleRepository_Expecter) Set(ctx interface{}, role interface{}, expiresAt interface{}) *EnrichedRoleRepository_Set_Call {
-	return &EnrichedRoleRepository_Set_Call{Call: _e.mock.On("Set", ctx, role, expiresAt)}
+func (_e *EnrichedRoleRepository_Expecter) Set(ctx interface{}, role interface{}, version interface{}, expiresAt interface{}) *EnrichedRoleRepository_Set_Call {
+	return &EnrichedRoleRepository_Set_Call{Call: _e.mock.On("Set", ctx, role, version, expiresAt)}
 }
 
-func (_c *EnrichedRoleRepository_Set_Call) Run(run func(ctx context.Context, role *model.EnrichedRole, expiresAt time.Time)) *EnrichedRoleRepository_Set_Call {
+func (_c *EnrichedRoleRepository_Set_Call) Run(run func(ctx context.Context, role *model.EnrichedRole, version int64, expiresAt time.Time)) *EnrichedRoleRepository_Set_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.EnrichedRole), args[2].(time.Time))
+		run(args[0].(context.Context), args[1].(*model.EnrichedRole), args[2].(int64), args[3].(time.Time))
 	})
 	return _c
 }
@@ -173,7 +300,105 @@ func (_c *EnrichedRoleRepository_Set_Call) Return(_a0 error) *EnrichedRoleReposi
 	return _c
 }
 
-func (_c *EnrichedRoleRepository_Set_Call) RunAndReturn(run func(context.Context, *model.EnrichedRole, time.Time) error) *EnrichedRoleRepository_Set_Call {
+func (_c *EnrichedRoleRepository_Set_Call) RunAndReturn(run func(context.Context, *model.EnrichedRole, int64, time.Time) error) *EnrichedRoleRepository_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMany provides a mock function with given fields: ctx, roles, versions, expiresAt
+func (_m *EnrichedRoleRepository) SetMany(ctx context.Context, roles []*model.EnrichedRole, versions map[string]int64, expiresAt time.Time) error {
+	ret := _m.Called(ctx, roles, versions, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.EnrichedRole, map[string]int64, time.Time) error); ok {
+		r0 = rf(ctx, roles, versions, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrichedRoleRepository_SetMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMany'
+type EnrichedRoleRepository_SetMany_Call struct {
+	*mock.Call
+}
+
+// SetMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roles []*model.EnrichedRole
+//   - versions map[string]int64
+//   - expiresAt time.Time
+func (_e *EnrichedRoleRepository_Expecter) SetMany(ctx interface{}, roles interface{}, versions interface{}, expiresAt interface{}) *EnrichedRoleRepository_SetMany_Call {
+	return &EnrichedRoleRepository_SetMany_Call{Call: _e.mock.On("SetMany", ctx, roles, versions, expiresAt)}
+}
+
+func (_c *EnrichedRoleRepository_SetMany_Call) Run(run func(ctx context.Context, roles []*model.EnrichedRole, versions map[string]int64, expiresAt time.Time)) *EnrichedRoleRepository_SetMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.EnrichedRole), args[2].(map[string]int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_SetMany_Call) Return(_a0 error) *EnrichedRoleRepository_SetMany_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_SetMany_Call) RunAndReturn(run func(context.Context, []*model.EnrichedRole, map[string]int64, time.Time) error) *EnrichedRoleRepository_SetMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetNotFound provides a mock function with given fields: ctx, id, version, expiresAt
+func (_m *EnrichedRoleRepository) SetNotFound(ctx context.Context, id string, version int64, expiresAt time.Time) error {
+	ret := _m.Called(ctx, id, version, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNotFound")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Time) error); ok {
+		r0 = rf(ctx, id, version, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrichedRoleRepository_SetNotFound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNotFound'
+type EnrichedRoleRepository_SetNotFound_Call struct {
+	*mock.Call
+}
+
+// SetNotFound is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version int64
+//   - expiresAt time.Time
+func (_e *EnrichedRoleRepository_Expecter) SetNotFound(ctx interface{}, id interface{}, version interface{}, expiresAt interface{}) *EnrichedRoleRepository_SetNotFound_Call {
+	return &EnrichedRoleRepository_SetNotFound_Call{Call: _e.mock.On("SetNotFound", ctx, id, version, expiresAt)}
+}
+
+func (_c *EnrichedRoleRepository_SetNotFound_Call) Run(run func(ctx context.Context, id string, version int64, expiresAt time.Time)) *EnrichedRoleRepository_SetNotFound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_SetNotFound_Call) Return(_a0 error) *EnrichedRoleRepository_SetNotFound_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EnrichedRoleRepository_SetNotFound_Call) RunAndReturn(run func(context.Context, string, int64, time.Time) error) *EnrichedRoleRepository_SetNotFound_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -22,17 +22,17 @@ func (_m *RolePermissionRepository) EXPECT() *RolePermissionRepository_Expecter
 	return &RolePermissionRepository_Expecter{mock: &_m.Mock}
 }
 
-// Assign provides a mock function with given fields: ctx, roleID, permissionID
-func (_m *RolePermissionRepository) Assign(ctx context.Context, roleID string, permissionID string) error {
-	ret := _m.Called(ctx, roleID, permissionID)
+// Assign provides a mock function with given fields: ctx, assignment
+func (_m *RolePermissionRepository) Assign(ctx context.Context, assignment *model.AssignRolePermission) error {
+	ret := _m.Called(ctx, assignment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Assign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, roleID, permissionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AssignRolePermission) error); ok {
+		r0 = rf(ctx, assignment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,15 +47,14 @@ type RolePermissionRepository_Assign_Call struct {
 
 // Assign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roleID string
-//   - permissionID string
-func (_e *RolePermissionRepository_Expecter) Assign(ctx interface{}, roleID interface{}, permissionID interface{}) *RolePermissionRepository_Assign_Call {
-	return &RolePermissionRepository_Assign_Call{Call: _e.mock.On("Assign", ctx, roleID, permissionID)}
+//   - assignment *model.AssignRolePermission
+func (_e *RolePermissionRepository_Expecter) Assign(ctx interface{}, assignment interface{}) *RolePermissionRepository_Assign_Call {
+	return &RolePermissionRepository_Assign_Call{Call: _e.mock.On("Assign", ctx, assignment)}
 }
 
-func (_c *RolePermissionRepository_Assign_Call) Run(run func(ctx context.Context, roleID string, permissionID string)) *RolePermissionRepository_Assign_Call {
+func (_c *RolePermissionRepository_Assign_Call) Run(run func(ctx context.Context, assignment *model.AssignRolePermission)) *RolePermissionRepository_Assign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*model.AssignRolePermission))
 	})
 	return _c
 }
@@ -65,7 +64,7 @@ func (_c *RolePermissionRepository_Assign_Call) Return(_a0 error) *RolePermissio
 	return _c
 }
 
-func (_c *RolePermissionRepository_Assign_Call) RunAndReturn(run func(context.Context, string, string) error) *RolePermissionRepository_Assign_Call {
+func (_c *RolePermissionRepository_Assign_Call) RunAndReturn(run func(context.Context, *model.AssignRolePermission) error) *RolePermissionRepository_Assign_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -177,6 +176,66 @@ func (_c *RolePermissionRepository_Revoke_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SetPermissions provides a mock function with given fields: ctx, roleID, permissionIDs
+func (_m *RolePermissionRepository) SetPermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error) {
+	ret := _m.Called(ctx, roleID, permissionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPermissions")
+	}
+
+	var r0 *model.RolePermissionsDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*model.RolePermissionsDiff, error)); ok {
+		return rf(ctx, roleID, permissionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.RolePermissionsDiff); ok {
+		r0 = rf(ctx, roleID, permissionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RolePermissionsDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, roleID, permissionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RolePermissionRepository_SetPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPermissions'
+type RolePermissionRepository_SetPermissions_Call struct {
+	*mock.Call
+}
+
+// SetPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID string
+//   - permissionIDs []string
+func (_e *RolePermissionRepository_Expecter) SetPermissions(ctx interface{}, roleID interface{}, permissionIDs interface{}) *RolePermissionRepository_SetPermissions_Call {
+	return &RolePermissionRepository_SetPermissions_Call{Call: _e.mock.On("SetPermissions", ctx, roleID, permissionIDs)}
+}
+
+func (_c *RolePermissionRepository_SetPermissions_Call) Run(run func(ctx context.Context, roleID string, permissionIDs []string)) *RolePermissionRepository_SetPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *RolePermissionRepository_SetPermissions_Call) Return(_a0 *model.RolePermissionsDiff, _a1 error) *RolePermissionRepository_SetPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RolePermissionRepository_SetPermissions_Call) RunAndReturn(run func(context.Context, string, []string) (*model.RolePermissionsDiff, error)) *RolePermissionRepository_SetPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRolePermissionRepository creates a new instance of RolePermissionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRolePermissionRepository(t interface {
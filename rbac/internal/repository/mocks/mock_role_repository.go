@@ -190,34 +190,43 @@ func (_c *RoleRepository_Get_Call) RunAndReturn(run func(context.Context, string
 	return _c
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *RoleRepository) List(ctx context.Context) ([]*model.Role, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, filter
+func (_m *RoleRepository) List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []*model.Role
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Role, error)); ok {
-		return rf(ctx)
+	var r0 []*model.RoleListItem
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RoleFilter) ([]*model.RoleListItem, *string, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.Role); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RoleFilter) []*model.RoleListItem); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Role)
+			r0 = ret.Get(0).([]*model.RoleListItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RoleFilter) *string); ok {
+		r1 = rf(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.RoleFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // RoleRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -227,23 +236,71 @@ type RoleRepository_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *RoleRepository_Expecter) List(ctx interface{}) *RoleRepository_List_Call {
-	return &RoleRepository_List_Call{Call: _e.mock.On("List", ctx)}
+//   - filter *model.RoleFilter
+func (_e *RoleRepository_Expecter) List(ctx interface{}, filter interface{}) *RoleRepository_List_Call {
+	return &RoleRepository_List_Call{Call: _e.mock.On("List", ctx, filter)}
 }
 
-func (_c *RoleRepository_List_Call) Run(run func(ctx context.Context)) *RoleRepository_List_Call {
+func (_c *RoleRepository_List_Call) Run(run func(ctx context.Context, filter *model.RoleFilter)) *RoleRepository_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*model.RoleFilter))
 	})
 	return _c
 }
 
-func (_c *RoleRepository_List_Call) Return(_a0 []*model.Role, _a1 error) *RoleRepository_List_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *RoleRepository_List_Call) Return(_a0 []*model.RoleListItem, _a1 *string, _a2 error) *RoleRepository_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RoleRepository_List_Call) RunAndReturn(run func(context.Context, *model.RoleFilter) ([]*model.RoleListItem, *string, error)) *RoleRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *RoleRepository) Restore(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RoleRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type RoleRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RoleRepository_Expecter) Restore(ctx interface{}, id interface{}) *RoleRepository_Restore_Call {
+	return &RoleRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *RoleRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *RoleRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RoleRepository_Restore_Call) Return(_a0 error) *RoleRepository_Restore_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *RoleRepository_List_Call) RunAndReturn(run func(context.Context) ([]*model.Role, error)) *RoleRepository_List_Call {
+func (_c *RoleRepository_Restore_Call) RunAndReturn(run func(context.Context, string) error) *RoleRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,17 +22,17 @@ func (_m *UserRoleRepository) EXPECT() *UserRoleRepository_Expecter {
 	return &UserRoleRepository_Expecter{mock: &_m.Mock}
 }
 
-// Assign provides a mock function with given fields: ctx, userID, roleID, assignedBy
-func (_m *UserRoleRepository) Assign(ctx context.Context, userID string, roleID string, assignedBy *string) error {
-	ret := _m.Called(ctx, userID, roleID, assignedBy)
+// Assign provides a mock function with given fields: ctx, assignment
+func (_m *UserRoleRepository) Assign(ctx context.Context, assignment *model.AssignUserRole) error {
+	ret := _m.Called(ctx, assignment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Assign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) error); ok {
-		r0 = rf(ctx, userID, roleID, assignedBy)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AssignUserRole) error); ok {
+		r0 = rf(ctx, assignment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,16 +47,14 @@ type UserRoleRepository_Assign_Call struct {
 
 // Assign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - roleID string
-//   - assignedBy *string
-func (_e *UserRoleRepository_Expecter) Assign(ctx interface{}, userID interface{}, roleID interface{}, assignedBy interface{}) *UserRoleRepository_Assign_Call {
-	return &UserRoleRepository_Assign_Call{Call: _e.mock.On("Assign", ctx, userID, roleID, assignedBy)}
+//   - assignment *model.AssignUserRole
+func (_e *UserRoleRepository_Expecter) Assign(ctx interface{}, assignment interface{}) *UserRoleRepository_Assign_Call {
+	return &UserRoleRepository_Assign_Call{Call: _e.mock.On("Assign", ctx, assignment)}
 }
 
-func (_c *UserRoleRepository_Assign_Call) Run(run func(ctx context.Context, userID string, roleID string, assignedBy *string)) *UserRoleRepository_Assign_Call {
+func (_c *UserRoleRepository_Assign_Call) Run(run func(ctx context.Context, assignment *model.AssignUserRole)) *UserRoleRepository_Assign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string))
+		run(args[0].(context.Context), args[1].(*model.AssignUserRole))
 	})
 	return _c
 }
@@ -65,7 +64,125 @@ func (_c *UserRoleRepository_Assign_Call) Return(_a0 error) *UserRoleRepository_
 	return _c
 }
 
-func (_c *UserRoleRepository_Assign_Call) RunAndReturn(run func(context.Context, string, string, *string) error) *UserRoleRepository_Assign_Call {
+func (_c *UserRoleRepository_Assign_Call) RunAndReturn(run func(context.Context, *model.AssignUserRole) error) *UserRoleRepository_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkAssign provides a mock function with given fields: ctx, assignments
+func (_m *UserRoleRepository) BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error) {
+	ret := _m.Called(ctx, assignments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkAssign")
+	}
+
+	var r0 []*model.BulkAssignResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.AssignUserRole) ([]*model.BulkAssignResult, error)); ok {
+		return rf(ctx, assignments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.AssignUserRole) []*model.BulkAssignResult); ok {
+		r0 = rf(ctx, assignments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BulkAssignResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.AssignUserRole) error); ok {
+		r1 = rf(ctx, assignments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRoleRepository_BulkAssign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkAssign'
+type UserRoleRepository_BulkAssign_Call struct {
+	*mock.Call
+}
+
+// BulkAssign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assignments []*model.AssignUserRole
+func (_e *UserRoleRepository_Expecter) BulkAssign(ctx interface{}, assignments interface{}) *UserRoleRepository_BulkAssign_Call {
+	return &UserRoleRepository_BulkAssign_Call{Call: _e.mock.On("BulkAssign", ctx, assignments)}
+}
+
+func (_c *UserRoleRepository_BulkAssign_Call) Run(run func(ctx context.Context, assignments []*model.AssignUserRole)) *UserRoleRepository_BulkAssign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.AssignUserRole))
+	})
+	return _c
+}
+
+func (_c *UserRoleRepository_BulkAssign_Call) Return(_a0 []*model.BulkAssignResult, _a1 error) *UserRoleRepository_BulkAssign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRoleRepository_BulkAssign_Call) RunAndReturn(run func(context.Context, []*model.AssignUserRole) ([]*model.BulkAssignResult, error)) *UserRoleRepository_BulkAssign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, limit
+func (_m *UserRoleRepository) DeleteExpired(ctx context.Context, limit int32) ([]*model.UserRole, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 []*model.UserRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]*model.UserRole, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []*model.UserRole); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRoleRepository_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type UserRoleRepository_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int32
+func (_e *UserRoleRepository_Expecter) DeleteExpired(ctx interface{}, limit interface{}) *UserRoleRepository_DeleteExpired_Call {
+	return &UserRoleRepository_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx, limit)}
+}
+
+func (_c *UserRoleRepository_DeleteExpired_Call) Run(run func(ctx context.Context, limit int32)) *UserRoleRepository_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *UserRoleRepository_DeleteExpired_Call) Return(_a0 []*model.UserRole, _a1 error) *UserRoleRepository_DeleteExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRoleRepository_DeleteExpired_Call) RunAndReturn(run func(context.Context, int32) ([]*model.UserRole, error)) *UserRoleRepository_DeleteExpired_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -140,6 +257,65 @@ func (_c *UserRoleRepository_GetRoleUsers_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetUserEnrichedRoles provides a mock function with given fields: ctx, userID
+func (_m *UserRoleRepository) GetUserEnrichedRoles(ctx context.Context, userID string) ([]*model.EnrichedRole, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserEnrichedRoles")
+	}
+
+	var r0 []*model.EnrichedRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.EnrichedRole, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.EnrichedRole); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EnrichedRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRoleRepository_GetUserEnrichedRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserEnrichedRoles'
+type UserRoleRepository_GetUserEnrichedRoles_Call struct {
+	*mock.Call
+}
+
+// GetUserEnrichedRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserRoleRepository_Expecter) GetUserEnrichedRoles(ctx interface{}, userID interface{}) *UserRoleRepository_GetUserEnrichedRoles_Call {
+	return &UserRoleRepository_GetUserEnrichedRoles_Call{Call: _e.mock.On("GetUserEnrichedRoles", ctx, userID)}
+}
+
+func (_c *UserRoleRepository_GetUserEnrichedRoles_Call) Run(run func(ctx context.Context, userID string)) *UserRoleRepository_GetUserEnrichedRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRoleRepository_GetUserEnrichedRoles_Call) Return(_a0 []*model.EnrichedRole, _a1 error) *UserRoleRepository_GetUserEnrichedRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRoleRepository_GetUserEnrichedRoles_Call) RunAndReturn(run func(context.Context, string) ([]*model.EnrichedRole, error)) *UserRoleRepository_GetUserEnrichedRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserRoles provides a mock function with given fields: ctx, userID
 func (_m *UserRoleRepository) GetUserRoles(ctx context.Context, userID string) ([]string, error) {
 	ret := _m.Called(ctx, userID)
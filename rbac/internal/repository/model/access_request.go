@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessRequest строка заявки на доступ
+type AccessRequest struct {
+	Seq             int64      `db:"seq"`
+	ID              uuid.UUID  `db:"id"`
+	UserID          uuid.UUID  `db:"user_id"`
+	RoleID          uuid.UUID  `db:"role_id"`
+	RequestedBy     *uuid.UUID `db:"requested_by"`
+	Justification   string     `db:"justification"`
+	ValidUntil      *time.Time `db:"valid_until"`
+	Status          string     `db:"status"`
+	CreatedAt       time.Time  `db:"created_at"`
+	ExpiresAt       time.Time  `db:"expires_at"`
+	DecidedBy       *uuid.UUID `db:"decided_by"`
+	DecidedAt       *time.Time `db:"decided_at"`
+	DecisionComment *string    `db:"decision_comment"`
+}
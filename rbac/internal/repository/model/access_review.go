@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccessReviewCampaign строка кампании вместе со сводкой решений
+type AccessReviewCampaign struct {
+	Seq         int64      `db:"seq"`
+	ID          uuid.UUID  `db:"id"`
+	Name        string     `db:"name"`
+	Description *string    `db:"description"`
+	RoleIDs     []string   `db:"role_ids"`
+	ReviewerIDs []string   `db:"reviewer_ids"`
+	Status      string     `db:"status"`
+	CreatedBy   *uuid.UUID `db:"created_by"`
+	CreatedAt   time.Time  `db:"created_at"`
+	DueAt       *time.Time `db:"due_at"`
+	ClosedBy    *uuid.UUID `db:"closed_by"`
+	ClosedAt    *time.Time `db:"closed_at"`
+	Total       int64      `db:"total"`
+	Pending     int64      `db:"pending"`
+	Keep        int64      `db:"keep"`
+	Revoke      int64      `db:"revoke"`
+	Revoked     int64      `db:"revoked"`
+}
+
+// AccessReviewItem строка назначения кампании
+type AccessReviewItem struct {
+	Seq        int64      `db:"seq"`
+	ID         uuid.UUID  `db:"id"`
+	CampaignID uuid.UUID  `db:"campaign_id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	RoleID     uuid.UUID  `db:"role_id"`
+	Decision   string     `db:"decision"`
+	ReviewedBy *uuid.UUID `db:"reviewed_by"`
+	ReviewedAt *time.Time `db:"reviewed_at"`
+	Comment    *string    `db:"comment"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
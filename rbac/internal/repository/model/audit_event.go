@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditEvent struct {
+	Seq            int64     `db:"seq"`
+	ID             uuid.UUID `db:"id"`
+	OccurredAt     time.Time `db:"occurred_at"`
+	ActorType      string    `db:"actor_type"`
+	ActorID        *string   `db:"actor_id"`
+	ActorSessionID *string   `db:"actor_session_id"`
+	Action         string    `db:"action"`
+	TargetType     string    `db:"target_type"`
+	TargetID       string    `db:"target_id"`
+	Before         []byte    `db:"before"`
+	After          []byte    `db:"after"`
+	RequestID      *string   `db:"request_id"`
+}
@@ -1,5 +1,6 @@
 package model
 
+// EnrichedRoleRow строка роли с правами, агрегированными в JSON-массив
 type EnrichedRoleRow struct {
 	Role
 	PermissionsJSON []byte `db:"permissions"`
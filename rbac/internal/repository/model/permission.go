@@ -2,8 +2,17 @@ package model
 
 import "github.com/google/uuid"
 
+// Значения колонки role_permissions.effect
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+)
+
+// Permission право доступа; json-теги используются при агрегации прав в EnrichedRoleRow
 type Permission struct {
-	ID       uuid.UUID `db:"id"`
-	Resource string    `db:"resource"`
-	Action   string    `db:"action"`
+	ID        uuid.UUID `db:"id" json:"id"`
+	Resource  string    `db:"resource" json:"resource"`
+	Action    string    `db:"action" json:"action"`
+	Effect    string    `db:"effect" json:"effect"`
+	Condition *string   `db:"condition" json:"condition"`
 }
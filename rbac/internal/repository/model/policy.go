@@ -0,0 +1,12 @@
+package model
+
+import "github.com/google/uuid"
+
+// PolicyGrant строка назначения права роли при выгрузке политики
+type PolicyGrant struct {
+	RoleID    uuid.UUID `db:"role_id"`
+	Resource  string    `db:"resource"`
+	Action    string    `db:"action"`
+	Effect    string    `db:"effect"`
+	Condition *string   `db:"condition"`
+}
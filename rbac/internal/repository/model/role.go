@@ -7,10 +7,19 @@ import (
 )
 
 type Role struct {
-	ID          uuid.UUID  `db:"id"`
-	Name        string     `db:"name"`
-	Description string     `db:"description"`
-	CreatedAt   time.Time  `db:"created_at"`
-	UpdatedAt   *time.Time `db:"updated_at"`
-	DeletedAt   *time.Time `db:"deleted_at"`
+	ID               uuid.UUID  `db:"id"`
+	Name             string     `db:"name"`
+	Description      string     `db:"description"`
+	IsSystem         bool       `db:"is_system"`
+	RequiresApproval bool       `db:"requires_approval"`
+	ApproverRoleID   *uuid.UUID `db:"approver_role_id"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        *time.Time `db:"updated_at"`
+	DeletedAt        *time.Time `db:"deleted_at"`
+}
+
+// RoleListRow строка списка ролей с количеством пользователей
+type RoleListRow struct {
+	Role
+	UserCount int32 `db:"user_count"`
 }
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleConstraint строка ограничения вместе с ролями набора
+type RoleConstraint struct {
+	ID          uuid.UUID `db:"id"`
+	Name        string    `db:"name"`
+	Description *string   `db:"description"`
+	RoleIDs     []string  `db:"role_ids"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ConstraintViolation строка отчета о нарушениях ограничений
+type ConstraintViolation struct {
+	ConstraintID   string   `db:"constraint_id"`
+	ConstraintName string   `db:"constraint_name"`
+	UserID         string   `db:"user_id"`
+	RoleIDs        []string `db:"role_ids"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserRole struct {
+	UserID     uuid.UUID  `db:"user_id"`
+	RoleID     uuid.UUID  `db:"role_id"`
+	AssignedBy *uuid.UUID `db:"assigned_by"`
+	AssignedAt time.Time  `db:"assigned_at"`
+	ValidFrom  time.Time  `db:"valid_from"`
+	ValidUntil *time.Time `db:"valid_until"`
+}
@@ -0,0 +1,167 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+)
+
+func (r *policyRepository) Apply(ctx context.Context, plan func(state *model.PolicyState) (*model.PolicyPlan, error)) (*model.PolicyPlan, error) {
+	var result *model.PolicyPlan
+
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		// Блокировка запрещает параллельные изменения RBAC до конца транзакции, чтение не блокируется
+		_, err := tx.Exec(ctx, `LOCK TABLE permissions, roles, role_permissions IN SHARE ROW EXCLUSIVE MODE`)
+		if err != nil {
+			return fmt.Errorf("failed to lock rbac tables: %w", err)
+		}
+
+		state, err := loadState(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		result, err = plan(state)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range result.Changes {
+			if err = applyChange(ctx, tx, state, change); err != nil {
+				return err
+			}
+		}
+
+		// Согласующие задаются после создания всех ролей: роль может ссылаться на роль, созданную позже в плане
+		for _, change := range result.Changes {
+			if err = applyApproval(ctx, tx, state, change); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func applyChange(ctx context.Context, tx pgx.Tx, state *model.PolicyState, change *model.PolicyChange) error {
+	switch change.Type {
+	case model.PolicyChangeCreatePermission:
+		resource, action, _ := strings.Cut(change.Permission, authz.Separator)
+		var id string
+		err := tx.QueryRow(ctx,
+			`INSERT INTO permissions (resource, action) VALUES ($1, $2) RETURNING id::text`,
+			resource, action).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("failed to create permission %s: %w", change.Permission, err)
+		}
+		state.PermissionIDs[change.Permission] = id
+		return nil
+
+	case model.PolicyChangeCreateRole:
+		var id string
+		err := tx.QueryRow(ctx,
+			`INSERT INTO roles (name, description) VALUES ($1, $2) RETURNING id::text`,
+			change.Role, change.Description).Scan(&id)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return model.ErrRoleAlreadyExists
+			}
+			return fmt.Errorf("failed to create role %s: %w", change.Role, err)
+		}
+		state.RoleIDs[change.Role] = id
+		change.RoleID = id
+		return nil
+	}
+
+	roleID, ok := state.RoleIDs[change.Role]
+	if !ok {
+		return fmt.Errorf("%w: role %s is missing in policy state", model.ErrInternal, change.Role)
+	}
+	change.RoleID = roleID
+
+	switch change.Type {
+	case model.PolicyChangeUpdateRole:
+		_, err := tx.Exec(ctx,
+			`UPDATE roles SET description = $2, updated_at = NOW() WHERE id = $1`,
+			roleID, change.Description)
+		if err != nil {
+			return fmt.Errorf("failed to update role %s: %w", change.Role, err)
+		}
+
+	case model.PolicyChangeDeleteRole:
+		_, err := tx.Exec(ctx, `UPDATE roles SET deleted_at = NOW() WHERE id = $1`, roleID)
+		if err != nil {
+			return fmt.Errorf("failed to delete role %s: %w", change.Role, err)
+		}
+
+	case model.PolicyChangeAddGrant, model.PolicyChangeUpdateGrant, model.PolicyChangeRemoveGrant:
+		permissionID, ok := state.PermissionIDs[change.Permission]
+		if !ok {
+			return fmt.Errorf("%w: permission %s is missing in policy state", model.ErrInternal, change.Permission)
+		}
+		if err := applyGrantChange(ctx, tx, roleID, permissionID, change); err != nil {
+			return fmt.Errorf("failed to apply %s %s for role %s: %w", change.Type, change.Permission, change.Role, err)
+		}
+
+	default:
+		return fmt.Errorf("%w: unknown policy change type %q", model.ErrInternal, change.Type)
+	}
+
+	return nil
+}
+
+func applyApproval(ctx context.Context, tx pgx.Tx, state *model.PolicyState, change *model.PolicyChange) error {
+	if change.Type != model.PolicyChangeCreateRole && change.Type != model.PolicyChangeUpdateRole {
+		return nil
+	}
+
+	var approverID *string
+	if change.Approver != "" {
+		id, ok := state.RoleIDs[change.Approver]
+		if !ok {
+			return fmt.Errorf("%w: approver role %s is missing in policy state", model.ErrInternal, change.Approver)
+		}
+		approverID = &id
+	}
+
+	_, err := tx.Exec(ctx,
+		`UPDATE roles SET requires_approval = $2, approver_role_id = $3 WHERE id = $1`,
+		change.RoleID, change.RequiresApproval, approverID)
+	if err != nil {
+		return fmt.Errorf("failed to set approval for role %s: %w", change.Role, err)
+	}
+	return nil
+}
+
+func applyGrantChange(ctx context.Context, tx pgx.Tx, roleID, permissionID string, change *model.PolicyChange) error {
+	var err error
+	switch change.Type {
+	case model.PolicyChangeAddGrant:
+		_, err = tx.Exec(ctx, `
+			INSERT INTO role_permissions (role_id, permission_id, effect, condition)
+			VALUES ($1, $2, $3, $4)`,
+			roleID, permissionID, converter.EffectToRepo(change.Effect), converter.ConditionToRepo(change.Condition))
+	case model.PolicyChangeUpdateGrant:
+		_, err = tx.Exec(ctx, `
+			UPDATE role_permissions SET effect = $3, condition = $4
+			WHERE role_id = $1 AND permission_id = $2`,
+			roleID, permissionID, converter.EffectToRepo(change.Effect), converter.ConditionToRepo(change.Condition))
+	case model.PolicyChangeRemoveGrant:
+		_, err = tx.Exec(ctx, `DELETE FROM role_permissions WHERE role_id = $1 AND permission_id = $2`, roleID, permissionID)
+	}
+	return err
+}
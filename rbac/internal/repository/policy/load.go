@@ -0,0 +1,61 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// querier общий интерфейс пула и транзакции для чтения состояния
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func (r *policyRepository) Load(ctx context.Context) (*model.PolicyState, error) {
+	return loadState(ctx, r.readPool)
+}
+
+func loadState(ctx context.Context, q querier) (*model.PolicyState, error) {
+	rows, err := q.Query(ctx, `SELECT id, resource, action FROM permissions ORDER BY resource, action`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load permissions: %w", err)
+	}
+	permissions, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.Permission])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect permissions: %w", err)
+	}
+
+	rows, err = q.Query(ctx, `
+		SELECT id, name, description, is_system, requires_approval, approver_role_id
+		FROM roles
+		WHERE deleted_at IS NULL
+		ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load roles: %w", err)
+	}
+	roles, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.Role])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect roles: %w", err)
+	}
+
+	rows, err = q.Query(ctx, `
+		SELECT rp.role_id, p.resource, p.action, rp.effect, rp.condition
+		FROM role_permissions rp
+		JOIN roles r ON r.id = rp.role_id AND r.deleted_at IS NULL
+		JOIN permissions p ON p.id = rp.permission_id
+		ORDER BY p.resource, p.action`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role permissions: %w", err)
+	}
+	grants, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.PolicyGrant])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect role permissions: %w", err)
+	}
+
+	return converter.PolicyStateToDomain(permissions, roles, grants), nil
+}
@@ -0,0 +1,21 @@
+package policy
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+)
+
+var _ def.PolicyRepository = (*policyRepository)(nil)
+
+type policyRepository struct {
+	writePool *pgxpool.Pool // Primary - для применения политики
+	readPool  *pgxpool.Pool // Replica - для выгрузки
+}
+
+func NewRepository(writePool, readPool *pgxpool.Pool) *policyRepository {
+	return &policyRepository{
+		writePool: writePool,
+		readPool:  readPool,
+	}
+}
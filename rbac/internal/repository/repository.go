@@ -14,7 +14,8 @@ type RoleRepository interface {
 	Get(ctx context.Context, id string) (*model.Role, error)
 	Update(ctx context.Context, updateRole *model.UpdateRole) error
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context) ([]*model.Role, error)
+	Restore(ctx context.Context, id string) error
+	List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error)
 }
 
 type PermissionRepository interface {
@@ -22,20 +23,85 @@ type PermissionRepository interface {
 }
 
 type UserRoleRepository interface {
-	Assign(ctx context.Context, userID, roleID string, assignedBy *string) error
+	Assign(ctx context.Context, assignment *model.AssignUserRole) error
+	BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error)
 	Revoke(ctx context.Context, userID, roleID string) error
 	GetUserRoles(ctx context.Context, userID string) ([]string, error)
+	// GetUserEnrichedRoles загружает действующие роли пользователя вместе с правами одним запросом
+	GetUserEnrichedRoles(ctx context.Context, userID string) ([]*model.EnrichedRole, error)
 	GetRoleUsers(ctx context.Context, roleID string, limit int32, cursor string) ([]string, *string, error)
+	DeleteExpired(ctx context.Context, limit int32) ([]*model.UserRole, error)
 }
 
 type RolePermissionRepository interface {
-	Assign(ctx context.Context, roleID, permissionID string) error
+	Assign(ctx context.Context, assignment *model.AssignRolePermission) error
 	Revoke(ctx context.Context, roleID, permissionID string) error
 	GetRolePermissions(ctx context.Context, roleID string) ([]*model.Permission, error)
+	SetPermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error)
 }
 
-type EnrichedRoleRepository interface {
-	Set(ctx context.Context, role *model.EnrichedRole, expiresAt time.Time) error
-	Get(ctx context.Context, id string) (*model.EnrichedRole, error)
+// PolicyRepository выгрузка и применение декларативной политики RBAC
+type PolicyRepository interface {
+	// Load загружает права, активные роли и назначения прав ролям
+	Load(ctx context.Context) (*model.PolicyState, error)
+	// Apply в одной транзакции блокирует таблицы RBAC, строит функцией plan план
+	// по актуальному состоянию и применяет его. RoleID изменений заполняется по результату.
+	Apply(ctx context.Context, plan func(state *model.PolicyState) (*model.PolicyPlan, error)) (*model.PolicyPlan, error)
+}
+
+type AccessRequestRepository interface {
+	Create(ctx context.Context, request *model.CreateAccessRequest) (*model.AccessRequest, error)
+	Get(ctx context.Context, id string) (*model.AccessRequest, error)
+	List(ctx context.Context, filter *model.AccessRequestFilter) ([]*model.AccessRequest, *string, error)
+	// Decide возвращает model.ErrAccessRequestNotPending, если решение уже принято или срок истек
+	Decide(ctx context.Context, decision *model.AccessRequestDecision) (*model.AccessRequest, error)
+	Reopen(ctx context.Context, id string) error
+	ExpirePending(ctx context.Context, limit int32) ([]*model.AccessRequest, error)
+}
+
+// RoleConstraintRepository ограничения разделения обязанностей
+type RoleConstraintRepository interface {
+	Create(ctx context.Context, constraint *model.CreateRoleConstraint) (*model.RoleConstraint, error)
+	Get(ctx context.Context, id string) (*model.RoleConstraint, error)
+	List(ctx context.Context) ([]*model.RoleConstraint, error)
 	Delete(ctx context.Context, id string) error
+	ListViolations(ctx context.Context, constraintID *string) ([]*model.ConstraintViolation, error)
+}
+
+// AccessReviewRepository кампании пересмотра доступа
+type AccessReviewRepository interface {
+	// Create создает кампанию вместе со снимком назначений в одной транзакции
+	Create(ctx context.Context, campaign *model.CreateAccessReview) (*model.AccessReviewCampaign, error)
+	Get(ctx context.Context, id string) (*model.AccessReviewCampaign, error)
+	List(ctx context.Context, filter *model.AccessReviewFilter) ([]*model.AccessReviewCampaign, *string, error)
+	GetItem(ctx context.Context, campaignID, itemID string) (*model.AccessReviewItem, error)
+	ListItems(ctx context.Context, filter *model.AccessReviewItemFilter) ([]*model.AccessReviewItem, *string, error)
+	// DecideItem возвращает model.ErrAccessReviewClosed, если кампания уже закрыта
+	DecideItem(ctx context.Context, decision *model.AccessReviewItemDecision) (*model.AccessReviewItem, error)
+	// Close возвращает model.ErrAccessReviewClosed, если кампания уже закрыта
+	Close(ctx context.Context, id string, closedBy *string, revokeUnreviewed bool) (*model.AccessReviewCampaign, error)
+	// ListPendingRevocations назначения с решением «отозвать», отзыв которых еще не применен
+	ListPendingRevocations(ctx context.Context, campaignID string) ([]*model.AccessReviewItem, error)
+	MarkRevoked(ctx context.Context, itemID string) error
+}
+
+type AuditEventRepository interface {
+	Create(ctx context.Context, event *model.AuditEvent) error
+	List(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, *string, error)
+}
+
+// EnrichedRoleRepository кэш ролей с правами.
+// Записи хранятся под текущей версией роли: Invalidate увеличивает версию,
+// поэтому запись, загруженная до изменения, не может перезаписать актуальное состояние.
+type EnrichedRoleRepository interface {
+	GetVersion(ctx context.Context, id string) (int64, error)
+	// Get возвращает model.ErrCacheMiss при отсутствии записи и model.ErrRoleNotFound для отрицательной записи
+	Get(ctx context.Context, id string, version int64) (*model.EnrichedRole, error)
+	// GetMany возвращает найденные в кэше роли и текущие версии всех запрошенных ролей
+	GetMany(ctx context.Context, ids []string) (map[string]*model.EnrichedRole, map[string]int64, error)
+	Set(ctx context.Context, role *model.EnrichedRole, version int64, expiresAt time.Time) error
+	// SetMany сохраняет роли под версиями из versions; роли без версии пропускаются
+	SetMany(ctx context.Context, roles []*model.EnrichedRole, versions map[string]int64, expiresAt time.Time) error
+	SetNotFound(ctx context.Context, id string, version int64, expiresAt time.Time) error
+	Invalidate(ctx context.Context, id string) error
 }
@@ -0,0 +1,35 @@
+package role
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// encodeCursor кодирует позицию последней роли страницы (имя и ID) в непрозрачный курсор
+func encodeCursor(name string, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id.String() + ":" + name))
+}
+
+// decodeCursor возвращает имя и ID роли, после которой начинается страница
+func decodeCursor(cursor string) (string, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", uuid.Nil, model.ErrInvalidCursor
+	}
+
+	rawID, name, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", uuid.Nil, model.ErrInvalidCursor
+	}
+
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return "", uuid.Nil, model.ErrInvalidCursor
+	}
+
+	return name, id, nil
+}
@@ -2,21 +2,27 @@ package role
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
 	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
 )
 
 func (r *roleRepository) Get(ctx context.Context, id string) (*model.Role, error) {
-	query := `SELECT id, name, description, created_at, updated_at, deleted_at FROM roles WHERE id = $1 AND deleted_at IS NULL`
+	query := `SELECT id, name, description, is_system, requires_approval, approver_role_id, created_at, updated_at, deleted_at FROM roles WHERE id = $1 AND deleted_at IS NULL`
 
 	row := r.readPool.QueryRow(ctx, query, id)
 
 	var role repoModel.Role
-	err := row.Scan(&role.ID, &role.Name, &role.Description, &role.CreatedAt, &role.UpdatedAt, &role.DeletedAt)
+	err := row.Scan(&role.ID, &role.Name, &role.Description, &role.IsSystem, &role.RequiresApproval, &role.ApproverRoleID, &role.CreatedAt, &role.UpdatedAt, &role.DeletedAt)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrRoleNotFound
+		}
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
 
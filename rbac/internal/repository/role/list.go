@@ -3,7 +3,9 @@ package role
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
@@ -11,28 +13,79 @@ import (
 	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
 )
 
-func (r *roleRepository) List(ctx context.Context) ([]*model.Role, error) {
-	query := `
-		SELECT id, name, description, created_at, updated_at, deleted_at
-		FROM roles 
-		WHERE deleted_at IS NULL
-		ORDER BY name ASC`
+// likeEscaper экранирует спецсимволы шаблона LIKE в поисковой строке
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
-	rows, err := r.readPool.Query(ctx, query)
+func (r *roleRepository) List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error) {
+	builder := sq.StatementBuilder.
+		Select(
+			"r.id", "r.name", "r.description", "r.is_system", "r.requires_approval", "r.approver_role_id", "r.created_at", "r.updated_at", "r.deleted_at",
+			`(SELECT COUNT(*) FROM user_roles ur
+				WHERE ur.role_id = r.id
+				  AND ur.valid_from <= NOW()
+				  AND (ur.valid_until IS NULL OR ur.valid_until > NOW()))::int AS user_count`,
+		).
+		From("roles r").
+		OrderBy("r.name ASC", "r.id ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	switch filter.Status {
+	case model.RoleStatusDeleted:
+		builder = builder.Where(sq.NotEq{"r.deleted_at": nil})
+	case model.RoleStatusAll:
+	default:
+		builder = builder.Where(sq.Eq{"r.deleted_at": nil})
+	}
+
+	if filter.NameQuery != "" {
+		builder = builder.Where(sq.ILike{"r.name": "%" + likeEscaper.Replace(filter.NameQuery) + "%"})
+	}
+
+	if len(filter.PermissionIDs) > 0 {
+		builder = builder.Where(sq.Expr(
+			`r.id IN (SELECT rp.role_id FROM role_permissions rp
+				WHERE rp.permission_id = ANY(?::uuid[])
+				GROUP BY rp.role_id
+				HAVING COUNT(DISTINCT rp.permission_id) = ?)`,
+			filter.PermissionIDs, len(filter.PermissionIDs),
+		))
+	}
+
+	if filter.Cursor != "" {
+		name, id, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		builder = builder.Where(sq.Expr("(r.name, r.id) > (?, ?)", name, id))
+	}
+
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit) + 1)
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list roles: %w", err)
+		return nil, nil, fmt.Errorf("%w: failed to build list query: %w", model.ErrInternal, err)
 	}
-	defer rows.Close()
 
-	rawRows, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.Role])
+	rows, err := r.readPool.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to collect roles: %w", err)
+		return nil, nil, fmt.Errorf("failed to list roles: %w", err)
 	}
+	defer rows.Close()
 
-	result, err := converter.RolesToDomain(rawRows)
+	rawRows, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.RoleListRow])
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert roles: %w", err)
+		return nil, nil, fmt.Errorf("failed to collect roles: %w", err)
+	}
+
+	var nextCursor *string
+	if filter.Limit > 0 && len(rawRows) > int(filter.Limit) {
+		rawRows = rawRows[:filter.Limit]
+		last := rawRows[len(rawRows)-1]
+		next := encodeCursor(last.Name, last.ID)
+		nextCursor = &next
 	}
 
-	return result, nil
+	return converter.RoleListRowsToDomain(rawRows), nextCursor, nil
 }
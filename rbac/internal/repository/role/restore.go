@@ -0,0 +1,30 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (r *roleRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE roles SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND deleted_at IS NOT NULL`
+	result, err := r.writePool.Exec(ctx, query, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		// Имя уже занято активной ролью, созданной после удаления
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return model.ErrRoleAlreadyExists
+		}
+		return fmt.Errorf("%w: restore role failed: %w", model.ErrInternal, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrRoleNotFound
+	}
+
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func (r *roleRepository) Update(ctx context.Context, updateRole *model.UpdateRole) error {
-	updates, err := converter.UpdateRoleToRepo(updateRole.Name, updateRole.Description)
+	updates, err := converter.UpdateRoleToRepo(updateRole)
 	if err != nil {
 		return fmt.Errorf("failed to prepare update data: %w", err)
 	}
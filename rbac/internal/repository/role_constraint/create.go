@@ -0,0 +1,52 @@
+package role_constraint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (r *roleConstraintRepository) Create(ctx context.Context, constraint *model.CreateRoleConstraint) (*model.RoleConstraint, error) {
+	created := &model.RoleConstraint{
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		RoleIDs:     slices.Sorted(slices.Values(constraint.RoleIDs)),
+	}
+
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO role_constraints (name, description)
+			VALUES ($1, $2)
+			RETURNING id, created_at`,
+			constraint.Name, constraint.Description).Scan(&created.ID, &created.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO role_constraint_roles (constraint_id, role_id)
+			SELECT $1, unnest($2::uuid[])`,
+			created.ID, constraint.RoleIDs)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case "23505": // Unique constraint violation
+				return nil, model.ErrRoleConstraintExists
+			case "23503": // Foreign key constraint violation
+				return nil, model.ErrRoleNotFound
+			}
+		}
+		return nil, fmt.Errorf("%w: create role constraint failed: %w", model.ErrInternal, err)
+	}
+
+	return created, nil
+}
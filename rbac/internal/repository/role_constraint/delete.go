@@ -0,0 +1,21 @@
+package role_constraint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (r *roleConstraintRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.writePool.Exec(ctx, `DELETE FROM role_constraints WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("%w: delete role constraint failed: %w", model.ErrInternal, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return model.ErrRoleConstraintNotFound
+	}
+
+	return nil
+}
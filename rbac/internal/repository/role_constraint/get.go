@@ -0,0 +1,32 @@
+package role_constraint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *roleConstraintRepository) Get(ctx context.Context, id string) (*model.RoleConstraint, error) {
+	query := selectConstraints + ` WHERE c.id = $1 GROUP BY c.id`
+
+	rows, err := r.readPool.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: get role constraint failed: %w", model.ErrInternal, err)
+	}
+
+	constraint, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[repoModel.RoleConstraint])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrRoleConstraintNotFound
+		}
+		return nil, fmt.Errorf("%w: get role constraint failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.RoleConstraintToDomain(&constraint), nil
+}
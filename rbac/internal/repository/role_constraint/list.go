@@ -0,0 +1,29 @@
+package role_constraint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *roleConstraintRepository) List(ctx context.Context) ([]*model.RoleConstraint, error) {
+	query := selectConstraints + ` GROUP BY c.id ORDER BY c.name`
+
+	rows, err := r.readPool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: list role constraints failed: %w", model.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	constraints, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.RoleConstraint])
+	if err != nil {
+		return nil, fmt.Errorf("%w: collect role constraints failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.RoleConstraintsToDomain(constraints), nil
+}
@@ -0,0 +1,50 @@
+package role_constraint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// ListViolations возвращает пользователей, у которых действуют или запланированы несколько ролей
+// одного набора с пересекающимися периодами действия.
+// Если constraintID задан, отчет строится только по этому ограничению.
+func (r *roleConstraintRepository) ListViolations(ctx context.Context, constraintID *string) ([]*model.ConstraintViolation, error) {
+	query := `
+		SELECT c.id::text AS constraint_id, c.name AS constraint_name, ur.user_id::text AS user_id,
+			array_agg(ur.role_id::text ORDER BY ur.role_id) AS role_ids
+		FROM role_constraints c
+		JOIN role_constraint_roles cr ON cr.constraint_id = c.id
+		JOIN user_roles ur ON ur.role_id = cr.role_id
+		WHERE (ur.valid_until IS NULL OR ur.valid_until > NOW())
+		  AND EXISTS (
+			SELECT 1
+			FROM role_constraint_roles other_cr
+			JOIN user_roles other ON other.role_id = other_cr.role_id AND other.user_id = ur.user_id
+			WHERE other_cr.constraint_id = c.id
+			  AND other.role_id <> ur.role_id
+			  AND tstzrange(other.valid_from, other.valid_until) && tstzrange(ur.valid_from, ur.valid_until)
+		  )
+		  AND ($1::uuid IS NULL OR c.id = $1::uuid)
+		GROUP BY c.id, c.name, ur.user_id
+		HAVING COUNT(*) > 1
+		ORDER BY c.name, ur.user_id`
+
+	rows, err := r.readPool.Query(ctx, query, constraintID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: list constraint violations failed: %w", model.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	violations, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.ConstraintViolation])
+	if err != nil {
+		return nil, fmt.Errorf("%w: collect constraint violations failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.ConstraintViolationsToDomain(violations), nil
+}
@@ -0,0 +1,28 @@
+package role_constraint
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+)
+
+var _ def.RoleConstraintRepository = (*roleConstraintRepository)(nil)
+
+// selectConstraints выборка ограничений вместе с упорядоченным набором ролей
+const selectConstraints = `
+	SELECT c.id, c.name, c.description, c.created_at,
+		array_agg(cr.role_id::text ORDER BY cr.role_id) AS role_ids
+	FROM role_constraints c
+	JOIN role_constraint_roles cr ON cr.constraint_id = c.id`
+
+type roleConstraintRepository struct {
+	writePool *pgxpool.Pool // Primary - для записи (INSERT, DELETE)
+	readPool  *pgxpool.Pool // Replica - для чтения (SELECT)
+}
+
+func NewRepository(writePool, readPool *pgxpool.Pool) *roleConstraintRepository {
+	return &roleConstraintRepository{
+		writePool: writePool,
+		readPool:  readPool,
+	}
+}
@@ -9,13 +9,14 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
 )
 
-func (r *rolePermissionRepository) Assign(ctx context.Context, roleID, permissionID string) error {
+func (r *rolePermissionRepository) Assign(ctx context.Context, assignment *model.AssignRolePermission) error {
 	query, args, err := sq.StatementBuilder.
 		Insert("role_permissions").
-		Columns("role_id", "permission_id").
-		Values(roleID, permissionID).
+		Columns("role_id", "permission_id", "effect", "condition").
+		Values(assignment.RoleID, assignment.PermissionID, converter.EffectToRepo(assignment.Effect), assignment.Condition).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -13,7 +13,7 @@ import (
 
 func (r *rolePermissionRepository) GetRolePermissions(ctx context.Context, roleID string) ([]*model.Permission, error) {
 	query := `
-		SELECT p.id, p.resource, p.action
+		SELECT p.id, p.resource, p.action, rp.effect, rp.condition
 		FROM permissions p
 		JOIN role_permissions rp ON p.id = rp.permission_id
 		WHERE rp.role_id = $1
@@ -0,0 +1,87 @@
+package role_permission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+)
+
+// SetPermissions заменяет набор прав роли в одной транзакции.
+// Новые права назначаются с эффектом allow без условия, сохраняемые не изменяются.
+func (r *rolePermissionRepository) SetPermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error) {
+	diff := &model.RolePermissionsDiff{
+		RoleID:    roleID,
+		Added:     []string{},
+		Removed:   []string{},
+		Unchanged: []string{},
+	}
+
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		// Блокировка роли сериализует параллельные замены набора прав
+		var locked string
+		err := tx.QueryRow(ctx, `SELECT id::text FROM roles WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, roleID).Scan(&locked)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return model.ErrRoleNotFound
+			}
+			return fmt.Errorf("failed to lock role: %w", err)
+		}
+
+		var found int
+		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM permissions WHERE id = ANY($1::uuid[])`, permissionIDs).Scan(&found)
+		if err != nil {
+			return fmt.Errorf("failed to check permissions: %w", err)
+		}
+		if found != len(permissionIDs) {
+			return model.ErrPermissionNotFound
+		}
+
+		rows, err := tx.Query(ctx, `
+			DELETE FROM role_permissions
+			WHERE role_id = $1 AND permission_id <> ALL($2::uuid[])
+			RETURNING permission_id::text`, roleID, permissionIDs)
+		if err != nil {
+			return fmt.Errorf("failed to remove role permissions: %w", err)
+		}
+		diff.Removed, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("failed to collect removed permissions: %w", err)
+		}
+
+		rows, err = tx.Query(ctx, `
+			INSERT INTO role_permissions (role_id, permission_id, effect)
+			SELECT $1, id, $3 FROM unnest($2::uuid[]) AS id
+			ON CONFLICT (role_id, permission_id) DO NOTHING
+			RETURNING permission_id::text`, roleID, permissionIDs, converter.EffectToRepo(authz.EffectAllow))
+		if err != nil {
+			return fmt.Errorf("failed to add role permissions: %w", err)
+		}
+		diff.Added, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("failed to collect added permissions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	added := make(map[string]struct{}, len(diff.Added))
+	for _, id := range diff.Added {
+		added[id] = struct{}{}
+	}
+	for _, id := range permissionIDs {
+		if _, ok := added[id]; !ok {
+			diff.Unchanged = append(diff.Unchanged, id)
+		}
+	}
+
+	return diff, nil
+}
@@ -2,33 +2,39 @@ package user_role
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
-	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
-func (r *userRoleRepository) Assign(ctx context.Context, userID, roleID string, assignedBy *string) error {
-	query := `INSERT INTO user_roles (user_id, role_id, assigned_by) VALUES ($1, $2, $3)`
-	result, err := r.writePool.Exec(ctx, query, userID, roleID, assignedBy)
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			switch pgErr.Code {
-			case "23505": // unique_violation
-				return model.ErrRoleAlreadyAssigned
-			case "23503": // foreign_key_violation
-				return model.ErrUserRoleNotFound
-			}
+const assignQuery = `
+	INSERT INTO user_roles (user_id, role_id, assigned_by, valid_from, valid_until)
+	VALUES ($1, $2, $3, COALESCE($4, NOW()), $5)`
+
+// Assign назначает роль после проверки ограничений разделения обязанностей
+func (r *userRoleRepository) Assign(ctx context.Context, assignment *model.AssignUserRole) error {
+	return pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		if err := checkConstraints(ctx, tx, assignment); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, assignQuery,
+			assignment.UserID,
+			assignment.RoleID,
+			assignment.AssignedBy,
+			assignment.ValidFrom,
+			assignment.ValidUntil,
+		)
+		if err != nil {
+			return mapAssignError(err)
 		}
-		return fmt.Errorf("%w: assign role failed: %w", model.ErrInternal, err)
-	}
 
-	if rows := result.RowsAffected(); rows == 0 {
-		return fmt.Errorf("%w: assign role failed: no rows affected", model.ErrInternal)
-	}
+		if rows := result.RowsAffected(); rows == 0 {
+			return fmt.Errorf("%w: assign role failed: no rows affected", model.ErrInternal)
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -0,0 +1,76 @@
+package user_role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// BulkAssign назначает роли в одной транзакции. Каждый элемент выполняется в своей точке
+// сохранения, поэтому ожидаемые ошибки (дубликат, отсутствующая роль, неверный период,
+// нарушение разделения обязанностей) отражаются в результате элемента, не прерывая остальные.
+// Прочие ошибки откатывают весь пакет.
+func (r *userRoleRepository) BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error) {
+	results := make([]*model.BulkAssignResult, 0, len(assignments))
+
+	err := pgx.BeginFunc(ctx, r.writePool, func(tx pgx.Tx) error {
+		for _, assignment := range assignments {
+			status, err := r.assignInSavepoint(ctx, tx, assignment)
+			if err != nil {
+				return err
+			}
+			results = append(results, &model.BulkAssignResult{Assignment: assignment, Status: status})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *userRoleRepository) assignInSavepoint(ctx context.Context, tx pgx.Tx, assignment *model.AssignUserRole) (model.BulkAssignStatus, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: create savepoint failed: %w", model.ErrInternal, err)
+	}
+	defer func() { _ = savepoint.Rollback(ctx) }()
+
+	if err = checkConstraints(ctx, savepoint, assignment); err != nil {
+		if errors.Is(err, model.ErrSoDViolation) {
+			return model.BulkAssignStatusSoDViolation, nil
+		}
+		return 0, err
+	}
+
+	_, err = savepoint.Exec(ctx, assignQuery,
+		assignment.UserID,
+		assignment.RoleID,
+		assignment.AssignedBy,
+		assignment.ValidFrom,
+		assignment.ValidUntil,
+	)
+	if err != nil {
+		mapped := mapAssignError(err)
+		switch {
+		case errors.Is(mapped, model.ErrRoleAlreadyAssigned):
+			return model.BulkAssignStatusAlreadyAssigned, nil
+		case errors.Is(mapped, model.ErrUserRoleNotFound):
+			return model.BulkAssignStatusNotFound, nil
+		case errors.Is(mapped, model.ErrInvalidValidityPeriod):
+			return model.BulkAssignStatusInvalidValidityPeriod, nil
+		}
+		return 0, mapped
+	}
+
+	if err = savepoint.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%w: release savepoint failed: %w", model.ErrInternal, err)
+	}
+
+	return model.BulkAssignStatusAssigned, nil
+}
@@ -0,0 +1,49 @@
+package user_role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// lockUserQuery сериализует назначения одному пользователю до конца транзакции,
+// чтобы параллельные назначения конфликтующих ролей не прошли проверку одновременно
+const lockUserQuery = `SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0))`
+
+// conflictQuery ищет роль пользователя из одного ограничения с назначаемой ролью, период действия
+// которой пересекается с периодом нового назначения. Периоды полуоткрытые [valid_from, valid_until),
+// отсутствие valid_until - бессрочное назначение, отсутствие valid_from у нового - с текущего момента
+const conflictQuery = `
+	SELECT c.id::text, c.name, ur.role_id::text
+	FROM role_constraint_roles assigned
+	JOIN role_constraints c ON c.id = assigned.constraint_id
+	JOIN role_constraint_roles held ON held.constraint_id = c.id AND held.role_id <> assigned.role_id
+	JOIN user_roles ur ON ur.role_id = held.role_id AND ur.user_id = $1
+	WHERE assigned.role_id = $2
+	  AND tstzrange(ur.valid_from, ur.valid_until) && tstzrange(COALESCE($3::timestamptz, NOW()), $4::timestamptz)
+	ORDER BY c.name
+	LIMIT 1`
+
+// checkConstraints проверяет ограничения разделения обязанностей для назначения
+// и возвращает *model.SoDViolationError при конфликте
+func checkConstraints(ctx context.Context, tx pgx.Tx, assignment *model.AssignUserRole) error {
+	if _, err := tx.Exec(ctx, lockUserQuery, assignment.UserID); err != nil {
+		return fmt.Errorf("%w: lock user assignments failed: %w", model.ErrInternal, err)
+	}
+
+	violation := &model.SoDViolationError{RoleID: assignment.RoleID}
+	err := tx.QueryRow(ctx, conflictQuery, assignment.UserID, assignment.RoleID, assignment.ValidFrom, assignment.ValidUntil).
+		Scan(&violation.ConstraintID, &violation.ConstraintName, &violation.ConflictingRoleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return mapAssignError(err)
+	}
+
+	return violation
+}
@@ -0,0 +1,39 @@
+package user_role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+// DeleteExpired удаляет пачку истекших назначений и возвращает удаленные связи
+func (r *userRoleRepository) DeleteExpired(ctx context.Context, limit int32) ([]*model.UserRole, error) {
+	query := `
+		DELETE FROM user_roles
+		WHERE (user_id, role_id) IN (
+			SELECT user_id, role_id FROM user_roles
+			WHERE valid_until IS NOT NULL AND valid_until <= NOW()
+			ORDER BY valid_until
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING user_id, role_id, assigned_by, assigned_at, valid_from, valid_until`
+
+	rows, err := r.writePool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: delete expired user roles failed: %w", model.ErrInternal, err)
+	}
+	defer rows.Close()
+
+	rawRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.UserRole])
+	if err != nil {
+		return nil, fmt.Errorf("%w: collect expired user roles failed: %w", model.ErrInternal, err)
+	}
+
+	return converter.UserRolesToDomain(rawRows), nil
+}
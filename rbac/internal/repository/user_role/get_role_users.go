@@ -10,9 +10,18 @@ import (
 func (r *userRoleRepository) GetRoleUsers(ctx context.Context, roleID string, limit int32, cursor string) ([]string, *string, error) {
 	query := `
 		SELECT user_id::text FROM user_roles
-		WHERE role_id = $1 AND ($2::text = '' OR user_id > $2)
+		WHERE role_id = $1
+		  AND valid_from <= NOW()
+		  AND (valid_until IS NULL OR valid_until > NOW())
+		  AND ($2::uuid IS NULL OR user_id > $2::uuid)
 		ORDER BY user_id LIMIT $3`
-	args := []interface{}{roleID, cursor, limit + 1}
+
+	// Пустой курсор - первая страница
+	var after *string
+	if cursor != "" {
+		after = &cursor
+	}
+	args := []interface{}{roleID, after, limit + 1}
 
 	rows, err := r.readPool.Query(ctx, query, args...)
 	if err != nil {
@@ -27,8 +36,9 @@ func (r *userRoleRepository) GetRoleUsers(ctx context.Context, roleID string, li
 
 	var nextCursor *string
 	if len(userIDs) > int(limit) {
-		next := userIDs[limit]
 		userIDs = userIDs[:limit]
+		// Курсор — последний пользователь страницы: следующая выборка идет строго после него
+		next := userIDs[limit-1]
 		nextCursor = &next
 	}
 
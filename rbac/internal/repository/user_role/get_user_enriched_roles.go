@@ -0,0 +1,50 @@
+package user_role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/converter"
+	repoModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/model"
+)
+
+func (r *userRoleRepository) GetUserEnrichedRoles(ctx context.Context, userID string) ([]*model.EnrichedRole, error) {
+	query := `
+		SELECT r.id, r.name, r.description, r.is_system, r.requires_approval, r.approver_role_id, r.created_at, r.updated_at, r.deleted_at,
+			COALESCE(
+				json_agg(json_build_object(
+					'id', p.id,
+					'resource', p.resource,
+					'action', p.action,
+					'effect', rp.effect,
+					'condition', rp.condition
+				) ORDER BY p.resource, p.action) FILTER (WHERE p.id IS NOT NULL),
+				'[]'
+			) AS permissions
+		FROM user_roles ur
+		JOIN roles r ON r.id = ur.role_id AND r.deleted_at IS NULL
+		LEFT JOIN role_permissions rp ON rp.role_id = r.id
+		LEFT JOIN permissions p ON p.id = rp.permission_id
+		WHERE ur.user_id = $1
+		  AND ur.valid_from <= NOW()
+		  AND (ur.valid_until IS NULL OR ur.valid_until > NOW())
+		GROUP BY r.id
+		ORDER BY r.name
+	`
+
+	rows, err := r.readPool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user enriched roles: %w", err)
+	}
+	defer rows.Close()
+
+	enrichedRoles, err := pgx.CollectRows(rows, pgx.RowToStructByNameLax[repoModel.EnrichedRoleRow])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect user enriched roles: %w", err)
+	}
+
+	return converter.EnrichedRoleRowsToDomain(enrichedRoles)
+}
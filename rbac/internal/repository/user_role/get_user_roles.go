@@ -12,6 +12,9 @@ func (r *userRoleRepository) GetUserRoles(ctx context.Context, userID string) ([
 		FROM roles r 
 		JOIN user_roles ur ON r.id = ur.role_id 
 		WHERE ur.user_id = $1
+		  AND r.deleted_at IS NULL
+		  AND ur.valid_from <= NOW()
+		  AND (ur.valid_until IS NULL OR ur.valid_until > NOW())
 		ORDER BY r.name`
 
 	rows, err := r.readPool.Query(ctx, query, userID)
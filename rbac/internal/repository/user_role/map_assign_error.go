@@ -0,0 +1,26 @@
+package user_role
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// mapAssignError преобразует ошибку вставки назначения в доменную ошибку
+func mapAssignError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation
+			return model.ErrRoleAlreadyAssigned
+		case "23503": // foreign_key_violation
+			return model.ErrUserRoleNotFound
+		case "23514": // check_violation
+			return model.ErrInvalidValidityPeriod
+		}
+	}
+	return fmt.Errorf("%w: assign role failed: %w", model.ErrInternal, err)
+}
@@ -0,0 +1,100 @@
+package user_role_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// exclusiveRoles создаёт две роли, входящие в одно ограничение разделения обязанностей
+func (s *RepositorySuite) exclusiveRoles() (string, string) {
+	held, assigned := s.createRole(), s.createRole()
+
+	var constraintID string
+	err := s.pool.QueryRow(s.ctx, `INSERT INTO role_constraints (name) VALUES ($1) RETURNING id::text`,
+		"sod-"+uuid.NewString()[:8]).Scan(&constraintID)
+	s.Require().NoError(err)
+
+	_, err = s.pool.Exec(s.ctx,
+		`INSERT INTO role_constraint_roles (constraint_id, role_id) VALUES ($1, $2), ($1, $3)`,
+		constraintID, held, assigned)
+	s.Require().NoError(err)
+
+	return held, assigned
+}
+
+// TestAssignSoDValidityWindows проверяет, что конфликтом считаются только пересекающиеся периоды действия
+func (s *RepositorySuite) TestAssignSoDValidityWindows() {
+	now := time.Now()
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name         string
+		heldFrom     time.Time
+		heldUntil    *time.Time
+		assignFrom   *time.Time
+		assignUntil  *time.Time
+		wantConflict bool
+	}{
+		{
+			name:         "active permanent binding conflicts",
+			heldFrom:     now.Add(-time.Hour),
+			wantConflict: true,
+		},
+		{
+			name:      "expired binding does not conflict",
+			heldFrom:  now.Add(-2 * time.Hour),
+			heldUntil: at(-time.Hour),
+		},
+		{
+			name:       "assignment starts after held binding ends",
+			heldFrom:   now.Add(-time.Hour),
+			heldUntil:  at(time.Hour),
+			assignFrom: at(2 * time.Hour),
+		},
+		{
+			name:        "assignment ends before future binding starts",
+			heldFrom:    now.Add(24 * time.Hour),
+			assignUntil: at(time.Hour),
+		},
+		{
+			name:        "adjacent windows do not overlap",
+			heldFrom:    now.Add(-time.Hour),
+			heldUntil:   at(time.Hour),
+			assignFrom:  at(time.Hour),
+			assignUntil: at(2 * time.Hour),
+		},
+		{
+			name:         "permanent assignment overlaps future binding",
+			heldFrom:     now.Add(24 * time.Hour),
+			wantConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			held, assigned := s.exclusiveRoles()
+			userID := uuid.NewString()
+			s.bind(userID, held, tt.heldFrom, tt.heldUntil)
+
+			err := s.repository.Assign(s.ctx, &model.AssignUserRole{
+				UserID:     userID,
+				RoleID:     assigned,
+				ValidFrom:  tt.assignFrom,
+				ValidUntil: tt.assignUntil,
+			})
+
+			if tt.wantConflict {
+				assert.ErrorIs(s.T(), err, model.ErrSoDViolation)
+				return
+			}
+			assert.NoError(s.T(), err)
+		})
+	}
+}
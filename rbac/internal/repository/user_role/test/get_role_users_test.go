@@ -0,0 +1,81 @@
+package user_role_test
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// members назначает роль count пользователям и возвращает их ID в порядке выборки
+func (s *RepositorySuite) members(roleID string, count int) []string {
+	userIDs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		userID := uuid.NewString()
+		s.bind(userID, roleID, time.Now().Add(-time.Hour), nil)
+		userIDs = append(userIDs, userID)
+	}
+
+	sort.Strings(userIDs)
+	return userIDs
+}
+
+// TestGetRoleUsersPagination проверяет, что обход страниц не теряет пользователей на границе страниц
+func (s *RepositorySuite) TestGetRoleUsersPagination() {
+	roleID := s.createRole()
+	members := s.members(roleID, 5)
+
+	var (
+		collected []string
+		cursor    string
+		pages     int
+	)
+	for {
+		page, next, err := s.repository.GetRoleUsers(s.ctx, roleID, 2, cursor)
+		s.Require().NoError(err)
+
+		collected = append(collected, page...)
+		pages++
+		if next == nil {
+			break
+		}
+		cursor = *next
+	}
+
+	assert.Equal(s.T(), members, collected)
+	assert.Equal(s.T(), 3, pages)
+}
+
+// TestGetRoleUsersExactPage проверяет, что полная последняя страница не возвращает курсор
+func (s *RepositorySuite) TestGetRoleUsersExactPage() {
+	roleID := s.createRole()
+	members := s.members(roleID, 2)
+
+	page, next, err := s.repository.GetRoleUsers(s.ctx, roleID, 2, "")
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), members, page)
+	assert.Nil(s.T(), next)
+}
+
+// TestGetRoleUsersActiveBindingsOnly проверяет, что истекшие и будущие назначения не попадают в выборку
+func (s *RepositorySuite) TestGetRoleUsersActiveBindingsOnly() {
+	roleID := s.createRole()
+	now := time.Now()
+	hourAgo, inHour := now.Add(-time.Hour), now.Add(time.Hour)
+
+	permanent, temporary := uuid.NewString(), uuid.NewString()
+	s.bind(permanent, roleID, now.Add(-2*time.Hour), nil)
+	s.bind(temporary, roleID, hourAgo, &inHour)
+	s.bind(uuid.NewString(), roleID, now.Add(-2*time.Hour), &hourAgo) // истекло
+	s.bind(uuid.NewString(), roleID, inHour, nil)                     // ещё не началось
+
+	page, next, err := s.repository.GetRoleUsers(s.ctx, roleID, 10, "")
+
+	s.Require().NoError(err)
+	expected := []string{permanent, temporary}
+	sort.Strings(expected)
+	assert.Equal(s.T(), expected, page)
+	assert.Nil(s.T(), next)
+}
@@ -0,0 +1,114 @@
+package access_explain
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Explain объясняет решение по праву для действующих ролей пользователя.
+// Иерархия ролей не моделируется, поэтому в объяснении участвуют только прямые назначения.
+func (s *AccessExplainService) Explain(ctx context.Context, check *model.AccessCheck) (*model.AccessExplanation, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.explain_access")
+	defer span.End()
+
+	if err := validatePermission(check.Permission); err != nil {
+		return nil, err
+	}
+
+	roles, err := s.userRoleService.GetUserRoles(ctx, check.UserID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ролей пользователя для объяснения решения", err)
+		return nil, err
+	}
+
+	return explain(ctx, roles, check), nil
+}
+
+// validatePermission проверяет, что право задано конкретным разрешением без шаблонов
+func validatePermission(permission string) error {
+	parsed, err := authz.Parse(permission)
+	if err != nil || parsed.Effect == authz.EffectDeny || parsed.IsWildcard() {
+		return model.ErrInvalidPermission.WithMessage(model.MsgPermissionValue, permission)
+	}
+	return nil
+}
+
+// explain вычисляет решение по правам ролей и сопоставляет покрывающие права с ролями
+func explain(ctx context.Context, roles []*model.EnrichedRole, check *model.AccessCheck) *model.AccessExplanation {
+	var (
+		permissions []authz.Permission
+		owners      []*model.EnrichedRole
+		sources     []*model.Permission
+	)
+	result := &model.AccessExplanation{
+		Permission: check.Permission,
+		Roles:      make([]*model.Role, 0, len(roles)),
+		Grants:     []*model.GrantTrace{},
+	}
+	for _, role := range roles {
+		result.Roles = append(result.Roles, &role.Role)
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, authz.Permission{
+				Resource:  permission.Resource,
+				Action:    permission.Action,
+				Effect:    permission.Effect,
+				Condition: permission.Condition,
+			})
+			owners = append(owners, role)
+			sources = append(sources, permission)
+		}
+	}
+
+	decision, matches := authz.Explain(permissions, check.Permission, check.Attributes)
+	result.Decision = decision
+	for _, match := range matches {
+		owner := owners[match.Index]
+		result.Grants = append(result.Grants, &model.GrantTrace{
+			RoleID:     owner.Role.ID.String(),
+			RoleName:   owner.Role.Name,
+			Permission: sources[match.Index],
+			Applied:    match.Applied,
+		})
+	}
+	result.Reason = reason(ctx, result)
+
+	return result
+}
+
+// reason формирует краткое описание причины решения на языке клиента
+func reason(ctx context.Context, explanation *model.AccessExplanation) string {
+	switch explanation.Decision {
+	case authz.DecisionDeny:
+		grant := firstApplied(explanation.Grants, authz.EffectDeny)
+		return i18n.Translate(ctx, model.MsgExplainDenied, explanation.Permission, grantKey(grant), grant.RoleName)
+	case authz.DecisionAllow:
+		grant := firstApplied(explanation.Grants, authz.EffectAllow)
+		return i18n.Translate(ctx, model.MsgExplainAllowed, explanation.Permission, grantKey(grant), grant.RoleName)
+	}
+
+	if len(explanation.Roles) == 0 {
+		return i18n.Translate(ctx, model.MsgExplainNoRoles)
+	}
+	if len(explanation.Grants) == 0 {
+		return i18n.Translate(ctx, model.MsgExplainNotGranted, explanation.Permission)
+	}
+	return i18n.Translate(ctx, model.MsgExplainConditionsNotMet, explanation.Permission)
+}
+
+func firstApplied(grants []*model.GrantTrace, effect authz.Effect) *model.GrantTrace {
+	for _, grant := range grants {
+		if grant.Applied && grant.Permission.Effect == effect {
+			return grant
+		}
+	}
+	return nil
+}
+
+func grantKey(grant *model.GrantTrace) string {
+	return authz.Permission{Resource: grant.Permission.Resource, Action: grant.Permission.Action}.Key()
+}
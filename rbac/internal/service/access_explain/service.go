@@ -0,0 +1,26 @@
+package access_explain
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ service.AccessExplainServiceInterface = (*AccessExplainService)(nil)
+
+type AccessExplainService struct {
+	userRoleService    service.UserRoleServiceInterface
+	roleService        service.RoleServiceInterface
+	roleConstraintRepo repository.RoleConstraintRepository
+}
+
+func NewService(
+	userRoleService service.UserRoleServiceInterface,
+	roleService service.RoleServiceInterface,
+	roleConstraintRepo repository.RoleConstraintRepository,
+) *AccessExplainService {
+	return &AccessExplainService{
+		userRoleService:    userRoleService,
+		roleService:        roleService,
+		roleConstraintRepo: roleConstraintRepo,
+	}
+}
@@ -0,0 +1,92 @@
+package access_explain
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Simulate вычисляет решение для текущих ролей и для ролей после изменений.
+// Изменения не применяются; ограничения разделения обязанностей проверяются
+// для итогового набора ролей, но не приводят к ошибке.
+func (s *AccessExplainService) Simulate(ctx context.Context, check *model.AccessCheck, changes *model.RoleChanges) (*model.AccessSimulation, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.simulate_access")
+	defer span.End()
+
+	if err := validatePermission(check.Permission); err != nil {
+		return nil, err
+	}
+
+	current, err := s.userRoleService.GetUserRoles(ctx, check.UserID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ролей пользователя для моделирования", err)
+		return nil, err
+	}
+
+	simulated, err := s.applyChanges(ctx, current, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	constraints, err := s.roleConstraintRepo.List(ctx)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ограничений ролей для моделирования", err)
+		return nil, err
+	}
+
+	return &model.AccessSimulation{
+		Current:             explain(ctx, current, check),
+		Simulated:           explain(ctx, simulated, check),
+		ViolatedConstraints: violatedConstraints(constraints, simulated),
+	}, nil
+}
+
+// applyChanges возвращает набор ролей после отзыва и назначения; назначаемые роли читаются через RoleService
+func (s *AccessExplainService) applyChanges(ctx context.Context, current []*model.EnrichedRole, changes *model.RoleChanges) ([]*model.EnrichedRole, error) {
+	result := make([]*model.EnrichedRole, 0, len(current)+len(changes.AddRoleIDs))
+	present := make(map[string]struct{}, len(current))
+	for _, role := range current {
+		roleID := role.Role.ID.String()
+		if slices.Contains(changes.RemoveRoleIDs, roleID) {
+			continue
+		}
+		result = append(result, role)
+		present[roleID] = struct{}{}
+	}
+
+	for _, roleID := range changes.AddRoleIDs {
+		if _, ok := present[roleID]; ok {
+			continue
+		}
+
+		role, err := s.roleService.Get(ctx, roleID)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения назначаемой роли для моделирования", err)
+			return nil, err
+		}
+		result = append(result, role)
+		present[roleID] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// violatedConstraints возвращает имена ограничений, из набора которых у пользователя больше одной роли
+func violatedConstraints(constraints []*model.RoleConstraint, roles []*model.EnrichedRole) []string {
+	violated := []string{}
+	for _, constraint := range constraints {
+		count := 0
+		for _, role := range roles {
+			if slices.Contains(constraint.RoleIDs, role.Role.ID.String()) {
+				count++
+			}
+		}
+		if count > 1 {
+			violated = append(violated, constraint.Name)
+		}
+	}
+	return violated
+}
@@ -0,0 +1,141 @@
+package access_explain_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/i18n"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func enrichedRole(name string, permissions ...*model.Permission) *model.EnrichedRole {
+	return &model.EnrichedRole{
+		Role:        model.Role{ID: uuid.New(), Name: name},
+		Permissions: permissions,
+	}
+}
+
+func permission(resource, action string, effect authz.Effect, cond string) *model.Permission {
+	return &model.Permission{ID: uuid.New(), Resource: resource, Action: action, Effect: effect, Condition: cond}
+}
+
+func (s *ServiceSuite) TestExplainDenyOverridesAllow() {
+	userID := uuid.NewString()
+	teacher := enrichedRole("teacher", permission("schedule", "*", authz.EffectAllow, ""))
+	suspended := enrichedRole("suspended", permission("schedule", "write", authz.EffectDeny, ""))
+
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{teacher, suspended}, nil).Once()
+
+	result, err := s.service.Explain(s.ctx, &model.AccessCheck{UserID: userID, Permission: "schedule:write"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), authz.DecisionDeny, result.Decision)
+	assert.Len(s.T(), result.Roles, 2)
+	assert.Len(s.T(), result.Grants, 2)
+	assert.Equal(s.T(), "suspended", result.Grants[1].RoleName)
+	assert.Contains(s.T(), result.Reason, "suspended")
+}
+
+func (s *ServiceSuite) TestExplainConditionalAllowWithoutAttributes() {
+	userID := uuid.NewString()
+	teacher := enrichedRole("teacher",
+		permission("grade", "write", authz.EffectAllow, `resource.owner_id == subject.id`))
+
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{teacher}, nil).Twice()
+
+	result, err := s.service.Explain(s.ctx, &model.AccessCheck{UserID: userID, Permission: "grade:write"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), authz.DecisionNotApplicable, result.Decision)
+	assert.False(s.T(), result.Grants[0].Applied)
+
+	result, err = s.service.Explain(s.ctx, &model.AccessCheck{
+		UserID:     userID,
+		Permission: "grade:write",
+		Attributes: condition.NewAttributes(map[string]any{"id": userID}, map[string]any{"owner_id": userID}, nil),
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), authz.DecisionAllow, result.Decision)
+	assert.True(s.T(), result.Grants[0].Applied)
+}
+
+func (s *ServiceSuite) TestExplainMissingPermission() {
+	userID := uuid.NewString()
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{enrichedRole("student", permission("schedule", "read", authz.EffectAllow, ""))}, nil).Once()
+
+	result, err := s.service.Explain(s.ctx, &model.AccessCheck{UserID: userID, Permission: "schedule:write"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), authz.DecisionNotApplicable, result.Decision)
+	assert.Empty(s.T(), result.Grants)
+	assert.Contains(s.T(), result.Reason, "не назначено")
+}
+
+func (s *ServiceSuite) TestExplainReasonFollowsLocale() {
+	userID := uuid.NewString()
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{}, nil).Once()
+
+	ctx := i18n.WithLocale(s.ctx, i18n.EN)
+	result, err := s.service.Explain(ctx, &model.AccessCheck{UserID: userID, Permission: "schedule:write"})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "user has no active roles", result.Reason)
+}
+
+func (s *ServiceSuite) TestExplainRejectsWildcardPermission() {
+	_, err := s.service.Explain(s.ctx, &model.AccessCheck{UserID: uuid.NewString(), Permission: "schedule:*"})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPermission)
+	s.userRoleService.AssertNotCalled(s.T(), "GetUserRoles", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestSimulateAddAndRemoveRoles() {
+	userID := uuid.NewString()
+	student := enrichedRole("student", permission("schedule", "read", authz.EffectAllow, ""))
+	teacher := enrichedRole("teacher", permission("schedule", "write", authz.EffectAllow, ""))
+	parent := enrichedRole("parent")
+	constraint := &model.RoleConstraint{
+		ID:      uuid.New(),
+		Name:    "student_teacher",
+		RoleIDs: []string{student.Role.ID.String(), teacher.Role.ID.String()},
+	}
+
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{student, parent}, nil).Once()
+	s.roleService.On("Get", mock.Anything, teacher.Role.ID.String()).Return(teacher, nil).Once()
+	s.roleConstraintRepository.On("List", mock.Anything).Return([]*model.RoleConstraint{constraint}, nil).Once()
+
+	result, err := s.service.Simulate(s.ctx, &model.AccessCheck{UserID: userID, Permission: "schedule:write"}, &model.RoleChanges{
+		AddRoleIDs:    []string{teacher.Role.ID.String(), student.Role.ID.String()},
+		RemoveRoleIDs: []string{parent.Role.ID.String()},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), authz.DecisionNotApplicable, result.Current.Decision)
+	assert.Equal(s.T(), authz.DecisionAllow, result.Simulated.Decision)
+	assert.Len(s.T(), result.Simulated.Roles, 2)
+	assert.Equal(s.T(), []string{"student_teacher"}, result.ViolatedConstraints)
+	s.roleService.AssertNumberOfCalls(s.T(), "Get", 1)
+}
+
+func (s *ServiceSuite) TestSimulateUnknownRole() {
+	userID := uuid.NewString()
+	roleID := uuid.NewString()
+
+	s.userRoleService.On("GetUserRoles", mock.Anything, userID).Return([]*model.EnrichedRole{}, nil).Once()
+	s.roleService.On("Get", mock.Anything, roleID).Return(nil, model.ErrRoleNotFound).Once()
+
+	_, err := s.service.Simulate(s.ctx, &model.AccessCheck{UserID: userID, Permission: "schedule:read"}, &model.RoleChanges{
+		AddRoleIDs: []string{roleID},
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleNotFound)
+}
@@ -0,0 +1,54 @@
+package access_explain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_explain"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	userRoleService          *serviceMocks.UserRoleServiceInterface
+	roleService              *serviceMocks.RoleServiceInterface
+	roleConstraintRepository *repositoryMocks.RoleConstraintRepository
+
+	service *access_explain.AccessExplainService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.userRoleService = serviceMocks.NewUserRoleServiceInterface(s.T())
+	s.roleService = serviceMocks.NewRoleServiceInterface(s.T())
+	s.roleConstraintRepository = repositoryMocks.NewRoleConstraintRepository(s.T())
+
+	s.service = access_explain.NewService(s.userRoleService, s.roleService, s.roleConstraintRepository)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.userRoleService.ExpectedCalls = nil
+	s.roleService.ExpectedCalls = nil
+	s.roleConstraintRepository.ExpectedCalls = nil
+
+	s.userRoleService.Calls = nil
+	s.roleService.Calls = nil
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,69 @@
+package access_request
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Approve согласует заявку и назначает роль. Если назначить роль не удалось,
+// заявка возвращается в ожидание, чтобы решение можно было повторить.
+func (s *AccessRequestService) Approve(ctx context.Context, id string, comment *string) (*model.AccessRequest, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.approve_access_request")
+	defer span.End()
+
+	role, approver, err := s.checkApprover(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка проверки согласующего заявки", err)
+		return nil, err
+	}
+
+	approved, err := s.accessRequestRepo.Decide(ctx, &model.AccessRequestDecision{
+		ID:        id,
+		Status:    model.AccessRequestStatusApproved,
+		DecidedBy: approver,
+		Comment:   comment,
+	})
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка согласования заявки на доступ", err)
+		return nil, err
+	}
+
+	requestID := approved.ID.String()
+	err = s.userRoleService.Assign(ctx, &model.AssignUserRole{
+		UserID:          approved.UserID,
+		RoleID:          approved.RoleID,
+		AssignedBy:      approver,
+		ValidUntil:      approved.ValidUntil,
+		AccessRequestID: &requestID,
+	})
+	if err != nil && !errors.Is(err, model.ErrRoleAlreadyAssigned) {
+		errreport.Report(ctx, "❌ [Service] Ошибка назначения роли по заявке", err)
+		if reopenErr := s.accessRequestRepo.Reopen(ctx, requestID); reopenErr != nil {
+			logger.Error(ctx, "❌ [Service] Ошибка возврата заявки в ожидание",
+				zap.String("access_request_id", requestID),
+				zap.Error(reopenErr))
+		}
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionAccessRequestApprove,
+		TargetType: model.AuditTargetAccessRequest,
+		TargetID:   requestID,
+		After:      approved,
+	})
+
+	event := &model.AccessRequestEvent{Request: approved, ApproverRoleID: role.ApproverRoleID}
+	if err = s.eventProducer.Produce(ctx, model.EventTypeAccessRequestApproved, approved.UserID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события AccessRequestApproved", zap.Error(err))
+	}
+
+	return approved, nil
+}
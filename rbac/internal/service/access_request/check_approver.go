@@ -0,0 +1,54 @@
+package access_request
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// checkApprover проверяет, что инициатор вправе принять решение по заявке, и возвращает
+// роль заявки и идентификатор согласующего. Системный инициатор (CLI, фоновые процессы) не проверяется,
+// вызов без пользователя отклоняется, а роль без роли согласующих согласовать нельзя.
+func (s *AccessRequestService) checkApprover(ctx context.Context, id string) (*model.Role, *string, error) {
+	request, err := s.accessRequestRepo.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if request.Status != model.AccessRequestStatusPending {
+		return nil, nil, model.ErrAccessRequestNotPending
+	}
+
+	role, err := s.roleRepo.Get(ctx, request.RoleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	switch actor.Type {
+	case audit.ActorTypeSystem:
+		return role, nil, nil
+	case audit.ActorTypeUser:
+	default:
+		return nil, nil, model.ErrApproverUnknown
+	}
+
+	if actor.ID == request.UserID {
+		return nil, nil, model.ErrSelfApproval
+	}
+
+	if role.ApproverRoleID == nil {
+		return nil, nil, model.ErrApproverRoleMissing
+	}
+
+	roleIDs, err := s.userRoleRepo.GetUserRoles(ctx, actor.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !slices.Contains(roleIDs, *role.ApproverRoleID) {
+		return nil, nil, model.ErrNotApprover
+	}
+
+	return role, &actor.ID, nil
+}
@@ -0,0 +1,69 @@
+package access_request
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Create создает заявку на роль, требующую согласования.
+// Если пользователь не указан, заявка оформляется на инициатора из сессии.
+func (s *AccessRequestService) Create(ctx context.Context, request *model.CreateAccessRequest) (*model.AccessRequest, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.create_access_request")
+	defer span.End()
+
+	actor := audit.ActorFromContext(ctx)
+	if actor.Type == audit.ActorTypeUser {
+		request.RequestedBy = &actor.ID
+		if request.UserID == "" {
+			request.UserID = actor.ID
+		}
+	}
+	if request.UserID == "" {
+		return nil, model.ErrAccessRequestUserMissing
+	}
+
+	now := time.Now()
+	if request.ValidUntil != nil && !request.ValidUntil.After(now) {
+		return nil, model.ErrInvalidValidityPeriod
+	}
+
+	role, err := s.roleRepo.Get(ctx, request.RoleID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения роли для заявки", err)
+		return nil, err
+	}
+	if !role.RequiresApproval {
+		return nil, model.ErrApprovalNotRequired
+	}
+
+	request.ExpiresAt = now.Add(requestTTL)
+
+	created, err := s.accessRequestRepo.Create(ctx, request)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка создания заявки на доступ", err)
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionAccessRequestCreate,
+		TargetType: model.AuditTargetAccessRequest,
+		TargetID:   created.ID.String(),
+		After:      created,
+	})
+
+	// Событие с ролью согласующих — точка подключения уведомлений
+	event := &model.AccessRequestEvent{Request: created, ApproverRoleID: role.ApproverRoleID}
+	if err = s.eventProducer.Produce(ctx, model.EventTypeAccessRequestCreated, created.UserID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события AccessRequestCreated", zap.Error(err))
+	}
+
+	return created, nil
+}
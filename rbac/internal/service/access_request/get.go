@@ -0,0 +1,22 @@
+package access_request
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessRequestService) Get(ctx context.Context, id string) (*model.AccessRequest, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.get_access_request")
+	defer span.End()
+
+	request, err := s.accessRequestRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения заявки на доступ", err)
+		return nil, err
+	}
+
+	return request, nil
+}
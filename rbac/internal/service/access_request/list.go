@@ -0,0 +1,22 @@
+package access_request
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessRequestService) List(ctx context.Context, filter *model.AccessRequestFilter) ([]*model.AccessRequest, *string, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_access_requests")
+	defer span.End()
+
+	requests, nextCursor, err := s.accessRequestRepo.List(ctx, filter)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения списка заявок на доступ", err)
+		return nil, nil, err
+	}
+
+	return requests, nextCursor, nil
+}
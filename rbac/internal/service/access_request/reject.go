@@ -0,0 +1,48 @@
+package access_request
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessRequestService) Reject(ctx context.Context, id string, comment *string) (*model.AccessRequest, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.reject_access_request")
+	defer span.End()
+
+	role, approver, err := s.checkApprover(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка проверки согласующего заявки", err)
+		return nil, err
+	}
+
+	rejected, err := s.accessRequestRepo.Decide(ctx, &model.AccessRequestDecision{
+		ID:        id,
+		Status:    model.AccessRequestStatusRejected,
+		DecidedBy: approver,
+		Comment:   comment,
+	})
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка отклонения заявки на доступ", err)
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionAccessRequestReject,
+		TargetType: model.AuditTargetAccessRequest,
+		TargetID:   rejected.ID.String(),
+		After:      rejected,
+	})
+
+	event := &model.AccessRequestEvent{Request: rejected, ApproverRoleID: role.ApproverRoleID}
+	if err = s.eventProducer.Produce(ctx, model.EventTypeAccessRequestRejected, rejected.UserID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события AccessRequestRejected", zap.Error(err))
+	}
+
+	return rejected, nil
+}
@@ -0,0 +1,40 @@
+package access_request
+
+import (
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+// requestTTL — срок, в течение которого заявка ожидает решения согласующего
+const requestTTL = 72 * time.Hour
+
+var _ service.AccessRequestServiceInterface = (*AccessRequestService)(nil)
+
+type AccessRequestService struct {
+	accessRequestRepo repository.AccessRequestRepository
+	roleRepo          repository.RoleRepository
+	userRoleRepo      repository.UserRoleRepository
+	userRoleService   service.UserRoleServiceInterface
+	auditService      service.AuditServiceInterface
+	eventProducer     service.DomainEventProducerService
+}
+
+func NewService(
+	accessRequestRepo repository.AccessRequestRepository,
+	roleRepo repository.RoleRepository,
+	userRoleRepo repository.UserRoleRepository,
+	userRoleService service.UserRoleServiceInterface,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+) *AccessRequestService {
+	return &AccessRequestService{
+		accessRequestRepo: accessRequestRepo,
+		roleRepo:          roleRepo,
+		userRoleRepo:      userRoleRepo,
+		userRoleService:   userRoleService,
+		auditService:      auditService,
+		eventProducer:     eventProducer,
+	}
+}
@@ -0,0 +1,57 @@
+package access_request_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateForSessionUser() {
+	userID := uuid.NewString()
+	roleID := uuid.NewString()
+	approverRoleID := uuid.NewString()
+	created := &model.AccessRequest{ID: uuid.New(), UserID: userID, RoleID: roleID, Status: model.AccessRequestStatusPending}
+
+	s.roleRepository.On("Get", mock.Anything, roleID).
+		Return(&model.Role{RequiresApproval: true, ApproverRoleID: &approverRoleID}, nil).Once()
+	s.accessRequestRepository.On("Create", mock.Anything, mock.MatchedBy(func(request *model.CreateAccessRequest) bool {
+		return request.UserID == userID &&
+			request.RequestedBy != nil && *request.RequestedBy == userID &&
+			!request.ExpiresAt.IsZero()
+	})).Return(created, nil).Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeAccessRequestCreated, userID,
+		mock.MatchedBy(func(event *model.AccessRequestEvent) bool {
+			return event.Request == created && event.ApproverRoleID == &approverRoleID
+		})).Return(nil).Once()
+
+	result, err := s.service.Create(s.userContext(userID), &model.CreateAccessRequest{
+		RoleID:        roleID,
+		Justification: "нужен доступ для проверки журналов",
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), created, result)
+
+	s.accessRequestRepository.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreateApprovalNotRequired() {
+	roleID := uuid.NewString()
+
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(&model.Role{}, nil).Once()
+
+	_, err := s.service.Create(s.userContext(uuid.NewString()), &model.CreateAccessRequest{RoleID: roleID})
+
+	assert.ErrorIs(s.T(), err, model.ErrApprovalNotRequired)
+	s.accessRequestRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateWithoutUser() {
+	_, err := s.service.Create(s.ctx, &model.CreateAccessRequest{RoleID: uuid.NewString()})
+
+	assert.ErrorIs(s.T(), err, model.ErrAccessRequestUserMissing)
+}
@@ -0,0 +1,183 @@
+package access_request_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// pendingRequest настраивает заявку в ожидании и роль с указанной ролью согласующих
+func (s *ServiceSuite) pendingRequest(approverRoleID *string) *model.AccessRequest {
+	request := &model.AccessRequest{
+		ID:     uuid.New(),
+		UserID: uuid.NewString(),
+		RoleID: uuid.NewString(),
+		Status: model.AccessRequestStatusPending,
+	}
+
+	s.accessRequestRepository.On("Get", mock.Anything, request.ID.String()).Return(request, nil).Once()
+	s.roleRepository.On("Get", mock.Anything, request.RoleID).
+		Return(&model.Role{RequiresApproval: true, ApproverRoleID: approverRoleID}, nil).Once()
+
+	return request
+}
+
+// approver возвращает пользователя, состоящего в роли согласующих
+func (s *ServiceSuite) approver(approverRoleID string) string {
+	approverID := uuid.NewString()
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, approverID).Return([]string{approverRoleID}, nil).Once()
+
+	return approverID
+}
+
+func (s *ServiceSuite) TestApproveAssignsRole() {
+	approverRoleID := uuid.NewString()
+	approverID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+	id := request.ID.String()
+	approved := *request
+	approved.Status = model.AccessRequestStatusApproved
+
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, approverID).Return([]string{approverRoleID}, nil).Once()
+	s.accessRequestRepository.On("Decide", mock.Anything, mock.MatchedBy(func(decision *model.AccessRequestDecision) bool {
+		return decision.ID == id && decision.Status == model.AccessRequestStatusApproved &&
+			decision.DecidedBy != nil && *decision.DecidedBy == approverID
+	})).Return(&approved, nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, mock.MatchedBy(func(assignment *model.AssignUserRole) bool {
+		return assignment.UserID == request.UserID && assignment.RoleID == request.RoleID &&
+			assignment.AccessRequestID != nil && *assignment.AccessRequestID == id
+	})).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionAccessRequestApprove && record.TargetID == id
+	})).Return().Once()
+
+	result, err := s.service.Approve(s.userContext(approverID), id, nil)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.AccessRequestStatusApproved, result.Status)
+
+	s.userRoleService.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApproveReopensOnAssignError() {
+	approverRoleID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+	id := request.ID.String()
+	assignErr := errors.New("database unavailable")
+
+	s.accessRequestRepository.On("Decide", mock.Anything, mock.Anything).Return(request, nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, mock.Anything).Return(assignErr).Once()
+	s.accessRequestRepository.On("Reopen", mock.Anything, id).Return(nil).Once()
+
+	_, err := s.service.Approve(s.userContext(s.approver(approverRoleID)), id, nil)
+
+	assert.ErrorIs(s.T(), err, assignErr)
+	s.accessRequestRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApproveAlreadyAssignedIsSuccess() {
+	approverRoleID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+
+	s.accessRequestRepository.On("Decide", mock.Anything, mock.Anything).Return(request, nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, mock.Anything).Return(model.ErrRoleAlreadyAssigned).Once()
+
+	_, err := s.service.Approve(s.userContext(s.approver(approverRoleID)), request.ID.String(), nil)
+
+	assert.NoError(s.T(), err)
+	s.accessRequestRepository.AssertNotCalled(s.T(), "Reopen", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestApproveNotApprover() {
+	approverRoleID := uuid.NewString()
+	actorID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, actorID).Return([]string{uuid.NewString()}, nil).Once()
+
+	_, err := s.service.Approve(s.userContext(actorID), request.ID.String(), nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrNotApprover)
+	s.accessRequestRepository.AssertNotCalled(s.T(), "Decide", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestApproveSelf() {
+	approverRoleID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+
+	_, err := s.service.Approve(s.userContext(request.UserID), request.ID.String(), nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrSelfApproval)
+}
+
+func (s *ServiceSuite) TestApproveWithoutUserRejected() {
+	approverRoleID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+
+	_, err := s.service.Approve(s.ctx, request.ID.String(), nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrApproverUnknown)
+	s.accessRequestRepository.AssertNotCalled(s.T(), "Decide", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestApproveWithoutApproverRoleFailsClosed() {
+	request := s.pendingRequest(nil)
+
+	_, err := s.service.Approve(s.userContext(uuid.NewString()), request.ID.String(), nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrApproverRoleMissing)
+	s.accessRequestRepository.AssertNotCalled(s.T(), "Decide", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestApproveBySystemActor() {
+	request := s.pendingRequest(nil)
+
+	s.accessRequestRepository.On("Decide", mock.Anything, mock.MatchedBy(func(decision *model.AccessRequestDecision) bool {
+		return decision.DecidedBy == nil
+	})).Return(request, nil).Once()
+	s.userRoleService.On("Assign", mock.Anything, mock.Anything).Return(nil).Once()
+
+	_, err := s.service.Approve(audit.WithSystemActor(s.ctx, "rbac_policy_cli"), request.ID.String(), nil)
+
+	assert.NoError(s.T(), err)
+	s.accessRequestRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRejectNotPending() {
+	request := &model.AccessRequest{ID: uuid.New(), Status: model.AccessRequestStatusRejected}
+	s.accessRequestRepository.On("Get", mock.Anything, request.ID.String()).Return(request, nil).Once()
+
+	_, err := s.service.Reject(s.userContext(uuid.NewString()), request.ID.String(), nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrAccessRequestNotPending)
+}
+
+func (s *ServiceSuite) TestRejectRecordsDecision() {
+	approverRoleID := uuid.NewString()
+	request := s.pendingRequest(&approverRoleID)
+	id := request.ID.String()
+	comment := "нет обоснования"
+	rejected := *request
+	rejected.Status = model.AccessRequestStatusRejected
+
+	s.accessRequestRepository.On("Decide", mock.Anything, mock.MatchedBy(func(decision *model.AccessRequestDecision) bool {
+		return decision.Status == model.AccessRequestStatusRejected && decision.Comment == &comment
+	})).Return(&rejected, nil).Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeAccessRequestRejected, request.UserID, mock.Anything).
+		Return(nil).Once()
+
+	result, err := s.service.Reject(s.userContext(s.approver(approverRoleID)), id, &comment)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.AccessRequestStatusRejected, result.Status)
+	s.userRoleService.AssertNotCalled(s.T(), "Assign", mock.Anything, mock.Anything)
+	s.eventProducer.AssertExpectations(s.T())
+}
@@ -0,0 +1,90 @@
+package access_request_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_request"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	accessRequestRepository *repositoryMocks.AccessRequestRepository
+	roleRepository          *repositoryMocks.RoleRepository
+	userRoleRepository      *repositoryMocks.UserRoleRepository
+	userRoleService         *serviceMocks.UserRoleServiceInterface
+	auditService            *serviceMocks.AuditServiceInterface
+	eventProducer           *serviceMocks.DomainEventProducerService
+
+	service *access_request.AccessRequestService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.accessRequestRepository = repositoryMocks.NewAccessRequestRepository(s.T())
+	s.roleRepository = repositoryMocks.NewRoleRepository(s.T())
+	s.userRoleRepository = repositoryMocks.NewUserRoleRepository(s.T())
+	s.userRoleService = serviceMocks.NewUserRoleServiceInterface(s.T())
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.service = access_request.NewService(s.accessRequestRepository, s.roleRepository, s.userRoleRepository,
+		s.userRoleService, s.auditService, s.eventProducer)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.accessRequestRepository.ExpectedCalls = nil
+	s.roleRepository.ExpectedCalls = nil
+	s.userRoleRepository.ExpectedCalls = nil
+	s.userRoleService.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	s.accessRequestRepository.Calls = nil
+	s.userRoleService.Calls = nil
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+// userContext возвращает контекст запроса пользователя, прошедшего через Envoy
+func (s *ServiceSuite) userContext(userID string) context.Context {
+	md := metadata.New(map[string]string{interceptor.HeaderUserID: userID})
+
+	var userCtx context.Context
+	_, _ = interceptor.IdentityInterceptor()(
+		metadata.NewIncomingContext(s.ctx, md), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, _ any) (any, error) {
+			userCtx = ctx
+			return nil, nil
+		},
+	)
+
+	return userCtx
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
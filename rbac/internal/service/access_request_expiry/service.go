@@ -0,0 +1,63 @@
+package access_request_expiry
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+const (
+	// sweepInterval — периодичность проверки просроченных заявок
+	sweepInterval = time.Minute
+	// sweepBatchSize — максимальное количество заявок, закрываемых за один запрос
+	sweepBatchSize int32 = 500
+	// auditActorName — имя системного инициатора в журнале аудита
+	auditActorName = "access_request_expiry"
+)
+
+var _ def.AccessRequestExpiryService = (*AccessRequestExpiryService)(nil)
+
+type AccessRequestExpiryService struct {
+	accessRequestRepo repository.AccessRequestRepository
+	eventProducer     def.DomainEventProducerService
+	auditService      def.AuditServiceInterface
+	interval          time.Duration
+}
+
+func NewService(
+	accessRequestRepo repository.AccessRequestRepository,
+	eventProducer def.DomainEventProducerService,
+	auditService def.AuditServiceInterface,
+) *AccessRequestExpiryService {
+	return &AccessRequestExpiryService{
+		accessRequestRepo: accessRequestRepo,
+		eventProducer:     eventProducer,
+		auditService:      auditService,
+		interval:          sweepInterval,
+	}
+}
+
+func (s *AccessRequestExpiryService) Run(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Запуск закрытия просроченных заявок на доступ",
+		zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sweep(ctx); err != nil {
+			logger.Error(ctx, "❌ Ошибка закрытия просроченных заявок на доступ", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
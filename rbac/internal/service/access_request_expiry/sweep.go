@@ -0,0 +1,58 @@
+package access_request_expiry
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Sweep переводит просроченные заявки в expired пачками и публикует события.
+// Возвращает количество закрытых заявок.
+func (s *AccessRequestExpiryService) Sweep(ctx context.Context) (int, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.sweep_expired_access_requests")
+	defer span.End()
+
+	ctx = audit.WithSystemActor(ctx, auditActorName)
+
+	total := 0
+	for {
+		expired, err := s.accessRequestRepo.ExpirePending(ctx, sweepBatchSize)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка закрытия просроченных заявок на доступ", err)
+			return total, err
+		}
+
+		for _, request := range expired {
+			s.auditService.Record(ctx, &model.AuditRecord{
+				Action:     model.AuditActionAccessRequestExpire,
+				TargetType: model.AuditTargetAccessRequest,
+				TargetID:   request.ID.String(),
+				After:      request,
+			})
+
+			event := &model.AccessRequestEvent{Request: request}
+			if err = s.eventProducer.Produce(ctx, model.EventTypeAccessRequestExpired, request.UserID, event); err != nil {
+				logger.Error(ctx, "❌ Ошибка публикации события истечения заявки",
+					zap.String("access_request_id", request.ID.String()),
+					zap.Error(err))
+			}
+		}
+
+		total += len(expired)
+		if len(expired) < int(sweepBatchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info(ctx, "🧹 Закрыты просроченные заявки на доступ", zap.Int("count", total))
+	}
+
+	return total, nil
+}
@@ -0,0 +1,51 @@
+package access_request_expiry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/access_request_expiry"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	accessRequestRepository *repositoryMocks.AccessRequestRepository
+	eventProducer           *serviceMocks.DomainEventProducerService
+	auditService            *serviceMocks.AuditServiceInterface
+
+	service *access_request_expiry.AccessRequestExpiryService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.accessRequestRepository = repositoryMocks.NewAccessRequestRepository(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+
+	s.service = access_request_expiry.NewService(s.accessRequestRepository, s.eventProducer, s.auditService)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.accessRequestRepository.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
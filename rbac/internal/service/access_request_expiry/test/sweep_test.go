@@ -0,0 +1,50 @@
+package access_request_expiry_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestSweepExpiresPending() {
+	expired := []*model.AccessRequest{
+		{ID: uuid.New(), UserID: uuid.NewString(), Status: model.AccessRequestStatusExpired},
+		{ID: uuid.New(), UserID: uuid.NewString(), Status: model.AccessRequestStatusExpired},
+	}
+
+	s.accessRequestRepository.On("ExpirePending", mock.Anything, mock.AnythingOfType("int32")).Return(expired, nil).Once()
+
+	for _, request := range expired {
+		s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+			return record.Action == model.AuditActionAccessRequestExpire &&
+				record.TargetID == request.ID.String()
+		})).Return().Once()
+		s.eventProducer.On("Produce", mock.Anything, model.EventTypeAccessRequestExpired, request.UserID,
+			mock.MatchedBy(func(event *model.AccessRequestEvent) bool {
+				return event.Request == request
+			})).Return(nil).Once()
+	}
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), len(expired), count)
+
+	s.accessRequestRepository.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSweepRepositoryError() {
+	repoErr := errors.New("database unavailable")
+	s.accessRequestRepository.On("ExpirePending", mock.Anything, mock.AnythingOfType("int32")).Return(nil, repoErr).Once()
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.ErrorIs(s.T(), err, repoErr)
+	assert.Zero(s.T(), count)
+}
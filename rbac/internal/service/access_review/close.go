@@ -0,0 +1,86 @@
+package access_review
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Close закрывает кампанию и отзывает роли по решениям «отозвать» через UserRoleService.Revoke.
+// Ошибка отзыва отдельного назначения не прерывает закрытие: такие назначения остаются
+// без отметки об отзыве (summary.revoke > summary.revoked) и отзываются повторным вызовом Close.
+func (s *AccessReviewService) Close(ctx context.Context, id string, revokeUnreviewed bool) (*model.AccessReviewCampaign, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.close_access_review")
+	defer span.End()
+
+	var closedBy *string
+	actor := audit.ActorFromContext(ctx)
+	if actor.Type == audit.ActorTypeUser {
+		closedBy = &actor.ID
+	}
+
+	justClosed := true
+	campaign, err := s.accessReviewRepo.Close(ctx, id, closedBy, revokeUnreviewed)
+	if errors.Is(err, model.ErrAccessReviewClosed) {
+		justClosed = false
+		campaign, err = s.accessReviewRepo.Get(ctx, id)
+	}
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка закрытия кампании пересмотра доступа", err)
+		return nil, err
+	}
+
+	items, err := s.accessReviewRepo.ListPendingRevocations(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения отзываемых назначений кампании", err)
+		return nil, err
+	}
+	campaign.Summary.Revoked += s.applyRevocations(ctx, items)
+
+	if justClosed {
+		s.auditService.Record(ctx, &model.AuditRecord{
+			Action:     model.AuditActionAccessReviewClose,
+			TargetType: model.AuditTargetAccessReview,
+			TargetID:   id,
+			After:      campaign,
+		})
+
+		event := &model.AccessReviewEvent{Campaign: campaign}
+		if err = s.eventProducer.Produce(ctx, model.EventTypeAccessReviewClosed, id, event); err != nil {
+			logger.Error(ctx, "❌ [Service] Ошибка публикации события AccessReviewClosed", zap.Error(err))
+		}
+	}
+
+	return campaign, nil
+}
+
+// applyRevocations отзывает роли и возвращает число примененных отзывов.
+// Роль, уже отозванная другим способом, считается отозванной.
+func (s *AccessReviewService) applyRevocations(ctx context.Context, items []*model.AccessReviewItem) int32 {
+	var applied int32
+	for _, item := range items {
+		err := s.userRoleService.Revoke(ctx, item.UserID, item.RoleID)
+		if err != nil && !errors.Is(err, model.ErrRoleNotAssigned) {
+			logger.Error(ctx, "❌ [Service] Ошибка отзыва роли по решению кампании пересмотра",
+				zap.String("access_review_item_id", item.ID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		if err = s.accessReviewRepo.MarkRevoked(ctx, item.ID.String()); err != nil {
+			logger.Error(ctx, "❌ [Service] Ошибка отметки отзыва назначения кампании",
+				zap.String("access_review_item_id", item.ID.String()),
+				zap.Error(err))
+			continue
+		}
+		applied++
+	}
+	return applied
+}
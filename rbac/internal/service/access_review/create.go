@@ -0,0 +1,86 @@
+package access_review
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Create создает кампанию и фиксирует текущих участников ее ролей.
+// Назначения, появившиеся после создания, в кампанию не попадают.
+func (s *AccessReviewService) Create(ctx context.Context, campaign *model.CreateAccessReview) (*model.AccessReviewCampaign, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.create_access_review")
+	defer span.End()
+
+	if campaign.DueAt != nil && !campaign.DueAt.After(time.Now()) {
+		return nil, model.ErrInvalidDueDate
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	if actor.Type == audit.ActorTypeUser {
+		campaign.CreatedBy = &actor.ID
+	}
+
+	campaign.Bindings = nil
+	for _, roleID := range campaign.RoleIDs {
+		if _, err := s.roleRepo.Get(ctx, roleID); err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения роли для кампании пересмотра", err)
+			return nil, err
+		}
+
+		bindings, err := s.snapshotRole(ctx, roleID)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения участников роли для кампании пересмотра", err)
+			return nil, err
+		}
+		campaign.Bindings = append(campaign.Bindings, bindings...)
+	}
+
+	created, err := s.accessReviewRepo.Create(ctx, campaign)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка создания кампании пересмотра доступа", err)
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionAccessReviewCreate,
+		TargetType: model.AuditTargetAccessReview,
+		TargetID:   created.ID.String(),
+		After:      created,
+	})
+
+	event := &model.AccessReviewEvent{Campaign: created}
+	if err = s.eventProducer.Produce(ctx, model.EventTypeAccessReviewStarted, created.ID.String(), event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события AccessReviewStarted", zap.Error(err))
+	}
+
+	return created, nil
+}
+
+// snapshotRole постранично читает участников роли
+func (s *AccessReviewService) snapshotRole(ctx context.Context, roleID string) ([]*model.AccessReviewBinding, error) {
+	var (
+		bindings []*model.AccessReviewBinding
+		cursor   string
+	)
+	for {
+		userIDs, nextCursor, err := s.userRoleService.GetRoleUsers(ctx, roleID, pageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, userID := range userIDs {
+			bindings = append(bindings, &model.AccessReviewBinding{UserID: userID, RoleID: roleID})
+		}
+		if nextCursor == nil {
+			return bindings, nil
+		}
+		cursor = *nextCursor
+	}
+}
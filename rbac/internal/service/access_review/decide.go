@@ -0,0 +1,72 @@
+package access_review
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Decide фиксирует решение проверяющего; до закрытия кампании решение можно изменить.
+// Системный инициатор (CLI, фоновые процессы) не проверяется.
+func (s *AccessReviewService) Decide(ctx context.Context, decision *model.AccessReviewItemDecision) (*model.AccessReviewItem, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.decide_access_review_item")
+	defer span.End()
+
+	before, err := s.checkReviewer(ctx, decision)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка проверки проверяющего кампании", err)
+		return nil, err
+	}
+
+	decided, err := s.accessReviewRepo.DecideItem(ctx, decision)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка сохранения решения по назначению", err)
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionAccessReviewDecide,
+		TargetType: model.AuditTargetAccessReview,
+		TargetID:   decision.CampaignID,
+		Before:     before,
+		After:      decided,
+	})
+
+	return decided, nil
+}
+
+// checkReviewer проверяет, что кампания открыта, а инициатор входит в число проверяющих
+// и не пересматривает собственное назначение. Возвращает назначение до решения.
+func (s *AccessReviewService) checkReviewer(ctx context.Context, decision *model.AccessReviewItemDecision) (*model.AccessReviewItem, error) {
+	campaign, err := s.accessReviewRepo.Get(ctx, decision.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status != model.AccessReviewStatusOpen {
+		return nil, model.ErrAccessReviewClosed
+	}
+
+	item, err := s.accessReviewRepo.GetItem(ctx, decision.CampaignID, decision.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	if actor.Type != audit.ActorTypeUser {
+		return item, nil
+	}
+
+	if !slices.Contains(campaign.ReviewerIDs, actor.ID) {
+		return nil, model.ErrNotReviewer
+	}
+	if actor.ID == item.UserID {
+		return nil, model.ErrSelfReview
+	}
+
+	decision.ReviewedBy = &actor.ID
+	return item, nil
+}
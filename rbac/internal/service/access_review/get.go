@@ -0,0 +1,22 @@
+package access_review
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessReviewService) Get(ctx context.Context, id string) (*model.AccessReviewCampaign, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.get_access_review")
+	defer span.End()
+
+	campaign, err := s.accessReviewRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения кампании пересмотра доступа", err)
+		return nil, err
+	}
+
+	return campaign, nil
+}
@@ -0,0 +1,22 @@
+package access_review
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessReviewService) List(ctx context.Context, filter *model.AccessReviewFilter) ([]*model.AccessReviewCampaign, *string, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_access_reviews")
+	defer span.End()
+
+	campaigns, nextCursor, err := s.accessReviewRepo.List(ctx, filter)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения списка кампаний пересмотра доступа", err)
+		return nil, nil, err
+	}
+
+	return campaigns, nextCursor, nil
+}
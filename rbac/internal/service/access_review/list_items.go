@@ -0,0 +1,27 @@
+package access_review
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AccessReviewService) ListItems(ctx context.Context, filter *model.AccessReviewItemFilter) ([]*model.AccessReviewItem, *string, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_access_review_items")
+	defer span.End()
+
+	if _, err := s.accessReviewRepo.Get(ctx, filter.CampaignID); err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения кампании пересмотра доступа", err)
+		return nil, nil, err
+	}
+
+	items, nextCursor, err := s.accessReviewRepo.ListItems(ctx, filter)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения назначений кампании пересмотра", err)
+		return nil, nil, err
+	}
+
+	return items, nextCursor, nil
+}
@@ -0,0 +1,36 @@
+package access_review
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Report возвращает кампанию со всеми назначениями и решениями по ним
+func (s *AccessReviewService) Report(ctx context.Context, id string) (*model.AccessReviewReport, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.access_review_report")
+	defer span.End()
+
+	campaign, err := s.accessReviewRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения кампании пересмотра доступа", err)
+		return nil, err
+	}
+
+	report := &model.AccessReviewReport{Campaign: campaign, Items: []*model.AccessReviewItem{}}
+	filter := &model.AccessReviewItemFilter{CampaignID: id, Limit: pageSize}
+	for {
+		items, nextCursor, err := s.accessReviewRepo.ListItems(ctx, filter)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения назначений кампании пересмотра", err)
+			return nil, err
+		}
+		report.Items = append(report.Items, items...)
+		if nextCursor == nil {
+			return report, nil
+		}
+		filter.Cursor = *nextCursor
+	}
+}
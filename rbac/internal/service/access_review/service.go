@@ -0,0 +1,35 @@
+package access_review
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+// pageSize — размер страницы при снимке участников ролей и выгрузке отчета
+const pageSize int32 = 500
+
+var _ service.AccessReviewServiceInterface = (*AccessReviewService)(nil)
+
+type AccessReviewService struct {
+	accessReviewRepo repository.AccessReviewRepository
+	roleRepo         repository.RoleRepository
+	userRoleService  service.UserRoleServiceInterface
+	auditService     service.AuditServiceInterface
+	eventProducer    service.DomainEventProducerService
+}
+
+func NewService(
+	accessReviewRepo repository.AccessReviewRepository,
+	roleRepo repository.RoleRepository,
+	userRoleService service.UserRoleServiceInterface,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+) *AccessReviewService {
+	return &AccessReviewService{
+		accessReviewRepo: accessReviewRepo,
+		roleRepo:         roleRepo,
+		userRoleService:  userRoleService,
+		auditService:     auditService,
+		eventProducer:    eventProducer,
+	}
+}
@@ -0,0 +1,82 @@
+package access_review_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestCloseAppliesRevocations() {
+	campaign := &model.AccessReviewCampaign{
+		ID:      uuid.New(),
+		Status:  model.AccessReviewStatusClosed,
+		Summary: model.AccessReviewSummary{Total: 3, Keep: 0, Revoke: 3},
+	}
+	id := campaign.ID.String()
+	revoked := &model.AccessReviewItem{ID: uuid.New(), UserID: uuid.NewString(), RoleID: uuid.NewString()}
+	alreadyRevoked := &model.AccessReviewItem{ID: uuid.New(), UserID: uuid.NewString(), RoleID: uuid.NewString()}
+	failed := &model.AccessReviewItem{ID: uuid.New(), UserID: uuid.NewString(), RoleID: uuid.NewString()}
+
+	s.accessReviewRepository.On("Close", mock.Anything, id, (*string)(nil), true).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("ListPendingRevocations", mock.Anything, id).
+		Return([]*model.AccessReviewItem{revoked, alreadyRevoked, failed}, nil).Once()
+	s.userRoleService.On("Revoke", mock.Anything, revoked.UserID, revoked.RoleID).Return(nil).Once()
+	s.userRoleService.On("Revoke", mock.Anything, alreadyRevoked.UserID, alreadyRevoked.RoleID).Return(model.ErrRoleNotAssigned).Once()
+	s.userRoleService.On("Revoke", mock.Anything, failed.UserID, failed.RoleID).Return(errors.New("db down")).Once()
+	s.accessReviewRepository.On("MarkRevoked", mock.Anything, revoked.ID.String()).Return(nil).Once()
+	s.accessReviewRepository.On("MarkRevoked", mock.Anything, alreadyRevoked.ID.String()).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionAccessReviewClose && record.TargetID == id
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeAccessReviewClosed, id, mock.Anything).Return(nil).Once()
+
+	result, err := s.service.Close(s.ctx, id, true)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(2), result.Summary.Revoked)
+	s.accessReviewRepository.AssertNotCalled(s.T(), "MarkRevoked", mock.Anything, failed.ID.String())
+	s.userRoleService.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCloseRetriesOnClosedCampaign() {
+	campaign := &model.AccessReviewCampaign{
+		ID:      uuid.New(),
+		Status:  model.AccessReviewStatusClosed,
+		Summary: model.AccessReviewSummary{Total: 1, Revoke: 1},
+	}
+	id := campaign.ID.String()
+	item := &model.AccessReviewItem{ID: uuid.New(), UserID: uuid.NewString(), RoleID: uuid.NewString()}
+
+	s.accessReviewRepository.On("Close", mock.Anything, id, (*string)(nil), false).Return(nil, model.ErrAccessReviewClosed).Once()
+	s.accessReviewRepository.On("Get", mock.Anything, id).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("ListPendingRevocations", mock.Anything, id).Return([]*model.AccessReviewItem{item}, nil).Once()
+	s.userRoleService.On("Revoke", mock.Anything, item.UserID, item.RoleID).Return(nil).Once()
+	s.accessReviewRepository.On("MarkRevoked", mock.Anything, item.ID.String()).Return(nil).Once()
+
+	result, err := s.service.Close(s.ctx, id, false)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), int32(1), result.Summary.Revoked)
+	s.auditService.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything)
+	s.eventProducer.AssertNotCalled(s.T(), "Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCloseNotFound() {
+	id := uuid.NewString()
+
+	s.accessReviewRepository.On("Close", mock.Anything, id, (*string)(nil), false).Return(nil, model.ErrAccessReviewClosed).Once()
+	s.accessReviewRepository.On("Get", mock.Anything, id).Return(nil, model.ErrAccessReviewNotFound).Once()
+
+	_, err := s.service.Close(s.ctx, id, false)
+
+	assert.ErrorIs(s.T(), err, model.ErrAccessReviewNotFound)
+	s.userRoleService.AssertNotCalled(s.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything)
+}
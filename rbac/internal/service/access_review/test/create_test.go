@@ -0,0 +1,72 @@
+package access_review_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateSnapshotsAllRoleMembers() {
+	adminRoleID := uuid.NewString()
+	moderatorRoleID := uuid.NewString()
+	creatorID := uuid.NewString()
+	cursor := "user-2"
+	created := &model.AccessReviewCampaign{ID: uuid.New(), Name: "Осень 2025", Status: model.AccessReviewStatusOpen}
+
+	s.roleRepository.On("Get", mock.Anything, adminRoleID).Return(&model.Role{}, nil).Once()
+	s.roleRepository.On("Get", mock.Anything, moderatorRoleID).Return(&model.Role{}, nil).Once()
+	s.userRoleService.On("GetRoleUsers", mock.Anything, adminRoleID, mock.Anything, "").
+		Return([]string{"user-1", "user-2"}, &cursor, nil).Once()
+	s.userRoleService.On("GetRoleUsers", mock.Anything, adminRoleID, mock.Anything, cursor).
+		Return([]string{"user-3"}, nil, nil).Once()
+	s.userRoleService.On("GetRoleUsers", mock.Anything, moderatorRoleID, mock.Anything, "").
+		Return([]string{"user-1"}, nil, nil).Once()
+	s.accessReviewRepository.On("Create", mock.Anything, mock.MatchedBy(func(campaign *model.CreateAccessReview) bool {
+		return len(campaign.Bindings) == 4 &&
+			campaign.Bindings[3].UserID == "user-1" && campaign.Bindings[3].RoleID == moderatorRoleID &&
+			campaign.CreatedBy != nil && *campaign.CreatedBy == creatorID
+	})).Return(created, nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionAccessReviewCreate && record.TargetID == created.ID.String()
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeAccessReviewStarted, created.ID.String(), mock.Anything).
+		Return(nil).Once()
+
+	campaign, err := s.service.Create(s.userContext(creatorID), &model.CreateAccessReview{
+		Name:        "Осень 2025",
+		RoleIDs:     []string{adminRoleID, moderatorRoleID},
+		ReviewerIDs: []string{uuid.NewString()},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), created, campaign)
+	s.userRoleService.AssertExpectations(s.T())
+	s.accessReviewRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreateUnknownRole() {
+	roleID := uuid.NewString()
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(nil, model.ErrRoleNotFound).Once()
+
+	_, err := s.service.Create(s.ctx, &model.CreateAccessReview{Name: "Осень", RoleIDs: []string{roleID}})
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleNotFound)
+	s.accessReviewRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateRejectsPastDueDate() {
+	dueAt := time.Now().Add(-time.Hour)
+
+	_, err := s.service.Create(s.ctx, &model.CreateAccessReview{Name: "Осень", DueAt: &dueAt})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidDueDate)
+	s.userRoleService.AssertNotCalled(s.T(), "GetRoleUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
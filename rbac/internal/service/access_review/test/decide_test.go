@@ -0,0 +1,92 @@
+package access_review_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) openCampaign(reviewerIDs ...string) *model.AccessReviewCampaign {
+	return &model.AccessReviewCampaign{ID: uuid.New(), Status: model.AccessReviewStatusOpen, ReviewerIDs: reviewerIDs}
+}
+
+func (s *ServiceSuite) TestDecideByReviewer() {
+	reviewerID := uuid.NewString()
+	campaign := s.openCampaign(reviewerID)
+	item := &model.AccessReviewItem{ID: uuid.New(), CampaignID: campaign.ID.String(), UserID: uuid.NewString()}
+	decided := *item
+	decided.Decision = model.AccessReviewDecisionRevoke
+
+	s.accessReviewRepository.On("Get", mock.Anything, campaign.ID.String()).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("GetItem", mock.Anything, campaign.ID.String(), item.ID.String()).Return(item, nil).Once()
+	s.accessReviewRepository.On("DecideItem", mock.Anything, mock.MatchedBy(func(decision *model.AccessReviewItemDecision) bool {
+		return decision.ReviewedBy != nil && *decision.ReviewedBy == reviewerID &&
+			decision.Decision == model.AccessReviewDecisionRevoke
+	})).Return(&decided, nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionAccessReviewDecide && record.Before == item && record.After == &decided
+	})).Return().Once()
+
+	result, err := s.service.Decide(s.userContext(reviewerID), &model.AccessReviewItemDecision{
+		CampaignID: campaign.ID.String(),
+		ItemID:     item.ID.String(),
+		Decision:   model.AccessReviewDecisionRevoke,
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), model.AccessReviewDecisionRevoke, result.Decision)
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestDecideNotReviewer() {
+	campaign := s.openCampaign(uuid.NewString())
+	item := &model.AccessReviewItem{ID: uuid.New(), UserID: uuid.NewString()}
+
+	s.accessReviewRepository.On("Get", mock.Anything, campaign.ID.String()).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("GetItem", mock.Anything, campaign.ID.String(), item.ID.String()).Return(item, nil).Once()
+
+	_, err := s.service.Decide(s.userContext(uuid.NewString()), &model.AccessReviewItemDecision{
+		CampaignID: campaign.ID.String(),
+		ItemID:     item.ID.String(),
+		Decision:   model.AccessReviewDecisionKeep,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrNotReviewer)
+	s.accessReviewRepository.AssertNotCalled(s.T(), "DecideItem", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestDecideOwnBinding() {
+	reviewerID := uuid.NewString()
+	campaign := s.openCampaign(reviewerID)
+	item := &model.AccessReviewItem{ID: uuid.New(), UserID: reviewerID}
+
+	s.accessReviewRepository.On("Get", mock.Anything, campaign.ID.String()).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("GetItem", mock.Anything, campaign.ID.String(), item.ID.String()).Return(item, nil).Once()
+
+	_, err := s.service.Decide(s.userContext(reviewerID), &model.AccessReviewItemDecision{
+		CampaignID: campaign.ID.String(),
+		ItemID:     item.ID.String(),
+		Decision:   model.AccessReviewDecisionKeep,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrSelfReview)
+	s.accessReviewRepository.AssertNotCalled(s.T(), "DecideItem", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestDecideClosedCampaign() {
+	campaign := &model.AccessReviewCampaign{ID: uuid.New(), Status: model.AccessReviewStatusClosed}
+
+	s.accessReviewRepository.On("Get", mock.Anything, campaign.ID.String()).Return(campaign, nil).Once()
+
+	_, err := s.service.Decide(s.ctx, &model.AccessReviewItemDecision{
+		CampaignID: campaign.ID.String(),
+		ItemID:     uuid.NewString(),
+		Decision:   model.AccessReviewDecisionKeep,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrAccessReviewClosed)
+	s.accessReviewRepository.AssertNotCalled(s.T(), "DecideItem", mock.Anything, mock.Anything)
+}
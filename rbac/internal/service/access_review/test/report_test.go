@@ -0,0 +1,29 @@
+package access_review_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestReportReadsAllPages() {
+	campaign := &model.AccessReviewCampaign{ID: uuid.New()}
+	id := campaign.ID.String()
+	cursor := "2"
+
+	s.accessReviewRepository.On("Get", mock.Anything, id).Return(campaign, nil).Once()
+	s.accessReviewRepository.On("ListItems", mock.Anything, mock.MatchedBy(func(filter *model.AccessReviewItemFilter) bool {
+		return filter.CampaignID == id && filter.Cursor == ""
+	})).Return([]*model.AccessReviewItem{{ID: uuid.New()}, {ID: uuid.New()}}, &cursor, nil).Once()
+	s.accessReviewRepository.On("ListItems", mock.Anything, mock.MatchedBy(func(filter *model.AccessReviewItemFilter) bool {
+		return filter.CampaignID == id && filter.Cursor == cursor
+	})).Return([]*model.AccessReviewItem{{ID: uuid.New()}}, nil, nil).Once()
+
+	report, err := s.service.Report(s.ctx, id)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), campaign, report.Campaign)
+	assert.Len(s.T(), report.Items, 3)
+}
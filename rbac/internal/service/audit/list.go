@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *AuditService) List(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, *string, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_audit_events")
+	defer span.End()
+
+	events, nextCursor, err := s.auditEventRepo.List(ctx, filter)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения журнала аудита", err)
+		return nil, nil, err
+	}
+
+	return events, nextCursor, nil
+}
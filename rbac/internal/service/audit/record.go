@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Record сохраняет событие аудита с инициатором и request ID из контекста и экспортирует его в Kafka.
+// Изменение к этому моменту уже применено, поэтому ошибки записи не возвращаются вызывающему.
+func (s *AuditService) Record(ctx context.Context, record *model.AuditRecord) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.record_audit_event")
+	defer span.End()
+
+	event, err := newAuditEvent(ctx, record)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка подготовки события аудита", err)
+		return
+	}
+
+	if err = s.auditEventRepo.Create(ctx, event); err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка записи события аудита", err)
+		return
+	}
+
+	if err = s.auditProducerService.ProduceAuditEvent(ctx, *event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка экспорта события аудита",
+			zap.String("event_id", event.ID.String()),
+			zap.Error(err))
+	}
+}
+
+func newAuditEvent(ctx context.Context, record *model.AuditRecord) (*model.AuditEvent, error) {
+	before, err := marshalState(record.Before)
+	if err != nil {
+		return nil, fmt.Errorf("encode before state: %w", err)
+	}
+
+	after, err := marshalState(record.After)
+	if err != nil {
+		return nil, fmt.Errorf("encode after state: %w", err)
+	}
+
+	return &model.AuditEvent{
+		ID:         uuid.New(),
+		OccurredAt: time.Now().UTC(),
+		Actor:      audit.ActorFromContext(ctx),
+		Action:     record.Action,
+		TargetType: record.TargetType,
+		TargetID:   record.TargetID,
+		Before:     before,
+		After:      after,
+		RequestID:  audit.RequestIDFromContext(ctx),
+	}, nil
+}
+
+func marshalState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+	return json.Marshal(state)
+}
@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ service.AuditServiceInterface = (*AuditService)(nil)
+
+type AuditService struct {
+	auditEventRepo       repository.AuditEventRepository
+	auditProducerService service.AuditProducerService
+}
+
+func NewService(
+	auditEventRepo repository.AuditEventRepository,
+	auditProducerService service.AuditProducerService,
+) *AuditService {
+	return &AuditService{
+		auditEventRepo:       auditEventRepo,
+		auditProducerService: auditProducerService,
+	}
+}
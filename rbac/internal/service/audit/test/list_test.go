@@ -0,0 +1,42 @@
+package audit_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestListSuccess() {
+	action := model.AuditActionUserRoleAssign
+	filter := &model.AuditEventFilter{Action: &action, Limit: 10}
+	events := []*model.AuditEvent{
+		{ID: uuid.New(), Action: action},
+	}
+	nextCursor := "42"
+
+	s.auditEventRepository.On("List", mock.Anything, filter).Return(events, &nextCursor, nil).Once()
+
+	result, cursor, err := s.service.List(s.ctx, filter)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), events, result)
+	assert.Equal(s.T(), &nextCursor, cursor)
+
+	s.auditEventRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestListInvalidCursor() {
+	filter := &model.AuditEventFilter{Limit: 10, Cursor: "abc"}
+
+	s.auditEventRepository.On("List", mock.Anything, filter).Return(nil, nil, model.ErrInvalidCursor).Once()
+
+	result, cursor, err := s.service.List(s.ctx, filter)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidCursor)
+	assert.Nil(s.T(), result)
+	assert.Nil(s.T(), cursor)
+
+	s.auditEventRepository.AssertExpectations(s.T())
+}
@@ -0,0 +1,105 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	platformAudit "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestRecordPersistsAndExports() {
+	roleID := uuid.New()
+	ctx := platformAudit.WithSystemActor(s.ctx, "test")
+
+	var stored *model.AuditEvent
+	s.auditEventRepository.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*model.AuditEvent)
+		}).
+		Return(nil).Once()
+	s.auditProducerService.On("ProduceAuditEvent", mock.Anything, mock.MatchedBy(func(event model.AuditEvent) bool {
+		return event.Action == model.AuditActionRoleUpdate && event.TargetID == roleID.String()
+	})).Return(nil).Once()
+
+	s.service.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleUpdate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   roleID.String(),
+		Before:     &model.Role{ID: roleID, Name: "old"},
+		After:      &model.Role{ID: roleID, Name: "new"},
+	})
+
+	if assert.NotNil(s.T(), stored) {
+		assert.NotEqual(s.T(), uuid.Nil, stored.ID)
+		assert.False(s.T(), stored.OccurredAt.IsZero())
+		assert.Equal(s.T(), platformAudit.Actor{Type: platformAudit.ActorTypeSystem, ID: "test"}, stored.Actor)
+
+		var before, after model.Role
+		assert.NoError(s.T(), json.Unmarshal(stored.Before, &before))
+		assert.NoError(s.T(), json.Unmarshal(stored.After, &after))
+		assert.Equal(s.T(), "old", before.Name)
+		assert.Equal(s.T(), "new", after.Name)
+	}
+
+	s.auditEventRepository.AssertExpectations(s.T())
+	s.auditProducerService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRecordWithoutBeforeState() {
+	var stored *model.AuditEvent
+	s.auditEventRepository.On("Create", mock.Anything, mock.AnythingOfType("*model.AuditEvent")).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*model.AuditEvent)
+		}).
+		Return(nil).Once()
+	s.auditProducerService.On("ProduceAuditEvent", mock.Anything, mock.Anything).Return(nil).Once()
+
+	s.service.Record(s.ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleCreate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   uuid.NewString(),
+		After:      &model.Role{Name: "new"},
+	})
+
+	if assert.NotNil(s.T(), stored) {
+		assert.Nil(s.T(), stored.Before)
+		assert.NotNil(s.T(), stored.After)
+		assert.Equal(s.T(), platformAudit.ActorTypeAnonymous, stored.Actor.Type)
+	}
+
+	s.auditEventRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRecordRepositoryErrorSkipsExport() {
+	s.auditEventRepository.On("Create", mock.Anything, mock.Anything).Return(model.ErrInternal).Once()
+
+	s.service.Record(s.ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleDelete,
+		TargetType: model.AuditTargetRole,
+		TargetID:   uuid.NewString(),
+	})
+
+	s.auditEventRepository.AssertExpectations(s.T())
+	s.auditProducerService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRecordProducerErrorIsNotFatal() {
+	s.auditEventRepository.On("Create", mock.Anything, mock.Anything).Return(nil).Once()
+	s.auditProducerService.On("ProduceAuditEvent", mock.Anything, mock.Anything).Return(errors.New("kafka unavailable")).Once()
+
+	assert.NotPanics(s.T(), func() {
+		s.service.Record(s.ctx, &model.AuditRecord{
+			Action:     model.AuditActionUserRoleRevoke,
+			TargetType: model.AuditTargetUserRole,
+			TargetID:   uuid.NewString(),
+		})
+	})
+
+	s.auditEventRepository.AssertExpectations(s.T())
+	s.auditProducerService.AssertExpectations(s.T())
+}
@@ -0,0 +1,48 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/audit"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	auditEventRepository *repositoryMocks.AuditEventRepository
+	auditProducerService *serviceMocks.AuditProducerService
+
+	service *audit.AuditService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.auditEventRepository = repositoryMocks.NewAuditEventRepository(s.T())
+	s.auditProducerService = serviceMocks.NewAuditProducerService(s.T())
+
+	s.service = audit.NewService(s.auditEventRepository, s.auditProducerService)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.auditEventRepository.ExpectedCalls = nil
+	s.auditProducerService.ExpectedCalls = nil
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
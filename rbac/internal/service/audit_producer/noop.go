@@ -0,0 +1,20 @@
+package audit_producer
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+type noOpService struct{}
+
+// NewNoOpService создает no-op реализацию AuditProducerService
+// Используется когда Kafka отключен
+func NewNoOpService() def.AuditProducerService {
+	return &noOpService{}
+}
+
+func (n *noOpService) ProduceAuditEvent(ctx context.Context, event model.AuditEvent) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package audit_producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ def.AuditProducerService = (*service)(nil)
+
+type service struct {
+	producer kafka.Producer
+}
+
+func NewService(producer kafka.Producer) def.AuditProducerService {
+	return &service{
+		producer: producer,
+	}
+}
+
+func (s *service) ProduceAuditEvent(ctx context.Context, event model.AuditEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Producer] Ошибка кодирования AuditEvent", err)
+		return fmt.Errorf("encode audit event: %w", err)
+	}
+
+	// Ключ — объект изменения, чтобы события одного объекта попадали в одну партицию
+	if err = s.producer.Send(ctx, []byte(event.TargetType+":"+event.TargetID), payload); err != nil {
+		errreport.Report(ctx, "❌ [Producer] Ошибка отправки AuditEvent", err)
+		return fmt.Errorf("send audit event to kafka: %w", err)
+	}
+
+	logger.Info(ctx, "📤 Отправлено событие AuditEvent")
+
+	return nil
+}
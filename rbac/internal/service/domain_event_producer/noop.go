@@ -0,0 +1,19 @@
+package domain_event_producer
+
+import (
+	"context"
+
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+type noOpService struct{}
+
+// NewNoOpService создает no-op реализацию DomainEventProducerService
+// Используется когда Kafka отключен
+func NewNoOpService() def.DomainEventProducerService {
+	return &noOpService{}
+}
+
+func (n *noOpService) Produce(ctx context.Context, eventType, key string, payload any) error {
+	return nil
+}
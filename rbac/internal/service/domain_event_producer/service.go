@@ -0,0 +1,57 @@
+package domain_event_producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ def.DomainEventProducerService = (*service)(nil)
+
+type service struct {
+	producer kafka.Producer
+}
+
+func NewService(producer kafka.Producer) def.DomainEventProducerService {
+	return &service{
+		producer: producer,
+	}
+}
+
+func (s *service) Produce(ctx context.Context, eventType, key string, payload any) error {
+	event := model.DomainEvent{
+		EventID:    uuid.New(),
+		Type:       eventType,
+		Version:    model.DomainEventVersion,
+		OccurredAt: time.Now().UTC(),
+		Actor:      audit.ActorFromContext(ctx),
+		Payload:    payload,
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Producer] Ошибка кодирования доменного события", err)
+		return fmt.Errorf("encode %s: %w", eventType, err)
+	}
+
+	// Ключ — ID роли или пользователя, чтобы события одного объекта сохраняли порядок
+	if err = s.producer.Send(ctx, []byte(key), data); err != nil {
+		errreport.Report(ctx, "❌ [Producer] Ошибка отправки доменного события", err)
+		return fmt.Errorf("send %s to kafka: %w", eventType, err)
+	}
+
+	logger.Info(ctx, "📤 Отправлено доменное событие", zap.String("type", eventType))
+
+	return nil
+}
@@ -5,7 +5,10 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	condition "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
 )
 
 // RolePermissionServiceInterface is an autogenerated mock type for the RolePermissionServiceInterface type
@@ -21,17 +24,17 @@ func (_m *RolePermissionServiceInterface) EXPECT() *RolePermissionServiceInterfa
 	return &RolePermissionServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// Assign provides a mock function with given fields: ctx, roleID, permissionID
-func (_m *RolePermissionServiceInterface) Assign(ctx context.Context, roleID string, permissionID string) error {
-	ret := _m.Called(ctx, roleID, permissionID)
+// Assign provides a mock function with given fields: ctx, assignment
+func (_m *RolePermissionServiceInterface) Assign(ctx context.Context, assignment *model.AssignRolePermission) error {
+	ret := _m.Called(ctx, assignment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Assign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, roleID, permissionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AssignRolePermission) error); ok {
+		r0 = rf(ctx, assignment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,15 +49,14 @@ type RolePermissionServiceInterface_Assign_Call struct {
 
 // Assign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roleID string
-//   - permissionID string
-func (_e *RolePermissionServiceInterface_Expecter) Assign(ctx interface{}, roleID interface{}, permissionID interface{}) *RolePermissionServiceInterface_Assign_Call {
-	return &RolePermissionServiceInterface_Assign_Call{Call: _e.mock.On("Assign", ctx, roleID, permissionID)}
+//   - assignment *model.AssignRolePermission
+func (_e *RolePermissionServiceInterface_Expecter) Assign(ctx interface{}, assignment interface{}) *RolePermissionServiceInterface_Assign_Call {
+	return &RolePermissionServiceInterface_Assign_Call{Call: _e.mock.On("Assign", ctx, assignment)}
 }
 
-func (_c *RolePermissionServiceInterface_Assign_Call) Run(run func(ctx context.Context, roleID string, permissionID string)) *RolePermissionServiceInterface_Assign_Call {
+func (_c *RolePermissionServiceInterface_Assign_Call) Run(run func(ctx context.Context, assignment *model.AssignRolePermission)) *RolePermissionServiceInterface_Assign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(*model.AssignRolePermission))
 	})
 	return _c
 }
@@ -64,7 +66,65 @@ func (_c *RolePermissionServiceInterface_Assign_Call) Return(_a0 error) *RolePer
 	return _c
 }
 
-func (_c *RolePermissionServiceInterface_Assign_Call) RunAndReturn(run func(context.Context, string, string) error) *RolePermissionServiceInterface_Assign_Call {
+func (_c *RolePermissionServiceInterface_Assign_Call) RunAndReturn(run func(context.Context, *model.AssignRolePermission) error) *RolePermissionServiceInterface_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EvaluateCondition provides a mock function with given fields: ctx, expression, attrs
+func (_m *RolePermissionServiceInterface) EvaluateCondition(ctx context.Context, expression string, attrs condition.Attributes) (bool, error) {
+	ret := _m.Called(ctx, expression, attrs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateCondition")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, condition.Attributes) (bool, error)); ok {
+		return rf(ctx, expression, attrs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, condition.Attributes) bool); ok {
+		r0 = rf(ctx, expression, attrs)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, condition.Attributes) error); ok {
+		r1 = rf(ctx, expression, attrs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RolePermissionServiceInterface_EvaluateCondition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateCondition'
+type RolePermissionServiceInterface_EvaluateCondition_Call struct {
+	*mock.Call
+}
+
+// EvaluateCondition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - expression string
+//   - attrs condition.Attributes
+func (_e *RolePermissionServiceInterface_Expecter) EvaluateCondition(ctx interface{}, expression interface{}, attrs interface{}) *RolePermissionServiceInterface_EvaluateCondition_Call {
+	return &RolePermissionServiceInterface_EvaluateCondition_Call{Call: _e.mock.On("EvaluateCondition", ctx, expression, attrs)}
+}
+
+func (_c *RolePermissionServiceInterface_EvaluateCondition_Call) Run(run func(ctx context.Context, expression string, attrs condition.Attributes)) *RolePermissionServiceInterface_EvaluateCondition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(condition.Attributes))
+	})
+	return _c
+}
+
+func (_c *RolePermissionServiceInterface_EvaluateCondition_Call) Return(_a0 bool, _a1 error) *RolePermissionServiceInterface_EvaluateCondition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RolePermissionServiceInterface_EvaluateCondition_Call) RunAndReturn(run func(context.Context, string, condition.Attributes) (bool, error)) *RolePermissionServiceInterface_EvaluateCondition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -117,6 +177,66 @@ func (_c *RolePermissionServiceInterface_Revoke_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SetRolePermissions provides a mock function with given fields: ctx, roleID, permissionIDs
+func (_m *RolePermissionServiceInterface) SetRolePermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error) {
+	ret := _m.Called(ctx, roleID, permissionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRolePermissions")
+	}
+
+	var r0 *model.RolePermissionsDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*model.RolePermissionsDiff, error)); ok {
+		return rf(ctx, roleID, permissionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.RolePermissionsDiff); ok {
+		r0 = rf(ctx, roleID, permissionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RolePermissionsDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, roleID, permissionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RolePermissionServiceInterface_SetRolePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRolePermissions'
+type RolePermissionServiceInterface_SetRolePermissions_Call struct {
+	*mock.Call
+}
+
+// SetRolePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID string
+//   - permissionIDs []string
+func (_e *RolePermissionServiceInterface_Expecter) SetRolePermissions(ctx interface{}, roleID interface{}, permissionIDs interface{}) *RolePermissionServiceInterface_SetRolePermissions_Call {
+	return &RolePermissionServiceInterface_SetRolePermissions_Call{Call: _e.mock.On("SetRolePermissions", ctx, roleID, permissionIDs)}
+}
+
+func (_c *RolePermissionServiceInterface_SetRolePermissions_Call) Run(run func(ctx context.Context, roleID string, permissionIDs []string)) *RolePermissionServiceInterface_SetRolePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *RolePermissionServiceInterface_SetRolePermissions_Call) Return(_a0 *model.RolePermissionsDiff, _a1 error) *RolePermissionServiceInterface_SetRolePermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RolePermissionServiceInterface_SetRolePermissions_Call) RunAndReturn(run func(context.Context, string, []string) (*model.RolePermissionsDiff, error)) *RolePermissionServiceInterface_SetRolePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRolePermissionServiceInterface creates a new instance of RolePermissionServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRolePermissionServiceInterface(t interface {
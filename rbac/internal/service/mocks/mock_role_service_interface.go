@@ -131,6 +131,63 @@ func (_c *RoleServiceInterface_Delete_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FlushCache provides a mock function with given fields: ctx, roleIDs
+func (_m *RoleServiceInterface) FlushCache(ctx context.Context, roleIDs []string) (int, error) {
+	ret := _m.Called(ctx, roleIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushCache")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int, error)); ok {
+		return rf(ctx, roleIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int); ok {
+		r0 = rf(ctx, roleIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, roleIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleServiceInterface_FlushCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushCache'
+type RoleServiceInterface_FlushCache_Call struct {
+	*mock.Call
+}
+
+// FlushCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleIDs []string
+func (_e *RoleServiceInterface_Expecter) FlushCache(ctx interface{}, roleIDs interface{}) *RoleServiceInterface_FlushCache_Call {
+	return &RoleServiceInterface_FlushCache_Call{Call: _e.mock.On("FlushCache", ctx, roleIDs)}
+}
+
+func (_c *RoleServiceInterface_FlushCache_Call) Run(run func(ctx context.Context, roleIDs []string)) *RoleServiceInterface_FlushCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterface_FlushCache_Call) Return(_a0 int, _a1 error) *RoleServiceInterface_FlushCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleServiceInterface_FlushCache_Call) RunAndReturn(run func(context.Context, []string) (int, error)) *RoleServiceInterface_FlushCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *RoleServiceInterface) Get(ctx context.Context, id string) (*model.EnrichedRole, error) {
 	ret := _m.Called(ctx, id)
@@ -190,34 +247,43 @@ func (_c *RoleServiceInterface_Get_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// List provides a mock function with given fields: ctx
-func (_m *RoleServiceInterface) List(ctx context.Context) ([]*model.Role, error) {
-	ret := _m.Called(ctx)
+// List provides a mock function with given fields: ctx, filter
+func (_m *RoleServiceInterface) List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []*model.Role
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Role, error)); ok {
-		return rf(ctx)
+	var r0 []*model.RoleListItem
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RoleFilter) ([]*model.RoleListItem, *string, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.Role); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RoleFilter) []*model.RoleListItem); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Role)
+			r0 = ret.Get(0).([]*model.RoleListItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RoleFilter) *string); ok {
+		r1 = rf(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *model.RoleFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // RoleServiceInterface_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -227,23 +293,71 @@ type RoleServiceInterface_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *RoleServiceInterface_Expecter) List(ctx interface{}) *RoleServiceInterface_List_Call {
-	return &RoleServiceInterface_List_Call{Call: _e.mock.On("List", ctx)}
+//   - filter *model.RoleFilter
+func (_e *RoleServiceInterface_Expecter) List(ctx interface{}, filter interface{}) *RoleServiceInterface_List_Call {
+	return &RoleServiceInterface_List_Call{Call: _e.mock.On("List", ctx, filter)}
 }
 
-func (_c *RoleServiceInterface_List_Call) Run(run func(ctx context.Context)) *RoleServiceInterface_List_Call {
+func (_c *RoleServiceInterface_List_Call) Run(run func(ctx context.Context, filter *model.RoleFilter)) *RoleServiceInterface_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*model.RoleFilter))
 	})
 	return _c
 }
 
-func (_c *RoleServiceInterface_List_Call) Return(_a0 []*model.Role, _a1 error) *RoleServiceInterface_List_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *RoleServiceInterface_List_Call) Return(_a0 []*model.RoleListItem, _a1 *string, _a2 error) *RoleServiceInterface_List_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RoleServiceInterface_List_Call) RunAndReturn(run func(context.Context, *model.RoleFilter) ([]*model.RoleListItem, *string, error)) *RoleServiceInterface_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *RoleServiceInterface) Restore(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RoleServiceInterface_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type RoleServiceInterface_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RoleServiceInterface_Expecter) Restore(ctx interface{}, id interface{}) *RoleServiceInterface_Restore_Call {
+	return &RoleServiceInterface_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *RoleServiceInterface_Restore_Call) Run(run func(ctx context.Context, id string)) *RoleServiceInterface_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterface_Restore_Call) Return(_a0 error) *RoleServiceInterface_Restore_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *RoleServiceInterface_List_Call) RunAndReturn(run func(context.Context) ([]*model.Role, error)) *RoleServiceInterface_List_Call {
+func (_c *RoleServiceInterface_Restore_Call) RunAndReturn(run func(context.Context, string) error) *RoleServiceInterface_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,6 +409,63 @@ func (_c *RoleServiceInterface_Update_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// WarmCache provides a mock function with given fields: ctx, roleIDs
+func (_m *RoleServiceInterface) WarmCache(ctx context.Context, roleIDs []string) (int, error) {
+	ret := _m.Called(ctx, roleIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WarmCache")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int, error)); ok {
+		return rf(ctx, roleIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int); ok {
+		r0 = rf(ctx, roleIDs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, roleIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleServiceInterface_WarmCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WarmCache'
+type RoleServiceInterface_WarmCache_Call struct {
+	*mock.Call
+}
+
+// WarmCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleIDs []string
+func (_e *RoleServiceInterface_Expecter) WarmCache(ctx interface{}, roleIDs interface{}) *RoleServiceInterface_WarmCache_Call {
+	return &RoleServiceInterface_WarmCache_Call{Call: _e.mock.On("WarmCache", ctx, roleIDs)}
+}
+
+func (_c *RoleServiceInterface_WarmCache_Call) Run(run func(ctx context.Context, roleIDs []string)) *RoleServiceInterface_WarmCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterface_WarmCache_Call) Return(_a0 int, _a1 error) *RoleServiceInterface_WarmCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleServiceInterface_WarmCache_Call) RunAndReturn(run func(context.Context, []string) (int, error)) *RoleServiceInterface_WarmCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRoleServiceInterface creates a new instance of RoleServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRoleServiceInterface(t interface {
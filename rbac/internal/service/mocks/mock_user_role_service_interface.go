@@ -22,17 +22,17 @@ func (_m *UserRoleServiceInterface) EXPECT() *UserRoleServiceInterface_Expecter
 	return &UserRoleServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// Assign provides a mock function with given fields: ctx, userID, roleID, assignedBy
-func (_m *UserRoleServiceInterface) Assign(ctx context.Context, userID string, roleID string, assignedBy *string) error {
-	ret := _m.Called(ctx, userID, roleID, assignedBy)
+// Assign provides a mock function with given fields: ctx, assignment
+func (_m *UserRoleServiceInterface) Assign(ctx context.Context, assignment *model.AssignUserRole) error {
+	ret := _m.Called(ctx, assignment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Assign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) error); ok {
-		r0 = rf(ctx, userID, roleID, assignedBy)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AssignUserRole) error); ok {
+		r0 = rf(ctx, assignment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,16 +47,14 @@ type UserRoleServiceInterface_Assign_Call struct {
 
 // Assign is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - roleID string
-//   - assignedBy *string
-func (_e *UserRoleServiceInterface_Expecter) Assign(ctx interface{}, userID interface{}, roleID interface{}, assignedBy interface{}) *UserRoleServiceInterface_Assign_Call {
-	return &UserRoleServiceInterface_Assign_Call{Call: _e.mock.On("Assign", ctx, userID, roleID, assignedBy)}
+//   - assignment *model.AssignUserRole
+func (_e *UserRoleServiceInterface_Expecter) Assign(ctx interface{}, assignment interface{}) *UserRoleServiceInterface_Assign_Call {
+	return &UserRoleServiceInterface_Assign_Call{Call: _e.mock.On("Assign", ctx, assignment)}
 }
 
-func (_c *UserRoleServiceInterface_Assign_Call) Run(run func(ctx context.Context, userID string, roleID string, assignedBy *string)) *UserRoleServiceInterface_Assign_Call {
+func (_c *UserRoleServiceInterface_Assign_Call) Run(run func(ctx context.Context, assignment *model.AssignUserRole)) *UserRoleServiceInterface_Assign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string))
+		run(args[0].(context.Context), args[1].(*model.AssignUserRole))
 	})
 	return _c
 }
@@ -66,7 +64,136 @@ func (_c *UserRoleServiceInterface_Assign_Call) Return(_a0 error) *UserRoleServi
 	return _c
 }
 
-func (_c *UserRoleServiceInterface_Assign_Call) RunAndReturn(run func(context.Context, string, string, *string) error) *UserRoleServiceInterface_Assign_Call {
+func (_c *UserRoleServiceInterface_Assign_Call) RunAndReturn(run func(context.Context, *model.AssignUserRole) error) *UserRoleServiceInterface_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkAssign provides a mock function with given fields: ctx, assignments
+func (_m *UserRoleServiceInterface) BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error) {
+	ret := _m.Called(ctx, assignments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkAssign")
+	}
+
+	var r0 []*model.BulkAssignResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.AssignUserRole) ([]*model.BulkAssignResult, error)); ok {
+		return rf(ctx, assignments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.AssignUserRole) []*model.BulkAssignResult); ok {
+		r0 = rf(ctx, assignments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BulkAssignResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.AssignUserRole) error); ok {
+		r1 = rf(ctx, assignments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRoleServiceInterface_BulkAssign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkAssign'
+type UserRoleServiceInterface_BulkAssign_Call struct {
+	*mock.Call
+}
+
+// BulkAssign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assignments []*model.AssignUserRole
+func (_e *UserRoleServiceInterface_Expecter) BulkAssign(ctx interface{}, assignments interface{}) *UserRoleServiceInterface_BulkAssign_Call {
+	return &UserRoleServiceInterface_BulkAssign_Call{Call: _e.mock.On("BulkAssign", ctx, assignments)}
+}
+
+func (_c *UserRoleServiceInterface_BulkAssign_Call) Run(run func(ctx context.Context, assignments []*model.AssignUserRole)) *UserRoleServiceInterface_BulkAssign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.AssignUserRole))
+	})
+	return _c
+}
+
+func (_c *UserRoleServiceInterface_BulkAssign_Call) Return(_a0 []*model.BulkAssignResult, _a1 error) *UserRoleServiceInterface_BulkAssign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRoleServiceInterface_BulkAssign_Call) RunAndReturn(run func(context.Context, []*model.AssignUserRole) ([]*model.BulkAssignResult, error)) *UserRoleServiceInterface_BulkAssign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleMembers provides a mock function with given fields: ctx, roleID, limit, cursor
+func (_m *UserRoleServiceInterface) GetRoleMembers(ctx context.Context, roleID string, limit int32, cursor string) ([]*model.RoleMember, *string, error) {
+	ret := _m.Called(ctx, roleID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleMembers")
+	}
+
+	var r0 []*model.RoleMember
+	var r1 *string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string) ([]*model.RoleMember, *string, error)); ok {
+		return rf(ctx, roleID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string) []*model.RoleMember); ok {
+		r0 = rf(ctx, roleID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RoleMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, string) *string); ok {
+		r1 = rf(ctx, roleID, limit, cursor)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int32, string) error); ok {
+		r2 = rf(ctx, roleID, limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UserRoleServiceInterface_GetRoleMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleMembers'
+type UserRoleServiceInterface_GetRoleMembers_Call struct {
+	*mock.Call
+}
+
+// GetRoleMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID string
+//   - limit int32
+//   - cursor string
+func (_e *UserRoleServiceInterface_Expecter) GetRoleMembers(ctx interface{}, roleID interface{}, limit interface{}, cursor interface{}) *UserRoleServiceInterface_GetRoleMembers_Call {
+	return &UserRoleServiceInterface_GetRoleMembers_Call{Call: _e.mock.On("GetRoleMembers", ctx, roleID, limit, cursor)}
+}
+
+func (_c *UserRoleServiceInterface_GetRoleMembers_Call) Run(run func(ctx context.Context, roleID string, limit int32, cursor string)) *UserRoleServiceInterface_GetRoleMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *UserRoleServiceInterface_GetRoleMembers_Call) Return(_a0 []*model.RoleMember, _a1 *string, _a2 error) *UserRoleServiceInterface_GetRoleMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *UserRoleServiceInterface_GetRoleMembers_Call) RunAndReturn(run func(context.Context, string, int32, string) ([]*model.RoleMember, *string, error)) *UserRoleServiceInterface_GetRoleMembers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,68 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Apply приводит состояние RBAC к документу в одной транзакции.
+// План пересчитывается внутри транзакции, поэтому применяется ровно то, что отличается на момент записи.
+func (s *PolicyService) Apply(ctx context.Context, document *model.PolicyDocument, prune bool) (*model.PolicyPlan, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.apply_policy")
+	defer span.End()
+
+	if err := validateDocument(document); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.policyRepo.Apply(ctx, func(state *model.PolicyState) (*model.PolicyPlan, error) {
+		return buildPlan(state.Document, document, prune)
+	})
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка применения политики", err)
+		return nil, err
+	}
+
+	if plan.Empty() {
+		return plan, nil
+	}
+
+	invalidated := make(map[string]struct{})
+	for _, change := range plan.Changes {
+		if change.RoleID == "" {
+			continue
+		}
+		if _, ok := invalidated[change.RoleID]; ok {
+			continue
+		}
+		invalidated[change.RoleID] = struct{}{}
+		s.invalidateCache(ctx, change.RoleID)
+	}
+
+	applyID := uuid.NewString()
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionPolicyApply,
+		TargetType: model.AuditTargetPolicy,
+		TargetID:   applyID,
+		After:      plan.Changes,
+	})
+
+	event := model.PolicyApplied{ApplyID: applyID, Changes: plan.Changes}
+	if err = s.eventProducer.Produce(ctx, model.EventTypePolicyApplied, applyID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события PolicyApplied", zap.Error(err))
+	}
+
+	logger.Info(ctx, "✅ [Service] Политика применена",
+		zap.String("apply_id", applyID),
+		zap.Int("changes", len(plan.Changes)))
+
+	return plan, nil
+}
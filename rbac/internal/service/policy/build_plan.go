@@ -0,0 +1,136 @@
+package policy
+
+import (
+	"strings"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// buildPlan сравнивает текущее состояние с документом и возвращает изменения в порядке применения:
+// новые права, создание и изменение ролей, назначения, удаление ролей.
+// Права, отсутствующие в документе, не удаляются; системные роли не удаляются даже с prune.
+// Признак system не меняется: документ, объявляющий системной обычную или новую роль, отклоняется.
+func buildPlan(current, desired *model.PolicyDocument, prune bool) (*model.PolicyPlan, error) {
+	var permissionChanges, roleChanges, grantChanges, deleteChanges []*model.PolicyChange
+
+	existing := make(map[string]struct{}, len(current.Permissions))
+	for _, p := range current.Permissions {
+		existing[p.Key()] = struct{}{}
+	}
+	for _, p := range desired.Permissions {
+		if _, ok := existing[p.Key()]; !ok {
+			permissionChanges = append(permissionChanges, &model.PolicyChange{
+				Type:       model.PolicyChangeCreatePermission,
+				Permission: p.Key(),
+			})
+		}
+	}
+
+	currentRoles := make(map[string]*model.PolicyRole, len(current.Roles))
+	for _, role := range current.Roles {
+		currentRoles[role.Name] = role
+	}
+
+	desiredRoles := make(map[string]struct{}, len(desired.Roles))
+	for _, role := range desired.Roles {
+		desiredRoles[role.Name] = struct{}{}
+
+		currentRole, ok := currentRoles[role.Name]
+		if role.System && (!ok || !currentRole.System) {
+			return nil, model.ErrInvalidPolicy.WithMessage(model.MsgPolicyRoleSystem, role.Name)
+		}
+		switch {
+		case !ok:
+			currentRole = &model.PolicyRole{Name: role.Name}
+			roleChanges = append(roleChanges, roleChange(model.PolicyChangeCreateRole, role))
+		case currentRole.Description != role.Description ||
+			currentRole.RequiresApproval != role.RequiresApproval ||
+			currentRole.Approver != role.Approver:
+			roleChanges = append(roleChanges, roleChange(model.PolicyChangeUpdateRole, role))
+		}
+
+		grantChanges = append(grantChanges, diffGrants(role.Name, currentRole.Grants, role.Grants)...)
+	}
+
+	if prune {
+		for _, role := range current.Roles {
+			if _, ok := desiredRoles[role.Name]; ok || role.System {
+				continue
+			}
+			deleteChanges = append(deleteChanges, &model.PolicyChange{
+				Type: model.PolicyChangeDeleteRole,
+				Role: role.Name,
+			})
+		}
+	}
+
+	changes := make([]*model.PolicyChange, 0,
+		len(permissionChanges)+len(roleChanges)+len(grantChanges)+len(deleteChanges))
+	changes = append(changes, permissionChanges...)
+	changes = append(changes, roleChanges...)
+	changes = append(changes, grantChanges...)
+	changes = append(changes, deleteChanges...)
+
+	return &model.PolicyPlan{Changes: changes}, nil
+}
+
+// roleChange переносит в изменение все свойства роли, чтобы применение задавало их целиком
+func roleChange(changeType model.PolicyChangeType, role *model.PolicyRole) *model.PolicyChange {
+	return &model.PolicyChange{
+		Type:             changeType,
+		Role:             role.Name,
+		Description:      role.Description,
+		RequiresApproval: role.RequiresApproval,
+		Approver:         role.Approver,
+	}
+}
+
+func diffGrants(roleName string, current, desired []*model.PolicyGrant) []*model.PolicyChange {
+	var changes []*model.PolicyChange
+
+	currentGrants := make(map[string]*model.PolicyGrant, len(current))
+	for _, grant := range current {
+		currentGrants[grant.Permission] = grant
+	}
+
+	desiredGrants := make(map[string]struct{}, len(desired))
+	for _, grant := range desired {
+		desiredGrants[grant.Permission] = struct{}{}
+		effect, expr := grantEffect(grant), strings.TrimSpace(grant.Condition)
+
+		currentGrant, ok := currentGrants[grant.Permission]
+		switch {
+		case !ok:
+			changes = append(changes, grantChange(model.PolicyChangeAddGrant, roleName, grant.Permission, effect, expr))
+		case grantEffect(currentGrant) != effect || strings.TrimSpace(currentGrant.Condition) != expr:
+			changes = append(changes, grantChange(model.PolicyChangeUpdateGrant, roleName, grant.Permission, effect, expr))
+		}
+	}
+
+	for _, grant := range current {
+		if _, ok := desiredGrants[grant.Permission]; !ok {
+			changes = append(changes, grantChange(model.PolicyChangeRemoveGrant, roleName, grant.Permission, grantEffect(grant), grant.Condition))
+		}
+	}
+
+	return changes
+}
+
+func grantChange(changeType model.PolicyChangeType, roleName, permission string, effect authz.Effect, expr string) *model.PolicyChange {
+	return &model.PolicyChange{
+		Type:       changeType,
+		Role:       roleName,
+		Permission: permission,
+		Effect:     effect,
+		Condition:  expr,
+	}
+}
+
+// grantEffect трактует пустой эффект как разрешение
+func grantEffect(grant *model.PolicyGrant) authz.Effect {
+	if grant.Effect == authz.EffectDeny.String() {
+		return authz.EffectDeny
+	}
+	return authz.EffectAllow
+}
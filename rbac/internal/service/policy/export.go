@@ -0,0 +1,23 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Export возвращает текущее состояние RBAC документом политики
+func (s *PolicyService) Export(ctx context.Context) (*model.PolicyDocument, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.export_policy")
+	defer span.End()
+
+	state, err := s.policyRepo.Load(ctx)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка выгрузки политики", err)
+		return nil, err
+	}
+
+	return state.Document, nil
+}
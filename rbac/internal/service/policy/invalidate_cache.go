@@ -0,0 +1,19 @@
+package policy
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// invalidateCache сбрасывает кэш измененной роли; ошибка не прерывает операцию,
+// устаревшая запись в худшем случае истечет по TTL
+func (s *PolicyService) invalidateCache(ctx context.Context, id string) {
+	if err := s.enrichedRoleRepo.Invalidate(ctx, id); err != nil {
+		logger.Warn(ctx, "⚠️ [Service] Не удалось сбросить кэш роли",
+			zap.String("role_id", id),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,27 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Plan рассчитывает изменения, которые внесет документ, по текущему состоянию
+func (s *PolicyService) Plan(ctx context.Context, document *model.PolicyDocument, prune bool) (*model.PolicyPlan, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.plan_policy")
+	defer span.End()
+
+	if err := validateDocument(document); err != nil {
+		return nil, err
+	}
+
+	state, err := s.policyRepo.Load(ctx)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка загрузки состояния политики", err)
+		return nil, err
+	}
+
+	return buildPlan(state.Document, document, prune)
+}
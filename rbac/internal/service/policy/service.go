@@ -0,0 +1,29 @@
+package policy
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ service.PolicyServiceInterface = (*PolicyService)(nil)
+
+type PolicyService struct {
+	policyRepo       repository.PolicyRepository
+	enrichedRoleRepo repository.EnrichedRoleRepository
+	auditService     service.AuditServiceInterface
+	eventProducer    service.DomainEventProducerService
+}
+
+func NewService(
+	policyRepo repository.PolicyRepository,
+	enrichedRoleRepo repository.EnrichedRoleRepository,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+) *PolicyService {
+	return &PolicyService{
+		policyRepo:       policyRepo,
+		enrichedRoleRepo: enrichedRoleRepo,
+		auditService:     auditService,
+		eventProducer:    eventProducer,
+	}
+}
@@ -0,0 +1,143 @@
+package policy_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/converter"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// applyWithState имитирует репозиторий: строит план по state и заполняет RoleID, как при записи
+func applyWithState(state *model.PolicyState) func(context.Context, func(*model.PolicyState) (*model.PolicyPlan, error)) (*model.PolicyPlan, error) {
+	return func(_ context.Context, plan func(*model.PolicyState) (*model.PolicyPlan, error)) (*model.PolicyPlan, error) {
+		result, err := plan(state)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range result.Changes {
+			switch {
+			case change.Type == model.PolicyChangeCreatePermission:
+			case state.RoleIDs[change.Role] != "":
+				change.RoleID = state.RoleIDs[change.Role]
+			default:
+				change.RoleID = "id-" + change.Role
+			}
+		}
+		return result, nil
+	}
+}
+
+func (s *ServiceSuite) TestApplyPolicySuccess() {
+	s.policyRepository.On("Apply", mock.Anything, mock.Anything).Return(applyWithState(currentState())).Once()
+
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	for _, id := range []string{"id-admin", "id-teacher", "id-legacy"} {
+		s.enrichedRoleRepository.On("Invalidate", mock.Anything, id).Return(nil).Once()
+	}
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		changes, ok := record.After.([]*model.PolicyChange)
+		return record.Action == model.AuditActionPolicyApply &&
+			record.TargetType == model.AuditTargetPolicy &&
+			record.TargetID != "" &&
+			ok && len(changes) == 8
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypePolicyApplied, mock.Anything,
+		mock.MatchedBy(func(event model.PolicyApplied) bool {
+			return event.ApplyID != "" && len(event.Changes) == 8
+		})).Return(nil).Once()
+
+	plan, err := s.service.Apply(s.ctx, desiredDocument(), true)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), plan.Changes, 8)
+
+	s.policyRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApplyPolicyNoChanges() {
+	state := currentState()
+	s.policyRepository.On("Apply", mock.Anything, mock.Anything).Return(applyWithState(state)).Once()
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	plan, err := s.service.Apply(s.ctx, currentState().Document, true)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), plan.Empty())
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+// TestApplyPolicyExportRoundTrip проверяет, что выгруженный документ после сериализации
+// применяется без изменений: настройки согласования и системные роли не сбрасываются
+func (s *ServiceSuite) TestApplyPolicyExportRoundTrip() {
+	for _, format := range []model.PolicyFormat{model.PolicyFormatYAML, model.PolicyFormatJSON} {
+		s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+		s.policyRepository.On("Apply", mock.Anything, mock.Anything).Return(applyWithState(currentState())).Once()
+		s.enrichedRoleRepository.ExpectedCalls = nil
+		s.auditService.ExpectedCalls = nil
+		s.eventProducer.ExpectedCalls = nil
+
+		exported, err := s.service.Export(s.ctx)
+		s.Require().NoError(err)
+		data, err := converter.EncodePolicyDocument(exported, format)
+		s.Require().NoError(err)
+		document, err := converter.DecodePolicyDocument(data, format)
+		s.Require().NoError(err)
+
+		plan, err := s.service.Apply(s.ctx, document, true)
+
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), plan.Empty(), "%s", data)
+	}
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApplyPolicyEventFailureDoesNotFail() {
+	s.policyRepository.On("Apply", mock.Anything, mock.Anything).Return(applyWithState(currentState())).Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypePolicyApplied, mock.Anything, mock.Anything).
+		Return(errors.New("kafka unavailable")).Once()
+
+	plan, err := s.service.Apply(s.ctx, desiredDocument(), false)
+
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), plan.Changes)
+
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApplyPolicyRepositoryError() {
+	s.policyRepository.On("Apply", mock.Anything, mock.Anything).Return(nil, model.ErrInternal).Once()
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	plan, err := s.service.Apply(s.ctx, desiredDocument(), false)
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Nil(s.T(), plan)
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestApplyPolicyInvalidDocument() {
+	document := desiredDocument()
+	document.Version = 0
+
+	plan, err := s.service.Apply(s.ctx, document, false)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidPolicy)
+	assert.Nil(s.T(), plan)
+}
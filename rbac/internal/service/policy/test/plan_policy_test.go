@@ -0,0 +1,225 @@
+package policy_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// currentState состояние базы: системные admin и guest, пользовательская роль legacy,
+// назначаемая по согласованию участников admin
+func currentState() *model.PolicyState {
+	return &model.PolicyState{
+		Document: &model.PolicyDocument{
+			Version: model.PolicyDocumentVersion,
+			Permissions: []*model.PolicyPermission{
+				{Resource: "role", Action: "read"},
+				{Resource: "role", Action: "write"},
+			},
+			Roles: []*model.PolicyRole{
+				{Name: "admin", Description: "Администратор", System: true, Grants: []*model.PolicyGrant{
+					{Permission: "role:read", Effect: "allow"},
+					{Permission: "role:write", Effect: "allow"},
+				}},
+				{Name: "guest", Description: "Гость", System: true, Grants: []*model.PolicyGrant{}},
+				{Name: "legacy", Description: "Устаревшая", RequiresApproval: true, Approver: "admin", Grants: []*model.PolicyGrant{
+					{Permission: "role:read", Effect: "allow"},
+				}},
+			},
+		},
+		RoleIDs:       map[string]string{"admin": "id-admin", "guest": "id-guest", "legacy": "id-legacy"},
+		PermissionIDs: map[string]string{"role:read": "id-role-read", "role:write": "id-role-write"},
+	}
+}
+
+func desiredDocument() *model.PolicyDocument {
+	return &model.PolicyDocument{
+		Version: model.PolicyDocumentVersion,
+		Permissions: []*model.PolicyPermission{
+			{Resource: "role", Action: "read"},
+			{Resource: "role", Action: "write"},
+			{Resource: "schedule", Action: "read"},
+		},
+		Roles: []*model.PolicyRole{
+			{Name: "admin", Description: "Администратор системы", Grants: []*model.PolicyGrant{
+				{Permission: "role:read", Effect: "deny"},
+				{Permission: "schedule:read", Condition: "subject.school_id == resource.school_id"},
+			}},
+			{Name: "teacher", Description: "Учитель", Grants: []*model.PolicyGrant{
+				{Permission: "schedule:read"},
+			}},
+		},
+	}
+}
+
+func (s *ServiceSuite) TestPlanPolicyDiff() {
+	s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+
+	plan, err := s.service.Plan(s.ctx, desiredDocument(), true)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*model.PolicyChange{
+		{Type: model.PolicyChangeCreatePermission, Permission: "schedule:read"},
+		{Type: model.PolicyChangeUpdateRole, Role: "admin", Description: "Администратор системы"},
+		{Type: model.PolicyChangeCreateRole, Role: "teacher", Description: "Учитель"},
+		{Type: model.PolicyChangeUpdateGrant, Role: "admin", Permission: "role:read", Effect: authz.EffectDeny},
+		{Type: model.PolicyChangeAddGrant, Role: "admin", Permission: "schedule:read", Effect: authz.EffectAllow,
+			Condition: "subject.school_id == resource.school_id"},
+		{Type: model.PolicyChangeRemoveGrant, Role: "admin", Permission: "role:write", Effect: authz.EffectAllow},
+		{Type: model.PolicyChangeAddGrant, Role: "teacher", Permission: "schedule:read", Effect: authz.EffectAllow},
+		// Системная роль guest не удаляется даже с prune
+		{Type: model.PolicyChangeDeleteRole, Role: "legacy"},
+	}, plan.Changes)
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestPlanPolicyWithoutPruneKeepsRoles() {
+	s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+
+	plan, err := s.service.Plan(s.ctx, desiredDocument(), false)
+
+	assert.NoError(s.T(), err)
+	for _, change := range plan.Changes {
+		assert.NotEqual(s.T(), model.PolicyChangeDeleteRole, change.Type)
+	}
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestPlanPolicyExportedDocumentHasNoChanges() {
+	s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+
+	plan, err := s.service.Plan(s.ctx, currentState().Document, true)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), plan.Empty())
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestPlanPolicyApprovalChanges() {
+	s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+
+	document := currentState().Document
+	document.Roles[2].RequiresApproval = false
+	document.Roles[2].Approver = ""
+	document.Roles = append(document.Roles, &model.PolicyRole{
+		Name: "teacher", RequiresApproval: true, Approver: "reviewer",
+	}, &model.PolicyRole{Name: "reviewer"})
+
+	plan, err := s.service.Plan(s.ctx, document, false)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*model.PolicyChange{
+		{Type: model.PolicyChangeUpdateRole, Role: "legacy", Description: "Устаревшая"},
+		{Type: model.PolicyChangeCreateRole, Role: "teacher", RequiresApproval: true, Approver: "reviewer"},
+		{Type: model.PolicyChangeCreateRole, Role: "reviewer"},
+	}, plan.Changes)
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestPlanPolicyRejectsSystemFlag() {
+	tests := []struct {
+		name string
+		role *model.PolicyRole
+	}{
+		{name: "new role", role: &model.PolicyRole{Name: "teacher", System: true}},
+		{name: "existing role", role: &model.PolicyRole{Name: "legacy", System: true}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.policyRepository.On("Load", mock.Anything).Return(currentState(), nil).Once()
+
+			document := currentState().Document
+			document.Roles = append(document.Roles[:2], tt.role)
+
+			plan, err := s.service.Plan(s.ctx, document, false)
+
+			assert.ErrorIs(s.T(), err, model.ErrInvalidPolicy)
+			assert.Nil(s.T(), plan)
+		})
+	}
+
+	s.policyRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestPlanPolicyInvalidDocument() {
+	tests := []struct {
+		name   string
+		mutate func(document *model.PolicyDocument)
+	}{
+		{
+			name:   "unsupported version",
+			mutate: func(document *model.PolicyDocument) { document.Version = 2 },
+		},
+		{
+			name: "duplicate permission",
+			mutate: func(document *model.PolicyDocument) {
+				document.Permissions = append(document.Permissions, &model.PolicyPermission{Resource: "role", Action: "read"})
+			},
+		},
+		{
+			name: "wildcard in permission",
+			mutate: func(document *model.PolicyDocument) {
+				document.Permissions[0].Action = "!read"
+			},
+		},
+		{
+			name: "duplicate role",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles = append(document.Roles, &model.PolicyRole{Name: "admin"})
+			},
+		},
+		{
+			name:   "short role name",
+			mutate: func(document *model.PolicyDocument) { document.Roles[1].Name = "t" },
+		},
+		{
+			name: "undeclared permission",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles[1].Grants[0].Permission = "audit:read"
+			},
+		},
+		{
+			name: "unknown effect",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles[1].Grants[0].Effect = "maybe"
+			},
+		},
+		{
+			name: "invalid condition",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles[1].Grants[0].Condition = "subject.school_id =="
+			},
+		},
+		{
+			name: "role hierarchy",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles[1].Inherits = []string{"admin"}
+			},
+		},
+		{
+			name: "undeclared approver",
+			mutate: func(document *model.PolicyDocument) {
+				document.Roles[1].Approver = "legacy"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			document := desiredDocument()
+			tt.mutate(document)
+
+			plan, err := s.service.Plan(s.ctx, document, false)
+
+			assert.ErrorIs(s.T(), err, model.ErrInvalidPolicy)
+			assert.Nil(s.T(), plan)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/policy"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	policyRepository       *mocks.PolicyRepository
+	enrichedRoleRepository *mocks.EnrichedRoleRepository
+	auditService           *serviceMocks.AuditServiceInterface
+	eventProducer          *serviceMocks.DomainEventProducerService
+
+	service *policy.PolicyService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.policyRepository = mocks.NewPolicyRepository(s.T())
+	s.enrichedRoleRepository = mocks.NewEnrichedRoleRepository(s.T())
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.service = policy.NewService(s.policyRepository, s.enrichedRoleRepository, s.auditService, s.eventProducer)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.policyRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	// Сброс кэша ролей проверяется в отдельных тестах
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+const (
+	maxResourceLen = 100
+	maxActionLen   = 50
+	minRoleNameLen = 2
+	maxRoleNameLen = 50
+)
+
+// validateDocument проверяет документ целиком до обращения к базе:
+// назначения могут ссылаться только на права, а согласующие — только на роли, объявленные в документе
+func validateDocument(document *model.PolicyDocument) error {
+	if document == nil {
+		return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyEmpty)
+	}
+	if document.Version != model.PolicyDocumentVersion {
+		return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyUnsupportedVersion, document.Version)
+	}
+
+	permissions := make(map[string]struct{}, len(document.Permissions))
+	for _, p := range document.Permissions {
+		if p == nil {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyPermissionEmpty)
+		}
+		if err := validatePermissionPart(p.Key(), p.Resource, maxResourceLen, resourceMessages); err != nil {
+			return err
+		}
+		if err := validatePermissionPart(p.Key(), p.Action, maxActionLen, actionMessages); err != nil {
+			return err
+		}
+		if _, ok := permissions[p.Key()]; ok {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyPermissionDuplicate, p.Key())
+		}
+		permissions[p.Key()] = struct{}{}
+	}
+
+	roles := make(map[string]struct{}, len(document.Roles))
+	for _, role := range document.Roles {
+		if role == nil {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyRoleEmpty)
+		}
+		if n := utf8.RuneCountInString(role.Name); n < minRoleNameLen || n > maxRoleNameLen {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyRoleNameLength, role.Name, minRoleNameLen, maxRoleNameLen)
+		}
+		if _, ok := roles[role.Name]; ok {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyRoleDuplicate, role.Name)
+		}
+		roles[role.Name] = struct{}{}
+
+		if len(role.Inherits) > 0 {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyRoleInherits, role.Name)
+		}
+		if err := validateGrants(role, permissions); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range document.Roles {
+		if _, ok := roles[role.Approver]; role.Approver != "" && !ok {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyApproverUndeclared, role.Name, role.Approver)
+		}
+	}
+
+	return nil
+}
+
+func validateGrants(role *model.PolicyRole, permissions map[string]struct{}) error {
+	granted := make(map[string]struct{}, len(role.Grants))
+	for _, grant := range role.Grants {
+		if grant == nil {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyGrantEmpty, role.Name)
+		}
+		if _, ok := permissions[grant.Permission]; !ok {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyGrantUndeclared, role.Name, grant.Permission)
+		}
+		if _, ok := granted[grant.Permission]; ok {
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyGrantDuplicate, grant.Permission, role.Name)
+		}
+		granted[grant.Permission] = struct{}{}
+
+		switch grant.Effect {
+		case "", authz.EffectAllow.String(), authz.EffectDeny.String():
+		default:
+			return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyGrantEffect, grant.Effect, grant.Permission, role.Name)
+		}
+
+		if expr := strings.TrimSpace(grant.Condition); expr != "" {
+			if err := condition.Validate(expr); err != nil {
+				return model.ErrInvalidPolicy.WithMessage(model.MsgPolicyGrantCondition, grant.Permission, role.Name, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// partMessages ключи сообщений о некорректной части права (ресурсе или действии)
+type partMessages struct {
+	empty, tooLong, invalidChars string
+}
+
+var (
+	resourceMessages = partMessages{
+		empty:        model.MsgPolicyResourceEmpty,
+		tooLong:      model.MsgPolicyResourceTooLong,
+		invalidChars: model.MsgPolicyResourceInvalidChars,
+	}
+	actionMessages = partMessages{
+		empty:        model.MsgPolicyActionEmpty,
+		tooLong:      model.MsgPolicyActionTooLong,
+		invalidChars: model.MsgPolicyActionInvalidChars,
+	}
+)
+
+func validatePermissionPart(key, part string, maxLen int, messages partMessages) error {
+	switch {
+	case part == "":
+		return model.ErrInvalidPolicy.WithMessage(messages.empty, key)
+	case utf8.RuneCountInString(part) > maxLen:
+		return model.ErrInvalidPolicy.WithMessage(messages.tooLong, key, maxLen)
+	case strings.Contains(part, authz.Separator), strings.HasPrefix(part, authz.DenyPrefix):
+		return model.ErrInvalidPolicy.WithMessage(messages.invalidChars, key, authz.Separator, authz.DenyPrefix)
+	}
+	return nil
+}
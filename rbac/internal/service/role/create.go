@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
 func (s *RoleService) Create(ctx context.Context, name, description string) (uuid.UUID, error) {
@@ -19,5 +22,18 @@ func (s *RoleService) Create(ctx context.Context, name, description string) (uui
 		return uuid.Nil, err
 	}
 
+	created := &model.Role{ID: role, Name: name, Description: description}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleCreate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   role.String(),
+		After:      created,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleCreated, role.String(), created); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleCreated", zap.Error(err))
+	}
+
 	return role, nil
 }
@@ -3,19 +3,48 @@ package role
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
 func (s *RoleService) Delete(ctx context.Context, id string) error {
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.delete_role")
 	defer span.End()
 
-	err := s.roleRepo.Delete(ctx, id)
+	// Состояние до удаления для журнала аудита
+	before, err := s.roleRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения роли из репозитория", err)
+		return err
+	}
+
+	if before.IsSystem {
+		logger.Warn(ctx, "⚠️ [Service] Попытка удалить системную роль", zap.String("role_id", id))
+		return model.ErrSystemRoleProtected
+	}
+
+	err = s.roleRepo.Delete(ctx, id)
 	if err != nil {
 		errreport.Report(ctx, "❌ [Service] Ошибка удаления роли из репозитория", err)
 		return err
 	}
 
+	s.invalidateCache(ctx, id)
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleDelete,
+		TargetType: model.AuditTargetRole,
+		TargetID:   id,
+		Before:     before,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleDeleted, id, before); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleDeleted", zap.Error(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,49 @@
+package role
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// FlushCache сбрасывает кэш указанных ролей или всех ролей, если список пуст
+func (s *RoleService) FlushCache(ctx context.Context, roleIDs []string) (int, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.flush_role_cache")
+	defer span.End()
+
+	roleIDs, err := s.resolveRoleIDs(ctx, roleIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, id := range roleIDs {
+		if err = s.enrichedRoleRepo.Invalidate(ctx, id); err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка сброса кэша роли", err)
+			return i, err
+		}
+	}
+
+	return len(roleIDs), nil
+}
+
+// resolveRoleIDs возвращает ID всех ролей, если список не задан
+func (s *RoleService) resolveRoleIDs(ctx context.Context, roleIDs []string) ([]string, error) {
+	if len(roleIDs) > 0 {
+		return roleIDs, nil
+	}
+
+	roles, _, err := s.roleRepo.List(ctx, &model.RoleFilter{Status: model.RoleStatusActive})
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения списка ролей из репозитория", err)
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(roles))
+	for _, item := range roles {
+		ids = append(ids, item.Role.ID.String())
+	}
+
+	return ids, nil
+}
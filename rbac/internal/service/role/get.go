@@ -2,6 +2,8 @@ package role
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -16,13 +18,53 @@ func (s *RoleService) Get(ctx context.Context, id string) (*model.EnrichedRole,
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.get_role")
 	defer span.End()
 
-	enrichedRole, err := s.enrichedRoleRepo.Get(ctx, id)
-	if err == nil {
-		return enrichedRole, nil
+	// Без версии результат не кэшируется, чтобы не записать устаревшее состояние
+	version, err := s.enrichedRoleRepo.GetVersion(ctx, id)
+	cacheable := err == nil
+	if !cacheable {
+		logger.Warn(ctx, "⚠️ [Service] Не удалось получить версию роли в кэше", zap.Error(err))
 	}
 
+	if cacheable {
+		enrichedRole, err := s.enrichedRoleRepo.Get(ctx, id, version)
+		if err == nil {
+			return enrichedRole, nil
+		}
+		if errors.Is(err, model.ErrRoleNotFound) {
+			return nil, err
+		}
+	}
+
+	// Загрузка общая для всех ожидающих, поэтому отмена запроса, начавшего ее, не прерывает остальных.
+	// Каждый вызов по-прежнему завершается по своему контексту
+	loaded := s.loadGroup.DoChan(id+":"+strconv.FormatInt(version, 10), func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+
+		return s.load(loadCtx, id, version, cacheable)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-loaded:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*model.EnrichedRole), nil
+	}
+}
+
+// load загружает роль с правами из БД и сохраняет её в кэш под версией, прочитанной до загрузки
+func (s *RoleService) load(ctx context.Context, id string, version int64, cacheable bool) (*model.EnrichedRole, error) {
 	role, err := s.roleRepo.Get(ctx, id)
 	if err != nil {
+		if cacheable && errors.Is(err, model.ErrRoleNotFound) {
+			if err := s.enrichedRoleRepo.SetNotFound(ctx, id, version, time.Now().Add(notFoundCacheTTL)); err != nil {
+				logger.Warn(ctx, "⚠️ [Service] Не удалось кэшировать отсутствие роли", zap.Error(err))
+			}
+		}
+
 		errreport.Report(ctx, "❌ [Service] Ошибка получения роли из репозитория", err)
 		return nil, err
 	}
@@ -33,14 +75,16 @@ func (s *RoleService) Get(ctx context.Context, id string) (*model.EnrichedRole,
 		return nil, err
 	}
 
-	enrichedRole = &model.EnrichedRole{
+	enrichedRole := &model.EnrichedRole{
 		Role:        *role,
 		Permissions: permissions,
 	}
 
-	expiresAt := time.Now().Add(s.enrichedRoleTTL)
-	if err := s.enrichedRoleRepo.Set(ctx, enrichedRole, expiresAt); err != nil {
-		logger.Warn(ctx, "⚠️ [Service] Не удалось кэшировать роль", zap.Error(err))
+	if cacheable {
+		expiresAt := time.Now().Add(s.enrichedRoleTTL)
+		if err := s.enrichedRoleRepo.Set(ctx, enrichedRole, version, expiresAt); err != nil {
+			logger.Warn(ctx, "⚠️ [Service] Не удалось кэшировать роль", zap.Error(err))
+		}
 	}
 
 	return enrichedRole, nil
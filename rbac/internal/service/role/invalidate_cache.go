@@ -0,0 +1,19 @@
+package role
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+)
+
+// invalidateCache сбрасывает кэш роли после изменения; ошибка не прерывает операцию,
+// устаревшая запись в худшем случае истечет по TTL
+func (s *RoleService) invalidateCache(ctx context.Context, id string) {
+	if err := s.enrichedRoleRepo.Invalidate(ctx, id); err != nil {
+		logger.Warn(ctx, "⚠️ [Service] Не удалось сбросить кэш роли",
+			zap.String("role_id", id),
+			zap.Error(err))
+	}
+}
@@ -8,15 +8,15 @@ import (
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
-func (s *RoleService) List(ctx context.Context) ([]*model.Role, error) {
+func (s *RoleService) List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error) {
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_roles")
 	defer span.End()
 
-	roles, err := s.roleRepo.List(ctx)
+	roles, nextCursor, err := s.roleRepo.List(ctx, filter)
 	if err != nil {
 		errreport.Report(ctx, "❌ [Service] Ошибка получения списка ролей из репозитория", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	return roles, nil
+	return roles, nextCursor, nil
 }
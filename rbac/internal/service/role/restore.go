@@ -0,0 +1,45 @@
+package role
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *RoleService) Restore(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.restore_role")
+	defer span.End()
+
+	err := s.roleRepo.Restore(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка восстановления роли в репозитории", err)
+		return err
+	}
+
+	// Сбрасывает отрицательную запись кэша, оставшуюся после удаления
+	s.invalidateCache(ctx, id)
+
+	after, err := s.roleRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения восстановленной роли из репозитория", err)
+		return err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleRestore,
+		TargetType: model.AuditTargetRole,
+		TargetID:   id,
+		After:      after,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleRestored, id, after); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleRestored", zap.Error(err))
+	}
+
+	return nil
+}
@@ -3,10 +3,18 @@ package role
 import (
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
 )
 
+// notFoundCacheTTL время жизни отрицательной записи для несуществующей роли
+const notFoundCacheTTL = time.Minute
+
+// loadTimeout ограничивает общую загрузку роли, которая не зависит от отмены запроса-инициатора
+const loadTimeout = 10 * time.Second
+
 var _ service.RoleServiceInterface = (*RoleService)(nil)
 
 type RoleService struct {
@@ -14,6 +22,11 @@ type RoleService struct {
 	rolePermissionRepo repository.RolePermissionRepository
 	enrichedRoleRepo   repository.EnrichedRoleRepository
 	enrichedRoleTTL    time.Duration
+	auditService       service.AuditServiceInterface
+	eventProducer      service.DomainEventProducerService
+
+	// loadGroup объединяет параллельные загрузки одной роли при промахе кэша
+	loadGroup singleflight.Group
 }
 
 func NewService(
@@ -21,11 +34,15 @@ func NewService(
 	rolePermissionRepo repository.RolePermissionRepository,
 	enrichedRoleRepo repository.EnrichedRoleRepository,
 	enrichedRoleTTL time.Duration,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
 ) *RoleService {
 	return &RoleService{
 		roleRepo:           roleRepo,
 		rolePermissionRepo: rolePermissionRepo,
 		enrichedRoleRepo:   enrichedRoleRepo,
 		enrichedRoleTTL:    enrichedRoleTTL,
+		auditService:       auditService,
+		eventProducer:      eventProducer,
 	}
 }
@@ -0,0 +1,92 @@
+package role_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestFlushCacheSelectedRoles() {
+	roleIDs := []string{uuid.NewString(), uuid.NewString()}
+
+	for _, id := range roleIDs {
+		s.enrichedRoleRepository.On("Invalidate", mock.Anything, id).Return(nil).Once()
+	}
+
+	count, err := s.service.FlushCache(s.ctx, roleIDs)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, count)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestFlushCacheAllRoles() {
+	roles := []*model.RoleListItem{{Role: &model.Role{ID: uuid.New()}}, {Role: &model.Role{ID: uuid.New()}}, {Role: &model.Role{ID: uuid.New()}}}
+
+	s.roleRepository.On("List", mock.Anything, &model.RoleFilter{Status: model.RoleStatusActive}).Return(roles, nil, nil).Once()
+	for _, item := range roles {
+		s.enrichedRoleRepository.On("Invalidate", mock.Anything, item.Role.ID.String()).Return(nil).Once()
+	}
+
+	count, err := s.service.FlushCache(s.ctx, nil)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), len(roles), count)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestFlushCacheError() {
+	roleIDs := []string{uuid.NewString(), uuid.NewString()}
+
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleIDs[0]).Return(nil).Once()
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleIDs[1]).Return(assert.AnError).Once()
+
+	count, err := s.service.FlushCache(s.ctx, roleIDs)
+
+	assert.ErrorIs(s.T(), err, assert.AnError)
+	assert.Equal(s.T(), 1, count)
+
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestWarmCacheSkipsMissingRoles() {
+	existing := &model.Role{ID: uuid.New(), Name: "admin", CreatedAt: time.Now()}
+	missingID := uuid.NewString()
+
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, existing.ID.String()).Return(int64(2), nil).Once()
+	s.roleRepository.On("Get", mock.Anything, existing.ID.String()).Return(existing, nil).Once()
+	s.rolePermissionRepository.On("GetRolePermissions", mock.Anything, existing.ID.String()).Return([]*model.Permission{}, nil).Once()
+	s.enrichedRoleRepository.On("Set", mock.Anything, mock.Anything, int64(2), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, missingID).Return(int64(0), nil).Once()
+	s.roleRepository.On("Get", mock.Anything, missingID).Return(nil, model.ErrRoleNotFound).Once()
+	s.enrichedRoleRepository.On("SetNotFound", mock.Anything, missingID, int64(0), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	count, err := s.service.WarmCache(s.ctx, []string{existing.ID.String(), missingID})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.rolePermissionRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestWarmCacheListError() {
+	s.roleRepository.On("List", mock.Anything, &model.RoleFilter{Status: model.RoleStatusActive}).Return(nil, nil, model.ErrInternal).Once()
+
+	count, err := s.service.WarmCache(s.ctx, nil)
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Zero(s.T(), count)
+
+	s.roleRepository.AssertExpectations(s.T())
+}
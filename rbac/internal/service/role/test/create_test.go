@@ -32,3 +32,39 @@ func (s *ServiceSuite) TestCreateRepositoryError() {
 
 	s.roleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestCreateRecordsAudit() {
+	roleID := uuid.New()
+
+	s.roleRepository.On("Create", mock.Anything, "admin", "Administrator role").Return(roleID, nil)
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		after, ok := record.After.(*model.Role)
+		return record.Action == model.AuditActionRoleCreate &&
+			record.TargetType == model.AuditTargetRole &&
+			record.TargetID == roleID.String() &&
+			record.Before == nil &&
+			ok && after.Name == "admin"
+	})).Return().Once()
+
+	_, err := s.service.Create(s.ctx, "admin", "Administrator role")
+
+	assert.NoError(s.T(), err)
+
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreatePublishesEvent() {
+	roleID := uuid.New()
+
+	s.roleRepository.On("Create", mock.Anything, "admin", "Administrator role").Return(roleID, nil)
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeRoleCreated, roleID.String(),
+		&model.Role{ID: roleID, Name: "admin", Description: "Administrator role"}).Return(nil).Once()
+
+	_, err := s.service.Create(s.ctx, "admin", "Administrator role")
+
+	assert.NoError(s.T(), err)
+
+	s.eventProducer.AssertExpectations(s.T())
+}
@@ -10,19 +10,32 @@ import (
 func (s *ServiceSuite) TestDeleteSuccess() {
 	roleID := "123e4567-e89b-12d3-a456-426614174000"
 
+	before := &model.Role{Name: "moderator"}
+
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(before, nil)
 	s.roleRepository.On("Delete", mock.Anything, roleID).Return(nil)
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionRoleDelete &&
+			record.TargetID == roleID &&
+			record.Before == before &&
+			record.After == nil
+	})).Return().Once()
 
 	err := s.service.Delete(s.ctx, roleID)
 
 	assert.NoError(s.T(), err)
 
 	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestDeleteNotFound() {
 	roleID := "123e4567-e89b-12d3-a456-426614174000"
 
-	s.roleRepository.On("Delete", mock.Anything, roleID).Return(model.ErrRoleNotFound)
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(nil, model.ErrRoleNotFound)
 
 	err := s.service.Delete(s.ctx, roleID)
 
@@ -35,6 +48,7 @@ func (s *ServiceSuite) TestDeleteNotFound() {
 func (s *ServiceSuite) TestDeleteRepositoryError() {
 	roleID := "123e4567-e89b-12d3-a456-426614174000"
 
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(&model.Role{}, nil)
 	s.roleRepository.On("Delete", mock.Anything, roleID).Return(model.ErrInternal)
 
 	err := s.service.Delete(s.ctx, roleID)
@@ -44,3 +58,17 @@ func (s *ServiceSuite) TestDeleteRepositoryError() {
 
 	s.roleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestDeleteSystemRoleProtected() {
+	roleID := "650e8400-e29b-41d4-a716-446655440001"
+
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(&model.Role{Name: "admin", IsSystem: true}, nil)
+
+	err := s.service.Delete(s.ctx, roleID)
+
+	assert.ErrorIs(s.T(), err, model.ErrSystemRoleProtected)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.roleRepository.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	s.enrichedRoleRepository.AssertNotCalled(s.T(), "Invalidate", mock.Anything, mock.Anything)
+}
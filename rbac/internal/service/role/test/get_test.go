@@ -1,6 +1,8 @@
 package role_test
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,16 +26,17 @@ func (s *ServiceSuite) TestGetSuccess() {
 	}
 
 	// Проверяем кэш сначала
-	s.enrichedRoleRepository.On("Get", mock.Anything, roleID).Return(nil, assert.AnError).Once()
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(3), nil).Once()
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(3)).Return(nil, model.ErrCacheMiss).Once()
 
 	// Если нет в кэше, получаем из репозитория
 	s.roleRepository.On("Get", mock.Anything, roleID).Return(role, nil).Once()
 	s.rolePermissionRepository.On("GetRolePermissions", mock.Anything, roleID).Return(permissions, nil).Once()
 
-	// Сохраняем в кэш
+	// Сохраняем в кэш под прочитанной версией
 	s.enrichedRoleRepository.On("Set", mock.Anything, mock.MatchedBy(func(er *model.EnrichedRole) bool {
 		return er.Role.ID == role.ID && len(er.Permissions) == 2
-	}), mock.AnythingOfType("time.Time")).Return(nil).Once()
+	}), int64(3), mock.AnythingOfType("time.Time")).Return(nil).Once()
 
 	result, err := s.service.Get(s.ctx, roleID)
 
@@ -69,7 +72,8 @@ func (s *ServiceSuite) TestGetFromCache() {
 	}
 
 	// Роль найдена в кэше
-	s.enrichedRoleRepository.On("Get", mock.Anything, roleID).Return(expectedEnrichedRole, nil).Once()
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), nil).Once()
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(0)).Return(expectedEnrichedRole, nil).Once()
 
 	result, err := s.service.Get(s.ctx, roleID)
 
@@ -89,10 +93,12 @@ func (s *ServiceSuite) TestGetRoleNotFound() {
 	roleID := uuid.New().String()
 
 	// Нет в кэше
-	s.enrichedRoleRepository.On("Get", mock.Anything, roleID).Return(nil, assert.AnError).Once()
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), nil).Once()
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(0)).Return(nil, model.ErrCacheMiss).Once()
 
-	// Роль не найдена в репозитории
+	// Роль не найдена в репозитории, отсутствие кэшируется
 	s.roleRepository.On("Get", mock.Anything, roleID).Return(nil, model.ErrRoleNotFound).Once()
+	s.enrichedRoleRepository.On("SetNotFound", mock.Anything, roleID, int64(0), mock.AnythingOfType("time.Time")).Return(nil).Once()
 
 	result, err := s.service.Get(s.ctx, roleID)
 
@@ -115,7 +121,8 @@ func (s *ServiceSuite) TestGetPermissionsError() {
 	}
 
 	// Нет в кэше
-	s.enrichedRoleRepository.On("Get", mock.Anything, roleID).Return(nil, assert.AnError).Once()
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), nil).Once()
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(0)).Return(nil, model.ErrCacheMiss).Once()
 
 	// Роль найдена, но ошибка при получении прав
 	s.roleRepository.On("Get", mock.Anything, roleID).Return(role, nil).Once()
@@ -131,3 +138,127 @@ func (s *ServiceSuite) TestGetPermissionsError() {
 	s.rolePermissionRepository.AssertExpectations(s.T())
 	s.enrichedRoleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestGetNegativeCacheHit() {
+	roleID := uuid.New().String()
+
+	// Отсутствие роли закэшировано, БД не запрашивается
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(1), nil).Once()
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(1)).Return(nil, model.ErrRoleNotFound).Once()
+
+	result, err := s.service.Get(s.ctx, roleID)
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleNotFound)
+	assert.Nil(s.T(), result)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetCacheUnavailable() {
+	roleID := uuid.New().String()
+	role := &model.Role{ID: uuid.New(), Name: "admin", CreatedAt: time.Now()}
+
+	// Без версии роль загружается из БД и не кэшируется
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), assert.AnError).Once()
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(role, nil).Once()
+	s.rolePermissionRepository.On("GetRolePermissions", mock.Anything, roleID).Return([]*model.Permission{}, nil).Once()
+
+	result, err := s.service.Get(s.ctx, roleID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), role.ID, result.Role.ID)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.rolePermissionRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetConcurrentMissLoadsOnce() {
+	roleID := uuid.New().String()
+	role := &model.Role{ID: uuid.New(), Name: "admin", CreatedAt: time.Now()}
+	release := make(chan struct{})
+
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), nil)
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(0)).Return(nil, model.ErrCacheMiss)
+	s.enrichedRoleRepository.On("Set", mock.Anything, mock.Anything, int64(0), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	// Загрузка из БД блокируется, пока все запросы не дойдут до промаха кэша
+	s.roleRepository.On("Get", mock.Anything, roleID).
+		Run(func(mock.Arguments) { <-release }).
+		Return(role, nil).Once()
+	s.rolePermissionRepository.On("GetRolePermissions", mock.Anything, roleID).Return([]*model.Permission{}, nil).Once()
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.service.Get(s.ctx, roleID)
+			errs <- err
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(s.T(), err)
+	}
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.rolePermissionRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+// TestGetLeaderCancelDoesNotFailWaiters проверяет, что отмена запроса, начавшего загрузку,
+// не прерывает общую загрузку для остальных ожидающих
+func (s *ServiceSuite) TestGetLeaderCancelDoesNotFailWaiters() {
+	roleID := uuid.New().String()
+	role := &model.Role{ID: uuid.New(), Name: "admin", CreatedAt: time.Now()}
+	started, release := make(chan struct{}), make(chan struct{})
+	loadErr := make(chan error, 1)
+
+	s.enrichedRoleRepository.On("GetVersion", mock.Anything, roleID).Return(int64(0), nil)
+	s.enrichedRoleRepository.On("Get", mock.Anything, roleID, int64(0)).Return(nil, model.ErrCacheMiss)
+	s.enrichedRoleRepository.On("Set", mock.Anything, mock.Anything, int64(0), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	s.roleRepository.On("Get", mock.Anything, roleID).
+		Run(func(args mock.Arguments) {
+			close(started)
+			<-release
+			loadErr <- args.Get(0).(context.Context).Err()
+		}).
+		Return(role, nil).Once()
+	s.rolePermissionRepository.On("GetRolePermissions", mock.Anything, roleID).Return([]*model.Permission{}, nil).Once()
+
+	leaderCtx, cancel := context.WithCancel(s.ctx)
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := s.service.Get(leaderCtx, roleID)
+		leaderErr <- err
+	}()
+	<-started
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, err := s.service.Get(s.ctx, roleID)
+		waiterErr <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(s.T(), <-leaderErr, context.Canceled)
+
+	close(release)
+	assert.NoError(s.T(), <-loadErr)
+	assert.NoError(s.T(), <-waiterErr)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.rolePermissionRepository.AssertExpectations(s.T())
+}
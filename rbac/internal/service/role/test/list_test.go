@@ -24,49 +24,86 @@ func (s *ServiceSuite) TestListSuccess() {
 		CreatedAt:   time.Now(),
 	}
 
-	expectedRoles := []*model.Role{role1, role2}
+	expectedRoles := []*model.RoleListItem{{Role: role1, UserCount: 3}, {Role: role2}}
+	filter := &model.RoleFilter{Status: model.RoleStatusActive, Limit: 2}
+	nextCursor := "cursor"
 
-	s.roleRepository.On("List", mock.Anything).Return(expectedRoles, nil).Once()
+	s.roleRepository.On("List", mock.Anything, filter).Return(expectedRoles, &nextCursor, nil).Once()
 
-	roles, err := s.service.List(s.ctx)
+	roles, cursor, err := s.service.List(s.ctx, filter)
 
 	assert.NoError(s.T(), err)
-	assert.NotNil(s.T(), roles)
 	assert.Len(s.T(), roles, 2)
+	assert.Equal(s.T(), &nextCursor, cursor)
 
-	assert.Equal(s.T(), role1.ID, roles[0].ID)
-	assert.Equal(s.T(), role1.Name, roles[0].Name)
-	assert.Equal(s.T(), role1.Description, roles[0].Description)
-
-	assert.Equal(s.T(), role2.ID, roles[1].ID)
-	assert.Equal(s.T(), role2.Name, roles[1].Name)
-	assert.Equal(s.T(), role2.Description, roles[1].Description)
+	assert.Equal(s.T(), role1.ID, roles[0].Role.ID)
+	assert.Equal(s.T(), int32(3), roles[0].UserCount)
+	assert.Equal(s.T(), role2.Name, roles[1].Role.Name)
+	assert.Zero(s.T(), roles[1].UserCount)
 
 	s.roleRepository.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestListEmptyResult() {
-	expectedRoles := []*model.Role{}
+	filter := &model.RoleFilter{NameQuery: "missing"}
 
-	s.roleRepository.On("List", mock.Anything).Return(expectedRoles, nil).Once()
+	s.roleRepository.On("List", mock.Anything, filter).Return([]*model.RoleListItem{}, nil, nil).Once()
 
-	roles, err := s.service.List(s.ctx)
+	roles, cursor, err := s.service.List(s.ctx, filter)
 
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), roles)
 	assert.Len(s.T(), roles, 0)
+	assert.Nil(s.T(), cursor)
 
 	s.roleRepository.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestListInternalError() {
-	s.roleRepository.On("List", mock.Anything).Return(nil, model.ErrInternal).Once()
+	filter := &model.RoleFilter{}
+
+	s.roleRepository.On("List", mock.Anything, filter).Return(nil, nil, model.ErrInternal).Once()
 
-	roles, err := s.service.List(s.ctx)
+	roles, cursor, err := s.service.List(s.ctx, filter)
 
 	assert.Error(s.T(), err)
 	assert.Nil(s.T(), roles)
+	assert.Nil(s.T(), cursor)
 	assert.Equal(s.T(), model.ErrInternal, err)
 
 	s.roleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestListInvalidCursor() {
+	filter := &model.RoleFilter{Cursor: "%%%"}
+
+	s.roleRepository.On("List", mock.Anything, filter).Return(nil, nil, model.ErrInvalidCursor).Once()
+
+	_, _, err := s.service.List(s.ctx, filter)
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidCursor)
+
+	s.roleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestListDeleted() {
+	deletedAt := time.Now()
+	deleted := &model.Role{
+		ID:        uuid.New(),
+		Name:      "archived",
+		CreatedAt: time.Now(),
+		DeletedAt: &deletedAt,
+	}
+	filter := &model.RoleFilter{Status: model.RoleStatusDeleted}
+
+	s.roleRepository.On("List", mock.Anything, filter).Return([]*model.RoleListItem{{Role: deleted}}, nil, nil).Once()
+
+	roles, _, err := s.service.List(s.ctx, filter)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), roles, 1)
+	assert.Equal(s.T(), deleted.ID, roles[0].Role.ID)
+	assert.NotNil(s.T(), roles[0].Role.DeletedAt)
+
+	s.roleRepository.AssertExpectations(s.T())
+}
@@ -0,0 +1,63 @@
+package role_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestRestoreSuccess() {
+	roleID := uuid.New()
+	restored := &model.Role{ID: roleID, Name: "moderator"}
+
+	s.roleRepository.On("Restore", mock.Anything, roleID.String()).Return(nil).Once()
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID.String()).Return(nil).Once()
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(restored, nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionRoleRestore &&
+			record.TargetID == roleID.String() &&
+			record.Before == nil &&
+			record.After == restored
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeRoleRestored, roleID.String(), restored).Return(nil).Once()
+
+	err := s.service.Restore(s.ctx, roleID.String())
+
+	assert.NoError(s.T(), err)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRestoreNotDeleted() {
+	roleID := uuid.New().String()
+
+	s.roleRepository.On("Restore", mock.Anything, roleID).Return(model.ErrRoleNotFound).Once()
+
+	err := s.service.Restore(s.ctx, roleID)
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleNotFound)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertNotCalled(s.T(), "Invalidate", mock.Anything, mock.Anything)
+	s.auditService.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestRestoreNameConflict() {
+	roleID := uuid.New().String()
+
+	s.roleRepository.On("Restore", mock.Anything, roleID).Return(model.ErrRoleAlreadyExists).Once()
+
+	err := s.service.Restore(s.ctx, roleID)
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleAlreadyExists)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.eventProducer.AssertNotCalled(s.T(), "Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role"
 )
 
@@ -19,6 +21,8 @@ type ServiceSuite struct {
 	roleRepository           *mocks.RoleRepository
 	rolePermissionRepository *mocks.RolePermissionRepository
 	enrichedRoleRepository   *mocks.EnrichedRoleRepository
+	auditService             *serviceMocks.AuditServiceInterface
+	eventProducer            *serviceMocks.DomainEventProducerService
 
 	service *role.RoleService
 }
@@ -36,13 +40,30 @@ func (s *ServiceSuite) SetupSuite() {
 	// Создаем моки для всех зависимостей
 	s.enrichedRoleRepository = mocks.NewEnrichedRoleRepository(s.T())
 
-	s.service = role.NewService(s.roleRepository, s.rolePermissionRepository, s.enrichedRoleRepository, time.Hour)
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.service = role.NewService(s.roleRepository, s.rolePermissionRepository, s.enrichedRoleRepository, time.Hour, s.auditService, s.eventProducer)
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.roleRepository.ExpectedCalls = nil
 	s.rolePermissionRepository.ExpectedCalls = nil
 	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	s.roleRepository.Calls = nil
+	s.rolePermissionRepository.Calls = nil
+	s.enrichedRoleRepository.Calls = nil
+	s.auditService.Calls = nil
+	s.eventProducer.Calls = nil
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 }
 
 func (s *ServiceSuite) TearDownTest() {
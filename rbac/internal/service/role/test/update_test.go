@@ -19,13 +19,26 @@ func (s *ServiceSuite) TestUpdateSuccess() {
 		Description: &description,
 	}
 
+	before := &model.Role{ID: roleID, Name: "administrator", Description: "Administrator role"}
+
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(before, nil)
 	s.roleRepository.On("Update", mock.Anything, mock.Anything).Return(nil)
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID.String()).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		after, ok := record.After.(*model.Role)
+		return record.Action == model.AuditActionRoleUpdate &&
+			record.Before == before &&
+			ok && after.Name == name && after.Description == description
+	})).Return().Once()
 
 	err := s.service.Update(s.ctx, updateRole)
 
 	assert.NoError(s.T(), err)
 
 	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestUpdateNotFound() {
@@ -39,7 +52,7 @@ func (s *ServiceSuite) TestUpdateNotFound() {
 		Description: &description,
 	}
 
-	s.roleRepository.On("Update", mock.Anything, mock.Anything).Return(model.ErrRoleNotFound)
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(nil, model.ErrRoleNotFound)
 
 	err := s.service.Update(s.ctx, updateRole)
 
@@ -60,6 +73,7 @@ func (s *ServiceSuite) TestUpdateInternalError() {
 		Description: &description,
 	}
 
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(&model.Role{ID: roleID}, nil)
 	s.roleRepository.On("Update", mock.Anything, mock.Anything).Return(model.ErrInternal)
 
 	err := s.service.Update(s.ctx, updateRole)
@@ -69,3 +83,47 @@ func (s *ServiceSuite) TestUpdateInternalError() {
 
 	s.roleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestUpdateSystemRoleRenameProtected() {
+	roleID := uuid.New()
+	name := "superuser"
+
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(&model.Role{ID: roleID, Name: "admin", IsSystem: true}, nil)
+
+	err := s.service.Update(s.ctx, &model.UpdateRole{ID: roleID.String(), Name: &name})
+
+	assert.ErrorIs(s.T(), err, model.ErrSystemRoleProtected)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.roleRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateSystemRoleCaseRenameProtected() {
+	roleID := uuid.New()
+	name := "Admin"
+
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(&model.Role{ID: roleID, Name: "admin", IsSystem: true}, nil)
+
+	err := s.service.Update(s.ctx, &model.UpdateRole{ID: roleID.String(), Name: &name})
+
+	assert.ErrorIs(s.T(), err, model.ErrSystemRoleProtected)
+
+	s.roleRepository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdateSystemRoleDescriptionAllowed() {
+	roleID := uuid.New()
+	name := "admin"
+	description := "Полный доступ"
+
+	s.roleRepository.On("Get", mock.Anything, roleID.String()).Return(&model.Role{ID: roleID, Name: "admin", IsSystem: true}, nil)
+	s.roleRepository.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID.String()).Return(nil).Once()
+
+	err := s.service.Update(s.ctx, &model.UpdateRole{ID: roleID.String(), Name: &name, Description: &description})
+
+	assert.NoError(s.T(), err)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
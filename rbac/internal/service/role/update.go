@@ -3,7 +3,10 @@ package role
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
@@ -12,11 +15,62 @@ func (s *RoleService) Update(ctx context.Context, updateRole *model.UpdateRole)
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.update_role")
 	defer span.End()
 
-	err := s.roleRepo.Update(ctx, updateRole)
+	// Состояние до изменения для журнала аудита
+	before, err := s.roleRepo.Get(ctx, updateRole.ID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения роли из репозитория", err)
+		return err
+	}
+
+	if before.IsSystem && updateRole.Name != nil && *updateRole.Name != before.Name {
+		logger.Warn(ctx, "⚠️ [Service] Попытка переименовать системную роль", zap.String("role_id", updateRole.ID))
+		return model.ErrSystemRoleProtected
+	}
+
+	// Роль согласующих должна существовать, иначе заявки на роль некому рассмотреть
+	if updateRole.ApproverRoleID != nil && *updateRole.ApproverRoleID != "" {
+		if _, err = s.roleRepo.Get(ctx, *updateRole.ApproverRoleID); err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения роли согласующих", err)
+			return err
+		}
+	}
+
+	err = s.roleRepo.Update(ctx, updateRole)
 	if err != nil {
 		errreport.Report(ctx, "❌ [Service] Ошибка обновления роли в репозитории", err)
 		return err
 	}
 
+	s.invalidateCache(ctx, updateRole.ID)
+
+	after := *before
+	if updateRole.Name != nil {
+		after.Name = *updateRole.Name
+	}
+	if updateRole.Description != nil {
+		after.Description = *updateRole.Description
+	}
+	if updateRole.RequiresApproval != nil {
+		after.RequiresApproval = *updateRole.RequiresApproval
+	}
+	if updateRole.ApproverRoleID != nil {
+		after.ApproverRoleID = updateRole.ApproverRoleID
+		if *updateRole.ApproverRoleID == "" {
+			after.ApproverRoleID = nil
+		}
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleUpdate,
+		TargetType: model.AuditTargetRole,
+		TargetID:   updateRole.ID,
+		Before:     before,
+		After:      &after,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleUpdated, updateRole.ID, &after); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleUpdated", zap.Error(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,42 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// WarmCache загружает в кэш указанные роли или все роли, если список пуст.
+// Несуществующие роли пропускаются и попадают в кэш как отрицательные записи.
+func (s *RoleService) WarmCache(ctx context.Context, roleIDs []string) (int, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.warm_role_cache")
+	defer span.End()
+
+	roleIDs, err := s.resolveRoleIDs(ctx, roleIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	warmed := 0
+	for _, id := range roleIDs {
+		version, err := s.enrichedRoleRepo.GetVersion(ctx, id)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка получения версии роли в кэше", err)
+			return warmed, err
+		}
+
+		if _, err = s.load(ctx, id, version, true); err != nil {
+			if errors.Is(err, model.ErrRoleNotFound) {
+				continue
+			}
+			return warmed, err
+		}
+
+		warmed++
+	}
+
+	return warmed, nil
+}
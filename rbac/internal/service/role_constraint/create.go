@@ -0,0 +1,56 @@
+package role_constraint
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *RoleConstraintService) Create(ctx context.Context, constraint *model.CreateRoleConstraint) (*model.RoleConstraint, []*model.ConstraintViolation, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.create_role_constraint")
+	defer span.End()
+
+	constraint.RoleIDs = slices.Compact(slices.Sorted(slices.Values(constraint.RoleIDs)))
+	if len(constraint.RoleIDs) < 2 {
+		return nil, nil, model.ErrInvalidRoleConstraint
+	}
+
+	created, err := s.roleConstraintRepo.Create(ctx, constraint)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка создания ограничения ролей", err)
+		return nil, nil, err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleConstraintCreate,
+		TargetType: model.AuditTargetRoleConstraint,
+		TargetID:   created.ID.String(),
+		After:      created,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleConstraintCreated, created.ID.String(), created); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleConstraintCreated", zap.Error(err))
+	}
+
+	// Ограничение действует только для новых назначений, существующие нарушения возвращаются отчетом
+	constraintID := created.ID.String()
+	violations, err := s.roleConstraintRepo.ListViolations(ctx, &constraintID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения нарушений нового ограничения ролей", err)
+		return nil, nil, err
+	}
+
+	if len(violations) > 0 {
+		logger.Warn(ctx, "⚠️ [Service] Новое ограничение ролей уже нарушено",
+			zap.String("constraint_id", constraintID),
+			zap.Int("violations", len(violations)))
+	}
+
+	return created, violations, nil
+}
@@ -0,0 +1,41 @@
+package role_constraint
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *RoleConstraintService) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.delete_role_constraint")
+	defer span.End()
+
+	constraint, err := s.roleConstraintRepo.Get(ctx, id)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ограничения ролей", err)
+		return err
+	}
+
+	if err = s.roleConstraintRepo.Delete(ctx, id); err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка удаления ограничения ролей", err)
+		return err
+	}
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRoleConstraintDelete,
+		TargetType: model.AuditTargetRoleConstraint,
+		TargetID:   id,
+		Before:     constraint,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRoleConstraintDeleted, id, constraint); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RoleConstraintDeleted", zap.Error(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package role_constraint
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *RoleConstraintService) List(ctx context.Context) ([]*model.RoleConstraint, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_role_constraints")
+	defer span.End()
+
+	constraints, err := s.roleConstraintRepo.List(ctx)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения списка ограничений ролей", err)
+		return nil, err
+	}
+
+	return constraints, nil
+}
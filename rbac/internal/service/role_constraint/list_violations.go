@@ -0,0 +1,22 @@
+package role_constraint
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *RoleConstraintService) ListViolations(ctx context.Context, constraintID *string) ([]*model.ConstraintViolation, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.list_constraint_violations")
+	defer span.End()
+
+	violations, err := s.roleConstraintRepo.ListViolations(ctx, constraintID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения нарушений ограничений ролей", err)
+		return nil, err
+	}
+
+	return violations, nil
+}
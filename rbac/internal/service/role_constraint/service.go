@@ -0,0 +1,26 @@
+package role_constraint
+
+import (
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+var _ service.RoleConstraintServiceInterface = (*RoleConstraintService)(nil)
+
+type RoleConstraintService struct {
+	roleConstraintRepo repository.RoleConstraintRepository
+	auditService       service.AuditServiceInterface
+	eventProducer      service.DomainEventProducerService
+}
+
+func NewService(
+	roleConstraintRepo repository.RoleConstraintRepository,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+) *RoleConstraintService {
+	return &RoleConstraintService{
+		roleConstraintRepo: roleConstraintRepo,
+		auditService:       auditService,
+		eventProducer:      eventProducer,
+	}
+}
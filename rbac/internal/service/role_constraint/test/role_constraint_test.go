@@ -0,0 +1,80 @@
+package role_constraint_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateReturnsExistingViolations() {
+	studentID := uuid.NewString()
+	teacherID := uuid.NewString()
+	created := &model.RoleConstraint{ID: uuid.New(), Name: "student_teacher", RoleIDs: []string{studentID, teacherID}}
+	violations := []*model.ConstraintViolation{{
+		ConstraintID: created.ID.String(),
+		UserID:       uuid.NewString(),
+		RoleIDs:      []string{studentID, teacherID},
+	}}
+
+	s.roleConstraintRepository.On("Create", mock.Anything, mock.MatchedBy(func(constraint *model.CreateRoleConstraint) bool {
+		return len(constraint.RoleIDs) == 2
+	})).Return(created, nil).Once()
+	s.roleConstraintRepository.On("ListViolations", mock.Anything, mock.MatchedBy(func(id *string) bool {
+		return id != nil && *id == created.ID.String()
+	})).Return(violations, nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionRoleConstraintCreate && record.TargetID == created.ID.String()
+	})).Return().Once()
+
+	constraint, result, err := s.service.Create(s.ctx, &model.CreateRoleConstraint{
+		Name:    "student_teacher",
+		RoleIDs: []string{studentID, teacherID, studentID},
+	})
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), created, constraint)
+	assert.Equal(s.T(), violations, result)
+
+	s.roleConstraintRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestCreateRequiresTwoRoles() {
+	roleID := uuid.NewString()
+
+	_, _, err := s.service.Create(s.ctx, &model.CreateRoleConstraint{Name: "single", RoleIDs: []string{roleID, roleID}})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidRoleConstraint)
+	s.roleConstraintRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestDeleteRecordsBeforeState() {
+	constraint := &model.RoleConstraint{ID: uuid.New(), Name: "admin_parent"}
+	id := constraint.ID.String()
+
+	s.roleConstraintRepository.On("Get", mock.Anything, id).Return(constraint, nil).Once()
+	s.roleConstraintRepository.On("Delete", mock.Anything, id).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionRoleConstraintDelete && record.Before == constraint
+	})).Return().Once()
+
+	err := s.service.Delete(s.ctx, id)
+
+	assert.NoError(s.T(), err)
+	s.roleConstraintRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestDeleteNotFound() {
+	id := uuid.NewString()
+	s.roleConstraintRepository.On("Get", mock.Anything, id).Return(nil, model.ErrRoleConstraintNotFound).Once()
+
+	err := s.service.Delete(s.ctx, id)
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleConstraintNotFound)
+	s.roleConstraintRepository.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
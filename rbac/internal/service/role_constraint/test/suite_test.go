@@ -0,0 +1,60 @@
+package role_constraint_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role_constraint"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	roleConstraintRepository *repositoryMocks.RoleConstraintRepository
+	auditService             *serviceMocks.AuditServiceInterface
+	eventProducer            *serviceMocks.DomainEventProducerService
+
+	service *role_constraint.RoleConstraintService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.roleConstraintRepository = repositoryMocks.NewRoleConstraintRepository(s.T())
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.service = role_constraint.NewService(s.roleConstraintRepository, s.auditService, s.eventProducer)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.roleConstraintRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	s.roleConstraintRepository.Calls = nil
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -3,19 +3,43 @@ package role_permission
 import (
 	"context"
 
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
-func (s *RolePermissionService) Assign(ctx context.Context, roleID, permissionID string) error {
+func (s *RolePermissionService) Assign(ctx context.Context, assignment *model.AssignRolePermission) error {
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.assign_permission_to_role")
 	defer span.End()
 
-	err := s.rolePermissionRepo.Assign(ctx, roleID, permissionID)
+	if assignment.Condition != nil {
+		if err := condition.Validate(*assignment.Condition); err != nil {
+			return model.ErrInvalidCondition.WithMessage(model.MsgConditionDetails, err.Error())
+		}
+	}
+
+	err := s.rolePermissionRepo.Assign(ctx, assignment)
 	if err != nil {
 		errreport.Report(ctx, "❌ [Service] Ошибка назначения права роли", err)
 		return err
 	}
 
+	s.invalidateCache(ctx, assignment.RoleID)
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRolePermissionAssign,
+		TargetType: model.AuditTargetRole,
+		TargetID:   assignment.RoleID,
+		After:      assignment,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypePermissionGrantedToRole, assignment.RoleID, assignment); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события PermissionGrantedToRole", zap.Error(err))
+	}
+
 	return nil
 }
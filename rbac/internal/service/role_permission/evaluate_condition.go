@@ -0,0 +1,27 @@
+package role_permission
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// EvaluateCondition вычисляет условие по переданным атрибутам без сохранения (для проверки перед назначением)
+func (s *RolePermissionService) EvaluateCondition(ctx context.Context, expression string, attrs condition.Attributes) (bool, error) {
+	_, span := tracing.StartSpan(ctx, "rbac.service.evaluate_condition")
+	defer span.End()
+
+	expr, err := condition.Parse(expression)
+	if err != nil {
+		return false, model.ErrInvalidCondition.WithMessage(model.MsgConditionDetails, err.Error())
+	}
+
+	result, err := expr.Evaluate(attrs)
+	if err != nil {
+		return false, model.ErrInvalidCondition.WithMessage(model.MsgConditionDetails, err.Error())
+	}
+
+	return result, nil
+}
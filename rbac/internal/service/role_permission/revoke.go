@@ -3,8 +3,12 @@ package role_permission
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
 func (s *RolePermissionService) Revoke(ctx context.Context, roleID, permissionID string) error {
@@ -17,5 +21,19 @@ func (s *RolePermissionService) Revoke(ctx context.Context, roleID, permissionID
 		return err
 	}
 
+	s.invalidateCache(ctx, roleID)
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRolePermissionRevoke,
+		TargetType: model.AuditTargetRole,
+		TargetID:   roleID,
+		Before:     &model.AssignRolePermission{RoleID: roleID, PermissionID: permissionID},
+	})
+
+	event := &model.RolePermissionRevoked{RoleID: roleID, PermissionID: permissionID}
+	if err = s.eventProducer.Produce(ctx, model.EventTypePermissionRevokedFromRole, roleID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события PermissionRevokedFromRole", zap.Error(err))
+	}
+
 	return nil
 }
@@ -9,10 +9,21 @@ var _ service.RolePermissionServiceInterface = (*RolePermissionService)(nil)
 
 type RolePermissionService struct {
 	rolePermissionRepo repository.RolePermissionRepository
+	enrichedRoleRepo   repository.EnrichedRoleRepository
+	auditService       service.AuditServiceInterface
+	eventProducer      service.DomainEventProducerService
 }
 
-func NewService(rolePermissionRepo repository.RolePermissionRepository) *RolePermissionService {
+func NewService(
+	rolePermissionRepo repository.RolePermissionRepository,
+	enrichedRoleRepo repository.EnrichedRoleRepository,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+) *RolePermissionService {
 	return &RolePermissionService{
 		rolePermissionRepo: rolePermissionRepo,
+		enrichedRoleRepo:   enrichedRoleRepo,
+		auditService:       auditService,
+		eventProducer:      eventProducer,
 	}
 }
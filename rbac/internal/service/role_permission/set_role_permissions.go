@@ -0,0 +1,47 @@
+package role_permission
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// SetRolePermissions заменяет весь набор прав роли и возвращает внесенные изменения
+func (s *RolePermissionService) SetRolePermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.set_role_permissions")
+	defer span.End()
+
+	diff, err := s.rolePermissionRepo.SetPermissions(ctx, roleID, permissionIDs)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка замены набора прав роли", err)
+		return nil, err
+	}
+
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 {
+		return diff, nil
+	}
+
+	s.invalidateCache(ctx, roleID)
+
+	before := append(append([]string{}, diff.Unchanged...), diff.Removed...)
+	after := append(append([]string{}, diff.Unchanged...), diff.Added...)
+
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionRolePermissionSet,
+		TargetType: model.AuditTargetRole,
+		TargetID:   roleID,
+		Before:     before,
+		After:      after,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeRolePermissionsReplaced, roleID, diff); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события RolePermissionsReplaced", zap.Error(err))
+	}
+
+	return diff, nil
+}
@@ -1,32 +1,42 @@
 package role_permission_test
 
 import (
+	"errors"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
 func (s *ServiceSuite) TestAssignSuccess() {
-	roleID := "role123"
-	permissionID := "permission456"
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+	}
 
-	s.rolePermissionRepository.On("Assign", mock.Anything, roleID, permissionID).Return(nil)
+	s.rolePermissionRepository.On("Assign", mock.Anything, assignment).Return(nil)
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, assignment.RoleID).Return(nil).Once()
 
-	err := s.service.Assign(s.ctx, roleID, permissionID)
+	err := s.service.Assign(s.ctx, assignment)
 
 	assert.NoError(s.T(), err)
 
 	s.rolePermissionRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestAssignAlreadyAssigned() {
-	roleID := "role123"
-	permissionID := "permission456"
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+	}
 
-	s.rolePermissionRepository.On("Assign", mock.Anything, roleID, permissionID).Return(model.ErrPermissionAlreadyAssigned)
+	s.rolePermissionRepository.On("Assign", mock.Anything, assignment).Return(model.ErrPermissionAlreadyAssigned)
 
-	err := s.service.Assign(s.ctx, roleID, permissionID)
+	err := s.service.Assign(s.ctx, assignment)
 
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), model.ErrPermissionAlreadyAssigned, err)
@@ -35,15 +45,64 @@ func (s *ServiceSuite) TestAssignAlreadyAssigned() {
 }
 
 func (s *ServiceSuite) TestAssignRepositoryError() {
-	roleID := "role123"
-	permissionID := "permission456"
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+	}
 
-	s.rolePermissionRepository.On("Assign", mock.Anything, roleID, permissionID).Return(model.ErrInternal)
+	s.rolePermissionRepository.On("Assign", mock.Anything, assignment).Return(model.ErrInternal)
 
-	err := s.service.Assign(s.ctx, roleID, permissionID)
+	err := s.service.Assign(s.ctx, assignment)
 
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), model.ErrInternal, err)
 
 	s.rolePermissionRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestAssignDenySuccess() {
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+		Effect:       authz.EffectDeny,
+	}
+
+	s.rolePermissionRepository.On("Assign", mock.Anything, assignment).Return(nil)
+
+	err := s.service.Assign(s.ctx, assignment)
+
+	assert.NoError(s.T(), err)
+
+	s.rolePermissionRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestAssignWithConditionSuccess() {
+	expression := `resource.teacher_id == subject.id`
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+		Condition:    &expression,
+	}
+
+	s.rolePermissionRepository.On("Assign", mock.Anything, assignment).Return(nil)
+
+	err := s.service.Assign(s.ctx, assignment)
+
+	assert.NoError(s.T(), err)
+
+	s.rolePermissionRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestAssignInvalidCondition() {
+	expression := `resource.teacher_id = subject.id`
+	assignment := &model.AssignRolePermission{
+		RoleID:       "role123",
+		PermissionID: "permission456",
+		Condition:    &expression,
+	}
+
+	err := s.service.Assign(s.ctx, assignment)
+
+	assert.Error(s.T(), err)
+	assert.True(s.T(), errors.Is(err, model.ErrInvalidCondition))
+}
@@ -0,0 +1,52 @@
+package role_permission_test
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestEvaluateConditionTrue() {
+	attrs := condition.NewAttributes(
+		map[string]any{"id": "teacher-1", "subject_ids": []any{"math"}},
+		map[string]any{"subject_id": "math"},
+		nil,
+	)
+
+	result, err := s.service.EvaluateCondition(s.ctx, `resource.subject_id in subject.subject_ids`, attrs)
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), result)
+}
+
+func (s *ServiceSuite) TestEvaluateConditionFalse() {
+	attrs := condition.NewAttributes(
+		map[string]any{"id": "teacher-1"},
+		map[string]any{"teacher_id": "teacher-2"},
+		nil,
+	)
+
+	result, err := s.service.EvaluateCondition(s.ctx, `resource.teacher_id == subject.id`, attrs)
+
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), result)
+}
+
+func (s *ServiceSuite) TestEvaluateConditionInvalidSyntax() {
+	_, err := s.service.EvaluateCondition(s.ctx, `resource.teacher_id ==`, condition.Attributes{})
+
+	assert.Error(s.T(), err)
+	assert.True(s.T(), errors.Is(err, model.ErrInvalidCondition))
+}
+
+func (s *ServiceSuite) TestEvaluateConditionNonBoolean() {
+	attrs := condition.NewAttributes(nil, map[string]any{"grade": 9}, nil)
+
+	_, err := s.service.EvaluateCondition(s.ctx, `resource.grade`, attrs)
+
+	assert.Error(s.T(), err)
+	assert.True(s.T(), errors.Is(err, model.ErrInvalidCondition))
+}
@@ -47,3 +47,49 @@ func (s *ServiceSuite) TestRevokeRepositoryError() {
 
 	s.rolePermissionRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestRevokePublishesEvent() {
+	roleID := "role123"
+	permissionID := "permission456"
+
+	s.rolePermissionRepository.On("Revoke", mock.Anything, roleID, permissionID).Return(nil)
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypePermissionRevokedFromRole, roleID,
+		&model.RolePermissionRevoked{RoleID: roleID, PermissionID: permissionID}).Return(nil).Once()
+
+	err := s.service.Revoke(s.ctx, roleID, permissionID)
+
+	assert.NoError(s.T(), err)
+
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRevokeInvalidatesRoleCache() {
+	roleID := "role123"
+	permissionID := "permission456"
+
+	s.rolePermissionRepository.On("Revoke", mock.Anything, roleID, permissionID).Return(nil)
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID).Return(nil).Once()
+
+	err := s.service.Revoke(s.ctx, roleID, permissionID)
+
+	assert.NoError(s.T(), err)
+
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRevokeInvalidateErrorDoesNotFail() {
+	roleID := "role123"
+	permissionID := "permission456"
+
+	s.rolePermissionRepository.On("Revoke", mock.Anything, roleID, permissionID).Return(nil)
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID).Return(assert.AnError).Once()
+
+	err := s.service.Revoke(s.ctx, roleID, permissionID)
+
+	assert.NoError(s.T(), err)
+
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+}
@@ -0,0 +1,77 @@
+package role_permission_test
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestSetRolePermissionsSuccess() {
+	roleID := uuid.New().String()
+	kept, added, removed := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	permissionIDs := []string{kept, added}
+	diff := &model.RolePermissionsDiff{
+		RoleID:    roleID,
+		Added:     []string{added},
+		Removed:   []string{removed},
+		Unchanged: []string{kept},
+	}
+
+	s.rolePermissionRepository.On("SetPermissions", mock.Anything, roleID, permissionIDs).Return(diff, nil).Once()
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, roleID).Return(nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionRolePermissionSet &&
+			record.TargetID == roleID &&
+			assert.ObjectsAreEqual([]string{kept, removed}, record.Before) &&
+			assert.ObjectsAreEqual([]string{kept, added}, record.After)
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeRolePermissionsReplaced, roleID, diff).Return(nil).Once()
+
+	result, err := s.service.SetRolePermissions(s.ctx, roleID, permissionIDs)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), diff, result)
+
+	s.rolePermissionRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSetRolePermissionsNoChanges() {
+	roleID := uuid.New().String()
+	permissionIDs := []string{uuid.New().String()}
+	diff := &model.RolePermissionsDiff{RoleID: roleID, Added: []string{}, Removed: []string{}, Unchanged: permissionIDs}
+
+	s.rolePermissionRepository.On("SetPermissions", mock.Anything, roleID, permissionIDs).Return(diff, nil).Once()
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	result, err := s.service.SetRolePermissions(s.ctx, roleID, permissionIDs)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), diff, result)
+
+	s.rolePermissionRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSetRolePermissionsUnknownPermission() {
+	roleID := uuid.New().String()
+	permissionIDs := []string{uuid.New().String()}
+
+	s.rolePermissionRepository.On("SetPermissions", mock.Anything, roleID, permissionIDs).Return(nil, model.ErrPermissionNotFound).Once()
+	s.eventProducer.ExpectedCalls = nil
+
+	result, err := s.service.SetRolePermissions(s.ctx, roleID, permissionIDs)
+
+	assert.ErrorIs(s.T(), err, model.ErrPermissionNotFound)
+	assert.Nil(s.T(), result)
+
+	s.rolePermissionRepository.AssertExpectations(s.T())
+}
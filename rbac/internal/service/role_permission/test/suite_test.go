@@ -4,10 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role_permission"
 )
 
@@ -16,6 +18,9 @@ type ServiceSuite struct {
 	ctx context.Context // nolint:containedctx
 
 	rolePermissionRepository *mocks.RolePermissionRepository
+	enrichedRoleRepository   *mocks.EnrichedRoleRepository
+	auditService             *serviceMocks.AuditServiceInterface
+	eventProducer            *serviceMocks.DomainEventProducerService
 
 	service *role_permission.RolePermissionService
 }
@@ -28,12 +33,28 @@ func (s *ServiceSuite) SetupSuite() {
 	}
 
 	s.rolePermissionRepository = mocks.NewRolePermissionRepository(s.T())
+	s.enrichedRoleRepository = mocks.NewEnrichedRoleRepository(s.T())
 
-	s.service = role_permission.NewService(s.rolePermissionRepository)
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.service = role_permission.NewService(s.rolePermissionRepository, s.enrichedRoleRepository, s.auditService, s.eventProducer)
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.rolePermissionRepository.ExpectedCalls = nil
+	s.enrichedRoleRepository.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+
+	// Сброс кэша роли проверяется в отдельных тестах
+	s.enrichedRoleRepository.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 }
 
 func (s *ServiceSuite) TearDownTest() {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/authz/condition"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
@@ -13,7 +14,10 @@ type RoleServiceInterface interface {
 	Get(ctx context.Context, id string) (*model.EnrichedRole, error)
 	Update(ctx context.Context, updateRole *model.UpdateRole) error
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context) ([]*model.Role, error)
+	Restore(ctx context.Context, id string) error
+	List(ctx context.Context, filter *model.RoleFilter) ([]*model.RoleListItem, *string, error)
+	FlushCache(ctx context.Context, roleIDs []string) (int, error)
+	WarmCache(ctx context.Context, roleIDs []string) (int, error)
 }
 
 type PermissionServiceInterface interface {
@@ -21,17 +25,91 @@ type PermissionServiceInterface interface {
 }
 
 type UserRoleServiceInterface interface {
-	Assign(ctx context.Context, userID, roleID string, assignedBy *string) error
+	Assign(ctx context.Context, assignment *model.AssignUserRole) error
+	BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error)
 	Revoke(ctx context.Context, userID, roleID string) error
 	GetUserRoles(ctx context.Context, userID string) ([]*model.EnrichedRole, error)
 	GetRoleUsers(ctx context.Context, roleID string, limit int32, cursor string) ([]string, *string, error)
+	GetRoleMembers(ctx context.Context, roleID string, limit int32, cursor string) ([]*model.RoleMember, *string, error)
 }
 
 type RolePermissionServiceInterface interface {
-	Assign(ctx context.Context, roleID, permissionID string) error
+	Assign(ctx context.Context, assignment *model.AssignRolePermission) error
 	Revoke(ctx context.Context, roleID, permissionID string) error
+	SetRolePermissions(ctx context.Context, roleID string, permissionIDs []string) (*model.RolePermissionsDiff, error)
+	EvaluateCondition(ctx context.Context, expression string, attrs condition.Attributes) (bool, error)
+}
+
+// PolicyServiceInterface выгрузка и применение политики RBAC как кода
+type PolicyServiceInterface interface {
+	Export(ctx context.Context) (*model.PolicyDocument, error)
+	// Plan рассчитывает изменения без записи; prune удаляет роли, отсутствующие в документе
+	Plan(ctx context.Context, document *model.PolicyDocument, prune bool) (*model.PolicyPlan, error)
+	Apply(ctx context.Context, document *model.PolicyDocument, prune bool) (*model.PolicyPlan, error)
+}
+
+// AccessRequestServiceInterface заявки на роли, требующие согласования
+type AccessRequestServiceInterface interface {
+	Create(ctx context.Context, request *model.CreateAccessRequest) (*model.AccessRequest, error)
+	Get(ctx context.Context, id string) (*model.AccessRequest, error)
+	List(ctx context.Context, filter *model.AccessRequestFilter) ([]*model.AccessRequest, *string, error)
+	// Approve согласует заявку и назначает роль пользователю
+	Approve(ctx context.Context, id string, comment *string) (*model.AccessRequest, error)
+	Reject(ctx context.Context, id string, comment *string) (*model.AccessRequest, error)
+}
+
+// RoleConstraintServiceInterface ограничения разделения обязанностей между ролями
+type RoleConstraintServiceInterface interface {
+	// Create возвращает созданное ограничение и уже существующие нарушения: роли у пользователей не отзываются
+	Create(ctx context.Context, constraint *model.CreateRoleConstraint) (*model.RoleConstraint, []*model.ConstraintViolation, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*model.RoleConstraint, error)
+	ListViolations(ctx context.Context, constraintID *string) ([]*model.ConstraintViolation, error)
+}
+
+// AccessReviewServiceInterface кампании периодического пересмотра доступа
+type AccessReviewServiceInterface interface {
+	// Create фиксирует текущих участников ролей кампании
+	Create(ctx context.Context, campaign *model.CreateAccessReview) (*model.AccessReviewCampaign, error)
+	Get(ctx context.Context, id string) (*model.AccessReviewCampaign, error)
+	List(ctx context.Context, filter *model.AccessReviewFilter) ([]*model.AccessReviewCampaign, *string, error)
+	ListItems(ctx context.Context, filter *model.AccessReviewItemFilter) ([]*model.AccessReviewItem, *string, error)
+	Decide(ctx context.Context, decision *model.AccessReviewItemDecision) (*model.AccessReviewItem, error)
+	// Close закрывает кампанию и отзывает роли; повторный вызов повторяет неудавшиеся отзывы
+	Close(ctx context.Context, id string, revokeUnreviewed bool) (*model.AccessReviewCampaign, error)
+	Report(ctx context.Context, id string) (*model.AccessReviewReport, error)
+}
+
+// AccessExplainServiceInterface диагностика решений по правам пользователя
+type AccessExplainServiceInterface interface {
+	Explain(ctx context.Context, check *model.AccessCheck) (*model.AccessExplanation, error)
+	// Simulate вычисляет решение с гипотетическими изменениями ролей, не применяя их
+	Simulate(ctx context.Context, check *model.AccessCheck, changes *model.RoleChanges) (*model.AccessSimulation, error)
 }
 
 type UserConsumerService interface {
 	Run(ctx context.Context) error
 }
+
+type DomainEventProducerService interface {
+	// Produce оборачивает payload в конверт DomainEvent и публикует его с ключом key (ID роли или пользователя)
+	Produce(ctx context.Context, eventType, key string, payload any) error
+}
+
+type UserRoleExpiryService interface {
+	Run(ctx context.Context) error
+}
+
+type AccessRequestExpiryService interface {
+	Run(ctx context.Context) error
+}
+
+type AuditServiceInterface interface {
+	// Record записывает изменение в журнал; ошибки записи логируются и не прерывают операцию
+	Record(ctx context.Context, record *model.AuditRecord)
+	List(ctx context.Context, filter *model.AuditEventFilter) ([]*model.AuditEvent, *string, error)
+}
+
+type AuditProducerService interface {
+	ProduceAuditEvent(ctx context.Context, event model.AuditEvent) error
+}
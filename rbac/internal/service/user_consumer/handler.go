@@ -7,6 +7,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka/model"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	rbacModel "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
@@ -22,10 +23,26 @@ func (s *service) UserCreatedHandler(ctx context.Context, msg model.Message) err
 	logger.Info(ctx, "📥 Получено событие UserCreated",
 		zap.String("topic", msg.Topic))
 
-	if err := s.userRoleService.Assign(ctx, event.UserID.String(), event.RoleID, nil); err != nil {
+	// Роль назначается по событию IAM, инициатор в журнале аудита — обработчик событий
+	systemCtx := audit.WithSystemActor(ctx, auditActorName)
+
+	if err := s.userRoleService.Assign(systemCtx, &rbacModel.AssignUserRole{
+		UserID: event.UserID.String(),
+		RoleID: event.RoleID,
+	}); err != nil {
 		logger.Error(ctx, "❌ Ошибка назначения роли", zap.Error(err))
 		return fmt.Errorf("assign role: %w", err)
 	}
 
+	// IAM не ведет собственного журнала: регистрация попадает в общий журнал RBAC.
+	// Регистрация публичная, поэтому инициатор - анонимный пользователь.
+	// Запись после назначения роли, чтобы повторная доставка события не дублировала ее
+	s.auditService.Record(ctx, &rbacModel.AuditRecord{
+		Action:     rbacModel.AuditActionUserRegister,
+		TargetType: rbacModel.AuditTargetUser,
+		TargetID:   event.UserID.String(),
+		After:      event,
+	})
+
 	return nil
 }
@@ -10,20 +10,26 @@ import (
 	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
 )
 
+// auditActorName — имя системного инициатора в журнале аудита
+const auditActorName = "user_consumer"
+
 var _ def.UserConsumerService = (*service)(nil)
 
 type service struct {
 	userCreatedConsumer kafka.Consumer
 	userRoleService     def.UserRoleServiceInterface
+	auditService        def.AuditServiceInterface
 }
 
 func NewService(
 	userCreatedConsumer kafka.Consumer,
 	userRoleService def.UserRoleServiceInterface,
+	auditService def.AuditServiceInterface,
 ) *service {
 	return &service{
 		userCreatedConsumer: userCreatedConsumer,
 		userRoleService:     userRoleService,
+		auditService:        auditService,
 	}
 }
 
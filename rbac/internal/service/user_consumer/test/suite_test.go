@@ -0,0 +1,36 @@
+package user_consumer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	userRoleService *serviceMocks.UserRoleServiceInterface
+	auditService    *serviceMocks.AuditServiceInterface
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.userRoleService = serviceMocks.NewUserRoleServiceInterface(s.T())
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
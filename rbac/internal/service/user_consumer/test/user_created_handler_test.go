@@ -0,0 +1,55 @@
+package user_consumer_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	kafkaModel "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/kafka/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_consumer"
+)
+
+func (s *ServiceSuite) userCreatedMessage(userID uuid.UUID) kafkaModel.Message {
+	payload, err := json.Marshal(model.UserCreated{UserID: userID, Login: "student", RoleID: uuid.NewString()})
+	s.Require().NoError(err)
+	return kafkaModel.Message{Topic: "user_created", Value: payload}
+}
+
+// TestUserCreatedRecordsRegistration проверяет, что регистрация в IAM попадает в журнал аудита
+// с анонимным инициатором, а назначение роли по умолчанию выполняется от имени обработчика событий
+func (s *ServiceSuite) TestUserCreatedRecordsRegistration() {
+	userID := uuid.New()
+
+	s.userRoleService.On("Assign", mock.MatchedBy(func(ctx context.Context) bool {
+		return audit.ActorFromContext(ctx).Type == audit.ActorTypeSystem
+	}), mock.Anything).Return(nil).Once()
+	s.auditService.On("Record", mock.MatchedBy(func(ctx context.Context) bool {
+		return audit.ActorFromContext(ctx).Type == audit.ActorTypeAnonymous
+	}), mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionUserRegister &&
+			record.TargetType == model.AuditTargetUser &&
+			record.TargetID == userID.String()
+	})).Return().Once()
+
+	err := user_consumer.NewService(nil, s.userRoleService, s.auditService).
+		UserCreatedHandler(s.ctx, s.userCreatedMessage(userID))
+
+	assert.NoError(s.T(), err)
+}
+
+// TestUserCreatedAssignFailureSkipsAudit проверяет, что событие, которое будет доставлено повторно,
+// не оставляет записи в журнале
+func (s *ServiceSuite) TestUserCreatedAssignFailureSkipsAudit() {
+	s.userRoleService.On("Assign", mock.Anything, mock.Anything).Return(model.ErrInternal).Once()
+
+	err := user_consumer.NewService(nil, s.userRoleService, s.auditService).
+		UserCreatedHandler(s.ctx, s.userCreatedMessage(uuid.New()))
+
+	assert.Error(s.T(), err)
+	s.auditService.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything)
+}
@@ -3,19 +3,50 @@ package user_role
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
-func (s *UserRoleService) Assign(ctx context.Context, userID, roleID string, assignedBy *string) error {
+func (s *UserRoleService) Assign(ctx context.Context, assignment *model.AssignUserRole) error {
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.assign")
 	defer span.End()
 
-	err := s.userRoleRepo.Assign(ctx, userID, roleID, assignedBy)
+	if err := prepareAssignment(ctx, assignment); err != nil {
+		return err
+	}
+
+	requiresApproval, err := s.requiresApproval(ctx, assignment)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения роли для назначения", err)
+		return err
+	}
+	if requiresApproval {
+		logger.Warn(ctx, "⚠️ [Service] Прямое назначение роли, требующей согласования",
+			zap.String("user_id", assignment.UserID),
+			zap.String("role_id", assignment.RoleID))
+		return model.ErrRoleRequiresApproval
+	}
+
+	err = s.userRoleRepo.Assign(ctx, assignment)
 	if err != nil {
 		errreport.Report(ctx, "❌ [Service] Ошибка назначения роли пользователю", err)
 		return err
 	}
 
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionUserRoleAssign,
+		TargetType: model.AuditTargetUserRole,
+		TargetID:   assignment.UserID,
+		After:      assignment,
+	})
+
+	if err = s.eventProducer.Produce(ctx, model.EventTypeUserRoleAssigned, assignment.UserID, assignment); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события UserRoleAssigned", zap.Error(err))
+	}
+
 	return nil
 }
@@ -0,0 +1,96 @@
+package user_role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// BulkAssign назначает роли пакетом в одной транзакции и возвращает результаты в порядке запроса.
+// По всем успешным назначениям публикуется одно агрегированное событие.
+func (s *UserRoleService) BulkAssign(ctx context.Context, assignments []*model.AssignUserRole) ([]*model.BulkAssignResult, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.bulk_assign")
+	defer span.End()
+
+	results := make([]*model.BulkAssignResult, len(assignments))
+	valid := make([]*model.AssignUserRole, 0, len(assignments))
+	validIdx := make([]int, 0, len(assignments))
+
+	// Признак согласования запрашивается один раз на роль
+	approval := make(map[string]model.BulkAssignStatus)
+
+	for i, assignment := range assignments {
+		if err := prepareAssignment(ctx, assignment); err != nil {
+			results[i] = &model.BulkAssignResult{Assignment: assignment, Status: model.BulkAssignStatusInvalidValidityPeriod}
+			continue
+		}
+
+		status, ok := approval[assignment.RoleID]
+		if !ok {
+			requiresApproval, err := s.requiresApproval(ctx, assignment)
+			switch {
+			case errors.Is(err, model.ErrRoleNotFound):
+				status = model.BulkAssignStatusNotFound
+			case err != nil:
+				errreport.Report(ctx, "❌ [Service] Ошибка получения роли для пакетного назначения", err)
+				return nil, err
+			case requiresApproval:
+				status = model.BulkAssignStatusRequiresApproval
+			}
+			approval[assignment.RoleID] = status
+		}
+		if status != 0 {
+			results[i] = &model.BulkAssignResult{Assignment: assignment, Status: status}
+			continue
+		}
+
+		valid = append(valid, assignment)
+		validIdx = append(validIdx, i)
+	}
+
+	if len(valid) > 0 {
+		repoResults, err := s.userRoleRepo.BulkAssign(ctx, valid)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка пакетного назначения ролей", err)
+			return nil, err
+		}
+
+		for i, result := range repoResults {
+			results[validIdx[i]] = result
+		}
+	}
+
+	assigned := make([]*model.AssignUserRole, 0, len(valid))
+	for _, result := range results {
+		if result.Status != model.BulkAssignStatusAssigned {
+			continue
+		}
+		assigned = append(assigned, result.Assignment)
+
+		s.auditService.Record(ctx, &model.AuditRecord{
+			Action:     model.AuditActionUserRoleAssign,
+			TargetType: model.AuditTargetUserRole,
+			TargetID:   result.Assignment.UserID,
+			After:      result.Assignment,
+		})
+	}
+
+	if len(assigned) == 0 {
+		return results, nil
+	}
+
+	batchID := uuid.NewString()
+	event := &model.UserRolesBulkAssigned{BatchID: batchID, Assignments: assigned}
+	if err := s.eventProducer.Produce(ctx, model.EventTypeUserRolesBulkAssigned, batchID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события UserRolesBulkAssigned", zap.Error(err))
+	}
+
+	return results, nil
+}
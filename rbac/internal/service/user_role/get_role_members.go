@@ -0,0 +1,51 @@
+package user_role
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// GetRoleMembers возвращает страницу участников роли с логином и email из IAM.
+// При недоступности IAM участники возвращаются без профилей.
+func (s *UserRoleService) GetRoleMembers(ctx context.Context, roleID string, limit int32, cursor string) ([]*model.RoleMember, *string, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.get_role_members")
+	defer span.End()
+
+	userIDs, nextCursor, err := s.userRoleRepo.GetRoleUsers(ctx, roleID, limit, cursor)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения пользователей роли", err)
+		return nil, nil, err
+	}
+
+	members := make([]*model.RoleMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, &model.RoleMember{UserID: userID})
+	}
+
+	if len(userIDs) == 0 {
+		return members, nextCursor, nil
+	}
+
+	profiles, err := s.iamClient.GetUsers(ctx, userIDs)
+	if err != nil {
+		logger.Warn(ctx, "⚠️ [Service] Не удалось получить профили участников роли из IAM", zap.String("role_id", roleID), zap.Error(err))
+		return members, nextCursor, nil
+	}
+
+	byID := make(map[string]*model.UserProfile, len(profiles))
+	for _, profile := range profiles {
+		byID[profile.ID] = profile
+	}
+
+	for _, member := range members {
+		member.Profile = byID[member.UserID]
+	}
+
+	return members, nextCursor, nil
+}
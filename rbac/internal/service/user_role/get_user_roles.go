@@ -2,13 +2,19 @@ package user_role
 
 import (
 	"context"
-	"sync"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
+// GetUserRoles возвращает действующие роли пользователя с правами.
+// Роли читаются из кэша пачкой; при любом промахе все роли пользователя
+// загружаются из БД одним запросом, а недостающие записи кэшируются.
 func (s *UserRoleService) GetUserRoles(ctx context.Context, userID string) ([]*model.EnrichedRole, error) {
 	ctx, span := tracing.StartSpan(ctx, "rbac.service.get_user_roles")
 	defer span.End()
@@ -19,31 +25,40 @@ func (s *UserRoleService) GetUserRoles(ctx context.Context, userID string) ([]*m
 		return nil, err
 	}
 
-	enrichedRoles := make([]*model.EnrichedRole, len(roleIDs))
-	errors := make([]error, len(roleIDs))
-
-	const maxConcurrency = 10
-	semaphore := make(chan struct{}, maxConcurrency)
+	if len(roleIDs) == 0 {
+		return []*model.EnrichedRole{}, nil
+	}
 
-	var wg sync.WaitGroup
-	for i, roleID := range roleIDs {
-		wg.Add(1)
-		go func(i int, roleID string) {
-			defer wg.Done()
+	// Без версий результат не кэшируется, чтобы не записать устаревшее состояние
+	cached, versions, err := s.enrichedRoleRepo.GetMany(ctx, roleIDs)
+	if err != nil {
+		logger.Warn(ctx, "⚠️ [Service] Не удалось прочитать роли из кэша", zap.Error(err))
+	}
 
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
+	if err == nil && len(cached) == len(roleIDs) {
+		enrichedRoles := make([]*model.EnrichedRole, 0, len(roleIDs))
+		for _, roleID := range roleIDs {
+			enrichedRoles = append(enrichedRoles, cached[roleID])
+		}
+		return enrichedRoles, nil
+	}
 
-			enrichedRoles[i], errors[i] = s.roleService.Get(ctx, roleID)
-		}(i, roleID)
+	enrichedRoles, err := s.userRoleRepo.GetUserEnrichedRoles(ctx, userID)
+	if err != nil {
+		errreport.Report(ctx, "❌ [Service] Ошибка получения ролей пользователя с правами", err)
+		return nil, err
 	}
 
-	wg.Wait()
+	if versions != nil {
+		missing := make([]*model.EnrichedRole, 0, len(enrichedRoles))
+		for _, enrichedRole := range enrichedRoles {
+			if _, hit := cached[enrichedRole.Role.ID.String()]; !hit {
+				missing = append(missing, enrichedRole)
+			}
+		}
 
-	for _, err := range errors {
-		if err != nil {
-			errreport.Report(ctx, "❌ [Service] Ошибка получения обогащенной роли", err)
-			return nil, err
+		if err := s.enrichedRoleRepo.SetMany(ctx, missing, versions, time.Now().Add(s.enrichedRoleTTL)); err != nil {
+			logger.Warn(ctx, "⚠️ [Service] Не удалось кэшировать роли пользователя", zap.Error(err))
 		}
 	}
 
@@ -0,0 +1,31 @@
+package user_role
+
+import (
+	"context"
+	"time"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// prepareAssignment проверяет период действия и заполняет инициатора назначения из сессии
+func prepareAssignment(ctx context.Context, assignment *model.AssignUserRole) error {
+	if assignment.ValidUntil != nil {
+		validFrom := time.Now()
+		if assignment.ValidFrom != nil {
+			validFrom = *assignment.ValidFrom
+		}
+
+		if !assignment.ValidUntil.After(validFrom) {
+			return model.ErrInvalidValidityPeriod
+		}
+	}
+
+	// Инициатор - всегда пользователь сессии: значение из запроса клиента подделало бы журнал аудита.
+	// Без пользователя (обработчик событий, фоновый процесс) остается инициатор, заданный сервисом
+	if actor := audit.ActorFromContext(ctx); actor.Type == audit.ActorTypeUser {
+		assignment.AssignedBy = &actor.ID
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package user_role
+
+import (
+	"context"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// requiresApproval сообщает, что роль нельзя назначить напрямую.
+// Назначения по согласованной заявке (AccessRequestID) проверку проходят.
+func (s *UserRoleService) requiresApproval(ctx context.Context, assignment *model.AssignUserRole) (bool, error) {
+	if assignment.AccessRequestID != nil {
+		return false, nil
+	}
+
+	role, err := s.roleRepo.Get(ctx, assignment.RoleID)
+	if err != nil {
+		return false, err
+	}
+
+	return role.RequiresApproval, nil
+}
@@ -3,8 +3,12 @@ package user_role
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
 func (s *UserRoleService) Revoke(ctx context.Context, userID, roleID string) error {
@@ -17,5 +21,17 @@ func (s *UserRoleService) Revoke(ctx context.Context, userID, roleID string) err
 		return err
 	}
 
+	s.auditService.Record(ctx, &model.AuditRecord{
+		Action:     model.AuditActionUserRoleRevoke,
+		TargetType: model.AuditTargetUserRole,
+		TargetID:   userID,
+		Before:     &model.AssignUserRole{UserID: userID, RoleID: roleID},
+	})
+
+	event := &model.UserRoleRevoked{UserID: userID, RoleID: roleID, Reason: model.RevokeReasonManual}
+	if err = s.eventProducer.Produce(ctx, model.EventTypeUserRoleRevoked, userID, event); err != nil {
+		logger.Error(ctx, "❌ [Service] Ошибка публикации события UserRoleRevoked", zap.Error(err))
+	}
+
 	return nil
 }
@@ -1,6 +1,9 @@
 package user_role
 
 import (
+	"time"
+
+	grpcClient "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
 )
@@ -8,13 +11,31 @@ import (
 var _ service.UserRoleServiceInterface = (*UserRoleService)(nil)
 
 type UserRoleService struct {
-	userRoleRepo repository.UserRoleRepository
-	roleService  service.RoleServiceInterface
+	userRoleRepo     repository.UserRoleRepository
+	roleRepo         repository.RoleRepository
+	enrichedRoleRepo repository.EnrichedRoleRepository
+	enrichedRoleTTL  time.Duration
+	auditService     service.AuditServiceInterface
+	eventProducer    service.DomainEventProducerService
+	iamClient        grpcClient.IAMClient
 }
 
-func NewService(userRoleRepo repository.UserRoleRepository, roleService service.RoleServiceInterface) *UserRoleService {
+func NewService(
+	userRoleRepo repository.UserRoleRepository,
+	roleRepo repository.RoleRepository,
+	enrichedRoleRepo repository.EnrichedRoleRepository,
+	enrichedRoleTTL time.Duration,
+	auditService service.AuditServiceInterface,
+	eventProducer service.DomainEventProducerService,
+	iamClient grpcClient.IAMClient,
+) *UserRoleService {
 	return &UserRoleService{
-		userRoleRepo: userRoleRepo,
-		roleService:  roleService,
+		userRoleRepo:     userRoleRepo,
+		roleRepo:         roleRepo,
+		enrichedRoleRepo: enrichedRoleRepo,
+		enrichedRoleTTL:  enrichedRoleTTL,
+		auditService:     auditService,
+		eventProducer:    eventProducer,
+		iamClient:        iamClient,
 	}
 }
@@ -1,9 +1,15 @@
 package user_role_test
 
 import (
+	"context"
+	"time"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
@@ -12,9 +18,9 @@ func (s *ServiceSuite) TestAssignSuccess() {
 	roleID := "role456"
 	assignedBy := "admin123"
 
-	s.userRoleRepository.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(nil)
+	s.userRoleRepository.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy}).Return(nil)
 
-	err := s.service.Assign(s.ctx, userID, roleID, &assignedBy)
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy})
 
 	assert.NoError(s.T(), err)
 
@@ -25,9 +31,9 @@ func (s *ServiceSuite) TestAssignSuccessWithoutAssignedBy() {
 	userID := "user123"
 	roleID := "role456"
 
-	s.userRoleRepository.On("Assign", mock.Anything, userID, roleID, (*string)(nil)).Return(nil)
+	s.userRoleRepository.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID}).Return(nil)
 
-	err := s.service.Assign(s.ctx, userID, roleID, nil)
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{UserID: userID, RoleID: roleID})
 
 	assert.NoError(s.T(), err)
 
@@ -39,9 +45,9 @@ func (s *ServiceSuite) TestAssignAlreadyAssigned() {
 	roleID := "role456"
 	assignedBy := "admin123"
 
-	s.userRoleRepository.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrRoleAlreadyAssigned)
+	s.userRoleRepository.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy}).Return(model.ErrRoleAlreadyAssigned)
 
-	err := s.service.Assign(s.ctx, userID, roleID, &assignedBy)
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy})
 
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), model.ErrRoleAlreadyAssigned, err)
@@ -54,12 +60,122 @@ func (s *ServiceSuite) TestAssignRepositoryError() {
 	roleID := "role456"
 	assignedBy := "admin123"
 
-	s.userRoleRepository.On("Assign", mock.Anything, userID, roleID, &assignedBy).Return(model.ErrInternal)
+	s.userRoleRepository.On("Assign", mock.Anything, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy}).Return(model.ErrInternal)
 
-	err := s.service.Assign(s.ctx, userID, roleID, &assignedBy)
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &assignedBy})
 
 	assert.Error(s.T(), err)
 	assert.Equal(s.T(), model.ErrInternal, err)
 
 	s.userRoleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestAssignWithValidityPeriod() {
+	userID := "user123"
+	roleID := "role456"
+	validFrom := time.Now().Add(time.Hour)
+	validUntil := validFrom.Add(7 * 24 * time.Hour)
+
+	assignment := &model.AssignUserRole{
+		UserID:     userID,
+		RoleID:     roleID,
+		ValidFrom:  &validFrom,
+		ValidUntil: &validUntil,
+	}
+
+	s.userRoleRepository.On("Assign", mock.Anything, assignment).Return(nil)
+
+	err := s.service.Assign(s.ctx, assignment)
+
+	assert.NoError(s.T(), err)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestAssignValidUntilBeforeValidFrom() {
+	validFrom := time.Now().Add(time.Hour)
+	validUntil := validFrom.Add(-time.Minute)
+
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{
+		UserID:     "user123",
+		RoleID:     "role456",
+		ValidFrom:  &validFrom,
+		ValidUntil: &validUntil,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidValidityPeriod)
+}
+
+func (s *ServiceSuite) TestAssignValidUntilInPast() {
+	validUntil := time.Now().Add(-time.Hour)
+
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{
+		UserID:     "user123",
+		RoleID:     "role456",
+		ValidUntil: &validUntil,
+	})
+
+	assert.ErrorIs(s.T(), err, model.ErrInvalidValidityPeriod)
+}
+
+// TestAssignTakesAssignedByFromSession проверяет, что инициатор из запроса заменяется пользователем сессии
+func (s *ServiceSuite) TestAssignTakesAssignedByFromSession() {
+	userID := "user123"
+	roleID := "role456"
+	adminID := "admin123"
+	forgedID := "forged123"
+
+	s.userRoleRepository.On("Assign", mock.Anything, mock.MatchedBy(func(assignment *model.AssignUserRole) bool {
+		return assignment.AssignedBy != nil && *assignment.AssignedBy == adminID
+	})).Return(nil)
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionUserRoleAssign && record.TargetID == userID
+	})).Return().Once()
+
+	// Контекст запроса, прошедшего через Envoy
+	md := metadata.New(map[string]string{
+		interceptor.HeaderUserID:    adminID,
+		interceptor.HeaderSessionID: "session123",
+	})
+
+	var err error
+	_, _ = interceptor.IdentityInterceptor()(
+		metadata.NewIncomingContext(s.ctx, md), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, _ any) (any, error) {
+			err = s.service.Assign(ctx, &model.AssignUserRole{UserID: userID, RoleID: roleID, AssignedBy: &forgedID})
+			return nil, err
+		},
+	)
+
+	assert.NoError(s.T(), err)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestAssignRequiresApproval() {
+	roleID := "role456"
+
+	s.roleRepository.ExpectedCalls = nil
+	s.roleRepository.On("Get", mock.Anything, roleID).Return(&model.Role{RequiresApproval: true}, nil).Once()
+
+	err := s.service.Assign(s.ctx, &model.AssignUserRole{UserID: "user123", RoleID: roleID})
+
+	assert.ErrorIs(s.T(), err, model.ErrRoleRequiresApproval)
+	s.userRoleRepository.AssertNotCalled(s.T(), "Assign", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAssignByAccessRequestSkipsApprovalCheck() {
+	requestID := "request789"
+	assignment := &model.AssignUserRole{UserID: "user123", RoleID: "role456", AccessRequestID: &requestID}
+
+	s.roleRepository.ExpectedCalls = nil
+	s.userRoleRepository.On("Assign", mock.Anything, assignment).Return(nil).Once()
+
+	err := s.service.Assign(s.ctx, assignment)
+
+	assert.NoError(s.T(), err)
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.roleRepository.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,100 @@
+package user_role_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestBulkAssignMixedResults() {
+	roleID := uuid.New().String()
+	past := time.Now().Add(-time.Hour)
+
+	first := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: roleID}
+	invalid := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: roleID, ValidUntil: &past}
+	duplicate := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: roleID}
+
+	s.userRoleRepository.On("BulkAssign", mock.Anything, []*model.AssignUserRole{first, duplicate}).Return([]*model.BulkAssignResult{
+		{Assignment: first, Status: model.BulkAssignStatusAssigned},
+		{Assignment: duplicate, Status: model.BulkAssignStatusAlreadyAssigned},
+	}, nil).Once()
+	s.auditService.ExpectedCalls = nil
+	s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+		return record.Action == model.AuditActionUserRoleAssign && record.TargetID == first.UserID
+	})).Return().Once()
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeUserRolesBulkAssigned, mock.Anything, mock.MatchedBy(func(event *model.UserRolesBulkAssigned) bool {
+		return event.BatchID != "" && len(event.Assignments) == 1 && event.Assignments[0] == first
+	})).Return(nil).Once()
+
+	results, err := s.service.BulkAssign(s.ctx, []*model.AssignUserRole{first, invalid, duplicate})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 3)
+	assert.Equal(s.T(), model.BulkAssignStatusAssigned, results[0].Status)
+	assert.Equal(s.T(), model.BulkAssignStatusInvalidValidityPeriod, results[1].Status)
+	assert.Same(s.T(), invalid, results[1].Assignment)
+	assert.Equal(s.T(), model.BulkAssignStatusAlreadyAssigned, results[2].Status)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestBulkAssignNothingAssignedSkipsEvent() {
+	past := time.Now().Add(-time.Hour)
+	invalid := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: uuid.New().String(), ValidUntil: &past}
+
+	s.eventProducer.ExpectedCalls = nil
+
+	results, err := s.service.BulkAssign(s.ctx, []*model.AssignUserRole{invalid})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 1)
+	assert.Equal(s.T(), model.BulkAssignStatusInvalidValidityPeriod, results[0].Status)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestBulkAssignRepositoryError() {
+	assignment := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: uuid.New().String()}
+
+	s.userRoleRepository.On("BulkAssign", mock.Anything, []*model.AssignUserRole{assignment}).Return(nil, model.ErrInternal).Once()
+	s.eventProducer.ExpectedCalls = nil
+
+	results, err := s.service.BulkAssign(s.ctx, []*model.AssignUserRole{assignment})
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Nil(s.T(), results)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestBulkAssignRequiresApproval() {
+	guardedRoleID := uuid.New().String()
+	plainRoleID := uuid.New().String()
+
+	guarded := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: guardedRoleID}
+	plain := &model.AssignUserRole{UserID: uuid.New().String(), RoleID: plainRoleID}
+
+	s.roleRepository.ExpectedCalls = nil
+	s.roleRepository.On("Get", mock.Anything, guardedRoleID).Return(&model.Role{RequiresApproval: true}, nil).Once()
+	s.roleRepository.On("Get", mock.Anything, plainRoleID).Return(&model.Role{}, nil).Once()
+	s.userRoleRepository.On("BulkAssign", mock.Anything, []*model.AssignUserRole{plain}).Return([]*model.BulkAssignResult{
+		{Assignment: plain, Status: model.BulkAssignStatusAssigned},
+	}, nil).Once()
+
+	results, err := s.service.BulkAssign(s.ctx, []*model.AssignUserRole{guarded, plain})
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), results, 2)
+	assert.Equal(s.T(), model.BulkAssignStatusRequiresApproval, results[0].Status)
+	assert.Equal(s.T(), model.BulkAssignStatusAssigned, results[1].Status)
+
+	s.roleRepository.AssertExpectations(s.T())
+	s.userRoleRepository.AssertExpectations(s.T())
+}
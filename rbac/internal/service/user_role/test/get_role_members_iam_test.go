@@ -0,0 +1,93 @@
+package user_role_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	grpcclient "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/client"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/interceptor"
+	grpcserver "github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/grpc/server"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc/iam"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role"
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	userV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1"
+)
+
+const (
+	rbacPeerID    = "spiffe://school.local/ns/default/sa/rbac"
+	rbacPeerToken = "rbac-service-token"
+)
+
+// plaintextTLS транспорт без TLS, как в конфигурациях k8s
+type plaintextTLS struct{}
+
+func (plaintextTLS) Enabled() bool                 { return false }
+func (plaintextTLS) CertFile() string              { return "" }
+func (plaintextTLS) KeyFile() string               { return "" }
+func (plaintextTLS) CAFile() string                { return "" }
+func (plaintextTLS) RequireClientCert() bool       { return false }
+func (plaintextTLS) AllowedPeerIDs() []string      { return nil }
+func (plaintextTLS) ServerName() string            { return "" }
+func (plaintextTLS) ReloadInterval() time.Duration { return 0 }
+
+// userService IAM, отдающий профиль по каждому запрошенному ID
+type userService struct {
+	userV1.UnimplementedUserServiceServer
+}
+
+func (userService) GetUsers(_ context.Context, req *userV1.GetUsersRequest) (*userV1.GetUsersResponse, error) {
+	users := make([]*commonV1.User, 0, len(req.GetUserIds()))
+	for _, id := range req.GetUserIds() {
+		users = append(users, &commonV1.User{Id: id, Info: &commonV1.UserInfo{Login: "login-" + id[:8]}})
+	}
+	return &userV1.GetUsersResponse{Users: users}, nil
+}
+
+// startIAM запускает IAM-сервер с проверкой доступа, как в iam/internal/app, и без mTLS
+func (s *ServiceSuite) startIAM() string {
+	srv, err := grpcserver.New(s.ctx, 5*time.Second, 4<<20, 4<<20, plaintextTLS{},
+		grpcserver.AccessInterceptors(
+			interceptor.WithTrustedPeers(map[string][]string{rbacPeerID: {"user:read"}}),
+			interceptor.WithServiceTokens(map[string]string{rbacPeerToken: rbacPeerID}),
+		)...,
+	)
+	s.Require().NoError(err)
+	userV1.RegisterUserServiceServer(srv, userService{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	go func() { _ = srv.Serve(listener) }()
+	s.T().Cleanup(srv.Stop)
+
+	return listener.Addr().String()
+}
+
+// TestGetRoleMembersProfilesThroughIAM проверяет, что при выключенном mTLS участники роли
+// получают профили из IAM: RBAC подтверждает себя токеном сервиса
+func (s *ServiceSuite) TestGetRoleMembersProfilesThroughIAM() {
+	conn, err := grpcclient.NewClient(s.startIAM(), plaintextTLS{}, 4<<20, 4<<20, 5*time.Second,
+		grpcclient.ServiceTokenUnaryClientInterceptor(rbacPeerToken))
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	service := user_role.NewService(s.userRoleRepository, s.roleRepository, s.enrichedRoleRepo, time.Hour,
+		s.auditService, s.eventProducer, iam.NewClient(userV1.NewUserServiceClient(conn)))
+
+	roleID := uuid.New().String()
+	userIDs := []string{uuid.New().String(), uuid.New().String()}
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(10), "").Return(userIDs, nil, nil).Once()
+
+	members, _, err := service.GetRoleMembers(s.ctx, roleID, 10, "")
+
+	s.Require().NoError(err)
+	s.Require().Len(members, 2)
+	for _, member := range members {
+		s.Require().NotNil(member.Profile, member.UserID)
+		assert.Equal(s.T(), "login-"+member.UserID[:8], member.Profile.Login)
+	}
+}
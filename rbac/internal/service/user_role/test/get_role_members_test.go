@@ -0,0 +1,94 @@
+package user_role_test
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestGetRoleMembersSuccess() {
+	roleID := uuid.New().String()
+	userIDs := []string{uuid.New().String(), uuid.New().String()}
+	nextCursor := userIDs[1]
+
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(2), "").Return(userIDs, &nextCursor, nil).Once()
+	s.iamClient.On("GetUsers", mock.Anything, userIDs).Return([]*model.UserProfile{
+		{ID: userIDs[1], Login: "second", Email: "second@example.com"},
+		{ID: userIDs[0], Login: "first", Email: "first@example.com"},
+	}, nil).Once()
+
+	members, cursor, err := s.service.GetRoleMembers(s.ctx, roleID, 2, "")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), &nextCursor, cursor)
+	assert.Len(s.T(), members, 2)
+	assert.Equal(s.T(), userIDs[0], members[0].UserID)
+	assert.Equal(s.T(), "first", members[0].Profile.Login)
+	assert.Equal(s.T(), "second@example.com", members[1].Profile.Email)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.iamClient.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetRoleMembersUnknownUser() {
+	roleID := uuid.New().String()
+	userIDs := []string{uuid.New().String(), uuid.New().String()}
+
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(10), "").Return(userIDs, nil, nil).Once()
+	s.iamClient.On("GetUsers", mock.Anything, userIDs).Return([]*model.UserProfile{
+		{ID: userIDs[0], Login: "first", Email: "first@example.com"},
+	}, nil).Once()
+
+	members, _, err := s.service.GetRoleMembers(s.ctx, roleID, 10, "")
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), members, 2)
+	assert.NotNil(s.T(), members[0].Profile)
+	assert.Nil(s.T(), members[1].Profile)
+}
+
+func (s *ServiceSuite) TestGetRoleMembersIAMUnavailable() {
+	roleID := uuid.New().String()
+	userIDs := []string{uuid.New().String()}
+
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(10), "").Return(userIDs, nil, nil).Once()
+	s.iamClient.On("GetUsers", mock.Anything, userIDs).Return(nil, errors.New("unavailable")).Once()
+
+	members, _, err := s.service.GetRoleMembers(s.ctx, roleID, 10, "")
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), members, 1)
+	assert.Equal(s.T(), userIDs[0], members[0].UserID)
+	assert.Nil(s.T(), members[0].Profile)
+
+	s.iamClient.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetRoleMembersEmptyPageSkipsIAM() {
+	roleID := uuid.New().String()
+
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(10), "").Return([]string{}, nil, nil).Once()
+
+	members, cursor, err := s.service.GetRoleMembers(s.ctx, roleID, 10, "")
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), members)
+	assert.Nil(s.T(), cursor)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetRoleMembersRepositoryError() {
+	roleID := uuid.New().String()
+
+	s.userRoleRepository.On("GetRoleUsers", mock.Anything, roleID, int32(10), "").Return(nil, nil, model.ErrInternal).Once()
+
+	members, _, err := s.service.GetRoleMembers(s.ctx, roleID, 10, "")
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Nil(s.T(), members)
+}
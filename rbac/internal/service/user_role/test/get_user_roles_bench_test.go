@@ -0,0 +1,183 @@
+package user_role_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/role"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role"
+)
+
+// benchRoundTrip имитирует сетевую задержку одного обращения к Postgres или Redis
+const benchRoundTrip = 200 * time.Microsecond
+
+const benchRolesPerUser = 20
+
+type benchFixture struct {
+	userID     string
+	roleIDs    []string
+	roles      []*model.EnrichedRole
+	byID       map[string]*model.EnrichedRole
+	roundTrips atomic.Int64
+}
+
+func newBenchFixture() *benchFixture {
+	f := &benchFixture{
+		userID: "user123",
+		byID:   make(map[string]*model.EnrichedRole, benchRolesPerUser),
+	}
+	for i := range benchRolesPerUser {
+		enrichedRole := newEnrichedRole(fmt.Sprintf("role-%d", i))
+		enrichedRole.Permissions = []*model.Permission{{Resource: "schedule", Action: "read"}}
+
+		f.roles = append(f.roles, enrichedRole)
+		f.roleIDs = append(f.roleIDs, enrichedRole.Role.ID.String())
+		f.byID[enrichedRole.Role.ID.String()] = enrichedRole
+	}
+	return f
+}
+
+func (f *benchFixture) roundTrip(mock.Arguments) {
+	f.roundTrips.Add(1)
+	time.Sleep(benchRoundTrip)
+}
+
+func (f *benchFixture) report(b *testing.B) {
+	b.ReportMetric(float64(f.roundTrips.Load())/float64(b.N), "roundtrips/op")
+}
+
+func (f *benchFixture) versions() map[string]int64 {
+	versions := make(map[string]int64, len(f.roleIDs))
+	for _, id := range f.roleIDs {
+		versions[id] = 0
+	}
+	return versions
+}
+
+// BenchmarkGetUserRoles измеряет пакетное чтение ролей: MGET из кэша и один JOIN-запрос при промахе
+func BenchmarkGetUserRoles(b *testing.B) {
+	if err := logger.InitDefault(); err != nil {
+		b.Fatal(err)
+	}
+
+	for _, warm := range []bool{false, true} {
+		b.Run(map[bool]string{false: "cold", true: "warm"}[warm], func(b *testing.B) {
+			f := newBenchFixture()
+			userRoleRepo := repositoryMocks.NewUserRoleRepository(b)
+			enrichedRoleRepo := repositoryMocks.NewEnrichedRoleRepository(b)
+
+			cached := map[string]*model.EnrichedRole{}
+			if warm {
+				cached = f.byID
+			}
+
+			userRoleRepo.On("GetUserRoles", mock.Anything, f.userID).Run(f.roundTrip).Return(f.roleIDs, nil)
+			userRoleRepo.On("GetUserEnrichedRoles", mock.Anything, f.userID).Run(f.roundTrip).Return(f.roles, nil).Maybe()
+			// GetMany — два запроса MGET: версии и данные
+			enrichedRoleRepo.On("GetMany", mock.Anything, f.roleIDs).Run(func(args mock.Arguments) {
+				f.roundTrip(args)
+				f.roundTrip(args)
+			}).Return(cached, f.versions(), nil)
+			enrichedRoleRepo.On("SetMany", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(f.roundTrip).Return(nil).Maybe()
+
+			service := user_role.NewService(userRoleRepo, nil, enrichedRoleRepo, time.Hour, nil, nil, nil)
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for range b.N {
+				if _, err := service.GetUserRoles(ctx, f.userID); err != nil {
+					b.Fatal(err)
+				}
+			}
+			f.report(b)
+		})
+	}
+}
+
+// BenchmarkGetUserRolesPerRoleFanOut воспроизводит прежнюю схему для сравнения:
+// горутина на каждую роль (не более 10 одновременно) и RoleService.Get с двумя запросами к Postgres при промахе
+func BenchmarkGetUserRolesPerRoleFanOut(b *testing.B) {
+	if err := logger.InitDefault(); err != nil {
+		b.Fatal(err)
+	}
+
+	for _, warm := range []bool{false, true} {
+		b.Run(map[bool]string{false: "cold", true: "warm"}[warm], func(b *testing.B) {
+			f := newBenchFixture()
+			userRoleRepo := repositoryMocks.NewUserRoleRepository(b)
+			roleRepo := repositoryMocks.NewRoleRepository(b)
+			rolePermissionRepo := repositoryMocks.NewRolePermissionRepository(b)
+			enrichedRoleRepo := repositoryMocks.NewEnrichedRoleRepository(b)
+
+			userRoleRepo.On("GetUserRoles", mock.Anything, f.userID).Run(f.roundTrip).Return(f.roleIDs, nil)
+			enrichedRoleRepo.On("GetVersion", mock.Anything, mock.Anything).Run(f.roundTrip).Return(int64(0), nil)
+			enrichedRoleRepo.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(f.roundTrip).Return(nil).Maybe()
+			for _, enrichedRole := range f.roles {
+				id := enrichedRole.Role.ID.String()
+				if warm {
+					enrichedRoleRepo.On("Get", mock.Anything, id, int64(0)).Run(f.roundTrip).Return(enrichedRole, nil)
+				} else {
+					enrichedRoleRepo.On("Get", mock.Anything, id, int64(0)).Run(f.roundTrip).Return(nil, model.ErrCacheMiss)
+				}
+				roleRepo.On("Get", mock.Anything, id).Run(f.roundTrip).Return(&enrichedRole.Role, nil).Maybe()
+				rolePermissionRepo.On("GetRolePermissions", mock.Anything, id).Run(f.roundTrip).Return(enrichedRole.Permissions, nil).Maybe()
+			}
+
+			roleService := role.NewService(roleRepo, rolePermissionRepo, enrichedRoleRepo, time.Hour, nil, nil)
+			ctx := context.Background()
+
+			b.ResetTimer()
+			for range b.N {
+				if err := getUserRolesPerRole(ctx, userRoleRepo.GetUserRoles, roleService.Get, f.userID); err != nil {
+					b.Fatal(err)
+				}
+			}
+			f.report(b)
+		})
+	}
+}
+
+func getUserRolesPerRole(
+	ctx context.Context,
+	getUserRoles func(context.Context, string) ([]string, error),
+	getRole func(context.Context, string) (*model.EnrichedRole, error),
+	userID string,
+) error {
+	roleIDs, err := getUserRoles(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(roleIDs))
+	semaphore := make(chan struct{}, 10)
+
+	var wg sync.WaitGroup
+	for i, roleID := range roleIDs {
+		wg.Add(1)
+		go func(i int, roleID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			_, errs[i] = getRole(ctx, roleID)
+		}(i, roleID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -10,45 +10,114 @@ import (
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 )
 
-func (s *ServiceSuite) TestGetUserRolesSuccess() {
-	userID := "user123"
-	roleID1 := uuid.New().String()
-	roleID2 := uuid.New().String()
-
-	role1 := &model.EnrichedRole{
+func newEnrichedRole(name string) *model.EnrichedRole {
+	return &model.EnrichedRole{
 		Role: model.Role{
 			ID:        uuid.New(),
-			Name:      "admin",
-			CreatedAt: time.Now(),
-		},
-		Permissions: []*model.Permission{},
-	}
-	role2 := &model.EnrichedRole{
-		Role: model.Role{
-			ID:        uuid.New(),
-			Name:      "user",
+			Name:      name,
 			CreatedAt: time.Now(),
 		},
 		Permissions: []*model.Permission{},
 	}
+}
+
+func (s *ServiceSuite) TestGetUserRolesFromCache() {
+	userID := "user123"
+	role1 := newEnrichedRole("admin")
+	role2 := newEnrichedRole("user")
+	roleIDs := []string{role1.Role.ID.String(), role2.Role.ID.String()}
 
-	s.userRoleRepository.On("GetUserRoles", mock.Anything, userID).Return([]string{roleID1, roleID2}, nil)
-	s.roleService.On("Get", mock.Anything, roleID1).Return(role1, nil)
-	s.roleService.On("Get", mock.Anything, roleID2).Return(role2, nil)
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, userID).Return(roleIDs, nil).Once()
+	s.enrichedRoleRepo.On("GetMany", mock.Anything, roleIDs).Return(
+		map[string]*model.EnrichedRole{roleIDs[0]: role1, roleIDs[1]: role2},
+		map[string]int64{roleIDs[0]: 0, roleIDs[1]: 0},
+		nil,
+	).Once()
 
 	result, err := s.service.GetUserRoles(s.ctx, userID)
 
 	assert.NoError(s.T(), err)
-	assert.NotNil(s.T(), result)
 	assert.Len(s.T(), result, 2)
 
+	// Порядок соответствует порядку ролей из репозитория
 	assert.Equal(s.T(), role1.Role.ID, result[0].Role.ID)
-	assert.Equal(s.T(), role1.Role.Name, result[0].Role.Name)
 	assert.Equal(s.T(), role2.Role.ID, result[1].Role.ID)
-	assert.Equal(s.T(), role2.Role.Name, result[1].Role.Name)
+
+	// БД с правами не запрашивается
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetUserRolesPartialMissLoadsOnce() {
+	userID := "user123"
+	role1 := newEnrichedRole("admin")
+	role2 := newEnrichedRole("user")
+	roleIDs := []string{role1.Role.ID.String(), role2.Role.ID.String()}
+
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, userID).Return(roleIDs, nil).Once()
+	s.enrichedRoleRepo.On("GetMany", mock.Anything, roleIDs).Return(
+		map[string]*model.EnrichedRole{roleIDs[0]: role1},
+		map[string]int64{roleIDs[0]: 1, roleIDs[1]: 4},
+		nil,
+	).Once()
+
+	// Одним запросом загружаются все роли пользователя
+	s.userRoleRepository.On("GetUserEnrichedRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{role1, role2}, nil).Once()
+
+	// Кэшируется только отсутствовавшая роль под прочитанной версией
+	s.enrichedRoleRepo.On("SetMany", mock.Anything, []*model.EnrichedRole{role2}, map[string]int64{roleIDs[0]: 1, roleIDs[1]: 4},
+		mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	result, err := s.service.GetUserRoles(s.ctx, userID)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), []*model.EnrichedRole{role1, role2}, result)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetUserRolesCacheUnavailable() {
+	userID := "user123"
+	role := newEnrichedRole("admin")
+	roleIDs := []string{role.Role.ID.String()}
+
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, userID).Return(roleIDs, nil).Once()
+	s.enrichedRoleRepo.On("GetMany", mock.Anything, roleIDs).Return(nil, nil, assert.AnError).Once()
+
+	// Без версий роли не кэшируются
+	s.userRoleRepository.On("GetUserEnrichedRoles", mock.Anything, userID).
+		Return([]*model.EnrichedRole{role}, nil).Once()
+
+	result, err := s.service.GetUserRoles(s.ctx, userID)
+
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), result, 1)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.enrichedRoleRepo.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestGetUserRolesLoadError() {
+	userID := "user123"
+	roleIDs := []string{uuid.NewString()}
+
+	s.userRoleRepository.On("GetUserRoles", mock.Anything, userID).Return(roleIDs, nil).Once()
+	s.enrichedRoleRepo.On("GetMany", mock.Anything, roleIDs).Return(
+		map[string]*model.EnrichedRole{},
+		map[string]int64{roleIDs[0]: 0},
+		nil,
+	).Once()
+	s.userRoleRepository.On("GetUserEnrichedRoles", mock.Anything, userID).Return(nil, model.ErrInternal).Once()
+
+	result, err := s.service.GetUserRoles(s.ctx, userID)
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Nil(s.T(), result)
 
 	s.userRoleRepository.AssertExpectations(s.T())
-	s.roleService.AssertExpectations(s.T())
+	s.enrichedRoleRepo.AssertExpectations(s.T())
 }
 
 func (s *ServiceSuite) TestGetUserRolesEmptyResult() {
@@ -1,6 +1,8 @@
 package user_role_test
 
 import (
+	"errors"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -47,3 +49,35 @@ func (s *ServiceSuite) TestRevokeRepositoryError() {
 
 	s.userRoleRepository.AssertExpectations(s.T())
 }
+
+func (s *ServiceSuite) TestRevokePublishesEvent() {
+	userID := "user123"
+	roleID := "role456"
+
+	s.userRoleRepository.On("Revoke", mock.Anything, userID, roleID).Return(nil)
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, model.EventTypeUserRoleRevoked, userID,
+		&model.UserRoleRevoked{UserID: userID, RoleID: roleID, Reason: model.RevokeReasonManual}).Return(nil).Once()
+
+	err := s.service.Revoke(s.ctx, userID, roleID)
+
+	assert.NoError(s.T(), err)
+
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestRevokeProducerErrorDoesNotFail() {
+	userID := "user123"
+	roleID := "role456"
+
+	s.userRoleRepository.On("Revoke", mock.Anything, userID, roleID).Return(nil)
+	s.eventProducer.ExpectedCalls = nil
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("kafka unavailable")).Once()
+
+	err := s.service.Revoke(s.ctx, userID, roleID)
+
+	assert.NoError(s.T(), err)
+
+	s.eventProducer.AssertExpectations(s.T())
+}
@@ -3,10 +3,14 @@ package user_role_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	clientMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/client/grpc/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
 	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
 	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
 	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role"
@@ -18,7 +22,10 @@ type ServiceSuite struct {
 
 	userRoleRepository *repositoryMocks.UserRoleRepository
 	roleRepository     *repositoryMocks.RoleRepository
-	roleService        *serviceMocks.RoleServiceInterface
+	enrichedRoleRepo   *repositoryMocks.EnrichedRoleRepository
+	auditService       *serviceMocks.AuditServiceInterface
+	eventProducer      *serviceMocks.DomainEventProducerService
+	iamClient          *clientMocks.IAMClient
 
 	service *user_role.UserRoleService
 }
@@ -32,16 +39,33 @@ func (s *ServiceSuite) SetupSuite() {
 
 	s.userRoleRepository = repositoryMocks.NewUserRoleRepository(s.T())
 	s.roleRepository = repositoryMocks.NewRoleRepository(s.T())
+	s.enrichedRoleRepo = repositoryMocks.NewEnrichedRoleRepository(s.T())
 
-	// Создаем мок для RoleServiceInterface
-	s.roleService = serviceMocks.NewRoleServiceInterface(s.T())
-	s.service = user_role.NewService(s.userRoleRepository, s.roleService)
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+	s.iamClient = clientMocks.NewIAMClient(s.T())
+	s.service = user_role.NewService(s.userRoleRepository, s.roleRepository, s.enrichedRoleRepo, time.Hour, s.auditService, s.eventProducer, s.iamClient)
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.userRoleRepository.ExpectedCalls = nil
 	s.roleRepository.ExpectedCalls = nil
-	s.roleService.ExpectedCalls = nil
+	s.enrichedRoleRepo.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+	s.iamClient.ExpectedCalls = nil
+
+	s.userRoleRepository.Calls = nil
+	s.roleRepository.Calls = nil
+
+	// Согласование назначений проверяется в отдельных тестах
+	s.roleRepository.On("Get", mock.Anything, mock.Anything).Return(&model.Role{}, nil).Maybe()
+
+	// Запись аудита проверяется в отдельных тестах
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+
+	// Публикация доменных событий проверяется в отдельных тестах
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 }
 
 func (s *ServiceSuite) TearDownTest() {
@@ -0,0 +1,63 @@
+package user_role_expiry
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository"
+	def "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service"
+)
+
+const (
+	// sweepInterval — периодичность проверки истекших назначений
+	sweepInterval = time.Minute
+	// sweepBatchSize — максимальное количество назначений, отзываемых за один запрос
+	sweepBatchSize int32 = 500
+	// auditActorName — имя системного инициатора в журнале аудита
+	auditActorName = "user_role_expiry"
+)
+
+var _ def.UserRoleExpiryService = (*UserRoleExpiryService)(nil)
+
+type UserRoleExpiryService struct {
+	userRoleRepo  repository.UserRoleRepository
+	eventProducer def.DomainEventProducerService
+	auditService  def.AuditServiceInterface
+	interval      time.Duration
+}
+
+func NewService(
+	userRoleRepo repository.UserRoleRepository,
+	eventProducer def.DomainEventProducerService,
+	auditService def.AuditServiceInterface,
+) *UserRoleExpiryService {
+	return &UserRoleExpiryService{
+		userRoleRepo:  userRoleRepo,
+		eventProducer: eventProducer,
+		auditService:  auditService,
+		interval:      sweepInterval,
+	}
+}
+
+func (s *UserRoleExpiryService) Run(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Запуск отзыва истекших назначений ролей",
+		zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sweep(ctx); err != nil {
+			logger.Error(ctx, "❌ Ошибка отзыва истекших назначений ролей", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package user_role_expiry
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/audit"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/errreport"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/tracing"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+// Sweep отзывает все истекшие назначения пачками и публикует события отзыва.
+// Возвращает количество отозванных назначений.
+func (s *UserRoleExpiryService) Sweep(ctx context.Context) (int, error) {
+	ctx, span := tracing.StartSpan(ctx, "rbac.service.sweep_expired_user_roles")
+	defer span.End()
+
+	ctx = audit.WithSystemActor(ctx, auditActorName)
+
+	total := 0
+	for {
+		expired, err := s.userRoleRepo.DeleteExpired(ctx, sweepBatchSize)
+		if err != nil {
+			errreport.Report(ctx, "❌ [Service] Ошибка удаления истекших назначений ролей", err)
+			return total, err
+		}
+
+		for _, userRole := range expired {
+			s.auditService.Record(ctx, &model.AuditRecord{
+				Action:     model.AuditActionUserRoleExpire,
+				TargetType: model.AuditTargetUserRole,
+				TargetID:   userRole.UserID.String(),
+				Before:     userRole,
+			})
+
+			event := &model.UserRoleRevoked{
+				UserID: userRole.UserID.String(),
+				RoleID: userRole.RoleID.String(),
+				Reason: model.RevokeReasonExpired,
+			}
+
+			// Назначение уже удалено, поэтому ошибка публикации не прерывает отзыв
+			if err = s.eventProducer.Produce(ctx, model.EventTypeUserRoleRevoked, event.UserID, event); err != nil {
+				logger.Error(ctx, "❌ Ошибка публикации события отзыва роли",
+					zap.String("user_id", userRole.UserID.String()),
+					zap.String("role_id", userRole.RoleID.String()),
+					zap.Error(err))
+			}
+		}
+
+		total += len(expired)
+		if len(expired) < int(sweepBatchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Info(ctx, "🧹 Отозваны истекшие назначения ролей", zap.Int("count", total))
+	}
+
+	return total, nil
+}
@@ -0,0 +1,52 @@
+package user_role_expiry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/platform/pkg/logger"
+	repositoryMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/repository/mocks"
+	serviceMocks "github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/mocks"
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/service/user_role_expiry"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx context.Context // nolint:containedctx
+
+	userRoleRepository *repositoryMocks.UserRoleRepository
+	eventProducer      *serviceMocks.DomainEventProducerService
+	auditService       *serviceMocks.AuditServiceInterface
+
+	service *user_role_expiry.UserRoleExpiryService
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	if err := logger.InitDefault(); err != nil {
+		panic(err)
+	}
+
+	s.userRoleRepository = repositoryMocks.NewUserRoleRepository(s.T())
+	s.eventProducer = serviceMocks.NewDomainEventProducerService(s.T())
+
+	s.auditService = serviceMocks.NewAuditServiceInterface(s.T())
+
+	s.service = user_role_expiry.NewService(s.userRoleRepository, s.eventProducer, s.auditService)
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.userRoleRepository.ExpectedCalls = nil
+	s.eventProducer.ExpectedCalls = nil
+	s.auditService.ExpectedCalls = nil
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,84 @@
+package user_role_expiry_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Alexander-Mandzhiev/school_schedule/rbac/internal/model"
+)
+
+func (s *ServiceSuite) TestSweepRevokesExpired() {
+	validUntil := time.Now().Add(-time.Minute)
+	expired := []*model.UserRole{
+		{UserID: uuid.New(), RoleID: uuid.New(), ValidUntil: &validUntil},
+		{UserID: uuid.New(), RoleID: uuid.New(), ValidUntil: &validUntil},
+	}
+
+	s.userRoleRepository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("int32")).Return(expired, nil).Once()
+
+	for _, userRole := range expired {
+		s.auditService.On("Record", mock.Anything, mock.MatchedBy(func(record *model.AuditRecord) bool {
+			return record.Action == model.AuditActionUserRoleExpire &&
+				record.TargetID == userRole.UserID.String()
+		})).Return().Once()
+		s.eventProducer.On("Produce", mock.Anything, model.EventTypeUserRoleRevoked, userRole.UserID.String(),
+			mock.MatchedBy(func(event *model.UserRoleRevoked) bool {
+				return event.UserID == userRole.UserID.String() &&
+					event.RoleID == userRole.RoleID.String() &&
+					event.Reason == model.RevokeReasonExpired
+			})).Return(nil).Once()
+	}
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), len(expired), count)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+	s.auditService.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSweepNothingExpired() {
+	s.userRoleRepository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("int32")).Return([]*model.UserRole{}, nil).Once()
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.NoError(s.T(), err)
+	assert.Zero(s.T(), count)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSweepProducerErrorDoesNotStop() {
+	expired := []*model.UserRole{
+		{UserID: uuid.New(), RoleID: uuid.New()},
+	}
+
+	s.userRoleRepository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("int32")).Return(expired, nil).Once()
+	s.auditService.On("Record", mock.Anything, mock.Anything).Return().Once()
+	s.eventProducer.On("Produce", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kafka unavailable")).Once()
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+	s.eventProducer.AssertExpectations(s.T())
+}
+
+func (s *ServiceSuite) TestSweepRepositoryError() {
+	s.userRoleRepository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("int32")).Return(nil, model.ErrInternal).Once()
+
+	count, err := s.service.Sweep(s.ctx)
+
+	assert.ErrorIs(s.T(), err, model.ErrInternal)
+	assert.Zero(s.T(), count)
+
+	s.userRoleRepository.AssertExpectations(s.T())
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	commonV1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+const (
+	// openAPIVersion версия спецификации генерируемых документов
+	openAPIVersion = "3.0.3"
+	// sessionSecurityScheme схема аутентификации по сессионной cookie (ext-auth)
+	sessionSecurityScheme = "sessionCookie"
+	// sessionCookieName имя cookie, из которой ext-auth читает сессию
+	sessionCookieName = "X-Session-Id"
+	// statusSchema схема ошибки в формате google.rpc.Status
+	statusSchema = "google.rpc.Status"
+	// maxQueryDepth ограничивает разворачивание вложенных сообщений в query-параметры
+	maxQueryDepth = 3
+	// idempotencyKeyHeader заголовок ключа идемпотентности и его максимальная длина
+	idempotencyKeyHeader = "Idempotency-Key"
+	maxIdempotencyKeyLen = 255
+)
+
+// pathParamPattern выделяет переменные шаблона пути: {name} и {name=segments/*}
+var pathParamPattern = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// documentBuilder собирает OpenAPI документ одного proto-файла
+type documentBuilder struct {
+	registry *registry
+	schemas  map[string]any
+}
+
+func newDocumentBuilder(r *registry) *documentBuilder {
+	return &documentBuilder{
+		registry: r,
+		schemas:  make(map[string]any),
+	}
+}
+
+// build возвращает документ или nil, если в файле нет HTTP-маршрутов
+func (b *documentBuilder) build(file *protogen.File) map[string]any {
+	paths := make(map[string]any)
+	tags := make([]any, 0, len(file.Services))
+
+	for _, service := range file.Services {
+		routes := 0
+		for _, method := range service.Methods {
+			rule, ok := proto.GetExtension(method.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+
+			for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				verb, template := httpPattern(r)
+				if verb == "" {
+					continue
+				}
+
+				item, _ := paths[openAPIPath(template)].(map[string]any)
+				if item == nil {
+					item = make(map[string]any)
+					paths[openAPIPath(template)] = item
+				}
+				item[strings.ToLower(verb)] = b.operation(service, method, r, template, verb)
+				routes++
+			}
+		}
+
+		if routes > 0 {
+			tags = append(tags, withDescription(map[string]any{"name": string(service.Desc.Name())}, service.Comments.Leading))
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	b.schemas[statusSchema] = map[string]any{
+		"type":        "object",
+		"description": "Ошибка gRPC в формате google.rpc.Status",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "integer", "format": "int32"},
+			"message": map[string]any{"type": "string"},
+			"details": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+		},
+	}
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":   string(file.Desc.Package()),
+			"version": packageVersion(file.Desc.Package()),
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]any{
+				sessionSecurityScheme: map[string]any{
+					"type":        "apiKey",
+					"in":          "cookie",
+					"name":        sessionCookieName,
+					"description": "Идентификатор сессии из ответа входа; проверяется External Auth",
+				},
+			},
+		},
+	}
+}
+
+// operation описывает один HTTP-маршрут метода
+func (b *documentBuilder) operation(service *protogen.Service, method *protogen.Method, rule *annotations.HttpRule, template, verb string) map[string]any {
+	op := map[string]any{
+		"operationId": fmt.Sprintf("%s_%s", service.Desc.Name(), method.Desc.Name()),
+		"tags":        []any{string(service.Desc.Name())},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "Успешный ответ",
+				"content":     jsonContent(b.ref(method.Output.Desc)),
+			},
+			"default": map[string]any{
+				"description": "Ошибка",
+				"content":     jsonContent(map[string]any{"$ref": schemaRef(statusSchema)}),
+			},
+		},
+	}
+
+	if summary, description := splitComment(method.Comments.Leading); summary != "" {
+		op["summary"] = summary
+		if description != "" {
+			op["description"] = description
+		}
+	}
+
+	if proto.GetExtension(method.Desc.Options(), commonV1.E_Public).(bool) {
+		op["x-public"] = true
+		op["security"] = []any{}
+	} else {
+		op["security"] = []any{map[string]any{sessionSecurityScheme: []any{}}}
+	}
+	if permission := proto.GetExtension(method.Desc.Options(), commonV1.E_Permission).(string); permission != "" {
+		op["x-permission"] = permission
+	}
+
+	input := method.Input
+	bound := make(map[string]bool)
+	var parameters []any
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(template, -1) {
+		name := match[1]
+		bound[name] = true
+
+		schema := map[string]any{"type": "string"}
+		if field := b.fieldByPath(input, strings.Split(name, ".")); field != nil {
+			schema = b.fieldSchema(field)
+		}
+		parameters = append(parameters, map[string]any{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   schema,
+		})
+	}
+
+	switch body := rule.GetBody(); body {
+	case "*":
+		op["requestBody"] = map[string]any{"required": true, "content": jsonContent(b.ref(input.Desc))}
+	case "":
+		parameters = append(parameters, b.queryParameters(input, "", bound, 0)...)
+	default:
+		if field := b.fieldByPath(input, []string{body}); field != nil {
+			op["requestBody"] = map[string]any{"required": true, "content": jsonContent(b.fieldSchema(field))}
+		}
+		bound[body] = true
+		parameters = append(parameters, b.queryParameters(input, "", bound, 0)...)
+	}
+
+	if proto.GetExtension(method.Desc.Options(), commonV1.E_IdempotencyKey).(bool) {
+		parameters = append(parameters, map[string]any{
+			"name":        idempotencyKeyHeader,
+			"in":          "header",
+			"description": "Повтор запроса с тем же ключом возвращает сохранённый результат",
+			"schema":      map[string]any{"type": "string", "maxLength": maxIdempotencyKeyLen},
+		})
+	}
+
+	if len(parameters) > 0 {
+		op["parameters"] = parameters
+	}
+
+	// Метод без тела запроса не должен описывать requestBody для GET/DELETE
+	if verb == http.MethodGet || verb == http.MethodDelete {
+		delete(op, "requestBody")
+	}
+
+	return op
+}
+
+// queryParameters разворачивает поля запроса, не занятые путём и телом, в query-параметры
+func (b *documentBuilder) queryParameters(message *protogen.Message, prefix string, bound map[string]bool, depth int) []any {
+	var parameters []any
+	for _, field := range message.Fields {
+		name := prefix + string(field.Desc.Name())
+		if bound[name] || field.Desc.IsMap() {
+			continue
+		}
+
+		if field.Desc.Kind() == protoreflect.MessageKind && !isScalarWellKnown(field.Desc.Message().FullName()) {
+			if field.Desc.IsList() || depth >= maxQueryDepth {
+				continue
+			}
+			parameters = append(parameters, b.queryParameters(field.Message, name+".", bound, depth+1)...)
+			continue
+		}
+
+		parameters = append(parameters, map[string]any{
+			"name":   name,
+			"in":     "query",
+			"schema": b.fieldSchema(field),
+		})
+	}
+
+	return parameters
+}
+
+// fieldByPath находит поле по пути вида a.b.c
+func (b *documentBuilder) fieldByPath(message *protogen.Message, path []string) *protogen.Field {
+	for i, name := range path {
+		var found *protogen.Field
+		for _, field := range message.Fields {
+			if string(field.Desc.Name()) == name {
+				found = field
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		if i == len(path)-1 {
+			return found
+		}
+		if found.Message == nil {
+			return nil
+		}
+		message = found.Message
+	}
+
+	return nil
+}
+
+// httpPattern возвращает HTTP-метод и шаблон пути правила
+func httpPattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Custom:
+		return strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	}
+
+	return "", ""
+}
+
+// openAPIPath переводит шаблон google.api.http в путь OpenAPI: {name=a/*} → {name}
+func openAPIPath(template string) string {
+	return pathParamPattern.ReplaceAllString(template, "{$1}")
+}
+
+// packageVersion возвращает версию API из имени пакета (auth.v1 → v1)
+func packageVersion(pkg protoreflect.FullName) string {
+	if name := string(pkg.Name()); strings.HasPrefix(name, "v") {
+		return name
+	}
+	return "v1"
+}
+
+func jsonContent(schema any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+func withDescription(m map[string]any, comments protogen.Comments) map[string]any {
+	if description := cleanComment(comments); description != "" {
+		m["description"] = description
+	}
+	return m
+}
+
+// cleanComment убирает отступы строк комментария proto
+func cleanComment(comments protogen.Comments) string {
+	lines := strings.Split(strings.TrimSpace(string(comments)), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// splitComment делит комментарий метода на summary (первая строка) и description
+func splitComment(comments protogen.Comments) (string, string) {
+	summary, description, _ := strings.Cut(cleanComment(comments), "\n")
+	return summary, strings.TrimSpace(description)
+}
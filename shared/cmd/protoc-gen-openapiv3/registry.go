@@ -0,0 +1,41 @@
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// registry индексирует сообщения и enum всех файлов запроса (включая зависимости),
+// чтобы брать комментарии для схем из любых импортированных proto
+type registry struct {
+	messages map[protoreflect.FullName]*protogen.Message
+	enums    map[protoreflect.FullName]*protogen.Enum
+}
+
+func newRegistry(files []*protogen.File) *registry {
+	r := &registry{
+		messages: make(map[protoreflect.FullName]*protogen.Message),
+		enums:    make(map[protoreflect.FullName]*protogen.Enum),
+	}
+
+	for _, file := range files {
+		for _, enum := range file.Enums {
+			r.enums[enum.Desc.FullName()] = enum
+		}
+		for _, message := range file.Messages {
+			r.addMessage(message)
+		}
+	}
+
+	return r
+}
+
+func (r *registry) addMessage(message *protogen.Message) {
+	r.messages[message.Desc.FullName()] = message
+	for _, enum := range message.Enums {
+		r.enums[enum.Desc.FullName()] = enum
+	}
+	for _, nested := range message.Messages {
+		r.addMessage(nested)
+	}
+}
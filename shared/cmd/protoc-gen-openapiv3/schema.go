@@ -0,0 +1,334 @@
+package main
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wellKnownSchemas описывает JSON-представление well-known типов (protojson)
+var wellKnownSchemas = map[protoreflect.FullName]map[string]any{
+	"google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":    {"type": "string", "example": "1.5s"},
+	"google.protobuf.FieldMask":   {"type": "string"},
+	"google.protobuf.Empty":       {"type": "object"},
+	"google.protobuf.Struct":      {"type": "object", "additionalProperties": true},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {"type": "array", "items": map[string]any{}},
+	"google.protobuf.Any":         {"type": "object", "properties": map[string]any{"@type": map[string]any{"type": "string"}}, "additionalProperties": true},
+	"google.protobuf.StringValue": {"type": "string", "nullable": true},
+	"google.protobuf.BytesValue":  {"type": "string", "format": "byte", "nullable": true},
+	"google.protobuf.BoolValue":   {"type": "boolean", "nullable": true},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32", "nullable": true},
+	"google.protobuf.UInt32Value": {"type": "integer", "format": "int64", "nullable": true},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64", "nullable": true},
+	"google.protobuf.UInt64Value": {"type": "string", "format": "uint64", "nullable": true},
+	"google.protobuf.FloatValue":  {"type": "number", "format": "float", "nullable": true},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double", "nullable": true},
+}
+
+// isScalarWellKnown сообщает, что тип передаётся в JSON скаляром и допустим в query
+func isScalarWellKnown(name protoreflect.FullName) bool {
+	schema, ok := wellKnownSchemas[name]
+	return ok && schema["type"] != "object" && schema["type"] != "array" && len(schema) > 0
+}
+
+// ref возвращает ссылку на схему сообщения, регистрируя её (и зависимые схемы) в components
+func (b *documentBuilder) ref(desc protoreflect.MessageDescriptor) map[string]any {
+	if schema, ok := wellKnownSchemas[desc.FullName()]; ok {
+		return clone(schema)
+	}
+
+	name := string(desc.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		// Заглушка до построения схемы защищает от бесконечной рекурсии
+		b.schemas[name] = map[string]any{}
+		b.schemas[name] = b.messageSchema(desc)
+	}
+
+	return map[string]any{"$ref": schemaRef(name)}
+}
+
+// enumRef возвращает ссылку на схему enum (значения передаются строками)
+func (b *documentBuilder) enumRef(desc protoreflect.EnumDescriptor) map[string]any {
+	name := string(desc.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		schema := map[string]any{"type": "string", "enum": enumValues(desc, nil)}
+		if enum := b.registry.enums[desc.FullName()]; enum != nil {
+			withDescription(schema, enum.Comments.Leading)
+		}
+		b.schemas[name] = schema
+	}
+
+	return map[string]any{"$ref": schemaRef(name)}
+}
+
+// messageSchema строит схему объекта; обязательные поля берутся из validate.rules (message.required)
+func (b *documentBuilder) messageSchema(desc protoreflect.MessageDescriptor) map[string]any {
+	message := b.registry.messages[desc.FullName()]
+	if message == nil {
+		return map[string]any{"type": "object"}
+	}
+
+	properties := make(map[string]any, len(message.Fields))
+	var required []any
+	for _, field := range message.Fields {
+		properties[string(field.Desc.Name())] = b.fieldSchema(field)
+		if fieldRules(field).GetMessage().GetRequired() {
+			required = append(required, string(field.Desc.Name()))
+		}
+	}
+
+	schema := withDescription(map[string]any{"type": "object", "properties": properties}, message.Comments.Leading)
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// fieldSchema строит схему поля с учётом repeated/map, описания и ограничений validate.rules
+func (b *documentBuilder) fieldSchema(field *protogen.Field) map[string]any {
+	rules := fieldRules(field)
+
+	var schema map[string]any
+	switch {
+	case field.Desc.IsMap():
+		schema = map[string]any{
+			"type":                 "object",
+			"additionalProperties": b.valueSchema(field.Desc.MapValue(), nil),
+		}
+	case field.Desc.IsList():
+		repeated := rules.GetRepeated()
+		schema = map[string]any{
+			"type":  "array",
+			"items": b.valueSchema(field.Desc, repeated.GetItems()),
+		}
+		if repeated != nil {
+			if repeated.MinItems != nil {
+				schema["minItems"] = repeated.GetMinItems()
+			}
+			if repeated.MaxItems != nil {
+				schema["maxItems"] = repeated.GetMaxItems()
+			}
+			if repeated.GetUnique() {
+				schema["uniqueItems"] = true
+			}
+		}
+	default:
+		schema = b.valueSchema(field.Desc, rules)
+	}
+
+	description := cleanComment(field.Comments.Leading)
+	var extension any
+	if rules != nil {
+		extension = rulesExtension(rules)
+	}
+	if description == "" && extension == nil {
+		return schema
+	}
+
+	// В OpenAPI 3.0 соседние с $ref ключи игнорируются, поэтому ссылка оборачивается в allOf
+	if _, isRef := schema["$ref"]; isRef {
+		schema = map[string]any{"allOf": []any{schema}}
+	}
+	if description != "" {
+		schema["description"] = description
+	}
+	if extension != nil {
+		schema["x-validate"] = extension
+	}
+
+	return schema
+}
+
+// valueSchema строит схему одного значения поля (элемента списка, значения map)
+func (b *documentBuilder) valueSchema(desc protoreflect.FieldDescriptor, rules *validate.FieldRules) map[string]any {
+	var schema map[string]any
+	switch desc.Kind() {
+	case protoreflect.BoolKind:
+		schema = map[string]any{"type": "boolean"}
+	case protoreflect.StringKind:
+		schema = map[string]any{"type": "string"}
+		applyStringRules(schema, rules.GetString_())
+	case protoreflect.BytesKind:
+		schema = map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		schema = map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		schema = map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson кодирует 64-битные числа строками
+		schema = map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		schema = map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		schema = map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		schema = map[string]any{"type": "number", "format": "double"}
+	case protoreflect.EnumKind:
+		enumRules := rules.GetEnum()
+		if len(enumRules.GetIn()) == 0 && len(enumRules.GetNotIn()) == 0 {
+			return b.enumRef(desc.Enum())
+		}
+		// Ограниченный набор значений описывается прямо в поле
+		return map[string]any{"type": "string", "enum": enumValues(desc.Enum(), enumRules)}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.ref(desc.Message())
+	}
+
+	applyNumericRules(schema, rules)
+	return schema
+}
+
+// fieldRules возвращает validate.rules поля или nil
+func fieldRules(field *protogen.Field) *validate.FieldRules {
+	rules, _ := proto.GetExtension(field.Desc.Options(), validate.E_Rules).(*validate.FieldRules)
+	return rules
+}
+
+// applyStringRules переносит строковые ограничения в ключевые слова JSON Schema
+func applyStringRules(schema map[string]any, rules *validate.StringRules) {
+	if rules == nil {
+		return
+	}
+
+	if rules.Len != nil {
+		schema["minLength"], schema["maxLength"] = rules.GetLen(), rules.GetLen()
+	}
+	if rules.MinLen != nil {
+		schema["minLength"] = rules.GetMinLen()
+	}
+	if rules.MaxLen != nil {
+		schema["maxLength"] = rules.GetMaxLen()
+	}
+	if rules.Pattern != nil {
+		schema["pattern"] = rules.GetPattern()
+	}
+	if rules.Const != nil {
+		schema["enum"] = []any{rules.GetConst()}
+	}
+	if in := rules.GetIn(); len(in) > 0 {
+		schema["enum"] = toAny(in)
+	}
+
+	switch {
+	case rules.GetUuid():
+		schema["format"] = "uuid"
+	case rules.GetEmail():
+		schema["format"] = "email"
+	case rules.GetHostname():
+		schema["format"] = "hostname"
+	case rules.GetIpv4():
+		schema["format"] = "ipv4"
+	case rules.GetIpv6():
+		schema["format"] = "ipv6"
+	case rules.GetUri():
+		schema["format"] = "uri"
+	case rules.GetUriRef():
+		schema["format"] = "uri-reference"
+	}
+}
+
+// applyNumericRules переносит числовые границы (gt/gte/lt/lte/in/const) любого числового типа
+func applyNumericRules(schema map[string]any, rules *validate.FieldRules) {
+	if rules == nil {
+		return
+	}
+
+	oneof := rules.ProtoReflect().Descriptor().Oneofs().ByName("type")
+	set := rules.ProtoReflect().WhichOneof(oneof)
+	if set == nil || set.Kind() != protoreflect.MessageKind {
+		return
+	}
+
+	typed := rules.ProtoReflect().Get(set).Message()
+	get := func(name protoreflect.Name) (protoreflect.Value, bool) {
+		fd := typed.Descriptor().Fields().ByName(name)
+		if fd == nil || !typed.Has(fd) {
+			return protoreflect.Value{}, false
+		}
+		return typed.Get(fd), true
+	}
+
+	// Только числовые правила содержат поле gte; для string/bytes/repeated и т.п. выходим
+	if typed.Descriptor().Fields().ByName("gte") == nil {
+		return
+	}
+
+	if v, ok := get("gte"); ok {
+		schema["minimum"] = v.Interface()
+	}
+	if v, ok := get("gt"); ok {
+		schema["minimum"], schema["exclusiveMinimum"] = v.Interface(), true
+	}
+	if v, ok := get("lte"); ok {
+		schema["maximum"] = v.Interface()
+	}
+	if v, ok := get("lt"); ok {
+		schema["maximum"], schema["exclusiveMaximum"] = v.Interface(), true
+	}
+	if v, ok := get("const"); ok {
+		schema["enum"] = []any{v.Interface()}
+	}
+	if v, ok := get("in"); ok && v.List().Len() > 0 {
+		values := make([]any, v.List().Len())
+		for i := range values {
+			values[i] = v.List().Get(i).Interface()
+		}
+		schema["enum"] = values
+	}
+}
+
+// enumValues возвращает имена значений enum с учётом правил in/not_in
+func enumValues(desc protoreflect.EnumDescriptor, rules *validate.EnumRules) []any {
+	values := make([]any, 0, desc.Values().Len())
+	for i := 0; i < desc.Values().Len(); i++ {
+		value := desc.Values().Get(i)
+		number := int32(value.Number())
+		if in := rules.GetIn(); len(in) > 0 && !slices.Contains(in, number) {
+			continue
+		}
+		if slices.Contains(rules.GetNotIn(), number) {
+			continue
+		}
+		values = append(values, string(value.Name()))
+	}
+	return values
+}
+
+// rulesExtension возвращает исходные validate.rules в JSON-виде для расширения x-validate
+func rulesExtension(rules *validate.FieldRules) any {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(rules)
+	if err != nil {
+		return nil
+	}
+
+	var extension map[string]any
+	if err = json.Unmarshal(data, &extension); err != nil || len(extension) == 0 {
+		return nil
+	}
+	return extension
+}
+
+func toAny[T any](values []T) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
+
+// clone копирует схему верхнего уровня, чтобы доработки поля не меняли общую таблицу
+func clone(schema map[string]any) map[string]any {
+	result := make(map[string]any, len(schema))
+	for key, value := range schema {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,1309 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access/v1/access.proto
+
+package access_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	common_v1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = common_v1.PermissionEffect(0)
+)
+
+// define the regex for a UUID once up-front
+var _access_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on AccessCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessCheckMultiError, or
+// nil if none found.
+func (m *AccessCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = AccessCheckValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPermission()); l < 3 || l > 200 {
+		err := AccessCheckValidationError{
+			field:  "Permission",
+			reason: "value length must be between 3 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessCheckValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessCheckValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessCheckValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessCheckValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AccessCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AccessCheck) _validateUuid(uuid string) error {
+	if matched := _access_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AccessCheckMultiError is an error wrapping multiple validation errors
+// returned by AccessCheck.ValidateAll() if the designated constraints aren't met.
+type AccessCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessCheckMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessCheckMultiError) AllErrors() []error { return m }
+
+// AccessCheckValidationError is the validation error returned by
+// AccessCheck.Validate if the designated constraints aren't met.
+type AccessCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessCheckValidationError) ErrorName() string { return "AccessCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessCheckValidationError{}
+
+// Validate checks the field values on RoleRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RoleRefMultiError, or nil if none found.
+func (m *RoleRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return RoleRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleRefMultiError is an error wrapping multiple validation errors returned
+// by RoleRef.ValidateAll() if the designated constraints aren't met.
+type RoleRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleRefMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleRefMultiError) AllErrors() []error { return m }
+
+// RoleRefValidationError is the validation error returned by RoleRef.Validate
+// if the designated constraints aren't met.
+type RoleRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleRefValidationError) ErrorName() string { return "RoleRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleRefValidationError{}
+
+// Validate checks the field values on GrantTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GrantTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GrantTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GrantTraceMultiError, or
+// nil if none found.
+func (m *GrantTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GrantTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for RoleName
+
+	// no validation rules for Permission
+
+	// no validation rules for Effect
+
+	// no validation rules for Applied
+
+	if m.Condition != nil {
+		// no validation rules for Condition
+	}
+
+	if len(errors) > 0 {
+		return GrantTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// GrantTraceMultiError is an error wrapping multiple validation errors
+// returned by GrantTrace.ValidateAll() if the designated constraints aren't met.
+type GrantTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GrantTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GrantTraceMultiError) AllErrors() []error { return m }
+
+// GrantTraceValidationError is the validation error returned by
+// GrantTrace.Validate if the designated constraints aren't met.
+type GrantTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GrantTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GrantTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GrantTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GrantTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GrantTraceValidationError) ErrorName() string { return "GrantTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GrantTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrantTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GrantTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GrantTraceValidationError{}
+
+// Validate checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Explanation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Explanation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExplanationMultiError, or
+// nil if none found.
+func (m *Explanation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Explanation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Decision
+
+	// no validation rules for Permission
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplanationValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGrants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExplanationValidationError{
+						field:  fmt.Sprintf("Grants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplanationValidationError{
+					field:  fmt.Sprintf("Grants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ExplanationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplanationMultiError is an error wrapping multiple validation errors
+// returned by Explanation.ValidateAll() if the designated constraints aren't met.
+type ExplanationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplanationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplanationMultiError) AllErrors() []error { return m }
+
+// ExplanationValidationError is the validation error returned by
+// Explanation.Validate if the designated constraints aren't met.
+type ExplanationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplanationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplanationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplanationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplanationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplanationValidationError) ErrorName() string { return "ExplanationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExplanationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplanation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplanationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplanationValidationError{}
+
+// Validate checks the field values on ExplainRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExplainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExplainRequestMultiError,
+// or nil if none found.
+func (m *ExplainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCheck() == nil {
+		err := ExplainRequestValidationError{
+			field:  "Check",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainRequestValidationError{
+				field:  "Check",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExplainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainRequestMultiError is an error wrapping multiple validation errors
+// returned by ExplainRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExplainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainRequestMultiError) AllErrors() []error { return m }
+
+// ExplainRequestValidationError is the validation error returned by
+// ExplainRequest.Validate if the designated constraints aren't met.
+type ExplainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainRequestValidationError) ErrorName() string { return "ExplainRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExplainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainRequestValidationError{}
+
+// Validate checks the field values on ExplainResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExplainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExplainResponseMultiError, or nil if none found.
+func (m *ExplainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExplanation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainResponseValidationError{
+				field:  "Explanation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExplainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExplainResponseMultiError is an error wrapping multiple validation errors
+// returned by ExplainResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ExplainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainResponseMultiError) AllErrors() []error { return m }
+
+// ExplainResponseValidationError is the validation error returned by
+// ExplainResponse.Validate if the designated constraints aren't met.
+type ExplainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainResponseValidationError) ErrorName() string { return "ExplainResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExplainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainResponseValidationError{}
+
+// Validate checks the field values on SimulateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulateRequestMultiError, or nil if none found.
+func (m *SimulateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCheck() == nil {
+		err := SimulateRequestValidationError{
+			field:  "Check",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulateRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulateRequestValidationError{
+					field:  "Check",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulateRequestValidationError{
+				field:  "Check",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetAddRoleIds()) > 50 {
+		err := SimulateRequestValidationError{
+			field:  "AddRoleIds",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SimulateRequest_AddRoleIds_Unique := make(map[string]struct{}, len(m.GetAddRoleIds()))
+
+	for idx, item := range m.GetAddRoleIds() {
+		_, _ = idx, item
+
+		if _, exists := _SimulateRequest_AddRoleIds_Unique[item]; exists {
+			err := SimulateRequestValidationError{
+				field:  fmt.Sprintf("AddRoleIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SimulateRequest_AddRoleIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = SimulateRequestValidationError{
+				field:  fmt.Sprintf("AddRoleIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRemoveRoleIds()) > 50 {
+		err := SimulateRequestValidationError{
+			field:  "RemoveRoleIds",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SimulateRequest_RemoveRoleIds_Unique := make(map[string]struct{}, len(m.GetRemoveRoleIds()))
+
+	for idx, item := range m.GetRemoveRoleIds() {
+		_, _ = idx, item
+
+		if _, exists := _SimulateRequest_RemoveRoleIds_Unique[item]; exists {
+			err := SimulateRequestValidationError{
+				field:  fmt.Sprintf("RemoveRoleIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SimulateRequest_RemoveRoleIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = SimulateRequestValidationError{
+				field:  fmt.Sprintf("RemoveRoleIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SimulateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SimulateRequest) _validateUuid(uuid string) error {
+	if matched := _access_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SimulateRequestMultiError is an error wrapping multiple validation errors
+// returned by SimulateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SimulateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulateRequestMultiError) AllErrors() []error { return m }
+
+// SimulateRequestValidationError is the validation error returned by
+// SimulateRequest.Validate if the designated constraints aren't met.
+type SimulateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateRequestValidationError) ErrorName() string { return "SimulateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimulateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateRequestValidationError{}
+
+// Validate checks the field values on SimulateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulateResponseMultiError, or nil if none found.
+func (m *SimulateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCurrent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulateResponseValidationError{
+					field:  "Current",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulateResponseValidationError{
+					field:  "Current",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulateResponseValidationError{
+				field:  "Current",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSimulated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SimulateResponseValidationError{
+					field:  "Simulated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SimulateResponseValidationError{
+					field:  "Simulated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSimulated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SimulateResponseValidationError{
+				field:  "Simulated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SimulateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulateResponseMultiError is an error wrapping multiple validation errors
+// returned by SimulateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SimulateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulateResponseMultiError) AllErrors() []error { return m }
+
+// SimulateResponseValidationError is the validation error returned by
+// SimulateResponse.Validate if the designated constraints aren't met.
+type SimulateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateResponseValidationError) ErrorName() string { return "SimulateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimulateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateResponseValidationError{}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: access_request/v1/access_request.proto
+
+package access_request_v1
+
+import (
+	_ "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Состояние заявки
+type AccessRequestStatus int32
+
+const (
+	AccessRequestStatus_ACCESS_REQUEST_STATUS_UNSPECIFIED AccessRequestStatus = 0
+	AccessRequestStatus_ACCESS_REQUEST_STATUS_PENDING     AccessRequestStatus = 1
+	AccessRequestStatus_ACCESS_REQUEST_STATUS_APPROVED    AccessRequestStatus = 2
+	AccessRequestStatus_ACCESS_REQUEST_STATUS_REJECTED    AccessRequestStatus = 3
+	// Срок рассмотрения истек без решения
+	AccessRequestStatus_ACCESS_REQUEST_STATUS_EXPIRED AccessRequestStatus = 4
+)
+
+// Enum value maps for AccessRequestStatus.
+var (
+	AccessRequestStatus_name = map[int32]string{
+		0: "ACCESS_REQUEST_STATUS_UNSPECIFIED",
+		1: "ACCESS_REQUEST_STATUS_PENDING",
+		2: "ACCESS_REQUEST_STATUS_APPROVED",
+		3: "ACCESS_REQUEST_STATUS_REJECTED",
+		4: "ACCESS_REQUEST_STATUS_EXPIRED",
+	}
+	AccessRequestStatus_value = map[string]int32{
+		"ACCESS_REQUEST_STATUS_UNSPECIFIED": 0,
+		"ACCESS_REQUEST_STATUS_PENDING":     1,
+		"ACCESS_REQUEST_STATUS_APPROVED":    2,
+		"ACCESS_REQUEST_STATUS_REJECTED":    3,
+		"ACCESS_REQUEST_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x AccessRequestStatus) Enum() *AccessRequestStatus {
+	p := new(AccessRequestStatus)
+	*p = x
+	return p
+}
+
+func (x AccessRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_access_request_v1_access_request_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessRequestStatus) Type() protoreflect.EnumType {
+	return &file_access_request_v1_access_request_proto_enumTypes[0]
+}
+
+func (x AccessRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessRequestStatus.Descriptor instead.
+func (AccessRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{0}
+}
+
+// Заявка на назначение роли
+type AccessRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId string                 `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// Пользователь, подавший заявку (сам пользователь или руководитель)
+	RequestedBy   *string `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3,oneof" json:"requested_by,omitempty"`
+	Justification string  `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// Окончание действия назначения после согласования (если не указано — бессрочно)
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
+	Status     AccessRequestStatus    `protobuf:"varint,7,opt,name=status,proto3,enum=access_request.v1.AccessRequestStatus" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Срок, после которого заявка без решения истекает
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DecidedBy       *string                `protobuf:"bytes,10,opt,name=decided_by,json=decidedBy,proto3,oneof" json:"decided_by,omitempty"`
+	DecidedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=decided_at,json=decidedAt,proto3,oneof" json:"decided_at,omitempty"`
+	DecisionComment *string                `protobuf:"bytes,12,opt,name=decision_comment,json=decisionComment,proto3,oneof" json:"decision_comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequest) ProtoMessage() {}
+
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetStatus() AccessRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessRequestStatus_ACCESS_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *AccessRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetDecidedBy() string {
+	if x != nil && x.DecidedBy != nil {
+		return *x.DecidedBy
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetDecisionComment() string {
+	if x != nil && x.DecisionComment != nil {
+		return *x.DecisionComment
+	}
+	return ""
+}
+
+// Запрос на подачу заявки
+type CreateAccessRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пользователь, которому нужна роль (по умолчанию — текущий пользователь)
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Justification string                 `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestRequest) Reset() {
+	*x = CreateAccessRequestRequest{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestRequest) ProtoMessage() {}
+
+func (x *CreateAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAccessRequestRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+// Созданная заявка
+type CreateAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestResponse) Reset() {
+	*x = CreateAccessRequestResponse{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestResponse) ProtoMessage() {}
+
+func (x *CreateAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Запрос заявки по ID
+type GetAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequestRequest) Reset() {
+	*x = GetAccessRequestRequest{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequestRequest) ProtoMessage() {}
+
+func (x *GetAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// Заявка
+type GetAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessRequestResponse) Reset() {
+	*x = GetAccessRequestResponse{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessRequestResponse) ProtoMessage() {}
+
+func (x *GetAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Запрос списка заявок
+type ListAccessRequestsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status *AccessRequestStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=access_request.v1.AccessRequestStatus,oneof" json:"status,omitempty"`
+	UserId *string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	RoleId *string                `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`
+	// Размер страницы (по умолчанию 50)
+	Limit         *int32  `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsRequest) Reset() {
+	*x = ListAccessRequestsRequest{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAccessRequestsRequest) GetStatus() AccessRequestStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AccessRequestStatus_ACCESS_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListAccessRequestsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsRequest) GetRoleId() string {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessRequestsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// Страница заявок
+type ListAccessRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*AccessRequest       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsResponse) Reset() {
+	*x = ListAccessRequestsResponse{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsResponse) ProtoMessage() {}
+
+func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAccessRequestsResponse) GetRequests() []*AccessRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListAccessRequestsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessRequestsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Решение по заявке
+type DecideAccessRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Comment       *string                `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAccessRequestRequest) Reset() {
+	*x = DecideAccessRequestRequest{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAccessRequestRequest) ProtoMessage() {}
+
+func (x *DecideAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*DecideAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DecideAccessRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DecideAccessRequestRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// Заявка после решения
+type DecideAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *AccessRequest         `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAccessRequestResponse) Reset() {
+	*x = DecideAccessRequestResponse{}
+	mi := &file_access_request_v1_access_request_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAccessRequestResponse) ProtoMessage() {}
+
+func (x *DecideAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_request_v1_access_request_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*DecideAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_access_request_v1_access_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DecideAccessRequestResponse) GetRequest() *AccessRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+var File_access_request_v1_access_request_proto protoreflect.FileDescriptor
+
+const file_access_request_v1_access_request_proto_rawDesc = "" +
+	"\n" +
+	"&access_request/v1/access_request.proto\x12\x11access_request.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\"\xff\x04\n" +
+	"\rAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\x12&\n" +
+	"\frequested_by\x18\x04 \x01(\tH\x00R\vrequestedBy\x88\x01\x01\x12$\n" +
+	"\rjustification\x18\x05 \x01(\tR\rjustification\x12@\n" +
+	"\vvalid_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"validUntil\x88\x01\x01\x12>\n" +
+	"\x06status\x18\a \x01(\x0e2&.access_request.v1.AccessRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tH\x02R\tdecidedBy\x88\x01\x01\x12>\n" +
+	"\n" +
+	"decided_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tdecidedAt\x88\x01\x01\x12.\n" +
+	"\x10decision_comment\x18\f \x01(\tH\x04R\x0fdecisionComment\x88\x01\x01B\x0f\n" +
+	"\r_requested_byB\x0e\n" +
+	"\f_valid_untilB\r\n" +
+	"\v_decided_byB\r\n" +
+	"\v_decided_atB\x13\n" +
+	"\x11_decision_comment\"\xf7\x01\n" +
+	"\x1aCreateAccessRequestRequest\x12&\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x06userId\x88\x01\x01\x12!\n" +
+	"\arole_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x120\n" +
+	"\rjustification\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\n" +
+	"\x18\xd0\x0fR\rjustification\x12@\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"validUntil\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_valid_until\"Y\n" +
+	"\x1bCreateAccessRequestResponse\x12:\n" +
+	"\arequest\x18\x01 \x01(\v2 .access_request.v1.AccessRequestR\arequest\"B\n" +
+	"\x17GetAccessRequestRequest\x12'\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\trequestId\"V\n" +
+	"\x18GetAccessRequestResponse\x12:\n" +
+	"\arequest\x18\x01 \x01(\v2 .access_request.v1.AccessRequestR\arequest\"\xb7\x02\n" +
+	"\x19ListAccessRequestsRequest\x12O\n" +
+	"\x06status\x18\x01 \x01(\x0e2&.access_request.v1.AccessRequestStatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00H\x00R\x06status\x88\x01\x01\x12&\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x01R\x06userId\x88\x01\x01\x12&\n" +
+	"\arole_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x02R\x06roleId\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x05 \x01(\tH\x04R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_user_idB\n" +
+	"\n" +
+	"\b_role_idB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"\xc1\x01\n" +
+	"\x1aListAccessRequestsResponse\x12<\n" +
+	"\brequests\x18\x01 \x03(\v2 .access_request.v1.AccessRequestR\brequests\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMoreB\x0e\n" +
+	"\f_next_cursor\"z\n" +
+	"\x1aDecideAccessRequestRequest\x12'\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\trequestId\x12'\n" +
+	"\acomment\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"Y\n" +
+	"\x1bDecideAccessRequestResponse\x12:\n" +
+	"\arequest\x18\x01 \x01(\v2 .access_request.v1.AccessRequestR\arequest*\xca\x01\n" +
+	"\x13AccessRequestStatus\x12%\n" +
+	"!ACCESS_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dACCESS_REQUEST_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eACCESS_REQUEST_STATUS_APPROVED\x10\x02\x12\"\n" +
+	"\x1eACCESS_REQUEST_STATUS_REJECTED\x10\x03\x12!\n" +
+	"\x1dACCESS_REQUEST_STATUS_EXPIRED\x10\x042\xc7\a\n" +
+	"\x14AccessRequestService\x12\xb5\x01\n" +
+	"\x13CreateAccessRequest\x12-.access_request.v1.CreateAccessRequestRequest\x1a..access_request.v1.CreateAccessRequestResponse\"?\x8a\xb5\x18\x15access_request:create\xa0\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/access-requests\x12\xb3\x01\n" +
+	"\x10GetAccessRequest\x12*.access_request.v1.GetAccessRequestRequest\x1a+.access_request.v1.GetAccessRequestResponse\"F\x8a\xb5\x18\x13access_request:read\x82\xd3\xe4\x93\x02&\x12$/api/v1/access-requests/{request_id}\x90\x02\x01\x12\xac\x01\n" +
+	"\x12ListAccessRequests\x12,.access_request.v1.ListAccessRequestsRequest\x1a-.access_request.v1.ListAccessRequestsResponse\"9\x8a\xb5\x18\x13access_request:read\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/access-requests\x90\x02\x01\x12\xc8\x01\n" +
+	"\x14ApproveAccessRequest\x12-.access_request.v1.DecideAccessRequestRequest\x1a..access_request.v1.DecideAccessRequestResponse\"Q\x8a\xb5\x18\x16access_request:approve\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/access-requests/{request_id}:approve\x12\xc6\x01\n" +
+	"\x13RejectAccessRequest\x12-.access_request.v1.DecideAccessRequestRequest\x1a..access_request.v1.DecideAccessRequestResponse\"P\x8a\xb5\x18\x16access_request:approve\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/access-requests/{request_id}:rejectBeZcgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_request/v1;access_request_v1b\x06proto3"
+
+var (
+	file_access_request_v1_access_request_proto_rawDescOnce sync.Once
+	file_access_request_v1_access_request_proto_rawDescData []byte
+)
+
+func file_access_request_v1_access_request_proto_rawDescGZIP() []byte {
+	file_access_request_v1_access_request_proto_rawDescOnce.Do(func() {
+		file_access_request_v1_access_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_access_request_v1_access_request_proto_rawDesc), len(file_access_request_v1_access_request_proto_rawDesc)))
+	})
+	return file_access_request_v1_access_request_proto_rawDescData
+}
+
+var file_access_request_v1_access_request_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_access_request_v1_access_request_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_access_request_v1_access_request_proto_goTypes = []any{
+	(AccessRequestStatus)(0),            // 0: access_request.v1.AccessRequestStatus
+	(*AccessRequest)(nil),               // 1: access_request.v1.AccessRequest
+	(*CreateAccessRequestRequest)(nil),  // 2: access_request.v1.CreateAccessRequestRequest
+	(*CreateAccessRequestResponse)(nil), // 3: access_request.v1.CreateAccessRequestResponse
+	(*GetAccessRequestRequest)(nil),     // 4: access_request.v1.GetAccessRequestRequest
+	(*GetAccessRequestResponse)(nil),    // 5: access_request.v1.GetAccessRequestResponse
+	(*ListAccessRequestsRequest)(nil),   // 6: access_request.v1.ListAccessRequestsRequest
+	(*ListAccessRequestsResponse)(nil),  // 7: access_request.v1.ListAccessRequestsResponse
+	(*DecideAccessRequestRequest)(nil),  // 8: access_request.v1.DecideAccessRequestRequest
+	(*DecideAccessRequestResponse)(nil), // 9: access_request.v1.DecideAccessRequestResponse
+	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
+}
+var file_access_request_v1_access_request_proto_depIdxs = []int32{
+	10, // 0: access_request.v1.AccessRequest.valid_until:type_name -> google.protobuf.Timestamp
+	0,  // 1: access_request.v1.AccessRequest.status:type_name -> access_request.v1.AccessRequestStatus
+	10, // 2: access_request.v1.AccessRequest.created_at:type_name -> google.protobuf.Timestamp
+	10, // 3: access_request.v1.AccessRequest.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 4: access_request.v1.AccessRequest.decided_at:type_name -> google.protobuf.Timestamp
+	10, // 5: access_request.v1.CreateAccessRequestRequest.valid_until:type_name -> google.protobuf.Timestamp
+	1,  // 6: access_request.v1.CreateAccessRequestResponse.request:type_name -> access_request.v1.AccessRequest
+	1,  // 7: access_request.v1.GetAccessRequestResponse.request:type_name -> access_request.v1.AccessRequest
+	0,  // 8: access_request.v1.ListAccessRequestsRequest.status:type_name -> access_request.v1.AccessRequestStatus
+	1,  // 9: access_request.v1.ListAccessRequestsResponse.requests:type_name -> access_request.v1.AccessRequest
+	1,  // 10: access_request.v1.DecideAccessRequestResponse.request:type_name -> access_request.v1.AccessRequest
+	2,  // 11: access_request.v1.AccessRequestService.CreateAccessRequest:input_type -> access_request.v1.CreateAccessRequestRequest
+	4,  // 12: access_request.v1.AccessRequestService.GetAccessRequest:input_type -> access_request.v1.GetAccessRequestRequest
+	6,  // 13: access_request.v1.AccessRequestService.ListAccessRequests:input_type -> access_request.v1.ListAccessRequestsRequest
+	8,  // 14: access_request.v1.AccessRequestService.ApproveAccessRequest:input_type -> access_request.v1.DecideAccessRequestRequest
+	8,  // 15: access_request.v1.AccessRequestService.RejectAccessRequest:input_type -> access_request.v1.DecideAccessRequestRequest
+	3,  // 16: access_request.v1.AccessRequestService.CreateAccessRequest:output_type -> access_request.v1.CreateAccessRequestResponse
+	5,  // 17: access_request.v1.AccessRequestService.GetAccessRequest:output_type -> access_request.v1.GetAccessRequestResponse
+	7,  // 18: access_request.v1.AccessRequestService.ListAccessRequests:output_type -> access_request.v1.ListAccessRequestsResponse
+	9,  // 19: access_request.v1.AccessRequestService.ApproveAccessRequest:output_type -> access_request.v1.DecideAccessRequestResponse
+	9,  // 20: access_request.v1.AccessRequestService.RejectAccessRequest:output_type -> access_request.v1.DecideAccessRequestResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_access_request_v1_access_request_proto_init() }
+func file_access_request_v1_access_request_proto_init() {
+	if File_access_request_v1_access_request_proto != nil {
+		return
+	}
+	file_access_request_v1_access_request_proto_msgTypes[0].OneofWrappers = []any{}
+	file_access_request_v1_access_request_proto_msgTypes[1].OneofWrappers = []any{}
+	file_access_request_v1_access_request_proto_msgTypes[5].OneofWrappers = []any{}
+	file_access_request_v1_access_request_proto_msgTypes[6].OneofWrappers = []any{}
+	file_access_request_v1_access_request_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_access_request_v1_access_request_proto_rawDesc), len(file_access_request_v1_access_request_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_access_request_v1_access_request_proto_goTypes,
+		DependencyIndexes: file_access_request_v1_access_request_proto_depIdxs,
+		EnumInfos:         file_access_request_v1_access_request_proto_enumTypes,
+		MessageInfos:      file_access_request_v1_access_request_proto_msgTypes,
+	}.Build()
+	File_access_request_v1_access_request_proto = out.File
+	file_access_request_v1_access_request_proto_goTypes = nil
+	file_access_request_v1_access_request_proto_depIdxs = nil
+}
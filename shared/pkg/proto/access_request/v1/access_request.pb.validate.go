@@ -0,0 +1,1456 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access_request/v1/access_request.proto
+
+package access_request_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _access_request_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on AccessRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessRequestMultiError, or
+// nil if none found.
+func (m *AccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for RoleId
+
+	// no validation rules for Justification
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.RequestedBy != nil {
+		// no validation rules for RequestedBy
+	}
+
+	if m.ValidUntil != nil {
+
+		if all {
+			switch v := interface{}(m.GetValidUntil()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessRequestValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DecidedBy != nil {
+		// no validation rules for DecidedBy
+	}
+
+	if m.DecidedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDecidedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessRequestValidationError{
+						field:  "DecidedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessRequestValidationError{
+						field:  "DecidedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDecidedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessRequestValidationError{
+					field:  "DecidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DecisionComment != nil {
+		// no validation rules for DecisionComment
+	}
+
+	if len(errors) > 0 {
+		return AccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessRequestMultiError is an error wrapping multiple validation errors
+// returned by AccessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessRequestMultiError) AllErrors() []error { return m }
+
+// AccessRequestValidationError is the validation error returned by
+// AccessRequest.Validate if the designated constraints aren't met.
+type AccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessRequestValidationError) ErrorName() string { return "AccessRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessRequestValidationError{}
+
+// Validate checks the field values on CreateAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccessRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccessRequestRequestMultiError, or nil if none found.
+func (m *CreateAccessRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccessRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRoleId()); err != nil {
+		err = CreateAccessRequestRequestValidationError{
+			field:  "RoleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetJustification()); l < 10 || l > 2000 {
+		err := CreateAccessRequestRequestValidationError{
+			field:  "Justification",
+			reason: "value length must be between 10 and 2000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.UserId != nil {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = CreateAccessRequestRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ValidUntil != nil {
+
+		if all {
+			switch v := interface{}(m.GetValidUntil()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAccessRequestRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAccessRequestRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAccessRequestRequestValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateAccessRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateAccessRequestRequest) _validateUuid(uuid string) error {
+	if matched := _access_request_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAccessRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAccessRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccessRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccessRequestRequestMultiError) AllErrors() []error { return m }
+
+// CreateAccessRequestRequestValidationError is the validation error returned
+// by CreateAccessRequestRequest.Validate if the designated constraints aren't met.
+type CreateAccessRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccessRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccessRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccessRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccessRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccessRequestRequestValidationError) ErrorName() string {
+	return "CreateAccessRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccessRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccessRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccessRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccessRequestRequestValidationError{}
+
+// Validate checks the field values on CreateAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccessRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccessRequestResponseMultiError, or nil if none found.
+func (m *CreateAccessRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccessRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAccessRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAccessRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAccessRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccessRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccessRequestResponseMultiError) AllErrors() []error { return m }
+
+// CreateAccessRequestResponseValidationError is the validation error returned
+// by CreateAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type CreateAccessRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccessRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccessRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccessRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccessRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccessRequestResponseValidationError) ErrorName() string {
+	return "CreateAccessRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccessRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccessRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccessRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccessRequestResponseValidationError{}
+
+// Validate checks the field values on GetAccessRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessRequestRequestMultiError, or nil if none found.
+func (m *GetAccessRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRequestId()); err != nil {
+		err = GetAccessRequestRequestValidationError{
+			field:  "RequestId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccessRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAccessRequestRequest) _validateUuid(uuid string) error {
+	if matched := _access_request_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAccessRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccessRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessRequestRequestMultiError) AllErrors() []error { return m }
+
+// GetAccessRequestRequestValidationError is the validation error returned by
+// GetAccessRequestRequest.Validate if the designated constraints aren't met.
+type GetAccessRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessRequestRequestValidationError) ErrorName() string {
+	return "GetAccessRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessRequestRequestValidationError{}
+
+// Validate checks the field values on GetAccessRequestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessRequestResponseMultiError, or nil if none found.
+func (m *GetAccessRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccessRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccessRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessRequestResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccessRequestResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessRequestResponseMultiError) AllErrors() []error { return m }
+
+// GetAccessRequestResponseValidationError is the validation error returned by
+// GetAccessRequestResponse.Validate if the designated constraints aren't met.
+type GetAccessRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessRequestResponseValidationError) ErrorName() string {
+	return "GetAccessRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessRequestResponseValidationError{}
+
+// Validate checks the field values on ListAccessRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessRequestsRequestMultiError, or nil if none found.
+func (m *ListAccessRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+
+		if _, ok := _ListAccessRequestsRequest_Status_NotInLookup[m.GetStatus()]; ok {
+			err := ListAccessRequestsRequestValidationError{
+				field:  "Status",
+				reason: "value must not be in list [ACCESS_REQUEST_STATUS_UNSPECIFIED]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := AccessRequestStatus_name[int32(m.GetStatus())]; !ok {
+			err := ListAccessRequestsRequestValidationError{
+				field:  "Status",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.UserId != nil {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = ListAccessRequestsRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.RoleId != nil {
+
+		if err := m._validateUuid(m.GetRoleId()); err != nil {
+			err = ListAccessRequestsRequestValidationError{
+				field:  "RoleId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListAccessRequestsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAccessRequestsRequest) _validateUuid(uuid string) error {
+	if matched := _access_request_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAccessRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccessRequestsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListAccessRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessRequestsRequestValidationError is the validation error returned by
+// ListAccessRequestsRequest.Validate if the designated constraints aren't met.
+type ListAccessRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessRequestsRequestValidationError) ErrorName() string {
+	return "ListAccessRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessRequestsRequestValidationError{}
+
+var _ListAccessRequestsRequest_Status_NotInLookup = map[AccessRequestStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessRequestsResponseMultiError, or nil if none found.
+func (m *ListAccessRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessRequestsResponseValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessRequestsResponseValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for HasMore
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessRequestsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessRequestsResponseValidationError is the validation error returned
+// by ListAccessRequestsResponse.Validate if the designated constraints aren't met.
+type ListAccessRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessRequestsResponseValidationError) ErrorName() string {
+	return "ListAccessRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessRequestsResponseValidationError{}
+
+// Validate checks the field values on DecideAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideAccessRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideAccessRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DecideAccessRequestRequestMultiError, or nil if none found.
+func (m *DecideAccessRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideAccessRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRequestId()); err != nil {
+		err = DecideAccessRequestRequestValidationError{
+			field:  "RequestId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Comment != nil {
+
+		if utf8.RuneCountInString(m.GetComment()) > 2000 {
+			err := DecideAccessRequestRequestValidationError{
+				field:  "Comment",
+				reason: "value length must be at most 2000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DecideAccessRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DecideAccessRequestRequest) _validateUuid(uuid string) error {
+	if matched := _access_request_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DecideAccessRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by DecideAccessRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DecideAccessRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideAccessRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideAccessRequestRequestMultiError) AllErrors() []error { return m }
+
+// DecideAccessRequestRequestValidationError is the validation error returned
+// by DecideAccessRequestRequest.Validate if the designated constraints aren't met.
+type DecideAccessRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideAccessRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideAccessRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideAccessRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideAccessRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideAccessRequestRequestValidationError) ErrorName() string {
+	return "DecideAccessRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideAccessRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideAccessRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideAccessRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideAccessRequestRequestValidationError{}
+
+// Validate checks the field values on DecideAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideAccessRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideAccessRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DecideAccessRequestResponseMultiError, or nil if none found.
+func (m *DecideAccessRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideAccessRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DecideAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DecideAccessRequestResponseValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecideAccessRequestResponseValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DecideAccessRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecideAccessRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by DecideAccessRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DecideAccessRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideAccessRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideAccessRequestResponseMultiError) AllErrors() []error { return m }
+
+// DecideAccessRequestResponseValidationError is the validation error returned
+// by DecideAccessRequestResponse.Validate if the designated constraints
+// aren't met.
+type DecideAccessRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideAccessRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideAccessRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideAccessRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideAccessRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideAccessRequestResponseValidationError) ErrorName() string {
+	return "DecideAccessRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideAccessRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideAccessRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideAccessRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideAccessRequestResponseValidationError{}
@@ -0,0 +1,1565 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: access_review/v1/access_review.proto
+
+package access_review_v1
+
+import (
+	_ "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Состояние кампании
+type AccessReviewStatus int32
+
+const (
+	AccessReviewStatus_ACCESS_REVIEW_STATUS_UNSPECIFIED AccessReviewStatus = 0
+	AccessReviewStatus_ACCESS_REVIEW_STATUS_OPEN        AccessReviewStatus = 1
+	AccessReviewStatus_ACCESS_REVIEW_STATUS_CLOSED      AccessReviewStatus = 2
+)
+
+// Enum value maps for AccessReviewStatus.
+var (
+	AccessReviewStatus_name = map[int32]string{
+		0: "ACCESS_REVIEW_STATUS_UNSPECIFIED",
+		1: "ACCESS_REVIEW_STATUS_OPEN",
+		2: "ACCESS_REVIEW_STATUS_CLOSED",
+	}
+	AccessReviewStatus_value = map[string]int32{
+		"ACCESS_REVIEW_STATUS_UNSPECIFIED": 0,
+		"ACCESS_REVIEW_STATUS_OPEN":        1,
+		"ACCESS_REVIEW_STATUS_CLOSED":      2,
+	}
+)
+
+func (x AccessReviewStatus) Enum() *AccessReviewStatus {
+	p := new(AccessReviewStatus)
+	*p = x
+	return p
+}
+
+func (x AccessReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_access_review_v1_access_review_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessReviewStatus) Type() protoreflect.EnumType {
+	return &file_access_review_v1_access_review_proto_enumTypes[0]
+}
+
+func (x AccessReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessReviewStatus.Descriptor instead.
+func (AccessReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{0}
+}
+
+// Решение по назначению
+type AccessReviewDecision int32
+
+const (
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED AccessReviewDecision = 0
+	// Решение еще не принято
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING AccessReviewDecision = 1
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP    AccessReviewDecision = 2
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE  AccessReviewDecision = 3
+)
+
+// Enum value maps for AccessReviewDecision.
+var (
+	AccessReviewDecision_name = map[int32]string{
+		0: "ACCESS_REVIEW_DECISION_UNSPECIFIED",
+		1: "ACCESS_REVIEW_DECISION_PENDING",
+		2: "ACCESS_REVIEW_DECISION_KEEP",
+		3: "ACCESS_REVIEW_DECISION_REVOKE",
+	}
+	AccessReviewDecision_value = map[string]int32{
+		"ACCESS_REVIEW_DECISION_UNSPECIFIED": 0,
+		"ACCESS_REVIEW_DECISION_PENDING":     1,
+		"ACCESS_REVIEW_DECISION_KEEP":        2,
+		"ACCESS_REVIEW_DECISION_REVOKE":      3,
+	}
+)
+
+func (x AccessReviewDecision) Enum() *AccessReviewDecision {
+	p := new(AccessReviewDecision)
+	*p = x
+	return p
+}
+
+func (x AccessReviewDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessReviewDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_access_review_v1_access_review_proto_enumTypes[1].Descriptor()
+}
+
+func (AccessReviewDecision) Type() protoreflect.EnumType {
+	return &file_access_review_v1_access_review_proto_enumTypes[1]
+}
+
+func (x AccessReviewDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessReviewDecision.Descriptor instead.
+func (AccessReviewDecision) EnumDescriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{1}
+}
+
+// Формат отчета
+type AccessReviewReportFormat int32
+
+const (
+	// По умолчанию — CSV
+	AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED AccessReviewReportFormat = 0
+	AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_CSV         AccessReviewReportFormat = 1
+	AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_JSON        AccessReviewReportFormat = 2
+)
+
+// Enum value maps for AccessReviewReportFormat.
+var (
+	AccessReviewReportFormat_name = map[int32]string{
+		0: "ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED",
+		1: "ACCESS_REVIEW_REPORT_FORMAT_CSV",
+		2: "ACCESS_REVIEW_REPORT_FORMAT_JSON",
+	}
+	AccessReviewReportFormat_value = map[string]int32{
+		"ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED": 0,
+		"ACCESS_REVIEW_REPORT_FORMAT_CSV":         1,
+		"ACCESS_REVIEW_REPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x AccessReviewReportFormat) Enum() *AccessReviewReportFormat {
+	p := new(AccessReviewReportFormat)
+	*p = x
+	return p
+}
+
+func (x AccessReviewReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessReviewReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_access_review_v1_access_review_proto_enumTypes[2].Descriptor()
+}
+
+func (AccessReviewReportFormat) Type() protoreflect.EnumType {
+	return &file_access_review_v1_access_review_proto_enumTypes[2]
+}
+
+func (x AccessReviewReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessReviewReportFormat.Descriptor instead.
+func (AccessReviewReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{2}
+}
+
+// Сводка решений кампании
+type AccessReviewSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Total   int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Pending int32                  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Keep    int32                  `protobuf:"varint,3,opt,name=keep,proto3" json:"keep,omitempty"`
+	Revoke  int32                  `protobuf:"varint,4,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	// Отзывы, уже примененные после закрытия
+	Revoked       int32 `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessReviewSummary) Reset() {
+	*x = AccessReviewSummary{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessReviewSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessReviewSummary) ProtoMessage() {}
+
+func (x *AccessReviewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessReviewSummary.ProtoReflect.Descriptor instead.
+func (*AccessReviewSummary) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessReviewSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AccessReviewSummary) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *AccessReviewSummary) GetKeep() int32 {
+	if x != nil {
+		return x.Keep
+	}
+	return 0
+}
+
+func (x *AccessReviewSummary) GetRevoke() int32 {
+	if x != nil {
+		return x.Revoke
+	}
+	return 0
+}
+
+func (x *AccessReviewSummary) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// Кампания пересмотра доступа
+type AccessReview struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RoleIds     []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	ReviewerIds []string               `protobuf:"bytes,5,rep,name=reviewer_ids,json=reviewerIds,proto3" json:"reviewer_ids,omitempty"`
+	Status      AccessReviewStatus     `protobuf:"varint,6,opt,name=status,proto3,enum=access_review.v1.AccessReviewStatus" json:"status,omitempty"`
+	CreatedBy   *string                `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Срок рассмотрения (информационный, кампания закрывается явно)
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	ClosedBy      *string                `protobuf:"bytes,10,opt,name=closed_by,json=closedBy,proto3,oneof" json:"closed_by,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3,oneof" json:"closed_at,omitempty"`
+	Summary       *AccessReviewSummary   `protobuf:"bytes,12,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessReview) Reset() {
+	*x = AccessReview{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessReview) ProtoMessage() {}
+
+func (x *AccessReview) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessReview.ProtoReflect.Descriptor instead.
+func (*AccessReview) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccessReview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessReview) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessReview) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AccessReview) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *AccessReview) GetReviewerIds() []string {
+	if x != nil {
+		return x.ReviewerIds
+	}
+	return nil
+}
+
+func (x *AccessReview) GetStatus() AccessReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccessReviewStatus_ACCESS_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *AccessReview) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AccessReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccessReview) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *AccessReview) GetClosedBy() string {
+	if x != nil && x.ClosedBy != nil {
+		return *x.ClosedBy
+	}
+	return ""
+}
+
+func (x *AccessReview) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *AccessReview) GetSummary() *AccessReviewSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// Назначение роли пользователю, зафиксированное кампанией
+type AccessReviewItem struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId string                 `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId     string                 `protobuf:"bytes,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Decision   AccessReviewDecision   `protobuf:"varint,5,opt,name=decision,proto3,enum=access_review.v1.AccessReviewDecision" json:"decision,omitempty"`
+	ReviewedBy *string                `protobuf:"bytes,6,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	Comment    *string                `protobuf:"bytes,8,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	// Время фактического отзыва роли
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessReviewItem) Reset() {
+	*x = AccessReviewItem{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessReviewItem) ProtoMessage() {}
+
+func (x *AccessReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessReviewItem.ProtoReflect.Descriptor instead.
+func (*AccessReviewItem) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccessReviewItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetDecision() AccessReviewDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *AccessReviewItem) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *AccessReviewItem) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// Запрос на создание кампании
+type CreateAccessReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,3,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	ReviewerIds   []string               `protobuf:"bytes,4,rep,name=reviewer_ids,json=reviewerIds,proto3" json:"reviewer_ids,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3,oneof" json:"due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessReviewRequest) Reset() {
+	*x = CreateAccessReviewRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessReviewRequest) ProtoMessage() {}
+
+func (x *CreateAccessReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessReviewRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAccessReviewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *CreateAccessReviewRequest) GetReviewerIds() []string {
+	if x != nil {
+		return x.ReviewerIds
+	}
+	return nil
+}
+
+func (x *CreateAccessReviewRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+// Созданная кампания
+type CreateAccessReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *AccessReview          `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessReviewResponse) Reset() {
+	*x = CreateAccessReviewResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessReviewResponse) ProtoMessage() {}
+
+func (x *CreateAccessReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessReviewResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAccessReviewResponse) GetCampaign() *AccessReview {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Запрос кампании по ID
+type GetAccessReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessReviewRequest) Reset() {
+	*x = GetAccessReviewRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessReviewRequest) ProtoMessage() {}
+
+func (x *GetAccessReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessReviewRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetAccessReviewRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// Кампания
+type GetAccessReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *AccessReview          `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessReviewResponse) Reset() {
+	*x = GetAccessReviewResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessReviewResponse) ProtoMessage() {}
+
+func (x *GetAccessReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessReviewResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAccessReviewResponse) GetCampaign() *AccessReview {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Запрос списка кампаний
+type ListAccessReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status *AccessReviewStatus    `protobuf:"varint,1,opt,name=status,proto3,enum=access_review.v1.AccessReviewStatus,oneof" json:"status,omitempty"`
+	// Размер страницы (по умолчанию 50)
+	Limit         *int32  `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessReviewsRequest) Reset() {
+	*x = ListAccessReviewsRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessReviewsRequest) ProtoMessage() {}
+
+func (x *ListAccessReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAccessReviewsRequest) GetStatus() AccessReviewStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AccessReviewStatus_ACCESS_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *ListAccessReviewsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessReviewsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// Страница кампаний
+type ListAccessReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*AccessReview        `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessReviewsResponse) Reset() {
+	*x = ListAccessReviewsResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessReviewsResponse) ProtoMessage() {}
+
+func (x *ListAccessReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAccessReviewsResponse) GetCampaigns() []*AccessReview {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListAccessReviewsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessReviewsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAccessReviewsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Запрос назначений кампании
+type ListAccessReviewItemsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Decision   *AccessReviewDecision  `protobuf:"varint,2,opt,name=decision,proto3,enum=access_review.v1.AccessReviewDecision,oneof" json:"decision,omitempty"`
+	// Размер страницы (по умолчанию 50)
+	Limit         *int32  `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessReviewItemsRequest) Reset() {
+	*x = ListAccessReviewItemsRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessReviewItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessReviewItemsRequest) ProtoMessage() {}
+
+func (x *ListAccessReviewItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessReviewItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewItemsRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAccessReviewItemsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ListAccessReviewItemsRequest) GetDecision() AccessReviewDecision {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *ListAccessReviewItemsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessReviewItemsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// Страница назначений
+type ListAccessReviewItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AccessReviewItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessReviewItemsResponse) Reset() {
+	*x = ListAccessReviewItemsResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessReviewItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessReviewItemsResponse) ProtoMessage() {}
+
+func (x *ListAccessReviewItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessReviewItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewItemsResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAccessReviewItemsResponse) GetItems() []*AccessReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAccessReviewItemsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAccessReviewItemsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAccessReviewItemsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Решение по назначению; до закрытия кампании решение можно изменить
+type DecideAccessReviewItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Decision      AccessReviewDecision   `protobuf:"varint,3,opt,name=decision,proto3,enum=access_review.v1.AccessReviewDecision" json:"decision,omitempty"`
+	Comment       *string                `protobuf:"bytes,4,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAccessReviewItemRequest) Reset() {
+	*x = DecideAccessReviewItemRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAccessReviewItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAccessReviewItemRequest) ProtoMessage() {}
+
+func (x *DecideAccessReviewItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAccessReviewItemRequest.ProtoReflect.Descriptor instead.
+func (*DecideAccessReviewItemRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DecideAccessReviewItemRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DecideAccessReviewItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DecideAccessReviewItemRequest) GetDecision() AccessReviewDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *DecideAccessReviewItemRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+// Назначение после решения
+type DecideAccessReviewItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *AccessReviewItem      `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAccessReviewItemResponse) Reset() {
+	*x = DecideAccessReviewItemResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAccessReviewItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAccessReviewItemResponse) ProtoMessage() {}
+
+func (x *DecideAccessReviewItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAccessReviewItemResponse.ProtoReflect.Descriptor instead.
+func (*DecideAccessReviewItemResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DecideAccessReviewItemResponse) GetItem() *AccessReviewItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// Запрос на закрытие кампании; повторный вызов повторяет неудавшиеся отзывы
+type CloseAccessReviewRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Отозвать назначения, по которым не принято решение
+	RevokeUnreviewed bool `protobuf:"varint,2,opt,name=revoke_unreviewed,json=revokeUnreviewed,proto3" json:"revoke_unreviewed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CloseAccessReviewRequest) Reset() {
+	*x = CloseAccessReviewRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccessReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccessReviewRequest) ProtoMessage() {}
+
+func (x *CloseAccessReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccessReviewRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccessReviewRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CloseAccessReviewRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CloseAccessReviewRequest) GetRevokeUnreviewed() bool {
+	if x != nil {
+		return x.RevokeUnreviewed
+	}
+	return false
+}
+
+// Закрытая кампания; summary.revoke - summary.revoked — отзывы, которые не удалось применить
+type CloseAccessReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *AccessReview          `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccessReviewResponse) Reset() {
+	*x = CloseAccessReviewResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccessReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccessReviewResponse) ProtoMessage() {}
+
+func (x *CloseAccessReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccessReviewResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccessReviewResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CloseAccessReviewResponse) GetCampaign() *AccessReview {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+// Запрос отчета
+type ExportAccessReviewReportRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	CampaignId    string                   `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Format        AccessReviewReportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=access_review.v1.AccessReviewReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccessReviewReportRequest) Reset() {
+	*x = ExportAccessReviewReportRequest{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccessReviewReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccessReviewReportRequest) ProtoMessage() {}
+
+func (x *ExportAccessReviewReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccessReviewReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccessReviewReportRequest) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportAccessReviewReportRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ExportAccessReviewReportRequest) GetFormat() AccessReviewReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED
+}
+
+// Отчет в запрошенном формате
+type ExportAccessReviewReportResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Report        []byte                   `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Format        AccessReviewReportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=access_review.v1.AccessReviewReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAccessReviewReportResponse) Reset() {
+	*x = ExportAccessReviewReportResponse{}
+	mi := &file_access_review_v1_access_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAccessReviewReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccessReviewReportResponse) ProtoMessage() {}
+
+func (x *ExportAccessReviewReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_review_v1_access_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccessReviewReportResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccessReviewReportResponse) Descriptor() ([]byte, []int) {
+	return file_access_review_v1_access_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportAccessReviewReportResponse) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ExportAccessReviewReportResponse) GetFormat() AccessReviewReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED
+}
+
+var File_access_review_v1_access_review_proto protoreflect.FileDescriptor
+
+const file_access_review_v1_access_review_proto_rawDesc = "" +
+	"\n" +
+	"$access_review/v1/access_review.proto\x12\x10access_review.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\"\x8b\x01\n" +
+	"\x13AccessReviewSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x05R\apending\x12\x12\n" +
+	"\x04keep\x18\x03 \x01(\x05R\x04keep\x12\x16\n" +
+	"\x06revoke\x18\x04 \x01(\x05R\x06revoke\x12\x18\n" +
+	"\arevoked\x18\x05 \x01(\x05R\arevoked\"\xd3\x04\n" +
+	"\fAccessReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\x12!\n" +
+	"\freviewer_ids\x18\x05 \x03(\tR\vreviewerIds\x12<\n" +
+	"\x06status\x18\x06 \x01(\x0e2$.access_review.v1.AccessReviewStatusR\x06status\x12\"\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tH\x01R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x126\n" +
+	"\x06due_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x05dueAt\x88\x01\x01\x12 \n" +
+	"\tclosed_by\x18\n" +
+	" \x01(\tH\x03R\bclosedBy\x88\x01\x01\x12<\n" +
+	"\tclosed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x04R\bclosedAt\x88\x01\x01\x12?\n" +
+	"\asummary\x18\f \x01(\v2%.access_review.v1.AccessReviewSummaryR\asummaryB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_created_byB\t\n" +
+	"\a_due_atB\f\n" +
+	"\n" +
+	"_closed_byB\f\n" +
+	"\n" +
+	"_closed_at\"\xbb\x03\n" +
+	"\x10AccessReviewItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcampaign_id\x18\x02 \x01(\tR\n" +
+	"campaignId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x04 \x01(\tR\x06roleId\x12B\n" +
+	"\bdecision\x18\x05 \x01(\x0e2&.access_review.v1.AccessReviewDecisionR\bdecision\x12$\n" +
+	"\vreviewed_by\x18\x06 \x01(\tH\x00R\n" +
+	"reviewedBy\x88\x01\x01\x12@\n" +
+	"\vreviewed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"reviewedAt\x88\x01\x01\x12\x1d\n" +
+	"\acomment\x18\b \x01(\tH\x02R\acomment\x88\x01\x01\x12>\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x03R\trevokedAt\x88\x01\x01B\x0e\n" +
+	"\f_reviewed_byB\x0e\n" +
+	"\f_reviewed_atB\n" +
+	"\n" +
+	"\b_commentB\r\n" +
+	"\v_revoked_at\"\xa7\x02\n" +
+	"\x19CreateAccessReviewRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x03\x18\xc8\x01R\x04name\x12/\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fH\x00R\vdescription\x88\x01\x01\x12.\n" +
+	"\brole_ids\x18\x03 \x03(\tB\x13\xfaB\x10\x92\x01\r\b\x01\x10\x14\x18\x01\"\x05r\x03\xb0\x01\x01R\aroleIds\x126\n" +
+	"\freviewer_ids\x18\x04 \x03(\tB\x13\xfaB\x10\x92\x01\r\b\x01\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\vreviewerIds\x126\n" +
+	"\x06due_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05dueAt\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_due_at\"X\n" +
+	"\x1aCreateAccessReviewResponse\x12:\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x1e.access_review.v1.AccessReviewR\bcampaign\"C\n" +
+	"\x16GetAccessReviewRequest\x12)\n" +
+	"\vcampaign_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"campaignId\"U\n" +
+	"\x17GetAccessReviewResponse\x12:\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x1e.access_review.v1.AccessReviewR\bcampaign\"\xcc\x01\n" +
+	"\x18ListAccessReviewsRequest\x12M\n" +
+	"\x06status\x18\x01 \x01(\x0e2$.access_review.v1.AccessReviewStatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00H\x00R\x06status\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x02R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"\xc0\x01\n" +
+	"\x19ListAccessReviewsResponse\x12<\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x1e.access_review.v1.AccessReviewR\tcampaigns\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMoreB\x0e\n" +
+	"\f_next_cursor\"\x84\x02\n" +
+	"\x1cListAccessReviewItemsRequest\x12)\n" +
+	"\vcampaign_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"campaignId\x12S\n" +
+	"\bdecision\x18\x02 \x01(\x0e2&.access_review.v1.AccessReviewDecisionB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00H\x00R\bdecision\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x01H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x02R\x06cursor\x88\x01\x01B\v\n" +
+	"\t_decisionB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"\xc0\x01\n" +
+	"\x1dListAccessReviewItemsResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".access_review.v1.AccessReviewItemR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMoreB\x0e\n" +
+	"\f_next_cursor\"\xf2\x01\n" +
+	"\x1dDecideAccessReviewItemRequest\x12)\n" +
+	"\vcampaign_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"campaignId\x12!\n" +
+	"\aitem_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06itemId\x12N\n" +
+	"\bdecision\x18\x03 \x01(\x0e2&.access_review.v1.AccessReviewDecisionB\n" +
+	"\xfaB\a\x82\x01\x04\x18\x02\x18\x03R\bdecision\x12'\n" +
+	"\acomment\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fH\x00R\acomment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_comment\"X\n" +
+	"\x1eDecideAccessReviewItemResponse\x126\n" +
+	"\x04item\x18\x01 \x01(\v2\".access_review.v1.AccessReviewItemR\x04item\"r\n" +
+	"\x18CloseAccessReviewRequest\x12)\n" +
+	"\vcampaign_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"campaignId\x12+\n" +
+	"\x11revoke_unreviewed\x18\x02 \x01(\bR\x10revokeUnreviewed\"W\n" +
+	"\x19CloseAccessReviewResponse\x12:\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x1e.access_review.v1.AccessReviewR\bcampaign\"\x9a\x01\n" +
+	"\x1fExportAccessReviewReportRequest\x12)\n" +
+	"\vcampaign_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\n" +
+	"campaignId\x12L\n" +
+	"\x06format\x18\x02 \x01(\x0e2*.access_review.v1.AccessReviewReportFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\"~\n" +
+	" ExportAccessReviewReportResponse\x12\x16\n" +
+	"\x06report\x18\x01 \x01(\fR\x06report\x12B\n" +
+	"\x06format\x18\x02 \x01(\x0e2*.access_review.v1.AccessReviewReportFormatR\x06format*z\n" +
+	"\x12AccessReviewStatus\x12$\n" +
+	" ACCESS_REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ACCESS_REVIEW_STATUS_OPEN\x10\x01\x12\x1f\n" +
+	"\x1bACCESS_REVIEW_STATUS_CLOSED\x10\x02*\xa6\x01\n" +
+	"\x14AccessReviewDecision\x12&\n" +
+	"\"ACCESS_REVIEW_DECISION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eACCESS_REVIEW_DECISION_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bACCESS_REVIEW_DECISION_KEEP\x10\x02\x12!\n" +
+	"\x1dACCESS_REVIEW_DECISION_REVOKE\x10\x03*\x92\x01\n" +
+	"\x18AccessReviewReportFormat\x12+\n" +
+	"'ACCESS_REVIEW_REPORT_FORMAT_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fACCESS_REVIEW_REPORT_FORMAT_CSV\x10\x01\x12$\n" +
+	" ACCESS_REVIEW_REPORT_FORMAT_JSON\x10\x022\xd4\n" +
+	"\n" +
+	"\x13AccessReviewService\x12\xae\x01\n" +
+	"\x12CreateAccessReview\x12+.access_review.v1.CreateAccessReviewRequest\x1a,.access_review.v1.CreateAccessReviewResponse\"=\x8a\xb5\x18\x14access_review:manage\xa0\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/access-reviews\x12\xad\x01\n" +
+	"\x0fGetAccessReview\x12(.access_review.v1.GetAccessReviewRequest\x1a).access_review.v1.GetAccessReviewResponse\"E\x8a\xb5\x18\x12access_review:read\x82\xd3\xe4\x93\x02&\x12$/api/v1/access-reviews/{campaign_id}\x90\x02\x01\x12\xa5\x01\n" +
+	"\x11ListAccessReviews\x12*.access_review.v1.ListAccessReviewsRequest\x1a+.access_review.v1.ListAccessReviewsResponse\"7\x8a\xb5\x18\x12access_review:read\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/access-reviews\x90\x02\x01\x12\xc5\x01\n" +
+	"\x15ListAccessReviewItems\x12..access_review.v1.ListAccessReviewItemsRequest\x1a/.access_review.v1.ListAccessReviewItemsResponse\"K\x8a\xb5\x18\x12access_review:read\x82\xd3\xe4\x93\x02,\x12*/api/v1/access-reviews/{campaign_id}/items\x90\x02\x01\x12\xdb\x01\n" +
+	"\x16DecideAccessReviewItem\x12/.access_review.v1.DecideAccessReviewItemRequest\x1a0.access_review.v1.DecideAccessReviewItemResponse\"^\x8a\xb5\x18\x14access_review:review\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/access-reviews/{campaign_id}/items/{item_id}:decide\x12\xbb\x01\n" +
+	"\x11CloseAccessReview\x12*.access_review.v1.CloseAccessReviewRequest\x1a+.access_review.v1.CloseAccessReviewResponse\"M\x8a\xb5\x18\x14access_review:manage\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/access-reviews/{campaign_id}:close\x12\xcf\x01\n" +
+	"\x18ExportAccessReviewReport\x121.access_review.v1.ExportAccessReviewReportRequest\x1a2.access_review.v1.ExportAccessReviewReportResponse\"L\x8a\xb5\x18\x12access_review:read\x82\xd3\xe4\x93\x02-\x12+/api/v1/access-reviews/{campaign_id}:export\x90\x02\x01BcZagithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/access_review/v1;access_review_v1b\x06proto3"
+
+var (
+	file_access_review_v1_access_review_proto_rawDescOnce sync.Once
+	file_access_review_v1_access_review_proto_rawDescData []byte
+)
+
+func file_access_review_v1_access_review_proto_rawDescGZIP() []byte {
+	file_access_review_v1_access_review_proto_rawDescOnce.Do(func() {
+		file_access_review_v1_access_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_access_review_v1_access_review_proto_rawDesc), len(file_access_review_v1_access_review_proto_rawDesc)))
+	})
+	return file_access_review_v1_access_review_proto_rawDescData
+}
+
+var file_access_review_v1_access_review_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_access_review_v1_access_review_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_access_review_v1_access_review_proto_goTypes = []any{
+	(AccessReviewStatus)(0),                  // 0: access_review.v1.AccessReviewStatus
+	(AccessReviewDecision)(0),                // 1: access_review.v1.AccessReviewDecision
+	(AccessReviewReportFormat)(0),            // 2: access_review.v1.AccessReviewReportFormat
+	(*AccessReviewSummary)(nil),              // 3: access_review.v1.AccessReviewSummary
+	(*AccessReview)(nil),                     // 4: access_review.v1.AccessReview
+	(*AccessReviewItem)(nil),                 // 5: access_review.v1.AccessReviewItem
+	(*CreateAccessReviewRequest)(nil),        // 6: access_review.v1.CreateAccessReviewRequest
+	(*CreateAccessReviewResponse)(nil),       // 7: access_review.v1.CreateAccessReviewResponse
+	(*GetAccessReviewRequest)(nil),           // 8: access_review.v1.GetAccessReviewRequest
+	(*GetAccessReviewResponse)(nil),          // 9: access_review.v1.GetAccessReviewResponse
+	(*ListAccessReviewsRequest)(nil),         // 10: access_review.v1.ListAccessReviewsRequest
+	(*ListAccessReviewsResponse)(nil),        // 11: access_review.v1.ListAccessReviewsResponse
+	(*ListAccessReviewItemsRequest)(nil),     // 12: access_review.v1.ListAccessReviewItemsRequest
+	(*ListAccessReviewItemsResponse)(nil),    // 13: access_review.v1.ListAccessReviewItemsResponse
+	(*DecideAccessReviewItemRequest)(nil),    // 14: access_review.v1.DecideAccessReviewItemRequest
+	(*DecideAccessReviewItemResponse)(nil),   // 15: access_review.v1.DecideAccessReviewItemResponse
+	(*CloseAccessReviewRequest)(nil),         // 16: access_review.v1.CloseAccessReviewRequest
+	(*CloseAccessReviewResponse)(nil),        // 17: access_review.v1.CloseAccessReviewResponse
+	(*ExportAccessReviewReportRequest)(nil),  // 18: access_review.v1.ExportAccessReviewReportRequest
+	(*ExportAccessReviewReportResponse)(nil), // 19: access_review.v1.ExportAccessReviewReportResponse
+	(*timestamppb.Timestamp)(nil),            // 20: google.protobuf.Timestamp
+}
+var file_access_review_v1_access_review_proto_depIdxs = []int32{
+	0,  // 0: access_review.v1.AccessReview.status:type_name -> access_review.v1.AccessReviewStatus
+	20, // 1: access_review.v1.AccessReview.created_at:type_name -> google.protobuf.Timestamp
+	20, // 2: access_review.v1.AccessReview.due_at:type_name -> google.protobuf.Timestamp
+	20, // 3: access_review.v1.AccessReview.closed_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: access_review.v1.AccessReview.summary:type_name -> access_review.v1.AccessReviewSummary
+	1,  // 5: access_review.v1.AccessReviewItem.decision:type_name -> access_review.v1.AccessReviewDecision
+	20, // 6: access_review.v1.AccessReviewItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	20, // 7: access_review.v1.AccessReviewItem.revoked_at:type_name -> google.protobuf.Timestamp
+	20, // 8: access_review.v1.CreateAccessReviewRequest.due_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: access_review.v1.CreateAccessReviewResponse.campaign:type_name -> access_review.v1.AccessReview
+	4,  // 10: access_review.v1.GetAccessReviewResponse.campaign:type_name -> access_review.v1.AccessReview
+	0,  // 11: access_review.v1.ListAccessReviewsRequest.status:type_name -> access_review.v1.AccessReviewStatus
+	4,  // 12: access_review.v1.ListAccessReviewsResponse.campaigns:type_name -> access_review.v1.AccessReview
+	1,  // 13: access_review.v1.ListAccessReviewItemsRequest.decision:type_name -> access_review.v1.AccessReviewDecision
+	5,  // 14: access_review.v1.ListAccessReviewItemsResponse.items:type_name -> access_review.v1.AccessReviewItem
+	1,  // 15: access_review.v1.DecideAccessReviewItemRequest.decision:type_name -> access_review.v1.AccessReviewDecision
+	5,  // 16: access_review.v1.DecideAccessReviewItemResponse.item:type_name -> access_review.v1.AccessReviewItem
+	4,  // 17: access_review.v1.CloseAccessReviewResponse.campaign:type_name -> access_review.v1.AccessReview
+	2,  // 18: access_review.v1.ExportAccessReviewReportRequest.format:type_name -> access_review.v1.AccessReviewReportFormat
+	2,  // 19: access_review.v1.ExportAccessReviewReportResponse.format:type_name -> access_review.v1.AccessReviewReportFormat
+	6,  // 20: access_review.v1.AccessReviewService.CreateAccessReview:input_type -> access_review.v1.CreateAccessReviewRequest
+	8,  // 21: access_review.v1.AccessReviewService.GetAccessReview:input_type -> access_review.v1.GetAccessReviewRequest
+	10, // 22: access_review.v1.AccessReviewService.ListAccessReviews:input_type -> access_review.v1.ListAccessReviewsRequest
+	12, // 23: access_review.v1.AccessReviewService.ListAccessReviewItems:input_type -> access_review.v1.ListAccessReviewItemsRequest
+	14, // 24: access_review.v1.AccessReviewService.DecideAccessReviewItem:input_type -> access_review.v1.DecideAccessReviewItemRequest
+	16, // 25: access_review.v1.AccessReviewService.CloseAccessReview:input_type -> access_review.v1.CloseAccessReviewRequest
+	18, // 26: access_review.v1.AccessReviewService.ExportAccessReviewReport:input_type -> access_review.v1.ExportAccessReviewReportRequest
+	7,  // 27: access_review.v1.AccessReviewService.CreateAccessReview:output_type -> access_review.v1.CreateAccessReviewResponse
+	9,  // 28: access_review.v1.AccessReviewService.GetAccessReview:output_type -> access_review.v1.GetAccessReviewResponse
+	11, // 29: access_review.v1.AccessReviewService.ListAccessReviews:output_type -> access_review.v1.ListAccessReviewsResponse
+	13, // 30: access_review.v1.AccessReviewService.ListAccessReviewItems:output_type -> access_review.v1.ListAccessReviewItemsResponse
+	15, // 31: access_review.v1.AccessReviewService.DecideAccessReviewItem:output_type -> access_review.v1.DecideAccessReviewItemResponse
+	17, // 32: access_review.v1.AccessReviewService.CloseAccessReview:output_type -> access_review.v1.CloseAccessReviewResponse
+	19, // 33: access_review.v1.AccessReviewService.ExportAccessReviewReport:output_type -> access_review.v1.ExportAccessReviewReportResponse
+	27, // [27:34] is the sub-list for method output_type
+	20, // [20:27] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_access_review_v1_access_review_proto_init() }
+func file_access_review_v1_access_review_proto_init() {
+	if File_access_review_v1_access_review_proto != nil {
+		return
+	}
+	file_access_review_v1_access_review_proto_msgTypes[1].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[2].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[3].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[7].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[8].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[9].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[10].OneofWrappers = []any{}
+	file_access_review_v1_access_review_proto_msgTypes[11].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_access_review_v1_access_review_proto_rawDesc), len(file_access_review_v1_access_review_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_access_review_v1_access_review_proto_goTypes,
+		DependencyIndexes: file_access_review_v1_access_review_proto_depIdxs,
+		EnumInfos:         file_access_review_v1_access_review_proto_enumTypes,
+		MessageInfos:      file_access_review_v1_access_review_proto_msgTypes,
+	}.Build()
+	File_access_review_v1_access_review_proto = out.File
+	file_access_review_v1_access_review_proto_goTypes = nil
+	file_access_review_v1_access_review_proto_depIdxs = nil
+}
@@ -0,0 +1,2623 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: access_review/v1/access_review.proto
+
+package access_review_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _access_review_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on AccessReviewSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessReviewSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessReviewSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessReviewSummaryMultiError, or nil if none found.
+func (m *AccessReviewSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessReviewSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Pending
+
+	// no validation rules for Keep
+
+	// no validation rules for Revoke
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return AccessReviewSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessReviewSummaryMultiError is an error wrapping multiple validation
+// errors returned by AccessReviewSummary.ValidateAll() if the designated
+// constraints aren't met.
+type AccessReviewSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessReviewSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessReviewSummaryMultiError) AllErrors() []error { return m }
+
+// AccessReviewSummaryValidationError is the validation error returned by
+// AccessReviewSummary.Validate if the designated constraints aren't met.
+type AccessReviewSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessReviewSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessReviewSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessReviewSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessReviewSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessReviewSummaryValidationError) ErrorName() string {
+	return "AccessReviewSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessReviewSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessReviewSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessReviewSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessReviewSummaryValidationError{}
+
+// Validate checks the field values on AccessReview with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessReview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessReview with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessReviewMultiError, or
+// nil if none found.
+func (m *AccessReview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessReview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessReviewValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessReviewValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessReviewValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.DueAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDueAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessReviewValidationError{
+						field:  "DueAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessReviewValidationError{
+						field:  "DueAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessReviewValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ClosedBy != nil {
+		// no validation rules for ClosedBy
+	}
+
+	if m.ClosedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetClosedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessReviewValidationError{
+						field:  "ClosedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessReviewValidationError{
+						field:  "ClosedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClosedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessReviewValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessReviewMultiError is an error wrapping multiple validation errors
+// returned by AccessReview.ValidateAll() if the designated constraints aren't met.
+type AccessReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessReviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessReviewMultiError) AllErrors() []error { return m }
+
+// AccessReviewValidationError is the validation error returned by
+// AccessReview.Validate if the designated constraints aren't met.
+type AccessReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessReviewValidationError) ErrorName() string { return "AccessReviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessReview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessReviewValidationError{}
+
+// Validate checks the field values on AccessReviewItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessReviewItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessReviewItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessReviewItemMultiError, or nil if none found.
+func (m *AccessReviewItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessReviewItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CampaignId
+
+	// no validation rules for UserId
+
+	// no validation rules for RoleId
+
+	// no validation rules for Decision
+
+	if m.ReviewedBy != nil {
+		// no validation rules for ReviewedBy
+	}
+
+	if m.ReviewedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReviewedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessReviewItemValidationError{
+						field:  "ReviewedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessReviewItemValidationError{
+						field:  "ReviewedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessReviewItemValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if m.RevokedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetRevokedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccessReviewItemValidationError{
+						field:  "RevokedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccessReviewItemValidationError{
+						field:  "RevokedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccessReviewItemValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AccessReviewItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessReviewItemMultiError is an error wrapping multiple validation errors
+// returned by AccessReviewItem.ValidateAll() if the designated constraints
+// aren't met.
+type AccessReviewItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessReviewItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessReviewItemMultiError) AllErrors() []error { return m }
+
+// AccessReviewItemValidationError is the validation error returned by
+// AccessReviewItem.Validate if the designated constraints aren't met.
+type AccessReviewItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessReviewItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessReviewItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessReviewItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessReviewItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessReviewItemValidationError) ErrorName() string { return "AccessReviewItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessReviewItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessReviewItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessReviewItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessReviewItemValidationError{}
+
+// Validate checks the field values on CreateAccessReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccessReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccessReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccessReviewRequestMultiError, or nil if none found.
+func (m *CreateAccessReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccessReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 200 {
+		err := CreateAccessReviewRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetRoleIds()); l < 1 || l > 20 {
+		err := CreateAccessReviewRequestValidationError{
+			field:  "RoleIds",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateAccessReviewRequest_RoleIds_Unique := make(map[string]struct{}, len(m.GetRoleIds()))
+
+	for idx, item := range m.GetRoleIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateAccessReviewRequest_RoleIds_Unique[item]; exists {
+			err := CreateAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("RoleIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateAccessReviewRequest_RoleIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = CreateAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("RoleIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := len(m.GetReviewerIds()); l < 1 || l > 50 {
+		err := CreateAccessReviewRequestValidationError{
+			field:  "ReviewerIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateAccessReviewRequest_ReviewerIds_Unique := make(map[string]struct{}, len(m.GetReviewerIds()))
+
+	for idx, item := range m.GetReviewerIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateAccessReviewRequest_ReviewerIds_Unique[item]; exists {
+			err := CreateAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("ReviewerIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateAccessReviewRequest_ReviewerIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = CreateAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("ReviewerIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+			err := CreateAccessReviewRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 2000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.DueAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDueAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAccessReviewRequestValidationError{
+						field:  "DueAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAccessReviewRequestValidationError{
+						field:  "DueAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAccessReviewRequestValidationError{
+					field:  "DueAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateAccessReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateAccessReviewRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateAccessReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAccessReviewRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateAccessReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccessReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccessReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateAccessReviewRequestValidationError is the validation error returned by
+// CreateAccessReviewRequest.Validate if the designated constraints aren't met.
+type CreateAccessReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccessReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccessReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccessReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccessReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccessReviewRequestValidationError) ErrorName() string {
+	return "CreateAccessReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccessReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccessReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccessReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccessReviewRequestValidationError{}
+
+// Validate checks the field values on CreateAccessReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccessReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccessReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccessReviewResponseMultiError, or nil if none found.
+func (m *CreateAccessReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccessReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAccessReviewResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAccessReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccessReviewResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateAccessReviewResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateAccessReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccessReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccessReviewResponseMultiError) AllErrors() []error { return m }
+
+// CreateAccessReviewResponseValidationError is the validation error returned
+// by CreateAccessReviewResponse.Validate if the designated constraints aren't met.
+type CreateAccessReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccessReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccessReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccessReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccessReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccessReviewResponseValidationError) ErrorName() string {
+	return "CreateAccessReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccessReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccessReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccessReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccessReviewResponseValidationError{}
+
+// Validate checks the field values on GetAccessReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessReviewRequestMultiError, or nil if none found.
+func (m *GetAccessReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCampaignId()); err != nil {
+		err = GetAccessReviewRequestValidationError{
+			field:  "CampaignId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccessReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAccessReviewRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAccessReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccessReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessReviewRequestMultiError) AllErrors() []error { return m }
+
+// GetAccessReviewRequestValidationError is the validation error returned by
+// GetAccessReviewRequest.Validate if the designated constraints aren't met.
+type GetAccessReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessReviewRequestValidationError) ErrorName() string {
+	return "GetAccessReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessReviewRequestValidationError{}
+
+// Validate checks the field values on GetAccessReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccessReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccessReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccessReviewResponseMultiError, or nil if none found.
+func (m *GetAccessReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccessReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccessReviewResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccessReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccessReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccessReviewResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccessReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccessReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccessReviewResponseMultiError) AllErrors() []error { return m }
+
+// GetAccessReviewResponseValidationError is the validation error returned by
+// GetAccessReviewResponse.Validate if the designated constraints aren't met.
+type GetAccessReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccessReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccessReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccessReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccessReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccessReviewResponseValidationError) ErrorName() string {
+	return "GetAccessReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccessReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccessReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccessReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccessReviewResponseValidationError{}
+
+// Validate checks the field values on ListAccessReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessReviewsRequestMultiError, or nil if none found.
+func (m *ListAccessReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+
+		if _, ok := _ListAccessReviewsRequest_Status_NotInLookup[m.GetStatus()]; ok {
+			err := ListAccessReviewsRequestValidationError{
+				field:  "Status",
+				reason: "value must not be in list [ACCESS_REVIEW_STATUS_UNSPECIFIED]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := AccessReviewStatus_name[int32(m.GetStatus())]; !ok {
+			err := ListAccessReviewsRequestValidationError{
+				field:  "Status",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListAccessReviewsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccessReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAccessReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessReviewsRequestValidationError is the validation error returned by
+// ListAccessReviewsRequest.Validate if the designated constraints aren't met.
+type ListAccessReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessReviewsRequestValidationError) ErrorName() string {
+	return "ListAccessReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessReviewsRequestValidationError{}
+
+var _ListAccessReviewsRequest_Status_NotInLookup = map[AccessReviewStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListAccessReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessReviewsResponseMultiError, or nil if none found.
+func (m *ListAccessReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCampaigns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessReviewsResponseValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessReviewsResponseValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessReviewsResponseValidationError{
+					field:  fmt.Sprintf("Campaigns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for HasMore
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAccessReviewsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListAccessReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessReviewsResponseValidationError is the validation error returned by
+// ListAccessReviewsResponse.Validate if the designated constraints aren't met.
+type ListAccessReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessReviewsResponseValidationError) ErrorName() string {
+	return "ListAccessReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessReviewsResponseValidationError{}
+
+// Validate checks the field values on ListAccessReviewItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessReviewItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessReviewItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessReviewItemsRequestMultiError, or nil if none found.
+func (m *ListAccessReviewItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessReviewItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCampaignId()); err != nil {
+		err = ListAccessReviewItemsRequestValidationError{
+			field:  "CampaignId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Decision != nil {
+
+		if _, ok := _ListAccessReviewItemsRequest_Decision_NotInLookup[m.GetDecision()]; ok {
+			err := ListAccessReviewItemsRequestValidationError{
+				field:  "Decision",
+				reason: "value must not be in list [ACCESS_REVIEW_DECISION_UNSPECIFIED]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := AccessReviewDecision_name[int32(m.GetDecision())]; !ok {
+			err := ListAccessReviewItemsRequestValidationError{
+				field:  "Decision",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 500 {
+			err := ListAccessReviewItemsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 500]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessReviewItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListAccessReviewItemsRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListAccessReviewItemsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAccessReviewItemsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessReviewItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessReviewItemsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessReviewItemsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessReviewItemsRequestValidationError is the validation error returned
+// by ListAccessReviewItemsRequest.Validate if the designated constraints
+// aren't met.
+type ListAccessReviewItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessReviewItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessReviewItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessReviewItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessReviewItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessReviewItemsRequestValidationError) ErrorName() string {
+	return "ListAccessReviewItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessReviewItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessReviewItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessReviewItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessReviewItemsRequestValidationError{}
+
+var _ListAccessReviewItemsRequest_Decision_NotInLookup = map[AccessReviewDecision]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListAccessReviewItemsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessReviewItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessReviewItemsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAccessReviewItemsResponseMultiError, or nil if none found.
+func (m *ListAccessReviewItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessReviewItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessReviewItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessReviewItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessReviewItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for HasMore
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListAccessReviewItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessReviewItemsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessReviewItemsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListAccessReviewItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessReviewItemsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessReviewItemsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessReviewItemsResponseValidationError is the validation error
+// returned by ListAccessReviewItemsResponse.Validate if the designated
+// constraints aren't met.
+type ListAccessReviewItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessReviewItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessReviewItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessReviewItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessReviewItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessReviewItemsResponseValidationError) ErrorName() string {
+	return "ListAccessReviewItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessReviewItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessReviewItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessReviewItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessReviewItemsResponseValidationError{}
+
+// Validate checks the field values on DecideAccessReviewItemRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideAccessReviewItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideAccessReviewItemRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DecideAccessReviewItemRequestMultiError, or nil if none found.
+func (m *DecideAccessReviewItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideAccessReviewItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCampaignId()); err != nil {
+		err = DecideAccessReviewItemRequestValidationError{
+			field:  "CampaignId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetItemId()); err != nil {
+		err = DecideAccessReviewItemRequestValidationError{
+			field:  "ItemId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DecideAccessReviewItemRequest_Decision_InLookup[m.GetDecision()]; !ok {
+		err := DecideAccessReviewItemRequestValidationError{
+			field:  "Decision",
+			reason: "value must be in list [ACCESS_REVIEW_DECISION_KEEP ACCESS_REVIEW_DECISION_REVOKE]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Comment != nil {
+
+		if utf8.RuneCountInString(m.GetComment()) > 2000 {
+			err := DecideAccessReviewItemRequestValidationError{
+				field:  "Comment",
+				reason: "value length must be at most 2000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DecideAccessReviewItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DecideAccessReviewItemRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DecideAccessReviewItemRequestMultiError is an error wrapping multiple
+// validation errors returned by DecideAccessReviewItemRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DecideAccessReviewItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideAccessReviewItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideAccessReviewItemRequestMultiError) AllErrors() []error { return m }
+
+// DecideAccessReviewItemRequestValidationError is the validation error
+// returned by DecideAccessReviewItemRequest.Validate if the designated
+// constraints aren't met.
+type DecideAccessReviewItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideAccessReviewItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideAccessReviewItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideAccessReviewItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideAccessReviewItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideAccessReviewItemRequestValidationError) ErrorName() string {
+	return "DecideAccessReviewItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideAccessReviewItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideAccessReviewItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideAccessReviewItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideAccessReviewItemRequestValidationError{}
+
+var _DecideAccessReviewItemRequest_Decision_InLookup = map[AccessReviewDecision]struct{}{
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on DecideAccessReviewItemResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideAccessReviewItemResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideAccessReviewItemResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DecideAccessReviewItemResponseMultiError, or nil if none found.
+func (m *DecideAccessReviewItemResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideAccessReviewItemResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DecideAccessReviewItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DecideAccessReviewItemResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecideAccessReviewItemResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DecideAccessReviewItemResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecideAccessReviewItemResponseMultiError is an error wrapping multiple
+// validation errors returned by DecideAccessReviewItemResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DecideAccessReviewItemResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideAccessReviewItemResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideAccessReviewItemResponseMultiError) AllErrors() []error { return m }
+
+// DecideAccessReviewItemResponseValidationError is the validation error
+// returned by DecideAccessReviewItemResponse.Validate if the designated
+// constraints aren't met.
+type DecideAccessReviewItemResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideAccessReviewItemResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideAccessReviewItemResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideAccessReviewItemResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideAccessReviewItemResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideAccessReviewItemResponseValidationError) ErrorName() string {
+	return "DecideAccessReviewItemResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideAccessReviewItemResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideAccessReviewItemResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideAccessReviewItemResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideAccessReviewItemResponseValidationError{}
+
+// Validate checks the field values on CloseAccessReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseAccessReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseAccessReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseAccessReviewRequestMultiError, or nil if none found.
+func (m *CloseAccessReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseAccessReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCampaignId()); err != nil {
+		err = CloseAccessReviewRequestValidationError{
+			field:  "CampaignId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RevokeUnreviewed
+
+	if len(errors) > 0 {
+		return CloseAccessReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloseAccessReviewRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloseAccessReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CloseAccessReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CloseAccessReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseAccessReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseAccessReviewRequestMultiError) AllErrors() []error { return m }
+
+// CloseAccessReviewRequestValidationError is the validation error returned by
+// CloseAccessReviewRequest.Validate if the designated constraints aren't met.
+type CloseAccessReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseAccessReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseAccessReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseAccessReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseAccessReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseAccessReviewRequestValidationError) ErrorName() string {
+	return "CloseAccessReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseAccessReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseAccessReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseAccessReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseAccessReviewRequestValidationError{}
+
+// Validate checks the field values on CloseAccessReviewResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseAccessReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseAccessReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseAccessReviewResponseMultiError, or nil if none found.
+func (m *CloseAccessReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseAccessReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloseAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloseAccessReviewResponseValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloseAccessReviewResponseValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloseAccessReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseAccessReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by CloseAccessReviewResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CloseAccessReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseAccessReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseAccessReviewResponseMultiError) AllErrors() []error { return m }
+
+// CloseAccessReviewResponseValidationError is the validation error returned by
+// CloseAccessReviewResponse.Validate if the designated constraints aren't met.
+type CloseAccessReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseAccessReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseAccessReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseAccessReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseAccessReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseAccessReviewResponseValidationError) ErrorName() string {
+	return "CloseAccessReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseAccessReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseAccessReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseAccessReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseAccessReviewResponseValidationError{}
+
+// Validate checks the field values on ExportAccessReviewReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportAccessReviewReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAccessReviewReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportAccessReviewReportRequestMultiError, or nil if none found.
+func (m *ExportAccessReviewReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAccessReviewReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCampaignId()); err != nil {
+		err = ExportAccessReviewReportRequestValidationError{
+			field:  "CampaignId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AccessReviewReportFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportAccessReviewReportRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportAccessReviewReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ExportAccessReviewReportRequest) _validateUuid(uuid string) error {
+	if matched := _access_review_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ExportAccessReviewReportRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportAccessReviewReportRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ExportAccessReviewReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAccessReviewReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAccessReviewReportRequestMultiError) AllErrors() []error { return m }
+
+// ExportAccessReviewReportRequestValidationError is the validation error
+// returned by ExportAccessReviewReportRequest.Validate if the designated
+// constraints aren't met.
+type ExportAccessReviewReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAccessReviewReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAccessReviewReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAccessReviewReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAccessReviewReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAccessReviewReportRequestValidationError) ErrorName() string {
+	return "ExportAccessReviewReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAccessReviewReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAccessReviewReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAccessReviewReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAccessReviewReportRequestValidationError{}
+
+// Validate checks the field values on ExportAccessReviewReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportAccessReviewReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAccessReviewReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportAccessReviewReportResponseMultiError, or nil if none found.
+func (m *ExportAccessReviewReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAccessReviewReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Report
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportAccessReviewReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportAccessReviewReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportAccessReviewReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportAccessReviewReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAccessReviewReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAccessReviewReportResponseMultiError) AllErrors() []error { return m }
+
+// ExportAccessReviewReportResponseValidationError is the validation error
+// returned by ExportAccessReviewReportResponse.Validate if the designated
+// constraints aren't met.
+type ExportAccessReviewReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAccessReviewReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAccessReviewReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAccessReviewReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAccessReviewReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAccessReviewReportResponseValidationError) ErrorName() string {
+	return "ExportAccessReviewReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAccessReviewReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAccessReviewReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAccessReviewReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAccessReviewReportResponseValidationError{}
@@ -302,10 +302,11 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\x04info\x18\x01 \x01(\v2\x15.common.v1.WhoamiInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04info\"\x0f\n" +
 	"\rLogoutRequest\"*\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x9b\x02\n" +
-	"\vAuthService\x12Y\n" +
-	"\x05Login\x12\x15.auth.v1.LoginRequest\x1a\x16.auth.v1.LoginResponse\"!\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12V\n" +
-	"\x06Whoami\x12\x16.auth.v1.WhoamiRequest\x1a\x17.auth.v1.WhoamiResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/auth/whoami\x12Y\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa8\x02\n" +
+	"\vAuthService\x12c\n" +
+	"\x05Login\x12\x15.auth.v1.LoginRequest\x1a\x16.auth.v1.LoginResponse\"+\x90\xb5\x18\x01\x9a\xb5\x18\x06\b\n" +
+	"\x10< \x03\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/auth/login\x12Y\n" +
+	"\x06Whoami\x12\x16.auth.v1.WhoamiRequest\x1a\x17.auth.v1.WhoamiResponse\"\x1e\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/auth/whoami\x90\x02\x01\x12Y\n" +
 	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x17.auth.v1.LogoutResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/logoutBQZOgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/auth/v1;auth_v1b\x06proto3"
 
 var (
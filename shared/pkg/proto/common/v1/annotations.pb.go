@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
+	sync "sync"
 	unsafe "unsafe"
 )
 
@@ -21,6 +22,140 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Признак, по которому считаются запросы при ограничении частоты
+type RateLimitKey int32
+
+const (
+	// Не указан: пользователь, а для анонимных запросов - IP клиента
+	RateLimitKey_RATE_LIMIT_KEY_UNSPECIFIED RateLimitKey = 0
+	// Идентификатор пользователя из сессии
+	RateLimitKey_RATE_LIMIT_KEY_USER RateLimitKey = 1
+	// Идентификатор сессии
+	RateLimitKey_RATE_LIMIT_KEY_SESSION RateLimitKey = 2
+	// IP адрес клиента
+	RateLimitKey_RATE_LIMIT_KEY_IP RateLimitKey = 3
+	// API ключ из заголовка x-api-key
+	RateLimitKey_RATE_LIMIT_KEY_API_KEY RateLimitKey = 4
+)
+
+// Enum value maps for RateLimitKey.
+var (
+	RateLimitKey_name = map[int32]string{
+		0: "RATE_LIMIT_KEY_UNSPECIFIED",
+		1: "RATE_LIMIT_KEY_USER",
+		2: "RATE_LIMIT_KEY_SESSION",
+		3: "RATE_LIMIT_KEY_IP",
+		4: "RATE_LIMIT_KEY_API_KEY",
+	}
+	RateLimitKey_value = map[string]int32{
+		"RATE_LIMIT_KEY_UNSPECIFIED": 0,
+		"RATE_LIMIT_KEY_USER":        1,
+		"RATE_LIMIT_KEY_SESSION":     2,
+		"RATE_LIMIT_KEY_IP":          3,
+		"RATE_LIMIT_KEY_API_KEY":     4,
+	}
+)
+
+func (x RateLimitKey) Enum() *RateLimitKey {
+	p := new(RateLimitKey)
+	*p = x
+	return p
+}
+
+func (x RateLimitKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_annotations_proto_enumTypes[0].Descriptor()
+}
+
+func (RateLimitKey) Type() protoreflect.EnumType {
+	return &file_common_v1_annotations_proto_enumTypes[0]
+}
+
+func (x RateLimitKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitKey.Descriptor instead.
+func (RateLimitKey) EnumDescriptor() ([]byte, []int) {
+	return file_common_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+// Лимит вызовов метода по алгоритму token bucket
+type RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Количество запросов за период
+	Requests uint32 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Длительность периода в секундах
+	PeriodSeconds uint32 `protobuf:"varint,2,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// Допустимый всплеск сверх равномерной скорости (по умолчанию равен requests)
+	Burst uint32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Признак, по которому считаются запросы
+	Key           RateLimitKey `protobuf:"varint,4,opt,name=key,proto3,enum=common.v1.RateLimitKey" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_common_v1_annotations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_annotations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_common_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateLimit) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RateLimit) GetPeriodSeconds() uint32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimit) GetKey() RateLimitKey {
+	if x != nil {
+		return x.Key
+	}
+	return RateLimitKey_RATE_LIMIT_KEY_UNSPECIFIED
+}
+
 var file_common_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -38,6 +173,22 @@ var file_common_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "varint,50002,opt,name=public",
 		Filename:      "common/v1/annotations.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*RateLimit)(nil),
+		Field:         50003,
+		Name:          "common.v1.rate_limit",
+		Tag:           "bytes,50003,opt,name=rate_limit",
+		Filename:      "common/v1/annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50004,
+		Name:          "common.v1.idempotency_key",
+		Tag:           "varint,50004,opt,name=idempotency_key",
+		Filename:      "common/v1/annotations.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MethodOptions.
@@ -50,29 +201,72 @@ var (
 	//
 	// optional bool public = 50002;
 	E_Public = &file_common_v1_annotations_proto_extTypes[1]
+	// Ограничение частоты вызовов метода (перекрывается конфигурацией rate_limit)
+	//
+	// optional common.v1.RateLimit rate_limit = 50003;
+	E_RateLimit = &file_common_v1_annotations_proto_extTypes[2]
+	// Метод принимает заголовок idempotency-key: повтор запроса с тем же ключом
+	// возвращает сохранённый результат вместо повторного выполнения
+	//
+	// optional bool idempotency_key = 50004;
+	E_IdempotencyKey = &file_common_v1_annotations_proto_extTypes[3]
 )
 
 var File_common_v1_annotations_proto protoreflect.FileDescriptor
 
 const file_common_v1_annotations_proto_rawDesc = "" +
 	"\n" +
-	"\x1bcommon/v1/annotations.proto\x12\tcommon.v1\x1a google/protobuf/descriptor.proto:@\n" +
+	"\x1bcommon/v1/annotations.proto\x12\tcommon.v1\x1a google/protobuf/descriptor.proto\"\x8f\x01\n" +
+	"\tRateLimit\x12\x1a\n" +
+	"\brequests\x18\x01 \x01(\rR\brequests\x12%\n" +
+	"\x0eperiod_seconds\x18\x02 \x01(\rR\rperiodSeconds\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\rR\x05burst\x12)\n" +
+	"\x03key\x18\x04 \x01(\x0e2\x17.common.v1.RateLimitKeyR\x03key*\x96\x01\n" +
+	"\fRateLimitKey\x12\x1e\n" +
+	"\x1aRATE_LIMIT_KEY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13RATE_LIMIT_KEY_USER\x10\x01\x12\x1a\n" +
+	"\x16RATE_LIMIT_KEY_SESSION\x10\x02\x12\x15\n" +
+	"\x11RATE_LIMIT_KEY_IP\x10\x03\x12\x1a\n" +
+	"\x16RATE_LIMIT_KEY_API_KEY\x10\x04:@\n" +
 	"\n" +
 	"permission\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\tR\n" +
 	"permission:8\n" +
-	"\x06public\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\bR\x06publicBUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1;common_v1b\x06proto3"
+	"\x06public\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\bR\x06public:U\n" +
+	"\n" +
+	"rate_limit\x12\x1e.google.protobuf.MethodOptions\x18ӆ\x03 \x01(\v2\x14.common.v1.RateLimitR\trateLimit:I\n" +
+	"\x0fidempotency_key\x12\x1e.google.protobuf.MethodOptions\x18Ԇ\x03 \x01(\bR\x0eidempotencyKeyBUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1;common_v1b\x06proto3"
+
+var (
+	file_common_v1_annotations_proto_rawDescOnce sync.Once
+	file_common_v1_annotations_proto_rawDescData []byte
+)
+
+func file_common_v1_annotations_proto_rawDescGZIP() []byte {
+	file_common_v1_annotations_proto_rawDescOnce.Do(func() {
+		file_common_v1_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_v1_annotations_proto_rawDesc), len(file_common_v1_annotations_proto_rawDesc)))
+	})
+	return file_common_v1_annotations_proto_rawDescData
+}
 
+var file_common_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_common_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_common_v1_annotations_proto_goTypes = []any{
-	(*descriptorpb.MethodOptions)(nil), // 0: google.protobuf.MethodOptions
+	(RateLimitKey)(0),                  // 0: common.v1.RateLimitKey
+	(*RateLimit)(nil),                  // 1: common.v1.RateLimit
+	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
 }
 var file_common_v1_annotations_proto_depIdxs = []int32{
-	0, // 0: common.v1.permission:extendee -> google.protobuf.MethodOptions
-	0, // 1: common.v1.public:extendee -> google.protobuf.MethodOptions
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	0, // [0:2] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: common.v1.RateLimit.key:type_name -> common.v1.RateLimitKey
+	2, // 1: common.v1.permission:extendee -> google.protobuf.MethodOptions
+	2, // 2: common.v1.public:extendee -> google.protobuf.MethodOptions
+	2, // 3: common.v1.rate_limit:extendee -> google.protobuf.MethodOptions
+	2, // 4: common.v1.idempotency_key:extendee -> google.protobuf.MethodOptions
+	1, // 5: common.v1.rate_limit:type_name -> common.v1.RateLimit
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	5, // [5:6] is the sub-list for extension type_name
+	1, // [1:5] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_annotations_proto_init() }
@@ -85,13 +279,15 @@ func file_common_v1_annotations_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_annotations_proto_rawDesc), len(file_common_v1_annotations_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   0,
-			NumExtensions: 2,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_v1_annotations_proto_goTypes,
 		DependencyIndexes: file_common_v1_annotations_proto_depIdxs,
+		EnumInfos:         file_common_v1_annotations_proto_enumTypes,
+		MessageInfos:      file_common_v1_annotations_proto_msgTypes,
 		ExtensionInfos:    file_common_v1_annotations_proto_extTypes,
 	}.Build()
 	File_common_v1_annotations_proto = out.File
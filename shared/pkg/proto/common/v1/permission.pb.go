@@ -22,12 +22,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Эффект права доступа в составе роли
+type PermissionEffect int32
+
+const (
+	PermissionEffect_PERMISSION_EFFECT_UNSPECIFIED PermissionEffect = 0 // Трактуется как разрешение
+	PermissionEffect_PERMISSION_EFFECT_ALLOW       PermissionEffect = 1 // Разрешение
+	PermissionEffect_PERMISSION_EFFECT_DENY        PermissionEffect = 2 // Явный запрет, имеет приоритет над разрешениями
+)
+
+// Enum value maps for PermissionEffect.
+var (
+	PermissionEffect_name = map[int32]string{
+		0: "PERMISSION_EFFECT_UNSPECIFIED",
+		1: "PERMISSION_EFFECT_ALLOW",
+		2: "PERMISSION_EFFECT_DENY",
+	}
+	PermissionEffect_value = map[string]int32{
+		"PERMISSION_EFFECT_UNSPECIFIED": 0,
+		"PERMISSION_EFFECT_ALLOW":       1,
+		"PERMISSION_EFFECT_DENY":        2,
+	}
+)
+
+func (x PermissionEffect) Enum() *PermissionEffect {
+	p := new(PermissionEffect)
+	*p = x
+	return p
+}
+
+func (x PermissionEffect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionEffect) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_permission_proto_enumTypes[0].Descriptor()
+}
+
+func (PermissionEffect) Type() protoreflect.EnumType {
+	return &file_common_v1_permission_proto_enumTypes[0]
+}
+
+func (x PermissionEffect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionEffect.Descriptor instead.
+func (PermissionEffect) EnumDescriptor() ([]byte, []int) {
+	return file_common_v1_permission_proto_rawDescGZIP(), []int{0}
+}
+
 // Право доступа
+// resource и action поддерживают шаблон "*" (например, schedule:* или *:read)
+// condition — необязательное условие (ABAC), при котором право действует
 type Permission struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
 	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Effect        PermissionEffect       `protobuf:"varint,4,opt,name=effect,proto3,enum=common.v1.PermissionEffect" json:"effect,omitempty"`
+	Condition     string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -83,16 +137,36 @@ func (x *Permission) GetAction() string {
 	return ""
 }
 
+func (x *Permission) GetEffect() PermissionEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return PermissionEffect_PERMISSION_EFFECT_UNSPECIFIED
+}
+
+func (x *Permission) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
 var File_common_v1_permission_proto protoreflect.FileDescriptor
 
 const file_common_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1acommon/v1/permission.proto\x12\tcommon.v1\x1a\x17validate/validate.proto\"p\n" +
+	"\x1acommon/v1/permission.proto\x12\tcommon.v1\x1a\x17validate/validate.proto\"\xd7\x01\n" +
 	"\n" +
 	"Permission\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12%\n" +
 	"\bresource\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\bresource\x12!\n" +
-	"\x06action\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06actionBUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1;common_v1b\x06proto3"
+	"\x06action\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x06action\x12=\n" +
+	"\x06effect\x18\x04 \x01(\x0e2\x1b.common.v1.PermissionEffectB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06effect\x12&\n" +
+	"\tcondition\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\tcondition*n\n" +
+	"\x10PermissionEffect\x12!\n" +
+	"\x1dPERMISSION_EFFECT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PERMISSION_EFFECT_ALLOW\x10\x01\x12\x1a\n" +
+	"\x16PERMISSION_EFFECT_DENY\x10\x02BUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1;common_v1b\x06proto3"
 
 var (
 	file_common_v1_permission_proto_rawDescOnce sync.Once
@@ -106,16 +180,19 @@ func file_common_v1_permission_proto_rawDescGZIP() []byte {
 	return file_common_v1_permission_proto_rawDescData
 }
 
+var file_common_v1_permission_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_common_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_common_v1_permission_proto_goTypes = []any{
-	(*Permission)(nil), // 0: common.v1.Permission
+	(PermissionEffect)(0), // 0: common.v1.PermissionEffect
+	(*Permission)(nil),    // 1: common.v1.Permission
 }
 var file_common_v1_permission_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: common.v1.Permission.effect:type_name -> common.v1.PermissionEffect
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_permission_proto_init() }
@@ -128,13 +205,14 @@ func file_common_v1_permission_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_permission_proto_rawDesc), len(file_common_v1_permission_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_v1_permission_proto_goTypes,
 		DependencyIndexes: file_common_v1_permission_proto_depIdxs,
+		EnumInfos:         file_common_v1_permission_proto_enumTypes,
 		MessageInfos:      file_common_v1_permission_proto_msgTypes,
 	}.Build()
 	File_common_v1_permission_proto = out.File
@@ -94,6 +94,28 @@ func (m *Permission) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := PermissionEffect_name[int32(m.GetEffect())]; !ok {
+		err := PermissionValidationError{
+			field:  "Effect",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCondition()) > 1000 {
+		err := PermissionValidationError{
+			field:  "Condition",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return PermissionMultiError(errors)
 	}
@@ -25,14 +25,21 @@ const (
 
 // Роль пользователя
 type Role struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	// Встроенная роль: удаление и переименование запрещены
+	IsSystem  bool                   `protobuf:"varint,6,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// Роль назначается только через согласованную заявку на доступ
+	RequiresApproval bool `protobuf:"varint,8,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	// Роль, участники которой согласуют заявки на эту роль
+	ApproverRoleId *string `protobuf:"bytes,9,opt,name=approver_role_id,json=approverRoleId,proto3,oneof" json:"approver_role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Role) Reset() {
@@ -100,6 +107,34 @@ func (x *Role) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Role) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *Role) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Role) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Role) GetApproverRoleId() string {
+	if x != nil && x.ApproverRoleId != nil {
+		return *x.ApproverRoleId
+	}
+	return ""
+}
+
 // Роль с правами доступа
 type RoleWithPermissions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -157,7 +192,7 @@ var File_common_v1_role_proto protoreflect.FileDescriptor
 
 const file_common_v1_role_proto_rawDesc = "" +
 	"\n" +
-	"\x14common/v1/role.proto\x12\tcommon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1acommon/v1/permission.proto\"\xeb\x01\n" +
+	"\x14common/v1/role.proto\x12\tcommon.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\x1acommon/v1/permission.proto\"\xc8\x03\n" +
 	"\x04Role\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
 	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182R\x04name\x12 \n" +
@@ -165,8 +200,15 @@ const file_common_v1_role_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tupdatedAt\x88\x01\x01B\r\n" +
-	"\v_updated_at\"s\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\tis_system\x18\x06 \x01(\bR\bisSystem\x12>\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdeletedAt\x88\x01\x01\x12+\n" +
+	"\x11requires_approval\x18\b \x01(\bR\x10requiresApproval\x12-\n" +
+	"\x10approver_role_id\x18\t \x01(\tH\x02R\x0eapproverRoleId\x88\x01\x01B\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_deleted_atB\x13\n" +
+	"\x11_approver_role_id\"s\n" +
 	"\x13RoleWithPermissions\x12#\n" +
 	"\x04role\x18\x01 \x01(\v2\x0f.common.v1.RoleR\x04role\x127\n" +
 	"\vpermissions\x18\x02 \x03(\v2\x15.common.v1.PermissionR\vpermissionsBUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1;common_v1b\x06proto3"
@@ -193,13 +235,14 @@ var file_common_v1_role_proto_goTypes = []any{
 var file_common_v1_role_proto_depIdxs = []int32{
 	2, // 0: common.v1.Role.created_at:type_name -> google.protobuf.Timestamp
 	2, // 1: common.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: common.v1.RoleWithPermissions.role:type_name -> common.v1.Role
-	3, // 3: common.v1.RoleWithPermissions.permissions:type_name -> common.v1.Permission
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 2: common.v1.Role.deleted_at:type_name -> google.protobuf.Timestamp
+	0, // 3: common.v1.RoleWithPermissions.role:type_name -> common.v1.Role
+	3, // 4: common.v1.RoleWithPermissions.permissions:type_name -> common.v1.Permission
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_role_proto_init() }
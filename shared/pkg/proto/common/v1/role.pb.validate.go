@@ -113,6 +113,10 @@ func (m *Role) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IsSystem
+
+	// no validation rules for RequiresApproval
+
 	if m.UpdatedAt != nil {
 
 		if all {
@@ -146,6 +150,43 @@ func (m *Role) validate(all bool) error {
 
 	}
 
+	if m.DeletedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeletedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleValidationError{
+						field:  "DeletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleValidationError{
+						field:  "DeletedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ApproverRoleId != nil {
+		// no validation rules for ApproverRoleId
+	}
+
 	if len(errors) > 0 {
 		return RoleMultiError(errors)
 	}
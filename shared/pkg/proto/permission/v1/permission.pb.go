@@ -111,9 +111,9 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"\x1epermission/v1/permission.proto\x12\rpermission.v1\x1a\x1acommon/v1/permission.proto\x1a\x1bcommon/v1/annotations.proto\"\r\n" +
 	"\vListRequest\"9\n" +
 	"\fListResponse\x12)\n" +
-	"\x04data\x18\x01 \x03(\v2\x15.common.v1.PermissionR\x04data2i\n" +
-	"\x11PermissionService\x12T\n" +
-	"\x04List\x12\x1a.permission.v1.ListRequest\x1a\x1b.permission.v1.ListResponse\"\x13\x8a\xb5\x18\x0fpermission:readB]Z[github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/permission/v1;permission_v1b\x06proto3"
+	"\x04data\x18\x01 \x03(\v2\x15.common.v1.PermissionR\x04data2l\n" +
+	"\x11PermissionService\x12W\n" +
+	"\x04List\x12\x1a.permission.v1.ListRequest\x1a\x1b.permission.v1.ListResponse\"\x16\x8a\xb5\x18\x0fpermission:read\x90\x02\x01B]Z[github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/permission/v1;permission_v1b\x06proto3"
 
 var (
 	file_permission_v1_permission_proto_rawDescOnce sync.Once
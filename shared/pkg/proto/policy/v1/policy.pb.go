@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: policy/v1/policy.proto
+
+package policy_v1
+
+import (
+	v1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Формат документа политики
+type PolicyFormat int32
+
+const (
+	// По умолчанию — YAML
+	PolicyFormat_POLICY_FORMAT_UNSPECIFIED PolicyFormat = 0
+	PolicyFormat_POLICY_FORMAT_YAML        PolicyFormat = 1
+	PolicyFormat_POLICY_FORMAT_JSON        PolicyFormat = 2
+)
+
+// Enum value maps for PolicyFormat.
+var (
+	PolicyFormat_name = map[int32]string{
+		0: "POLICY_FORMAT_UNSPECIFIED",
+		1: "POLICY_FORMAT_YAML",
+		2: "POLICY_FORMAT_JSON",
+	}
+	PolicyFormat_value = map[string]int32{
+		"POLICY_FORMAT_UNSPECIFIED": 0,
+		"POLICY_FORMAT_YAML":        1,
+		"POLICY_FORMAT_JSON":        2,
+	}
+)
+
+func (x PolicyFormat) Enum() *PolicyFormat {
+	p := new(PolicyFormat)
+	*p = x
+	return p
+}
+
+func (x PolicyFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_policy_v1_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyFormat) Type() protoreflect.EnumType {
+	return &file_policy_v1_policy_proto_enumTypes[0]
+}
+
+func (x PolicyFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyFormat.Descriptor instead.
+func (PolicyFormat) EnumDescriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{0}
+}
+
+// Тип изменения в плане применения политики
+type PolicyChangeType int32
+
+const (
+	PolicyChangeType_POLICY_CHANGE_TYPE_UNSPECIFIED       PolicyChangeType = 0
+	PolicyChangeType_POLICY_CHANGE_TYPE_CREATE_PERMISSION PolicyChangeType = 1
+	PolicyChangeType_POLICY_CHANGE_TYPE_CREATE_ROLE       PolicyChangeType = 2
+	PolicyChangeType_POLICY_CHANGE_TYPE_UPDATE_ROLE       PolicyChangeType = 3
+	PolicyChangeType_POLICY_CHANGE_TYPE_DELETE_ROLE       PolicyChangeType = 4
+	PolicyChangeType_POLICY_CHANGE_TYPE_ADD_GRANT         PolicyChangeType = 5
+	PolicyChangeType_POLICY_CHANGE_TYPE_UPDATE_GRANT      PolicyChangeType = 6
+	PolicyChangeType_POLICY_CHANGE_TYPE_REMOVE_GRANT      PolicyChangeType = 7
+)
+
+// Enum value maps for PolicyChangeType.
+var (
+	PolicyChangeType_name = map[int32]string{
+		0: "POLICY_CHANGE_TYPE_UNSPECIFIED",
+		1: "POLICY_CHANGE_TYPE_CREATE_PERMISSION",
+		2: "POLICY_CHANGE_TYPE_CREATE_ROLE",
+		3: "POLICY_CHANGE_TYPE_UPDATE_ROLE",
+		4: "POLICY_CHANGE_TYPE_DELETE_ROLE",
+		5: "POLICY_CHANGE_TYPE_ADD_GRANT",
+		6: "POLICY_CHANGE_TYPE_UPDATE_GRANT",
+		7: "POLICY_CHANGE_TYPE_REMOVE_GRANT",
+	}
+	PolicyChangeType_value = map[string]int32{
+		"POLICY_CHANGE_TYPE_UNSPECIFIED":       0,
+		"POLICY_CHANGE_TYPE_CREATE_PERMISSION": 1,
+		"POLICY_CHANGE_TYPE_CREATE_ROLE":       2,
+		"POLICY_CHANGE_TYPE_UPDATE_ROLE":       3,
+		"POLICY_CHANGE_TYPE_DELETE_ROLE":       4,
+		"POLICY_CHANGE_TYPE_ADD_GRANT":         5,
+		"POLICY_CHANGE_TYPE_UPDATE_GRANT":      6,
+		"POLICY_CHANGE_TYPE_REMOVE_GRANT":      7,
+	}
+)
+
+func (x PolicyChangeType) Enum() *PolicyChangeType {
+	p := new(PolicyChangeType)
+	*p = x
+	return p
+}
+
+func (x PolicyChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_policy_v1_policy_proto_enumTypes[1].Descriptor()
+}
+
+func (PolicyChangeType) Type() protoreflect.EnumType {
+	return &file_policy_v1_policy_proto_enumTypes[1]
+}
+
+func (x PolicyChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyChangeType.Descriptor instead.
+func (PolicyChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{1}
+}
+
+// Изменение в плане применения политики
+type PolicyChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PolicyChangeType       `protobuf:"varint,1,opt,name=type,proto3,enum=policy.v1.PolicyChangeType" json:"type,omitempty"`
+	// Имя роли (для изменений ролей и назначений)
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Право в формате resource:action (для изменений прав и назначений)
+	Permission string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Новое описание роли
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Эффект и условие назначения после изменения
+	Effect    v1.PermissionEffect `protobuf:"varint,5,opt,name=effect,proto3,enum=common.v1.PermissionEffect" json:"effect,omitempty"`
+	Condition *string             `protobuf:"bytes,6,opt,name=condition,proto3,oneof" json:"condition,omitempty"`
+	// Настройки согласования роли после изменения (для создания и изменения ролей)
+	RequiresApproval bool `protobuf:"varint,7,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	// Имя роли согласующих; пустое значение снимает согласующих
+	Approver      string `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyChange) Reset() {
+	*x = PolicyChange{}
+	mi := &file_policy_v1_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyChange) ProtoMessage() {}
+
+func (x *PolicyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyChange.ProtoReflect.Descriptor instead.
+func (*PolicyChange) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PolicyChange) GetType() PolicyChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return PolicyChangeType_POLICY_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *PolicyChange) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PolicyChange) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *PolicyChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PolicyChange) GetEffect() v1.PermissionEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return v1.PermissionEffect(0)
+}
+
+func (x *PolicyChange) GetCondition() string {
+	if x != nil && x.Condition != nil {
+		return *x.Condition
+	}
+	return ""
+}
+
+func (x *PolicyChange) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *PolicyChange) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+// Запрос на выгрузку политики
+type ExportPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        PolicyFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=policy.v1.PolicyFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPolicyRequest) Reset() {
+	*x = ExportPolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPolicyRequest) ProtoMessage() {}
+
+func (x *ExportPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ExportPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportPolicyRequest) GetFormat() PolicyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PolicyFormat_POLICY_FORMAT_UNSPECIFIED
+}
+
+// Документ политики в запрошенном формате
+type ExportPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      []byte                 `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Format        PolicyFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=policy.v1.PolicyFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPolicyResponse) Reset() {
+	*x = ExportPolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPolicyResponse) ProtoMessage() {}
+
+func (x *ExportPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ExportPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportPolicyResponse) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ExportPolicyResponse) GetFormat() PolicyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PolicyFormat_POLICY_FORMAT_UNSPECIFIED
+}
+
+// Запрос на расчет плана применения политики
+type PlanPolicyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Document []byte                 `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Format   PolicyFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=policy.v1.PolicyFormat" json:"format,omitempty"`
+	// Удалять роли, отсутствующие в документе (системные роли не удаляются никогда)
+	Prune         bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPolicyRequest) Reset() {
+	*x = PlanPolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPolicyRequest) ProtoMessage() {}
+
+func (x *PlanPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PlanPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanPolicyRequest) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *PlanPolicyRequest) GetFormat() PolicyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PolicyFormat_POLICY_FORMAT_UNSPECIFIED
+}
+
+func (x *PlanPolicyRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// План применения политики
+type PlanPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*PolicyChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanPolicyResponse) Reset() {
+	*x = PlanPolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanPolicyResponse) ProtoMessage() {}
+
+func (x *PlanPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PlanPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlanPolicyResponse) GetChanges() []*PolicyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Запрос на применение политики
+type ApplyPolicyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Document []byte                 `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Format   PolicyFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=policy.v1.PolicyFormat" json:"format,omitempty"`
+	// Удалять роли, отсутствующие в документе (системные роли не удаляются никогда)
+	Prune         bool `protobuf:"varint,3,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPolicyRequest) Reset() {
+	*x = ApplyPolicyRequest{}
+	mi := &file_policy_v1_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPolicyRequest) ProtoMessage() {}
+
+func (x *ApplyPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPolicyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplyPolicyRequest) GetDocument() []byte {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ApplyPolicyRequest) GetFormat() PolicyFormat {
+	if x != nil {
+		return x.Format
+	}
+	return PolicyFormat_POLICY_FORMAT_UNSPECIFIED
+}
+
+func (x *ApplyPolicyRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// Примененные изменения
+type ApplyPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*PolicyChange        `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPolicyResponse) Reset() {
+	*x = ApplyPolicyResponse{}
+	mi := &file_policy_v1_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPolicyResponse) ProtoMessage() {}
+
+func (x *ApplyPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_policy_v1_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_policy_v1_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplyPolicyResponse) GetChanges() []*PolicyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_policy_v1_policy_proto protoreflect.FileDescriptor
+
+const file_policy_v1_policy_proto_rawDesc = "" +
+	"\n" +
+	"\x16policy/v1/policy.proto\x12\tpolicy.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\x1a\x1acommon/v1/permission.proto\"\xc4\x02\n" +
+	"\fPolicyChange\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.policy.v1.PolicyChangeTypeR\x04type\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x123\n" +
+	"\x06effect\x18\x05 \x01(\x0e2\x1b.common.v1.PermissionEffectR\x06effect\x12!\n" +
+	"\tcondition\x18\x06 \x01(\tH\x00R\tcondition\x88\x01\x01\x12+\n" +
+	"\x11requires_approval\x18\a \x01(\bR\x10requiresApproval\x12\x1a\n" +
+	"\bapprover\x18\b \x01(\tR\bapproverB\f\n" +
+	"\n" +
+	"_condition\"P\n" +
+	"\x13ExportPolicyRequest\x129\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x17.policy.v1.PolicyFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\"c\n" +
+	"\x14ExportPolicyResponse\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\fR\bdocument\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.policy.v1.PolicyFormatR\x06format\"\x8e\x01\n" +
+	"\x11PlanPolicyRequest\x12(\n" +
+	"\bdocument\x18\x01 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\x80\x02R\bdocument\x129\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.policy.v1.PolicyFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\"G\n" +
+	"\x12PlanPolicyResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.policy.v1.PolicyChangeR\achanges\"\x8f\x01\n" +
+	"\x12ApplyPolicyRequest\x12(\n" +
+	"\bdocument\x18\x01 \x01(\fB\f\xfaB\tz\a\x10\x01\x18\x80\x80\x80\x02R\bdocument\x129\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.policy.v1.PolicyFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\x12\x14\n" +
+	"\x05prune\x18\x03 \x01(\bR\x05prune\"H\n" +
+	"\x13ApplyPolicyResponse\x121\n" +
+	"\achanges\x18\x01 \x03(\v2\x17.policy.v1.PolicyChangeR\achanges*]\n" +
+	"\fPolicyFormat\x12\x1d\n" +
+	"\x19POLICY_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12POLICY_FORMAT_YAML\x10\x01\x12\x16\n" +
+	"\x12POLICY_FORMAT_JSON\x10\x02*\xb8\x02\n" +
+	"\x10PolicyChangeType\x12\"\n" +
+	"\x1ePOLICY_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12(\n" +
+	"$POLICY_CHANGE_TYPE_CREATE_PERMISSION\x10\x01\x12\"\n" +
+	"\x1ePOLICY_CHANGE_TYPE_CREATE_ROLE\x10\x02\x12\"\n" +
+	"\x1ePOLICY_CHANGE_TYPE_UPDATE_ROLE\x10\x03\x12\"\n" +
+	"\x1ePOLICY_CHANGE_TYPE_DELETE_ROLE\x10\x04\x12 \n" +
+	"\x1cPOLICY_CHANGE_TYPE_ADD_GRANT\x10\x05\x12#\n" +
+	"\x1fPOLICY_CHANGE_TYPE_UPDATE_GRANT\x10\x06\x12#\n" +
+	"\x1fPOLICY_CHANGE_TYPE_REMOVE_GRANT\x10\a2\x8e\x03\n" +
+	"\rPolicyService\x12\x80\x01\n" +
+	"\fExportPolicy\x12\x1e.policy.v1.ExportPolicyRequest\x1a\x1f.policy.v1.ExportPolicyResponse\"/\x8a\xb5\x18\vpolicy:read\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/policy:export\x90\x02\x01\x12{\n" +
+	"\n" +
+	"PlanPolicy\x12\x1c.policy.v1.PlanPolicyRequest\x1a\x1d.policy.v1.PlanPolicyResponse\"0\x8a\xb5\x18\vpolicy:read\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/policy:plan\x90\x02\x01\x12}\n" +
+	"\vApplyPolicy\x12\x1d.policy.v1.ApplyPolicyRequest\x1a\x1e.policy.v1.ApplyPolicyResponse\"/\x8a\xb5\x18\fpolicy:write\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/policy:applyBUZSgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/policy/v1;policy_v1b\x06proto3"
+
+var (
+	file_policy_v1_policy_proto_rawDescOnce sync.Once
+	file_policy_v1_policy_proto_rawDescData []byte
+)
+
+func file_policy_v1_policy_proto_rawDescGZIP() []byte {
+	file_policy_v1_policy_proto_rawDescOnce.Do(func() {
+		file_policy_v1_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_policy_v1_policy_proto_rawDesc), len(file_policy_v1_policy_proto_rawDesc)))
+	})
+	return file_policy_v1_policy_proto_rawDescData
+}
+
+var file_policy_v1_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_policy_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_policy_v1_policy_proto_goTypes = []any{
+	(PolicyFormat)(0),            // 0: policy.v1.PolicyFormat
+	(PolicyChangeType)(0),        // 1: policy.v1.PolicyChangeType
+	(*PolicyChange)(nil),         // 2: policy.v1.PolicyChange
+	(*ExportPolicyRequest)(nil),  // 3: policy.v1.ExportPolicyRequest
+	(*ExportPolicyResponse)(nil), // 4: policy.v1.ExportPolicyResponse
+	(*PlanPolicyRequest)(nil),    // 5: policy.v1.PlanPolicyRequest
+	(*PlanPolicyResponse)(nil),   // 6: policy.v1.PlanPolicyResponse
+	(*ApplyPolicyRequest)(nil),   // 7: policy.v1.ApplyPolicyRequest
+	(*ApplyPolicyResponse)(nil),  // 8: policy.v1.ApplyPolicyResponse
+	(v1.PermissionEffect)(0),     // 9: common.v1.PermissionEffect
+}
+var file_policy_v1_policy_proto_depIdxs = []int32{
+	1,  // 0: policy.v1.PolicyChange.type:type_name -> policy.v1.PolicyChangeType
+	9,  // 1: policy.v1.PolicyChange.effect:type_name -> common.v1.PermissionEffect
+	0,  // 2: policy.v1.ExportPolicyRequest.format:type_name -> policy.v1.PolicyFormat
+	0,  // 3: policy.v1.ExportPolicyResponse.format:type_name -> policy.v1.PolicyFormat
+	0,  // 4: policy.v1.PlanPolicyRequest.format:type_name -> policy.v1.PolicyFormat
+	2,  // 5: policy.v1.PlanPolicyResponse.changes:type_name -> policy.v1.PolicyChange
+	0,  // 6: policy.v1.ApplyPolicyRequest.format:type_name -> policy.v1.PolicyFormat
+	2,  // 7: policy.v1.ApplyPolicyResponse.changes:type_name -> policy.v1.PolicyChange
+	3,  // 8: policy.v1.PolicyService.ExportPolicy:input_type -> policy.v1.ExportPolicyRequest
+	5,  // 9: policy.v1.PolicyService.PlanPolicy:input_type -> policy.v1.PlanPolicyRequest
+	7,  // 10: policy.v1.PolicyService.ApplyPolicy:input_type -> policy.v1.ApplyPolicyRequest
+	4,  // 11: policy.v1.PolicyService.ExportPolicy:output_type -> policy.v1.ExportPolicyResponse
+	6,  // 12: policy.v1.PolicyService.PlanPolicy:output_type -> policy.v1.PlanPolicyResponse
+	8,  // 13: policy.v1.PolicyService.ApplyPolicy:output_type -> policy.v1.ApplyPolicyResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_policy_v1_policy_proto_init() }
+func file_policy_v1_policy_proto_init() {
+	if File_policy_v1_policy_proto != nil {
+		return
+	}
+	file_policy_v1_policy_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_policy_v1_policy_proto_rawDesc), len(file_policy_v1_policy_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_policy_v1_policy_proto_goTypes,
+		DependencyIndexes: file_policy_v1_policy_proto_depIdxs,
+		EnumInfos:         file_policy_v1_policy_proto_enumTypes,
+		MessageInfos:      file_policy_v1_policy_proto_msgTypes,
+	}.Build()
+	File_policy_v1_policy_proto = out.File
+	file_policy_v1_policy_proto_goTypes = nil
+	file_policy_v1_policy_proto_depIdxs = nil
+}
@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: policy/v1/policy.proto
+
+package policy_v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	common_v1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = common_v1.PermissionEffect(0)
+)
+
+// Validate checks the field values on PolicyChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyChangeMultiError, or
+// nil if none found.
+func (m *PolicyChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Role
+
+	// no validation rules for Permission
+
+	// no validation rules for Description
+
+	// no validation rules for Effect
+
+	if m.Condition != nil {
+		// no validation rules for Condition
+	}
+
+	// no validation rules for RequiresApproval
+
+	// no validation rules for Approver
+
+	if len(errors) > 0 {
+		return PolicyChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyChangeMultiError is an error wrapping multiple validation errors
+// returned by PolicyChange.ValidateAll() if the designated constraints aren't met.
+type PolicyChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyChangeMultiError) AllErrors() []error { return m }
+
+// PolicyChangeValidationError is the validation error returned by
+// PolicyChange.Validate if the designated constraints aren't met.
+type PolicyChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyChangeValidationError) ErrorName() string { return "PolicyChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyChangeValidationError{}
+
+// Validate checks the field values on ExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicyRequestMultiError, or nil if none found.
+func (m *ExportPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := PolicyFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportPolicyRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ExportPolicyRequestValidationError is the validation error returned by
+// ExportPolicyRequest.Validate if the designated constraints aren't met.
+type ExportPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicyRequestValidationError) ErrorName() string {
+	return "ExportPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicyRequestValidationError{}
+
+// Validate checks the field values on ExportPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPolicyResponseMultiError, or nil if none found.
+func (m *ExportPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Document
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ExportPolicyResponseValidationError is the validation error returned by
+// ExportPolicyResponse.Validate if the designated constraints aren't met.
+type ExportPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPolicyResponseValidationError) ErrorName() string {
+	return "ExportPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPolicyResponseValidationError{}
+
+// Validate checks the field values on PlanPolicyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlanPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanPolicyRequestMultiError, or nil if none found.
+func (m *PlanPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetDocument()); l < 1 || l > 4194304 {
+		err := PlanPolicyRequestValidationError{
+			field:  "Document",
+			reason: "value length must be between 1 and 4194304 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PolicyFormat_name[int32(m.GetFormat())]; !ok {
+		err := PlanPolicyRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prune
+
+	if len(errors) > 0 {
+		return PlanPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by PlanPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlanPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPolicyRequestMultiError) AllErrors() []error { return m }
+
+// PlanPolicyRequestValidationError is the validation error returned by
+// PlanPolicyRequest.Validate if the designated constraints aren't met.
+type PlanPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPolicyRequestValidationError) ErrorName() string {
+	return "PlanPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPolicyRequestValidationError{}
+
+// Validate checks the field values on PlanPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanPolicyResponseMultiError, or nil if none found.
+func (m *PlanPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanPolicyResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanPolicyResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanPolicyResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanPolicyResponseMultiError is an error wrapping multiple validation errors
+// returned by PlanPolicyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PlanPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanPolicyResponseMultiError) AllErrors() []error { return m }
+
+// PlanPolicyResponseValidationError is the validation error returned by
+// PlanPolicyResponse.Validate if the designated constraints aren't met.
+type PlanPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanPolicyResponseValidationError) ErrorName() string {
+	return "PlanPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanPolicyResponseValidationError{}
+
+// Validate checks the field values on ApplyPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyPolicyRequestMultiError, or nil if none found.
+func (m *ApplyPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetDocument()); l < 1 || l > 4194304 {
+		err := ApplyPolicyRequestValidationError{
+			field:  "Document",
+			reason: "value length must be between 1 and 4194304 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PolicyFormat_name[int32(m.GetFormat())]; !ok {
+		err := ApplyPolicyRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prune
+
+	if len(errors) > 0 {
+		return ApplyPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyPolicyRequestMultiError is an error wrapping multiple validation errors
+// returned by ApplyPolicyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApplyPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyPolicyRequestMultiError) AllErrors() []error { return m }
+
+// ApplyPolicyRequestValidationError is the validation error returned by
+// ApplyPolicyRequest.Validate if the designated constraints aren't met.
+type ApplyPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPolicyRequestValidationError) ErrorName() string {
+	return "ApplyPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPolicyRequestValidationError{}
+
+// Validate checks the field values on ApplyPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyPolicyResponseMultiError, or nil if none found.
+func (m *ApplyPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyPolicyResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyPolicyResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplyPolicyResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApplyPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by ApplyPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyPolicyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyPolicyResponseMultiError) AllErrors() []error { return m }
+
+// ApplyPolicyResponseValidationError is the validation error returned by
+// ApplyPolicyResponse.Validate if the designated constraints aren't met.
+type ApplyPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPolicyResponseValidationError) ErrorName() string {
+	return "ApplyPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPolicyResponseValidationError{}
@@ -25,6 +25,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Состояние ролей в списке
+type RoleStatus int32
+
+const (
+	// Только активные роли
+	RoleStatus_ROLE_STATUS_UNSPECIFIED RoleStatus = 0
+	RoleStatus_ROLE_STATUS_ACTIVE      RoleStatus = 1
+	RoleStatus_ROLE_STATUS_DELETED     RoleStatus = 2
+	RoleStatus_ROLE_STATUS_ALL         RoleStatus = 3
+)
+
+// Enum value maps for RoleStatus.
+var (
+	RoleStatus_name = map[int32]string{
+		0: "ROLE_STATUS_UNSPECIFIED",
+		1: "ROLE_STATUS_ACTIVE",
+		2: "ROLE_STATUS_DELETED",
+		3: "ROLE_STATUS_ALL",
+	}
+	RoleStatus_value = map[string]int32{
+		"ROLE_STATUS_UNSPECIFIED": 0,
+		"ROLE_STATUS_ACTIVE":      1,
+		"ROLE_STATUS_DELETED":     2,
+		"ROLE_STATUS_ALL":         3,
+	}
+)
+
+func (x RoleStatus) Enum() *RoleStatus {
+	p := new(RoleStatus)
+	*p = x
+	return p
+}
+
+func (x RoleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_role_v1_role_proto_enumTypes[0].Descriptor()
+}
+
+func (RoleStatus) Type() protoreflect.EnumType {
+	return &file_role_v1_role_proto_enumTypes[0]
+}
+
+func (x RoleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleStatus.Descriptor instead.
+func (RoleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{0}
+}
+
 // Запрос на создание новой роли
 type CreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -125,12 +179,16 @@ func (x *CreateResponse) GetRoleId() string {
 
 // Запрос на обновление роли
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RoleId      string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Name        *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Требовать согласования заявки для назначения роли
+	RequiresApproval *bool `protobuf:"varint,4,opt,name=requires_approval,json=requiresApproval,proto3,oneof" json:"requires_approval,omitempty"`
+	// Роль согласующих; пустая строка снимает ограничение
+	ApproverRoleId *string `protobuf:"bytes,5,opt,name=approver_role_id,json=approverRoleId,proto3,oneof" json:"approver_role_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
@@ -184,6 +242,20 @@ func (x *UpdateRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateRequest) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
+func (x *UpdateRequest) GetApproverRoleId() string {
+	if x != nil && x.ApproverRoleId != nil {
+		return *x.ApproverRoleId
+	}
+	return ""
+}
+
 // Запрос на удаление роли по ID
 type DeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -229,6 +301,51 @@ func (x *DeleteRequest) GetRoleId() string {
 	return ""
 }
 
+// Запрос на восстановление удаленной роли по ID
+type RestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	mi := &file_role_v1_role_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_v1_role_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
 // Запрос на получение роли по ID
 type GetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -239,7 +356,7 @@ type GetRequest struct {
 
 func (x *GetRequest) Reset() {
 	*x = GetRequest{}
-	mi := &file_role_v1_role_proto_msgTypes[4]
+	mi := &file_role_v1_role_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +368,7 @@ func (x *GetRequest) String() string {
 func (*GetRequest) ProtoMessage() {}
 
 func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_role_v1_role_proto_msgTypes[4]
+	mi := &file_role_v1_role_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +381,7 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_role_v1_role_proto_rawDescGZIP(), []int{4}
+	return file_role_v1_role_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetRequest) GetRoleId() string {
@@ -284,7 +401,7 @@ type GetResponse struct {
 
 func (x *GetResponse) Reset() {
 	*x = GetResponse{}
-	mi := &file_role_v1_role_proto_msgTypes[5]
+	mi := &file_role_v1_role_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +413,7 @@ func (x *GetResponse) String() string {
 func (*GetResponse) ProtoMessage() {}
 
 func (x *GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_role_v1_role_proto_msgTypes[5]
+	mi := &file_role_v1_role_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +426,7 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return file_role_v1_role_proto_rawDescGZIP(), []int{5}
+	return file_role_v1_role_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetResponse) GetData() *v1.RoleWithPermissions {
@@ -321,14 +438,22 @@ func (x *GetResponse) GetData() *v1.RoleWithPermissions {
 
 // Запрос на получение списка ролей
 type ListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Status RoleStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=role.v1.RoleStatus" json:"status,omitempty"`
+	// Курсорная пагинация, роли упорядочены по имени
+	Limit  *int32  `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Поиск по подстроке имени без учета регистра
+	NameQuery *string `protobuf:"bytes,4,opt,name=name_query,json=nameQuery,proto3,oneof" json:"name_query,omitempty"`
+	// Только роли, которым назначены все перечисленные права
+	PermissionIds []string `protobuf:"bytes,5,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
-	mi := &file_role_v1_role_proto_msgTypes[6]
+	mi := &file_role_v1_role_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +465,7 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_role_v1_role_proto_msgTypes[6]
+	mi := &file_role_v1_role_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,20 +478,60 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_role_v1_role_proto_rawDescGZIP(), []int{6}
+	return file_role_v1_role_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRequest) GetStatus() RoleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RoleStatus_ROLE_STATUS_UNSPECIFIED
+}
+
+func (x *ListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListRequest) GetNameQuery() string {
+	if x != nil && x.NameQuery != nil {
+		return *x.NameQuery
+	}
+	return ""
+}
+
+func (x *ListRequest) GetPermissionIds() []string {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
 }
 
 // Ответ со списком ролей
 type ListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*v1.Role             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []*v1.Role             `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Количество пользователей с действующим назначением, ключ — ID роли
+	UserCounts    map[string]int32 `protobuf:"bytes,2,rep,name=user_counts,json=userCounts,proto3" json:"user_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Limit         int32            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Лимит записей на страницу
+	NextCursor    *string          `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Курсор для следующей страницы (если есть)
+	HasMore       bool             `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`               // Есть ли еще записи
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
-	mi := &file_role_v1_role_proto_msgTypes[7]
+	mi := &file_role_v1_role_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -378,7 +543,7 @@ func (x *ListResponse) String() string {
 func (*ListResponse) ProtoMessage() {}
 
 func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_role_v1_role_proto_msgTypes[7]
+	mi := &file_role_v1_role_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +556,7 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_role_v1_role_proto_rawDescGZIP(), []int{7}
+	return file_role_v1_role_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListResponse) GetData() []*v1.Role {
@@ -401,6 +566,214 @@ func (x *ListResponse) GetData() []*v1.Role {
 	return nil
 }
 
+func (x *ListResponse) GetUserCounts() map[string]int32 {
+	if x != nil {
+		return x.UserCounts
+	}
+	return nil
+}
+
+func (x *ListResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Запрос на сброс кэша; пустой список — все роли
+type FlushCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleIds       []string               `protobuf:"bytes,1,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheRequest) Reset() {
+	*x = FlushCacheRequest{}
+	mi := &file_role_v1_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheRequest) ProtoMessage() {}
+
+func (x *FlushCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_v1_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FlushCacheRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// Ответ с количеством сброшенных ролей
+type FlushCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheResponse) Reset() {
+	*x = FlushCacheResponse{}
+	mi := &file_role_v1_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheResponse) ProtoMessage() {}
+
+func (x *FlushCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_v1_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheResponse) Descriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FlushCacheResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Запрос на прогрев кэша; пустой список — все роли
+type WarmCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleIds       []string               `protobuf:"bytes,1,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheRequest) Reset() {
+	*x = WarmCacheRequest{}
+	mi := &file_role_v1_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheRequest) ProtoMessage() {}
+
+func (x *WarmCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_v1_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheRequest.ProtoReflect.Descriptor instead.
+func (*WarmCacheRequest) Descriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WarmCacheRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// Ответ с количеством загруженных в кэш ролей
+type WarmCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmCacheResponse) Reset() {
+	*x = WarmCacheResponse{}
+	mi := &file_role_v1_role_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmCacheResponse) ProtoMessage() {}
+
+func (x *WarmCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_v1_role_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarmCacheResponse.ProtoReflect.Descriptor instead.
+func (*WarmCacheResponse) Descriptor() ([]byte, []int) {
+	return file_role_v1_role_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WarmCacheResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_role_v1_role_proto protoreflect.FileDescriptor
 
 const file_role_v1_role_proto_rawDesc = "" +
@@ -410,32 +783,78 @@ const file_role_v1_role_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182R\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"3\n" +
 	"\x0eCreateResponse\x12!\n" +
-	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"\x96\x01\n" +
+	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"\xaf\x02\n" +
 	"\rUpdateRequest\x12!\n" +
 	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x12\"\n" +
 	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x02\x182H\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x120\n" +
+	"\x11requires_approval\x18\x04 \x01(\bH\x02R\x10requiresApproval\x88\x01\x01\x12:\n" +
+	"\x10approver_role_id\x18\x05 \x01(\tB\v\xfaB\br\x06\xd0\x01\x01\xb0\x01\x01H\x03R\x0eapproverRoleId\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\"2\n" +
+	"\f_descriptionB\x14\n" +
+	"\x12_requires_approvalB\x13\n" +
+	"\x11_approver_role_id\"2\n" +
 	"\rDeleteRequest\x12!\n" +
+	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"3\n" +
+	"\x0eRestoreRequest\x12!\n" +
 	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"/\n" +
 	"\n" +
 	"GetRequest\x12!\n" +
 	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"A\n" +
 	"\vGetResponse\x122\n" +
-	"\x04data\x18\x01 \x01(\v2\x1e.common.v1.RoleWithPermissionsR\x04data\"\r\n" +
-	"\vListRequest\"3\n" +
+	"\x04data\x18\x01 \x01(\v2\x1e.common.v1.RoleWithPermissionsR\x04data\"\x90\x02\n" +
+	"\vListRequest\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.role.v1.RoleStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12+\n" +
+	"\n" +
+	"name_query\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18dH\x02R\tnameQuery\x88\x01\x01\x126\n" +
+	"\x0epermission_ids\x18\x05 \x03(\tB\x0f\xfaB\f\x92\x01\t\x10\x14\"\x05r\x03\xb0\x01\x01R\rpermissionIdsB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursorB\r\n" +
+	"\v_name_query\"\xa1\x02\n" +
 	"\fListResponse\x12#\n" +
-	"\x04data\x18\x01 \x03(\v2\x0f.common.v1.RoleR\x04data2\xfe\x03\n" +
-	"\vRoleService\x12a\n" +
-	"\x06Create\x12\x16.role.v1.CreateRequest\x1a\x17.role.v1.CreateResponse\"&\x8a\xb5\x18\n" +
-	"role:write\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/roles\x12j\n" +
+	"\x04data\x18\x01 \x03(\v2\x0f.common.v1.RoleR\x04data\x12F\n" +
+	"\vuser_counts\x18\x02 \x03(\v2%.role.v1.ListResponse.UserCountsEntryR\n" +
+	"userCounts\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12$\n" +
+	"\vnext_cursor\x18\x04 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\x1a=\n" +
+	"\x0fUserCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\x0e\n" +
+	"\f_next_cursor\"@\n" +
+	"\x11FlushCacheRequest\x12+\n" +
+	"\brole_ids\x18\x01 \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\xe8\a\"\x05r\x03\xb0\x01\x01R\aroleIds\"*\n" +
+	"\x12FlushCacheResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"?\n" +
+	"\x10WarmCacheRequest\x12+\n" +
+	"\brole_ids\x18\x01 \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\xe8\a\"\x05r\x03\xb0\x01\x01R\aroleIds\")\n" +
+	"\x11WarmCacheResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count*o\n" +
+	"\n" +
+	"RoleStatus\x12\x1b\n" +
+	"\x17ROLE_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ROLE_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13ROLE_STATUS_DELETED\x10\x02\x12\x13\n" +
+	"\x0fROLE_STATUS_ALL\x10\x032\xfc\x06\n" +
+	"\vRoleService\x12e\n" +
+	"\x06Create\x12\x16.role.v1.CreateRequest\x1a\x17.role.v1.CreateResponse\"*\x8a\xb5\x18\n" +
+	"role:write\xa0\xb5\x18\x01\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/roles\x12j\n" +
 	"\x06Update\x12\x16.role.v1.UpdateRequest\x1a\x16.google.protobuf.Empty\"0\x8a\xb5\x18\n" +
 	"role:write\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/roles/{role_id}\x12g\n" +
 	"\x06Delete\x12\x16.role.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\"-\x8a\xb5\x18\n" +
-	"role:write\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/roles/{role_id}\x12^\n" +
-	"\x03Get\x12\x13.role.v1.GetRequest\x1a\x14.role.v1.GetResponse\",\x8a\xb5\x18\trole:read\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/roles/{role_id}\x12W\n" +
-	"\x04List\x12\x14.role.v1.ListRequest\x1a\x15.role.v1.ListResponse\"\"\x8a\xb5\x18\trole:read\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/rolesBQZOgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1;role_v1b\x06proto3"
+	"role:write\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/roles/{role_id}\x12t\n" +
+	"\aRestore\x12\x17.role.v1.RestoreRequest\x1a\x16.google.protobuf.Empty\"8\x8a\xb5\x18\n" +
+	"role:write\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/roles/{role_id}:restore\x12a\n" +
+	"\x03Get\x12\x13.role.v1.GetRequest\x1a\x14.role.v1.GetResponse\"/\x8a\xb5\x18\trole:read\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/roles/{role_id}\x90\x02\x01\x12Z\n" +
+	"\x04List\x12\x14.role.v1.ListRequest\x1a\x15.role.v1.ListResponse\"%\x8a\xb5\x18\trole:read\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/roles\x90\x02\x01\x12\x7f\n" +
+	"\n" +
+	"FlushCache\x12\x1a.role.v1.FlushCacheRequest\x1a\x1b.role.v1.FlushCacheResponse\"8\x8a\xb5\x18\x10role_cache:write\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/roles/cache:flush\x12{\n" +
+	"\tWarmCache\x12\x19.role.v1.WarmCacheRequest\x1a\x1a.role.v1.WarmCacheResponse\"7\x8a\xb5\x18\x10role_cache:write\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/roles/cache:warmBQZOgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role/v1;role_v1b\x06proto3"
 
 var (
 	file_role_v1_role_proto_rawDescOnce sync.Once
@@ -449,38 +868,54 @@ func file_role_v1_role_proto_rawDescGZIP() []byte {
 	return file_role_v1_role_proto_rawDescData
 }
 
-var file_role_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_role_v1_role_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_role_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_role_v1_role_proto_goTypes = []any{
-	(*CreateRequest)(nil),          // 0: role.v1.CreateRequest
-	(*CreateResponse)(nil),         // 1: role.v1.CreateResponse
-	(*UpdateRequest)(nil),          // 2: role.v1.UpdateRequest
-	(*DeleteRequest)(nil),          // 3: role.v1.DeleteRequest
-	(*GetRequest)(nil),             // 4: role.v1.GetRequest
-	(*GetResponse)(nil),            // 5: role.v1.GetResponse
-	(*ListRequest)(nil),            // 6: role.v1.ListRequest
-	(*ListResponse)(nil),           // 7: role.v1.ListResponse
-	(*v1.RoleWithPermissions)(nil), // 8: common.v1.RoleWithPermissions
-	(*v1.Role)(nil),                // 9: common.v1.Role
-	(*emptypb.Empty)(nil),          // 10: google.protobuf.Empty
+	(RoleStatus)(0),                // 0: role.v1.RoleStatus
+	(*CreateRequest)(nil),          // 1: role.v1.CreateRequest
+	(*CreateResponse)(nil),         // 2: role.v1.CreateResponse
+	(*UpdateRequest)(nil),          // 3: role.v1.UpdateRequest
+	(*DeleteRequest)(nil),          // 4: role.v1.DeleteRequest
+	(*RestoreRequest)(nil),         // 5: role.v1.RestoreRequest
+	(*GetRequest)(nil),             // 6: role.v1.GetRequest
+	(*GetResponse)(nil),            // 7: role.v1.GetResponse
+	(*ListRequest)(nil),            // 8: role.v1.ListRequest
+	(*ListResponse)(nil),           // 9: role.v1.ListResponse
+	(*FlushCacheRequest)(nil),      // 10: role.v1.FlushCacheRequest
+	(*FlushCacheResponse)(nil),     // 11: role.v1.FlushCacheResponse
+	(*WarmCacheRequest)(nil),       // 12: role.v1.WarmCacheRequest
+	(*WarmCacheResponse)(nil),      // 13: role.v1.WarmCacheResponse
+	nil,                            // 14: role.v1.ListResponse.UserCountsEntry
+	(*v1.RoleWithPermissions)(nil), // 15: common.v1.RoleWithPermissions
+	(*v1.Role)(nil),                // 16: common.v1.Role
+	(*emptypb.Empty)(nil),          // 17: google.protobuf.Empty
 }
 var file_role_v1_role_proto_depIdxs = []int32{
-	8,  // 0: role.v1.GetResponse.data:type_name -> common.v1.RoleWithPermissions
-	9,  // 1: role.v1.ListResponse.data:type_name -> common.v1.Role
-	0,  // 2: role.v1.RoleService.Create:input_type -> role.v1.CreateRequest
-	2,  // 3: role.v1.RoleService.Update:input_type -> role.v1.UpdateRequest
-	3,  // 4: role.v1.RoleService.Delete:input_type -> role.v1.DeleteRequest
-	4,  // 5: role.v1.RoleService.Get:input_type -> role.v1.GetRequest
-	6,  // 6: role.v1.RoleService.List:input_type -> role.v1.ListRequest
-	1,  // 7: role.v1.RoleService.Create:output_type -> role.v1.CreateResponse
-	10, // 8: role.v1.RoleService.Update:output_type -> google.protobuf.Empty
-	10, // 9: role.v1.RoleService.Delete:output_type -> google.protobuf.Empty
-	5,  // 10: role.v1.RoleService.Get:output_type -> role.v1.GetResponse
-	7,  // 11: role.v1.RoleService.List:output_type -> role.v1.ListResponse
-	7,  // [7:12] is the sub-list for method output_type
-	2,  // [2:7] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	15, // 0: role.v1.GetResponse.data:type_name -> common.v1.RoleWithPermissions
+	0,  // 1: role.v1.ListRequest.status:type_name -> role.v1.RoleStatus
+	16, // 2: role.v1.ListResponse.data:type_name -> common.v1.Role
+	14, // 3: role.v1.ListResponse.user_counts:type_name -> role.v1.ListResponse.UserCountsEntry
+	1,  // 4: role.v1.RoleService.Create:input_type -> role.v1.CreateRequest
+	3,  // 5: role.v1.RoleService.Update:input_type -> role.v1.UpdateRequest
+	4,  // 6: role.v1.RoleService.Delete:input_type -> role.v1.DeleteRequest
+	5,  // 7: role.v1.RoleService.Restore:input_type -> role.v1.RestoreRequest
+	6,  // 8: role.v1.RoleService.Get:input_type -> role.v1.GetRequest
+	8,  // 9: role.v1.RoleService.List:input_type -> role.v1.ListRequest
+	10, // 10: role.v1.RoleService.FlushCache:input_type -> role.v1.FlushCacheRequest
+	12, // 11: role.v1.RoleService.WarmCache:input_type -> role.v1.WarmCacheRequest
+	2,  // 12: role.v1.RoleService.Create:output_type -> role.v1.CreateResponse
+	17, // 13: role.v1.RoleService.Update:output_type -> google.protobuf.Empty
+	17, // 14: role.v1.RoleService.Delete:output_type -> google.protobuf.Empty
+	17, // 15: role.v1.RoleService.Restore:output_type -> google.protobuf.Empty
+	7,  // 16: role.v1.RoleService.Get:output_type -> role.v1.GetResponse
+	9,  // 17: role.v1.RoleService.List:output_type -> role.v1.ListResponse
+	11, // 18: role.v1.RoleService.FlushCache:output_type -> role.v1.FlushCacheResponse
+	13, // 19: role.v1.RoleService.WarmCache:output_type -> role.v1.WarmCacheResponse
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_role_v1_role_proto_init() }
@@ -489,18 +924,21 @@ func file_role_v1_role_proto_init() {
 		return
 	}
 	file_role_v1_role_proto_msgTypes[2].OneofWrappers = []any{}
+	file_role_v1_role_proto_msgTypes[7].OneofWrappers = []any{}
+	file_role_v1_role_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_role_v1_role_proto_rawDesc), len(file_role_v1_role_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_role_v1_role_proto_goTypes,
 		DependencyIndexes: file_role_v1_role_proto_depIdxs,
+		EnumInfos:         file_role_v1_role_proto_enumTypes,
 		MessageInfos:      file_role_v1_role_proto_msgTypes,
 	}.Build()
 	File_role_v1_role_proto = out.File
@@ -324,6 +324,30 @@ func (m *UpdateRequest) validate(all bool) error {
 		// no validation rules for Description
 	}
 
+	if m.RequiresApproval != nil {
+		// no validation rules for RequiresApproval
+	}
+
+	if m.ApproverRoleId != nil {
+
+		if m.GetApproverRoleId() != "" {
+
+			if err := m._validateUuid(m.GetApproverRoleId()); err != nil {
+				err = UpdateRequestValidationError{
+					field:  "ApproverRoleId",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateRequestMultiError(errors)
 	}
@@ -530,6 +554,126 @@ var _ interface {
 	ErrorName() string
 } = DeleteRequestValidationError{}
 
+// Validate checks the field values on RestoreRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RestoreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RestoreRequestMultiError,
+// or nil if none found.
+func (m *RestoreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRoleId()); err != nil {
+		err = RestoreRequestValidationError{
+			field:  "RoleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RestoreRequest) _validateUuid(uuid string) error {
+	if matched := _role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RestoreRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreRequestMultiError) AllErrors() []error { return m }
+
+// RestoreRequestValidationError is the validation error returned by
+// RestoreRequest.Validate if the designated constraints aren't met.
+type RestoreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreRequestValidationError) ErrorName() string { return "RestoreRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestoreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreRequestValidationError{}
+
 // Validate checks the field values on GetRequest with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -799,6 +943,79 @@ func (m *ListRequest) validate(all bool) error {
 
 	var errors []error
 
+	if _, ok := RoleStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPermissionIds()) > 20 {
+		err := ListRequestValidationError{
+			field:  "PermissionIds",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPermissionIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = ListRequestValidationError{
+				field:  fmt.Sprintf("PermissionIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.NameQuery != nil {
+
+		if utf8.RuneCountInString(m.GetNameQuery()) > 100 {
+			err := ListRequestValidationError{
+				field:  "NameQuery",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListRequestMultiError(errors)
 	}
@@ -806,6 +1023,14 @@ func (m *ListRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *ListRequest) _validateUuid(uuid string) error {
+	if matched := _role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
 // ListRequestMultiError is an error wrapping multiple validation errors
 // returned by ListRequest.ValidateAll() if the designated constraints aren't met.
 type ListRequestMultiError []error
@@ -932,6 +1157,16 @@ func (m *ListResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for UserCounts
+
+	// no validation rules for Limit
+
+	// no validation rules for HasMore
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
 	if len(errors) > 0 {
 		return ListResponseMultiError(errors)
 	}
@@ -1008,3 +1243,485 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListResponseValidationError{}
+
+// Validate checks the field values on FlushCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FlushCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlushCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlushCacheRequestMultiError, or nil if none found.
+func (m *FlushCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlushCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRoleIds()) > 1000 {
+		err := FlushCacheRequestValidationError{
+			field:  "RoleIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRoleIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = FlushCacheRequestValidationError{
+				field:  fmt.Sprintf("RoleIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FlushCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FlushCacheRequest) _validateUuid(uuid string) error {
+	if matched := _role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FlushCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlushCacheRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlushCacheRequestMultiError) AllErrors() []error { return m }
+
+// FlushCacheRequestValidationError is the validation error returned by
+// FlushCacheRequest.Validate if the designated constraints aren't met.
+type FlushCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushCacheRequestValidationError) ErrorName() string {
+	return "FlushCacheRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushCacheRequestValidationError{}
+
+// Validate checks the field values on FlushCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlushCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlushCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlushCacheResponseMultiError, or nil if none found.
+func (m *FlushCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlushCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return FlushCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FlushCacheResponseMultiError is an error wrapping multiple validation errors
+// returned by FlushCacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FlushCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FlushCacheResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FlushCacheResponseMultiError) AllErrors() []error { return m }
+
+// FlushCacheResponseValidationError is the validation error returned by
+// FlushCacheResponse.Validate if the designated constraints aren't met.
+type FlushCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FlushCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FlushCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FlushCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FlushCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FlushCacheResponseValidationError) ErrorName() string {
+	return "FlushCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FlushCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFlushCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FlushCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FlushCacheResponseValidationError{}
+
+// Validate checks the field values on WarmCacheRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WarmCacheRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarmCacheRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarmCacheRequestMultiError, or nil if none found.
+func (m *WarmCacheRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarmCacheRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRoleIds()) > 1000 {
+		err := WarmCacheRequestValidationError{
+			field:  "RoleIds",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRoleIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = WarmCacheRequestValidationError{
+				field:  fmt.Sprintf("RoleIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WarmCacheRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WarmCacheRequest) _validateUuid(uuid string) error {
+	if matched := _role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WarmCacheRequestMultiError is an error wrapping multiple validation errors
+// returned by WarmCacheRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WarmCacheRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarmCacheRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarmCacheRequestMultiError) AllErrors() []error { return m }
+
+// WarmCacheRequestValidationError is the validation error returned by
+// WarmCacheRequest.Validate if the designated constraints aren't met.
+type WarmCacheRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarmCacheRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarmCacheRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarmCacheRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarmCacheRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarmCacheRequestValidationError) ErrorName() string { return "WarmCacheRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarmCacheRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarmCacheRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarmCacheRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarmCacheRequestValidationError{}
+
+// Validate checks the field values on WarmCacheResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WarmCacheResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarmCacheResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarmCacheResponseMultiError, or nil if none found.
+func (m *WarmCacheResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarmCacheResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return WarmCacheResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarmCacheResponseMultiError is an error wrapping multiple validation errors
+// returned by WarmCacheResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WarmCacheResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarmCacheResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarmCacheResponseMultiError) AllErrors() []error { return m }
+
+// WarmCacheResponseValidationError is the validation error returned by
+// WarmCacheResponse.Validate if the designated constraints aren't met.
+type WarmCacheResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarmCacheResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarmCacheResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarmCacheResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarmCacheResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarmCacheResponseValidationError) ErrorName() string {
+	return "WarmCacheResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarmCacheResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarmCacheResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarmCacheResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarmCacheResponseValidationError{}
@@ -20,11 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RoleService_Create_FullMethodName = "/role.v1.RoleService/Create"
-	RoleService_Update_FullMethodName = "/role.v1.RoleService/Update"
-	RoleService_Delete_FullMethodName = "/role.v1.RoleService/Delete"
-	RoleService_Get_FullMethodName    = "/role.v1.RoleService/Get"
-	RoleService_List_FullMethodName   = "/role.v1.RoleService/List"
+	RoleService_Create_FullMethodName     = "/role.v1.RoleService/Create"
+	RoleService_Update_FullMethodName     = "/role.v1.RoleService/Update"
+	RoleService_Delete_FullMethodName     = "/role.v1.RoleService/Delete"
+	RoleService_Restore_FullMethodName    = "/role.v1.RoleService/Restore"
+	RoleService_Get_FullMethodName        = "/role.v1.RoleService/Get"
+	RoleService_List_FullMethodName       = "/role.v1.RoleService/List"
+	RoleService_FlushCache_FullMethodName = "/role.v1.RoleService/FlushCache"
+	RoleService_WarmCache_FullMethodName  = "/role.v1.RoleService/WarmCache"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -37,10 +40,16 @@ type RoleServiceClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Удаление роли
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Восстановление удаленной роли
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Получение роли по ID
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Получение списка ролей
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Сброс кэша ролей с правами
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error)
+	// Прогрев кэша ролей с правами
+	WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error)
 }
 
 type roleServiceClient struct {
@@ -81,6 +90,16 @@ func (c *roleServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
+func (c *roleServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RoleService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetResponse)
@@ -101,6 +120,26 @@ func (c *roleServiceClient) List(ctx context.Context, in *ListRequest, opts ...g
 	return out, nil
 }
 
+func (c *roleServiceClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushCacheResponse)
+	err := c.cc.Invoke(ctx, RoleService_FlushCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) WarmCache(ctx context.Context, in *WarmCacheRequest, opts ...grpc.CallOption) (*WarmCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarmCacheResponse)
+	err := c.cc.Invoke(ctx, RoleService_WarmCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility.
@@ -111,10 +150,16 @@ type RoleServiceServer interface {
 	Update(context.Context, *UpdateRequest) (*emptypb.Empty, error)
 	// Удаление роли
 	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// Восстановление удаленной роли
+	Restore(context.Context, *RestoreRequest) (*emptypb.Empty, error)
 	// Получение роли по ID
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	// Получение списка ролей
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Сброс кэша ролей с правами
+	FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error)
+	// Прогрев кэша ролей с правами
+	WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -134,12 +179,21 @@ func (UnimplementedRoleServiceServer) Update(context.Context, *UpdateRequest) (*
 func (UnimplementedRoleServiceServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedRoleServiceServer) Restore(context.Context, *RestoreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
 func (UnimplementedRoleServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
 func (UnimplementedRoleServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedRoleServiceServer) FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+func (UnimplementedRoleServiceServer) WarmCache(context.Context, *WarmCacheRequest) (*WarmCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmCache not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 func (UnimplementedRoleServiceServer) testEmbeddedByValue()                     {}
 
@@ -215,6 +269,24 @@ func _RoleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).Restore(ctx, req.(*RestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +323,42 @@ func _RoleService_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_FlushCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_WarmCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).WarmCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_WarmCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).WarmCache(ctx, req.(*WarmCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -270,6 +378,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _RoleService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _RoleService_Restore_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _RoleService_Get_Handler,
@@ -278,6 +390,14 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _RoleService_List_Handler,
 		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _RoleService_FlushCache_Handler,
+		},
+		{
+			MethodName: "WarmCache",
+			Handler:    _RoleService_WarmCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "role/v1/role.proto",
@@ -0,0 +1,628 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: role_constraint/v1/role_constraint.proto
+
+package role_constraint_v1
+
+import (
+	_ "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Взаимоисключающий набор ролей: пользователь может иметь не более одной роли из набора
+type RoleConstraint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleConstraint) Reset() {
+	*x = RoleConstraint{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleConstraint) ProtoMessage() {}
+
+func (x *RoleConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleConstraint.ProtoReflect.Descriptor instead.
+func (*RoleConstraint) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleConstraint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleConstraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleConstraint) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *RoleConstraint) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *RoleConstraint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Нарушение ограничения: пользователь одновременно имеет несколько ролей из набора
+type ConstraintViolation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConstraintId   string                 `protobuf:"bytes,1,opt,name=constraint_id,json=constraintId,proto3" json:"constraint_id,omitempty"`
+	ConstraintName string                 `protobuf:"bytes,2,opt,name=constraint_name,json=constraintName,proto3" json:"constraint_name,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleIds        []string               `protobuf:"bytes,4,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConstraintViolation) Reset() {
+	*x = ConstraintViolation{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstraintViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstraintViolation) ProtoMessage() {}
+
+func (x *ConstraintViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstraintViolation.ProtoReflect.Descriptor instead.
+func (*ConstraintViolation) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConstraintViolation) GetConstraintId() string {
+	if x != nil {
+		return x.ConstraintId
+	}
+	return ""
+}
+
+func (x *ConstraintViolation) GetConstraintName() string {
+	if x != nil {
+		return x.ConstraintName
+	}
+	return ""
+}
+
+func (x *ConstraintViolation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConstraintViolation) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// Запрос на создание ограничения
+type CreateRoleConstraintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RoleIds       []string               `protobuf:"bytes,3,rep,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleConstraintRequest) Reset() {
+	*x = CreateRoleConstraintRequest{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleConstraintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleConstraintRequest) ProtoMessage() {}
+
+func (x *CreateRoleConstraintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleConstraintRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleConstraintRequest) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRoleConstraintRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleConstraintRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoleConstraintRequest) GetRoleIds() []string {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+// Созданное ограничение и уже существующие нарушения
+type CreateRoleConstraintResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Constraint *RoleConstraint        `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	// Ограничение не отзывает роли: существующие нарушения нужно устранить вручную
+	Violations    []*ConstraintViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleConstraintResponse) Reset() {
+	*x = CreateRoleConstraintResponse{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleConstraintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleConstraintResponse) ProtoMessage() {}
+
+func (x *CreateRoleConstraintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleConstraintResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleConstraintResponse) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRoleConstraintResponse) GetConstraint() *RoleConstraint {
+	if x != nil {
+		return x.Constraint
+	}
+	return nil
+}
+
+func (x *CreateRoleConstraintResponse) GetViolations() []*ConstraintViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Запрос на удаление ограничения
+type DeleteRoleConstraintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConstraintId  string                 `protobuf:"bytes,1,opt,name=constraint_id,json=constraintId,proto3" json:"constraint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleConstraintRequest) Reset() {
+	*x = DeleteRoleConstraintRequest{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleConstraintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleConstraintRequest) ProtoMessage() {}
+
+func (x *DeleteRoleConstraintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleConstraintRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleConstraintRequest) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRoleConstraintRequest) GetConstraintId() string {
+	if x != nil {
+		return x.ConstraintId
+	}
+	return ""
+}
+
+// Запрос списка ограничений
+type ListRoleConstraintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleConstraintsRequest) Reset() {
+	*x = ListRoleConstraintsRequest{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleConstraintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleConstraintsRequest) ProtoMessage() {}
+
+func (x *ListRoleConstraintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleConstraintsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleConstraintsRequest) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{5}
+}
+
+// Список ограничений
+type ListRoleConstraintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constraints   []*RoleConstraint      `protobuf:"bytes,1,rep,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleConstraintsResponse) Reset() {
+	*x = ListRoleConstraintsResponse{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleConstraintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleConstraintsResponse) ProtoMessage() {}
+
+func (x *ListRoleConstraintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleConstraintsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleConstraintsResponse) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRoleConstraintsResponse) GetConstraints() []*RoleConstraint {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+// Запрос отчета о нарушениях
+type ListConstraintViolationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только нарушения указанного ограничения
+	ConstraintId  *string `protobuf:"bytes,1,opt,name=constraint_id,json=constraintId,proto3,oneof" json:"constraint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConstraintViolationsRequest) Reset() {
+	*x = ListConstraintViolationsRequest{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConstraintViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConstraintViolationsRequest) ProtoMessage() {}
+
+func (x *ListConstraintViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConstraintViolationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConstraintViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListConstraintViolationsRequest) GetConstraintId() string {
+	if x != nil && x.ConstraintId != nil {
+		return *x.ConstraintId
+	}
+	return ""
+}
+
+// Отчет о нарушениях
+type ListConstraintViolationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Violations    []*ConstraintViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConstraintViolationsResponse) Reset() {
+	*x = ListConstraintViolationsResponse{}
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConstraintViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConstraintViolationsResponse) ProtoMessage() {}
+
+func (x *ListConstraintViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_constraint_v1_role_constraint_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConstraintViolationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConstraintViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_role_constraint_v1_role_constraint_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListConstraintViolationsResponse) GetViolations() []*ConstraintViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+var File_role_constraint_v1_role_constraint_proto protoreflect.FileDescriptor
+
+const file_role_constraint_v1_role_constraint_proto_rawDesc = "" +
+	"\n" +
+	"(role_constraint/v1/role_constraint.proto\x12\x12role_constraint.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\"\xc1\x01\n" +
+	"\x0eRoleConstraint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_description\"\x97\x01\n" +
+	"\x13ConstraintViolation\x12#\n" +
+	"\rconstraint_id\x18\x01 \x01(\tR\fconstraintId\x12'\n" +
+	"\x0fconstraint_name\x18\x02 \x01(\tR\x0econstraintName\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x19\n" +
+	"\brole_ids\x18\x04 \x03(\tR\aroleIds\"\xad\x01\n" +
+	"\x1bCreateRoleConstraintRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12/\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03H\x00R\vdescription\x88\x01\x01\x12.\n" +
+	"\brole_ids\x18\x03 \x03(\tB\x13\xfaB\x10\x92\x01\r\b\x02\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\aroleIdsB\x0e\n" +
+	"\f_description\"\xab\x01\n" +
+	"\x1cCreateRoleConstraintResponse\x12B\n" +
+	"\n" +
+	"constraint\x18\x01 \x01(\v2\".role_constraint.v1.RoleConstraintR\n" +
+	"constraint\x12G\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2'.role_constraint.v1.ConstraintViolationR\n" +
+	"violations\"L\n" +
+	"\x1bDeleteRoleConstraintRequest\x12-\n" +
+	"\rconstraint_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\fconstraintId\"\x1c\n" +
+	"\x1aListRoleConstraintsRequest\"c\n" +
+	"\x1bListRoleConstraintsResponse\x12D\n" +
+	"\vconstraints\x18\x01 \x03(\v2\".role_constraint.v1.RoleConstraintR\vconstraints\"g\n" +
+	"\x1fListConstraintViolationsRequest\x122\n" +
+	"\rconstraint_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\fconstraintId\x88\x01\x01B\x10\n" +
+	"\x0e_constraint_id\"k\n" +
+	" ListConstraintViolationsResponse\x12G\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2'.role_constraint.v1.ConstraintViolationR\n" +
+	"violations2\x88\x06\n" +
+	"\x15RoleConstraintService\x12\xbb\x01\n" +
+	"\x14CreateRoleConstraint\x12/.role_constraint.v1.CreateRoleConstraintRequest\x1a0.role_constraint.v1.CreateRoleConstraintResponse\"@\x8a\xb5\x18\x15role_constraint:write\xa0\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/role-constraints\x12\xaa\x01\n" +
+	"\x14DeleteRoleConstraint\x12/.role_constraint.v1.DeleteRoleConstraintRequest\x1a\x16.google.protobuf.Empty\"I\x8a\xb5\x18\x15role_constraint:write\x82\xd3\xe4\x93\x02**(/api/v1/role-constraints/{constraint_id}\x12\xb3\x01\n" +
+	"\x13ListRoleConstraints\x12..role_constraint.v1.ListRoleConstraintsRequest\x1a/.role_constraint.v1.ListRoleConstraintsResponse\";\x8a\xb5\x18\x14role_constraint:read\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/role-constraints\x90\x02\x01\x12\xcd\x01\n" +
+	"\x18ListConstraintViolations\x123.role_constraint.v1.ListConstraintViolationsRequest\x1a4.role_constraint.v1.ListConstraintViolationsResponse\"F\x8a\xb5\x18\x14role_constraint:read\x82\xd3\xe4\x93\x02%\x12#/api/v1/role-constraints:violations\x90\x02\x01BgZegithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_constraint/v1;role_constraint_v1b\x06proto3"
+
+var (
+	file_role_constraint_v1_role_constraint_proto_rawDescOnce sync.Once
+	file_role_constraint_v1_role_constraint_proto_rawDescData []byte
+)
+
+func file_role_constraint_v1_role_constraint_proto_rawDescGZIP() []byte {
+	file_role_constraint_v1_role_constraint_proto_rawDescOnce.Do(func() {
+		file_role_constraint_v1_role_constraint_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_role_constraint_v1_role_constraint_proto_rawDesc), len(file_role_constraint_v1_role_constraint_proto_rawDesc)))
+	})
+	return file_role_constraint_v1_role_constraint_proto_rawDescData
+}
+
+var file_role_constraint_v1_role_constraint_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_role_constraint_v1_role_constraint_proto_goTypes = []any{
+	(*RoleConstraint)(nil),                   // 0: role_constraint.v1.RoleConstraint
+	(*ConstraintViolation)(nil),              // 1: role_constraint.v1.ConstraintViolation
+	(*CreateRoleConstraintRequest)(nil),      // 2: role_constraint.v1.CreateRoleConstraintRequest
+	(*CreateRoleConstraintResponse)(nil),     // 3: role_constraint.v1.CreateRoleConstraintResponse
+	(*DeleteRoleConstraintRequest)(nil),      // 4: role_constraint.v1.DeleteRoleConstraintRequest
+	(*ListRoleConstraintsRequest)(nil),       // 5: role_constraint.v1.ListRoleConstraintsRequest
+	(*ListRoleConstraintsResponse)(nil),      // 6: role_constraint.v1.ListRoleConstraintsResponse
+	(*ListConstraintViolationsRequest)(nil),  // 7: role_constraint.v1.ListConstraintViolationsRequest
+	(*ListConstraintViolationsResponse)(nil), // 8: role_constraint.v1.ListConstraintViolationsResponse
+	(*timestamppb.Timestamp)(nil),            // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 10: google.protobuf.Empty
+}
+var file_role_constraint_v1_role_constraint_proto_depIdxs = []int32{
+	9,  // 0: role_constraint.v1.RoleConstraint.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: role_constraint.v1.CreateRoleConstraintResponse.constraint:type_name -> role_constraint.v1.RoleConstraint
+	1,  // 2: role_constraint.v1.CreateRoleConstraintResponse.violations:type_name -> role_constraint.v1.ConstraintViolation
+	0,  // 3: role_constraint.v1.ListRoleConstraintsResponse.constraints:type_name -> role_constraint.v1.RoleConstraint
+	1,  // 4: role_constraint.v1.ListConstraintViolationsResponse.violations:type_name -> role_constraint.v1.ConstraintViolation
+	2,  // 5: role_constraint.v1.RoleConstraintService.CreateRoleConstraint:input_type -> role_constraint.v1.CreateRoleConstraintRequest
+	4,  // 6: role_constraint.v1.RoleConstraintService.DeleteRoleConstraint:input_type -> role_constraint.v1.DeleteRoleConstraintRequest
+	5,  // 7: role_constraint.v1.RoleConstraintService.ListRoleConstraints:input_type -> role_constraint.v1.ListRoleConstraintsRequest
+	7,  // 8: role_constraint.v1.RoleConstraintService.ListConstraintViolations:input_type -> role_constraint.v1.ListConstraintViolationsRequest
+	3,  // 9: role_constraint.v1.RoleConstraintService.CreateRoleConstraint:output_type -> role_constraint.v1.CreateRoleConstraintResponse
+	10, // 10: role_constraint.v1.RoleConstraintService.DeleteRoleConstraint:output_type -> google.protobuf.Empty
+	6,  // 11: role_constraint.v1.RoleConstraintService.ListRoleConstraints:output_type -> role_constraint.v1.ListRoleConstraintsResponse
+	8,  // 12: role_constraint.v1.RoleConstraintService.ListConstraintViolations:output_type -> role_constraint.v1.ListConstraintViolationsResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_role_constraint_v1_role_constraint_proto_init() }
+func file_role_constraint_v1_role_constraint_proto_init() {
+	if File_role_constraint_v1_role_constraint_proto != nil {
+		return
+	}
+	file_role_constraint_v1_role_constraint_proto_msgTypes[0].OneofWrappers = []any{}
+	file_role_constraint_v1_role_constraint_proto_msgTypes[2].OneofWrappers = []any{}
+	file_role_constraint_v1_role_constraint_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_role_constraint_v1_role_constraint_proto_rawDesc), len(file_role_constraint_v1_role_constraint_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_role_constraint_v1_role_constraint_proto_goTypes,
+		DependencyIndexes: file_role_constraint_v1_role_constraint_proto_depIdxs,
+		MessageInfos:      file_role_constraint_v1_role_constraint_proto_msgTypes,
+	}.Build()
+	File_role_constraint_v1_role_constraint_proto = out.File
+	file_role_constraint_v1_role_constraint_proto_goTypes = nil
+	file_role_constraint_v1_role_constraint_proto_depIdxs = nil
+}
@@ -7,12 +7,13 @@
 package role_permission_v1
 
 import (
-	_ "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
+	v1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,9 +28,13 @@ const (
 
 // Запрос на назначение права роли
 type AssignRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	PermissionId  string                 `protobuf:"bytes,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RoleId       string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	PermissionId string                 `protobuf:"bytes,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	// Эффект назначения (по умолчанию — разрешение)
+	Effect v1.PermissionEffect `protobuf:"varint,3,opt,name=effect,proto3,enum=common.v1.PermissionEffect" json:"effect,omitempty"`
+	// Условие (ABAC) над атрибутами subject, resource и request
+	Condition     *string `protobuf:"bytes,4,opt,name=condition,proto3,oneof" json:"condition,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -78,6 +83,20 @@ func (x *AssignRequest) GetPermissionId() string {
 	return ""
 }
 
+func (x *AssignRequest) GetEffect() v1.PermissionEffect {
+	if x != nil {
+		return x.Effect
+	}
+	return v1.PermissionEffect(0)
+}
+
+func (x *AssignRequest) GetCondition() string {
+	if x != nil && x.Condition != nil {
+		return *x.Condition
+	}
+	return ""
+}
+
 // Запрос на отзыв права у роли
 type RevokeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -131,20 +150,274 @@ func (x *RevokeRequest) GetPermissionId() string {
 	return ""
 }
 
+// Запрос на замену набора прав роли. Новые права назначаются с эффектом allow без условия,
+// у сохраняемых прав эффект и условие не меняются
+type SetRolePermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	PermissionIds []string               `protobuf:"bytes,2,rep,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRolePermissionsRequest) Reset() {
+	*x = SetRolePermissionsRequest{}
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRolePermissionsRequest) ProtoMessage() {}
+
+func (x *SetRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_role_permission_v1_role_permission_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetRolePermissionsRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *SetRolePermissionsRequest) GetPermissionIds() []string {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+// Изменения набора прав роли
+type SetRolePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         []string               `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []string               `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Unchanged     []string               `protobuf:"bytes,3,rep,name=unchanged,proto3" json:"unchanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRolePermissionsResponse) Reset() {
+	*x = SetRolePermissionsResponse{}
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRolePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRolePermissionsResponse) ProtoMessage() {}
+
+func (x *SetRolePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRolePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*SetRolePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_role_permission_v1_role_permission_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetRolePermissionsResponse) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *SetRolePermissionsResponse) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *SetRolePermissionsResponse) GetUnchanged() []string {
+	if x != nil {
+		return x.Unchanged
+	}
+	return nil
+}
+
+// Запрос на тестовое вычисление условия
+type EvaluateConditionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Condition string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Атрибуты пользователя (subject.*)
+	Subject *structpb.Struct `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Атрибуты ресурса (resource.*)
+	Resource *structpb.Struct `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Атрибуты запроса (request.*); request.time по умолчанию — текущее время
+	Request       *structpb.Struct `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateConditionRequest) Reset() {
+	*x = EvaluateConditionRequest{}
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateConditionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateConditionRequest) ProtoMessage() {}
+
+func (x *EvaluateConditionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateConditionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateConditionRequest) Descriptor() ([]byte, []int) {
+	return file_role_permission_v1_role_permission_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EvaluateConditionRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *EvaluateConditionRequest) GetSubject() *structpb.Struct {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *EvaluateConditionRequest) GetResource() *structpb.Struct {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *EvaluateConditionRequest) GetRequest() *structpb.Struct {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Результат вычисления условия
+type EvaluateConditionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateConditionResponse) Reset() {
+	*x = EvaluateConditionResponse{}
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateConditionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateConditionResponse) ProtoMessage() {}
+
+func (x *EvaluateConditionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_role_permission_v1_role_permission_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateConditionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateConditionResponse) Descriptor() ([]byte, []int) {
+	return file_role_permission_v1_role_permission_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EvaluateConditionResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
 var File_role_permission_v1_role_permission_proto protoreflect.FileDescriptor
 
 const file_role_permission_v1_role_permission_proto_rawDesc = "" +
 	"\n" +
-	"(role_permission/v1/role_permission.proto\x12\x12role_permission.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\"a\n" +
+	"(role_permission/v1/role_permission.proto\x12\x12role_permission.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x1bcommon/v1/annotations.proto\x1a\x1acommon/v1/permission.proto\"\xdd\x01\n" +
 	"\rAssignRequest\x12!\n" +
 	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x12-\n" +
-	"\rpermission_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\fpermissionId\"a\n" +
+	"\rpermission_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\fpermissionId\x12=\n" +
+	"\x06effect\x18\x03 \x01(\x0e2\x1b.common.v1.PermissionEffectB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06effect\x12-\n" +
+	"\tcondition\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xe8\aH\x00R\tcondition\x88\x01\x01B\f\n" +
+	"\n" +
+	"_condition\"a\n" +
 	"\rRevokeRequest\x12!\n" +
 	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x12-\n" +
-	"\rpermission_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\fpermissionId2\xc2\x02\n" +
+	"\rpermission_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\fpermissionId\"y\n" +
+	"\x19SetRolePermissionsRequest\x12!\n" +
+	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x129\n" +
+	"\x0epermission_ids\x18\x02 \x03(\tB\x12\xfaB\x0f\x92\x01\f\x10\xf4\x03\x18\x01\"\x05r\x03\xb0\x01\x01R\rpermissionIds\"j\n" +
+	"\x1aSetRolePermissionsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\tR\x05added\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x03(\tR\tunchanged\"\xdf\x01\n" +
+	"\x18EvaluateConditionRequest\x12(\n" +
+	"\tcondition\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xe8\aR\tcondition\x121\n" +
+	"\asubject\x18\x02 \x01(\v2\x17.google.protobuf.StructR\asubject\x123\n" +
+	"\bresource\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bresource\x121\n" +
+	"\arequest\x18\x04 \x01(\v2\x17.google.protobuf.StructR\arequest\"3\n" +
+	"\x19EvaluateConditionResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\bR\x06result2\xc9\x05\n" +
 	"\x15RolePermissionService\x12\x8c\x01\n" +
 	"\x06Assign\x12!.role_permission.v1.AssignRequest\x1a\x16.google.protobuf.Empty\"G\x8a\xb5\x18\x15role_permission:write\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/roles/{role_id}/permissions\x12\x99\x01\n" +
-	"\x06Revoke\x12!.role_permission.v1.RevokeRequest\x1a\x16.google.protobuf.Empty\"T\x8a\xb5\x18\x15role_permission:write\x82\xd3\xe4\x93\x025*3/api/v1/roles/{role_id}/permissions/{permission_id}BgZegithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1;role_permission_v1b\x06proto3"
+	"\x06Revoke\x12!.role_permission.v1.RevokeRequest\x1a\x16.google.protobuf.Empty\"T\x8a\xb5\x18\x15role_permission:write\x82\xd3\xe4\x93\x025*3/api/v1/roles/{role_id}/permissions/{permission_id}\x12\xbc\x01\n" +
+	"\x12SetRolePermissions\x12-.role_permission.v1.SetRolePermissionsRequest\x1a..role_permission.v1.SetRolePermissionsResponse\"G\x8a\xb5\x18\x15role_permission:write\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/v1/roles/{role_id}/permissions\x12\xc5\x01\n" +
+	"\x11EvaluateCondition\x12,.role_permission.v1.EvaluateConditionRequest\x1a-.role_permission.v1.EvaluateConditionResponse\"S\x8a\xb5\x18\x15role_permission:write\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/role-permissions/conditions:evaluate\x90\x02\x01BgZegithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/role_permission/v1;role_permission_v1b\x06proto3"
 
 var (
 	file_role_permission_v1_role_permission_proto_rawDescOnce sync.Once
@@ -158,22 +431,36 @@ func file_role_permission_v1_role_permission_proto_rawDescGZIP() []byte {
 	return file_role_permission_v1_role_permission_proto_rawDescData
 }
 
-var file_role_permission_v1_role_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_role_permission_v1_role_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_role_permission_v1_role_permission_proto_goTypes = []any{
-	(*AssignRequest)(nil), // 0: role_permission.v1.AssignRequest
-	(*RevokeRequest)(nil), // 1: role_permission.v1.RevokeRequest
-	(*emptypb.Empty)(nil), // 2: google.protobuf.Empty
+	(*AssignRequest)(nil),              // 0: role_permission.v1.AssignRequest
+	(*RevokeRequest)(nil),              // 1: role_permission.v1.RevokeRequest
+	(*SetRolePermissionsRequest)(nil),  // 2: role_permission.v1.SetRolePermissionsRequest
+	(*SetRolePermissionsResponse)(nil), // 3: role_permission.v1.SetRolePermissionsResponse
+	(*EvaluateConditionRequest)(nil),   // 4: role_permission.v1.EvaluateConditionRequest
+	(*EvaluateConditionResponse)(nil),  // 5: role_permission.v1.EvaluateConditionResponse
+	(v1.PermissionEffect)(0),           // 6: common.v1.PermissionEffect
+	(*structpb.Struct)(nil),            // 7: google.protobuf.Struct
+	(*emptypb.Empty)(nil),              // 8: google.protobuf.Empty
 }
 var file_role_permission_v1_role_permission_proto_depIdxs = []int32{
-	0, // 0: role_permission.v1.RolePermissionService.Assign:input_type -> role_permission.v1.AssignRequest
-	1, // 1: role_permission.v1.RolePermissionService.Revoke:input_type -> role_permission.v1.RevokeRequest
-	2, // 2: role_permission.v1.RolePermissionService.Assign:output_type -> google.protobuf.Empty
-	2, // 3: role_permission.v1.RolePermissionService.Revoke:output_type -> google.protobuf.Empty
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: role_permission.v1.AssignRequest.effect:type_name -> common.v1.PermissionEffect
+	7, // 1: role_permission.v1.EvaluateConditionRequest.subject:type_name -> google.protobuf.Struct
+	7, // 2: role_permission.v1.EvaluateConditionRequest.resource:type_name -> google.protobuf.Struct
+	7, // 3: role_permission.v1.EvaluateConditionRequest.request:type_name -> google.protobuf.Struct
+	0, // 4: role_permission.v1.RolePermissionService.Assign:input_type -> role_permission.v1.AssignRequest
+	1, // 5: role_permission.v1.RolePermissionService.Revoke:input_type -> role_permission.v1.RevokeRequest
+	2, // 6: role_permission.v1.RolePermissionService.SetRolePermissions:input_type -> role_permission.v1.SetRolePermissionsRequest
+	4, // 7: role_permission.v1.RolePermissionService.EvaluateCondition:input_type -> role_permission.v1.EvaluateConditionRequest
+	8, // 8: role_permission.v1.RolePermissionService.Assign:output_type -> google.protobuf.Empty
+	8, // 9: role_permission.v1.RolePermissionService.Revoke:output_type -> google.protobuf.Empty
+	3, // 10: role_permission.v1.RolePermissionService.SetRolePermissions:output_type -> role_permission.v1.SetRolePermissionsResponse
+	5, // 11: role_permission.v1.RolePermissionService.EvaluateCondition:output_type -> role_permission.v1.EvaluateConditionResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_role_permission_v1_role_permission_proto_init() }
@@ -181,13 +468,14 @@ func file_role_permission_v1_role_permission_proto_init() {
 	if File_role_permission_v1_role_permission_proto != nil {
 		return
 	}
+	file_role_permission_v1_role_permission_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_role_permission_v1_role_permission_proto_rawDesc), len(file_role_permission_v1_role_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
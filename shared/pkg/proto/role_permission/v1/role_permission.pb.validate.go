@@ -17,6 +17,8 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	common_v1 "github.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/common/v1"
 )
 
 // ensure the imports are used
@@ -33,6 +35,8 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = common_v1.PermissionEffect(0)
 )
 
 // define the regex for a UUID once up-front
@@ -84,6 +88,32 @@ func (m *AssignRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := common_v1.PermissionEffect_name[int32(m.GetEffect())]; !ok {
+		err := AssignRequestValidationError{
+			field:  "Effect",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Condition != nil {
+
+		if l := utf8.RuneCountInString(m.GetCondition()); l < 1 || l > 1000 {
+			err := AssignRequestValidationError{
+				field:  "Condition",
+				reason: "value length must be between 1 and 1000 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AssignRequestMultiError(errors)
 	}
@@ -301,3 +331,574 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = RevokeRequestValidationError{}
+
+// Validate checks the field values on SetRolePermissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRolePermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRolePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRolePermissionsRequestMultiError, or nil if none found.
+func (m *SetRolePermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRolePermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRoleId()); err != nil {
+		err = SetRolePermissionsRequestValidationError{
+			field:  "RoleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPermissionIds()) > 500 {
+		err := SetRolePermissionsRequestValidationError{
+			field:  "PermissionIds",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SetRolePermissionsRequest_PermissionIds_Unique := make(map[string]struct{}, len(m.GetPermissionIds()))
+
+	for idx, item := range m.GetPermissionIds() {
+		_, _ = idx, item
+
+		if _, exists := _SetRolePermissionsRequest_PermissionIds_Unique[item]; exists {
+			err := SetRolePermissionsRequestValidationError{
+				field:  fmt.Sprintf("PermissionIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SetRolePermissionsRequest_PermissionIds_Unique[item] = struct{}{}
+		}
+
+		if err := m._validateUuid(item); err != nil {
+			err = SetRolePermissionsRequestValidationError{
+				field:  fmt.Sprintf("PermissionIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetRolePermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetRolePermissionsRequest) _validateUuid(uuid string) error {
+	if matched := _role_permission_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetRolePermissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRolePermissionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetRolePermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRolePermissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRolePermissionsRequestMultiError) AllErrors() []error { return m }
+
+// SetRolePermissionsRequestValidationError is the validation error returned by
+// SetRolePermissionsRequest.Validate if the designated constraints aren't met.
+type SetRolePermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRolePermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRolePermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRolePermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRolePermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRolePermissionsRequestValidationError) ErrorName() string {
+	return "SetRolePermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRolePermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRolePermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRolePermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRolePermissionsRequestValidationError{}
+
+// Validate checks the field values on SetRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetRolePermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetRolePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetRolePermissionsResponseMultiError, or nil if none found.
+func (m *SetRolePermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetRolePermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetRolePermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetRolePermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by SetRolePermissionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetRolePermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetRolePermissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetRolePermissionsResponseMultiError) AllErrors() []error { return m }
+
+// SetRolePermissionsResponseValidationError is the validation error returned
+// by SetRolePermissionsResponse.Validate if the designated constraints aren't met.
+type SetRolePermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRolePermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRolePermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRolePermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRolePermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRolePermissionsResponseValidationError) ErrorName() string {
+	return "SetRolePermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetRolePermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRolePermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRolePermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRolePermissionsResponseValidationError{}
+
+// Validate checks the field values on EvaluateConditionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateConditionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateConditionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateConditionRequestMultiError, or nil if none found.
+func (m *EvaluateConditionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateConditionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCondition()); l < 1 || l > 1000 {
+		err := EvaluateConditionRequestValidationError{
+			field:  "Condition",
+			reason: "value length must be between 1 and 1000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateConditionRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateConditionRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EvaluateConditionRequestValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateConditionRequestValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EvaluateConditionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateConditionRequestMultiError is an error wrapping multiple validation
+// errors returned by EvaluateConditionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EvaluateConditionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateConditionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateConditionRequestMultiError) AllErrors() []error { return m }
+
+// EvaluateConditionRequestValidationError is the validation error returned by
+// EvaluateConditionRequest.Validate if the designated constraints aren't met.
+type EvaluateConditionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateConditionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateConditionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateConditionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateConditionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateConditionRequestValidationError) ErrorName() string {
+	return "EvaluateConditionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateConditionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateConditionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateConditionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateConditionRequestValidationError{}
+
+// Validate checks the field values on EvaluateConditionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateConditionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateConditionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateConditionResponseMultiError, or nil if none found.
+func (m *EvaluateConditionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateConditionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return EvaluateConditionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateConditionResponseMultiError is an error wrapping multiple validation
+// errors returned by EvaluateConditionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type EvaluateConditionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateConditionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateConditionResponseMultiError) AllErrors() []error { return m }
+
+// EvaluateConditionResponseValidationError is the validation error returned by
+// EvaluateConditionResponse.Validate if the designated constraints aren't met.
+type EvaluateConditionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateConditionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateConditionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateConditionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateConditionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateConditionResponseValidationError) ErrorName() string {
+	return "EvaluateConditionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateConditionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateConditionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateConditionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateConditionResponseValidationError{}
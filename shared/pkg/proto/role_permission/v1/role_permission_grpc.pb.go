@@ -20,8 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RolePermissionService_Assign_FullMethodName = "/role_permission.v1.RolePermissionService/Assign"
-	RolePermissionService_Revoke_FullMethodName = "/role_permission.v1.RolePermissionService/Revoke"
+	RolePermissionService_Assign_FullMethodName             = "/role_permission.v1.RolePermissionService/Assign"
+	RolePermissionService_Revoke_FullMethodName             = "/role_permission.v1.RolePermissionService/Revoke"
+	RolePermissionService_SetRolePermissions_FullMethodName = "/role_permission.v1.RolePermissionService/SetRolePermissions"
+	RolePermissionService_EvaluateCondition_FullMethodName  = "/role_permission.v1.RolePermissionService/EvaluateCondition"
 )
 
 // RolePermissionServiceClient is the client API for RolePermissionService service.
@@ -32,6 +34,10 @@ type RolePermissionServiceClient interface {
 	Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Отзыв права у роли
 	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Замена полного набора прав роли в одной транзакции
+	SetRolePermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*SetRolePermissionsResponse, error)
+	// Тестовое вычисление условия назначения по переданным атрибутам
+	EvaluateCondition(ctx context.Context, in *EvaluateConditionRequest, opts ...grpc.CallOption) (*EvaluateConditionResponse, error)
 }
 
 type rolePermissionServiceClient struct {
@@ -62,6 +68,26 @@ func (c *rolePermissionServiceClient) Revoke(ctx context.Context, in *RevokeRequ
 	return out, nil
 }
 
+func (c *rolePermissionServiceClient) SetRolePermissions(ctx context.Context, in *SetRolePermissionsRequest, opts ...grpc.CallOption) (*SetRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, RolePermissionService_SetRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolePermissionServiceClient) EvaluateCondition(ctx context.Context, in *EvaluateConditionRequest, opts ...grpc.CallOption) (*EvaluateConditionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateConditionResponse)
+	err := c.cc.Invoke(ctx, RolePermissionService_EvaluateCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RolePermissionServiceServer is the server API for RolePermissionService service.
 // All implementations must embed UnimplementedRolePermissionServiceServer
 // for forward compatibility.
@@ -70,6 +96,10 @@ type RolePermissionServiceServer interface {
 	Assign(context.Context, *AssignRequest) (*emptypb.Empty, error)
 	// Отзыв права у роли
 	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
+	// Замена полного набора прав роли в одной транзакции
+	SetRolePermissions(context.Context, *SetRolePermissionsRequest) (*SetRolePermissionsResponse, error)
+	// Тестовое вычисление условия назначения по переданным атрибутам
+	EvaluateCondition(context.Context, *EvaluateConditionRequest) (*EvaluateConditionResponse, error)
 	mustEmbedUnimplementedRolePermissionServiceServer()
 }
 
@@ -86,6 +116,12 @@ func (UnimplementedRolePermissionServiceServer) Assign(context.Context, *AssignR
 func (UnimplementedRolePermissionServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedRolePermissionServiceServer) SetRolePermissions(context.Context, *SetRolePermissionsRequest) (*SetRolePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRolePermissions not implemented")
+}
+func (UnimplementedRolePermissionServiceServer) EvaluateCondition(context.Context, *EvaluateConditionRequest) (*EvaluateConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateCondition not implemented")
+}
 func (UnimplementedRolePermissionServiceServer) mustEmbedUnimplementedRolePermissionServiceServer() {}
 func (UnimplementedRolePermissionServiceServer) testEmbeddedByValue()                               {}
 
@@ -143,6 +179,42 @@ func _RolePermissionService_Revoke_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolePermissionService_SetRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolePermissionServiceServer).SetRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolePermissionService_SetRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolePermissionServiceServer).SetRolePermissions(ctx, req.(*SetRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolePermissionService_EvaluateCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolePermissionServiceServer).EvaluateCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolePermissionService_EvaluateCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolePermissionServiceServer).EvaluateCondition(ctx, req.(*EvaluateConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RolePermissionService_ServiceDesc is the grpc.ServiceDesc for RolePermissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +230,14 @@ var RolePermissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _RolePermissionService_Revoke_Handler,
 		},
+		{
+			MethodName: "SetRolePermissions",
+			Handler:    _RolePermissionService_SetRolePermissions_Handler,
+		},
+		{
+			MethodName: "EvaluateCondition",
+			Handler:    _RolePermissionService_EvaluateCondition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "role_permission/v1/role_permission.proto",
@@ -212,6 +212,96 @@ func (x *GetUserResponse) GetUser() *v1.User {
 	return nil
 }
 
+// Запрос пакета пользователей
+type GetUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersRequest) Reset() {
+	*x = GetUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersRequest) ProtoMessage() {}
+
+func (x *GetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUsersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// Ответ с найденными пользователями без методов уведомлений; отсутствующие ID пропускаются
+type GetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*v1.User             `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersResponse) Reset() {
+	*x = GetUsersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersResponse) ProtoMessage() {}
+
+func (x *GetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUsersResponse) GetUsers() []*v1.User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
@@ -225,10 +315,15 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\"@\n" +
 	"\x0fGetUserResponse\x12-\n" +
-	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04user2\xc2\x01\n" +
-	"\vUserService\x12f\n" +
-	"\bRegister\x12\x18.user.v1.RegisterRequest\x1a\x19.user.v1.RegisterResponse\"%\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/users/register\x12K\n" +
-	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\r\x8a\xb5\x18\tuser:readBQZOgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1;user_v1b\x06proto3"
+	"\x04user\x18\x01 \x01(\v2\x0f.common.v1.UserB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04user\"?\n" +
+	"\x0fGetUsersRequest\x12,\n" +
+	"\buser_ids\x18\x01 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\auserIds\"9\n" +
+	"\x10GetUsersResponse\x12%\n" +
+	"\x05users\x18\x01 \x03(\v2\x0f.common.v1.UserR\x05users2\x9c\x02\n" +
+	"\vUserService\x12j\n" +
+	"\bRegister\x12\x18.user.v1.RegisterRequest\x1a\x19.user.v1.RegisterResponse\")\x90\xb5\x18\x01\xa0\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/users/register\x12N\n" +
+	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\"\x10\x8a\xb5\x18\tuser:read\x90\x02\x01\x12Q\n" +
+	"\bGetUsers\x12\x18.user.v1.GetUsersRequest\x1a\x19.user.v1.GetUsersResponse\"\x10\x8a\xb5\x18\tuser:read\x90\x02\x01BQZOgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user/v1;user_v1b\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -242,27 +337,32 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_user_v1_user_proto_goTypes = []any{
 	(*RegisterRequest)(nil),  // 0: user.v1.RegisterRequest
 	(*RegisterResponse)(nil), // 1: user.v1.RegisterResponse
 	(*GetUserRequest)(nil),   // 2: user.v1.GetUserRequest
 	(*GetUserResponse)(nil),  // 3: user.v1.GetUserResponse
-	(*v1.UserInfo)(nil),      // 4: common.v1.UserInfo
-	(*v1.User)(nil),          // 5: common.v1.User
+	(*GetUsersRequest)(nil),  // 4: user.v1.GetUsersRequest
+	(*GetUsersResponse)(nil), // 5: user.v1.GetUsersResponse
+	(*v1.UserInfo)(nil),      // 6: common.v1.UserInfo
+	(*v1.User)(nil),          // 7: common.v1.User
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	4, // 0: user.v1.RegisterRequest.info:type_name -> common.v1.UserInfo
-	5, // 1: user.v1.GetUserResponse.user:type_name -> common.v1.User
-	0, // 2: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
-	2, // 3: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	1, // 4: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
-	3, // 5: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: user.v1.RegisterRequest.info:type_name -> common.v1.UserInfo
+	7, // 1: user.v1.GetUserResponse.user:type_name -> common.v1.User
+	7, // 2: user.v1.GetUsersResponse.users:type_name -> common.v1.User
+	0, // 3: user.v1.UserService.Register:input_type -> user.v1.RegisterRequest
+	2, // 4: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	4, // 5: user.v1.UserService.GetUsers:input_type -> user.v1.GetUsersRequest
+	1, // 6: user.v1.UserService.Register:output_type -> user.v1.RegisterResponse
+	3, // 7: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	5, // 8: user.v1.UserService.GetUsers:output_type -> user.v1.GetUsersResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -276,7 +376,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -568,3 +568,273 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetUserResponseValidationError{}
+
+// Validate checks the field values on GetUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersRequestMultiError, or nil if none found.
+func (m *GetUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := GetUsersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = GetUsersRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUsersRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersRequestMultiError) AllErrors() []error { return m }
+
+// GetUsersRequestValidationError is the validation error returned by
+// GetUsersRequest.Validate if the designated constraints aren't met.
+type GetUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersRequestValidationError) ErrorName() string { return "GetUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersRequestValidationError{}
+
+// Validate checks the field values on GetUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsersResponseMultiError, or nil if none found.
+func (m *GetUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsersResponseMultiError) AllErrors() []error { return m }
+
+// GetUsersResponseValidationError is the validation error returned by
+// GetUsersResponse.Validate if the designated constraints aren't met.
+type GetUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsersResponseValidationError) ErrorName() string { return "GetUsersResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsersResponseValidationError{}
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,12 +26,82 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Результат назначения одной пары пользователь-роль
+type BulkAssignStatus int32
+
+const (
+	BulkAssignStatus_BULK_ASSIGN_STATUS_UNSPECIFIED      BulkAssignStatus = 0
+	BulkAssignStatus_BULK_ASSIGN_STATUS_ASSIGNED         BulkAssignStatus = 1
+	BulkAssignStatus_BULK_ASSIGN_STATUS_ALREADY_ASSIGNED BulkAssignStatus = 2
+	// Роль или пользователь не найдены
+	BulkAssignStatus_BULK_ASSIGN_STATUS_NOT_FOUND               BulkAssignStatus = 3
+	BulkAssignStatus_BULK_ASSIGN_STATUS_INVALID_VALIDITY_PERIOD BulkAssignStatus = 4
+	// Роль назначается только через согласованную заявку на доступ
+	BulkAssignStatus_BULK_ASSIGN_STATUS_REQUIRES_APPROVAL BulkAssignStatus = 5
+	// Назначение нарушает ограничение разделения обязанностей
+	BulkAssignStatus_BULK_ASSIGN_STATUS_SOD_VIOLATION BulkAssignStatus = 6
+)
+
+// Enum value maps for BulkAssignStatus.
+var (
+	BulkAssignStatus_name = map[int32]string{
+		0: "BULK_ASSIGN_STATUS_UNSPECIFIED",
+		1: "BULK_ASSIGN_STATUS_ASSIGNED",
+		2: "BULK_ASSIGN_STATUS_ALREADY_ASSIGNED",
+		3: "BULK_ASSIGN_STATUS_NOT_FOUND",
+		4: "BULK_ASSIGN_STATUS_INVALID_VALIDITY_PERIOD",
+		5: "BULK_ASSIGN_STATUS_REQUIRES_APPROVAL",
+		6: "BULK_ASSIGN_STATUS_SOD_VIOLATION",
+	}
+	BulkAssignStatus_value = map[string]int32{
+		"BULK_ASSIGN_STATUS_UNSPECIFIED":             0,
+		"BULK_ASSIGN_STATUS_ASSIGNED":                1,
+		"BULK_ASSIGN_STATUS_ALREADY_ASSIGNED":        2,
+		"BULK_ASSIGN_STATUS_NOT_FOUND":               3,
+		"BULK_ASSIGN_STATUS_INVALID_VALIDITY_PERIOD": 4,
+		"BULK_ASSIGN_STATUS_REQUIRES_APPROVAL":       5,
+		"BULK_ASSIGN_STATUS_SOD_VIOLATION":           6,
+	}
+)
+
+func (x BulkAssignStatus) Enum() *BulkAssignStatus {
+	p := new(BulkAssignStatus)
+	*p = x
+	return p
+}
+
+func (x BulkAssignStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkAssignStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_role_v1_user_role_proto_enumTypes[0].Descriptor()
+}
+
+func (BulkAssignStatus) Type() protoreflect.EnumType {
+	return &file_user_role_v1_user_role_proto_enumTypes[0]
+}
+
+func (x BulkAssignStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkAssignStatus.Descriptor instead.
+func (BulkAssignStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{0}
+}
+
 // Запрос на назначение роли пользователю
 type AssignRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	AssignedBy    *string                `protobuf:"bytes,3,opt,name=assigned_by,json=assignedBy,proto3,oneof" json:"assigned_by,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// Игнорируется: инициатором назначения считается пользователь сессии
+	AssignedBy *string `protobuf:"bytes,3,opt,name=assigned_by,json=assignedBy,proto3,oneof" json:"assigned_by,omitempty"`
+	// Начало действия назначения (по умолчанию — момент назначения)
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`
+	// Окончание действия назначения (если не указано — бессрочно)
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -86,6 +157,179 @@ func (x *AssignRequest) GetAssignedBy() string {
 	return ""
 }
 
+func (x *AssignRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *AssignRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+// Запрос на пакетное назначение ролей
+type BulkAssignUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*AssignRequest       `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAssignUserRolesRequest) Reset() {
+	*x = BulkAssignUserRolesRequest{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAssignUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAssignUserRolesRequest) ProtoMessage() {}
+
+func (x *BulkAssignUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAssignUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*BulkAssignUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BulkAssignUserRolesRequest) GetAssignments() []*AssignRequest {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// Результат по элементу пакета, в порядке запроса
+type BulkAssignResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId        string                 `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Status        BulkAssignStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=user_role.v1.BulkAssignStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAssignResult) Reset() {
+	*x = BulkAssignResult{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAssignResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAssignResult) ProtoMessage() {}
+
+func (x *BulkAssignResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAssignResult.ProtoReflect.Descriptor instead.
+func (*BulkAssignResult) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BulkAssignResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkAssignResult) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *BulkAssignResult) GetStatus() BulkAssignStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkAssignStatus_BULK_ASSIGN_STATUS_UNSPECIFIED
+}
+
+// Ответ на пакетное назначение ролей
+type BulkAssignUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkAssignResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AssignedCount int32                  `protobuf:"varint,2,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAssignUserRolesResponse) Reset() {
+	*x = BulkAssignUserRolesResponse{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAssignUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAssignUserRolesResponse) ProtoMessage() {}
+
+func (x *BulkAssignUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAssignUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*BulkAssignUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BulkAssignUserRolesResponse) GetResults() []*BulkAssignResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkAssignUserRolesResponse) GetAssignedCount() int32 {
+	if x != nil {
+		return x.AssignedCount
+	}
+	return 0
+}
+
 // Запрос на отзыв роли у пользователя
 type RevokeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -97,7 +341,7 @@ type RevokeRequest struct {
 
 func (x *RevokeRequest) Reset() {
 	*x = RevokeRequest{}
-	mi := &file_user_role_v1_user_role_proto_msgTypes[1]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -109,7 +353,7 @@ func (x *RevokeRequest) String() string {
 func (*RevokeRequest) ProtoMessage() {}
 
 func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_role_v1_user_role_proto_msgTypes[1]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,7 +366,7 @@ func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
 func (*RevokeRequest) Descriptor() ([]byte, []int) {
-	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{1}
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RevokeRequest) GetUserId() string {
@@ -149,7 +393,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_user_role_v1_user_role_proto_msgTypes[2]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -161,7 +405,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_role_v1_user_role_proto_msgTypes[2]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +418,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{2}
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetUserRolesRequest) GetUserId() string {
@@ -194,7 +438,7 @@ type GetUserRolesResponse struct {
 
 func (x *GetUserRolesResponse) Reset() {
 	*x = GetUserRolesResponse{}
-	mi := &file_user_role_v1_user_role_proto_msgTypes[3]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +450,7 @@ func (x *GetUserRolesResponse) String() string {
 func (*GetUserRolesResponse) ProtoMessage() {}
 
 func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_role_v1_user_role_proto_msgTypes[3]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +463,7 @@ func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{3}
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetUserRolesResponse) GetData() []*v1.RoleWithPermissions {
@@ -242,7 +486,7 @@ type GetRoleUsersRequest struct {
 
 func (x *GetRoleUsersRequest) Reset() {
 	*x = GetRoleUsersRequest{}
-	mi := &file_user_role_v1_user_role_proto_msgTypes[4]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +498,7 @@ func (x *GetRoleUsersRequest) String() string {
 func (*GetRoleUsersRequest) ProtoMessage() {}
 
 func (x *GetRoleUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_role_v1_user_role_proto_msgTypes[4]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +511,7 @@ func (x *GetRoleUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetRoleUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{4}
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetRoleUsersRequest) GetRoleId() string {
@@ -304,7 +548,7 @@ type GetRoleUsersResponse struct {
 
 func (x *GetRoleUsersResponse) Reset() {
 	*x = GetRoleUsersResponse{}
-	mi := &file_user_role_v1_user_role_proto_msgTypes[5]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +560,7 @@ func (x *GetRoleUsersResponse) String() string {
 func (*GetRoleUsersResponse) ProtoMessage() {}
 
 func (x *GetRoleUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_role_v1_user_role_proto_msgTypes[5]
+	mi := &file_user_role_v1_user_role_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +573,7 @@ func (x *GetRoleUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetRoleUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{5}
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetRoleUsersResponse) GetUserIds() []string {
@@ -360,17 +604,223 @@ func (x *GetRoleUsersResponse) GetHasMore() bool {
 	return false
 }
 
+// Запрос на получение участников роли
+type GetRoleMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        string                 `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // ID последнего пользователя с предыдущей страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleMembersRequest) Reset() {
+	*x = GetRoleMembersRequest{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleMembersRequest) ProtoMessage() {}
+
+func (x *GetRoleMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetRoleMembersRequest) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRoleMembersRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *GetRoleMembersRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetRoleMembersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// Участник роли. Логин и email отсутствуют, если IAM не вернул пользователя
+type RoleMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Login         *string                `protobuf:"bytes,2,opt,name=login,proto3,oneof" json:"login,omitempty"`
+	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleMember) Reset() {
+	*x = RoleMember{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMember) ProtoMessage() {}
+
+func (x *RoleMember) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMember.ProtoReflect.Descriptor instead.
+func (*RoleMember) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RoleMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoleMember) GetLogin() string {
+	if x != nil && x.Login != nil {
+		return *x.Login
+	}
+	return ""
+}
+
+func (x *RoleMember) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+// Ответ с участниками роли
+type GetRoleMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*RoleMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Лимит записей на страницу
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Курсор для следующей страницы (если есть)
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`               // Есть ли еще записи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoleMembersResponse) Reset() {
+	*x = GetRoleMembersResponse{}
+	mi := &file_user_role_v1_user_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoleMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleMembersResponse) ProtoMessage() {}
+
+func (x *GetRoleMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_role_v1_user_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetRoleMembersResponse) Descriptor() ([]byte, []int) {
+	return file_user_role_v1_user_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRoleMembersResponse) GetMembers() []*RoleMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GetRoleMembersResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRoleMembersResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetRoleMembersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 var File_user_role_v1_user_role_proto protoreflect.FileDescriptor
 
 const file_user_role_v1_user_role_proto_rawDesc = "" +
 	"\n" +
-	"\x1cuser_role/v1/user_role.proto\x12\fuser_role.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x14common/v1/role.proto\x1a\x1bcommon/v1/annotations.proto\"\x95\x01\n" +
+	"\x1cuser_role/v1/user_role.proto\x12\fuser_role.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\x14common/v1/role.proto\x1a\x1bcommon/v1/annotations.proto\"\xb6\x02\n" +
 	"\rAssignRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
 	"\arole_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x12.\n" +
 	"\vassigned_by\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\n" +
-	"assignedBy\x88\x01\x01B\x0e\n" +
-	"\f_assigned_by\"U\n" +
+	"assignedBy\x88\x01\x01\x12>\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tvalidFrom\x88\x01\x01\x12@\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
+	"validUntil\x88\x01\x01B\x0e\n" +
+	"\f_assigned_byB\r\n" +
+	"\v_valid_fromB\x0e\n" +
+	"\f_valid_until\"h\n" +
+	"\x1aBulkAssignUserRolesRequest\x12J\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1b.user_role.v1.AssignRequestB\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\vassignments\"|\n" +
+	"\x10BulkAssignResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.user_role.v1.BulkAssignStatusR\x06status\"~\n" +
+	"\x1bBulkAssignUserRolesResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.user_role.v1.BulkAssignResultR\aresults\x12%\n" +
+	"\x0eassigned_count\x18\x02 \x01(\x05R\rassignedCount\"U\n" +
 	"\rRevokeRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
 	"\arole_id\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\"8\n" +
@@ -391,12 +841,42 @@ const file_user_role_v1_user_role_proto_rawDesc = "" +
 	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
 	"nextCursor\x88\x01\x01\x12\x19\n" +
 	"\bhas_more\x18\x04 \x01(\bR\ahasMoreB\x0e\n" +
-	"\f_next_cursor2\xb3\x04\n" +
+	"\f_next_cursor\"\x92\x01\n" +
+	"\x15GetRoleMembersRequest\x12!\n" +
+	"\arole_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x06roleId\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"o\n" +
+	"\n" +
+	"RoleMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05login\x18\x02 \x01(\tH\x00R\x05login\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01B\b\n" +
+	"\x06_loginB\b\n" +
+	"\x06_email\"\xb3\x01\n" +
+	"\x16GetRoleMembersResponse\x122\n" +
+	"\amembers\x18\x01 \x03(\v2\x18.user_role.v1.RoleMemberR\amembers\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMoreB\x0e\n" +
+	"\f_next_cursor*\xa2\x02\n" +
+	"\x10BulkAssignStatus\x12\"\n" +
+	"\x1eBULK_ASSIGN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBULK_ASSIGN_STATUS_ASSIGNED\x10\x01\x12'\n" +
+	"#BULK_ASSIGN_STATUS_ALREADY_ASSIGNED\x10\x02\x12 \n" +
+	"\x1cBULK_ASSIGN_STATUS_NOT_FOUND\x10\x03\x12.\n" +
+	"*BULK_ASSIGN_STATUS_INVALID_VALIDITY_PERIOD\x10\x04\x12(\n" +
+	"$BULK_ASSIGN_STATUS_REQUIRES_APPROVAL\x10\x05\x12$\n" +
+	" BULK_ASSIGN_STATUS_SOD_VIOLATION\x10\x062\xff\x06\n" +
 	"\x0fUserRoleService\x12z\n" +
-	"\x06Assign\x12\x1b.user_role.v1.AssignRequest\x1a\x16.google.protobuf.Empty\";\x8a\xb5\x18\x0fuser_role:write\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/users/{user_id}/roles\x12\x81\x01\n" +
-	"\x06Revoke\x12\x1b.user_role.v1.RevokeRequest\x1a\x16.google.protobuf.Empty\"B\x8a\xb5\x18\x0fuser_role:write\x82\xd3\xe4\x93\x02)*'/api/v1/users/{user_id}/roles/{role_id}\x12\x8e\x01\n" +
-	"\fGetUserRoles\x12!.user_role.v1.GetUserRolesRequest\x1a\".user_role.v1.GetUserRolesResponse\"7\x8a\xb5\x18\x0euser_role:read\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{user_id}/roles\x12\x8e\x01\n" +
-	"\fGetRoleUsers\x12!.user_role.v1.GetRoleUsersRequest\x1a\".user_role.v1.GetRoleUsersResponse\"7\x8a\xb5\x18\x0euser_role:read\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/roles/{role_id}/usersB[ZYgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1;user_role_v1b\x06proto3"
+	"\x06Assign\x12\x1b.user_role.v1.AssignRequest\x1a\x16.google.protobuf.Empty\";\x8a\xb5\x18\x0fuser_role:write\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/users/{user_id}/roles\x12\xa7\x01\n" +
+	"\x13BulkAssignUserRoles\x12(.user_role.v1.BulkAssignUserRolesRequest\x1a).user_role.v1.BulkAssignUserRolesResponse\";\x8a\xb5\x18\x0fuser_role:write\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/user-roles:bulkAssign\x12\x81\x01\n" +
+	"\x06Revoke\x12\x1b.user_role.v1.RevokeRequest\x1a\x16.google.protobuf.Empty\"B\x8a\xb5\x18\x0fuser_role:write\x82\xd3\xe4\x93\x02)*'/api/v1/users/{user_id}/roles/{role_id}\x12\x91\x01\n" +
+	"\fGetUserRoles\x12!.user_role.v1.GetUserRolesRequest\x1a\".user_role.v1.GetUserRolesResponse\":\x8a\xb5\x18\x0euser_role:read\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/users/{user_id}/roles\x90\x02\x01\x12\x91\x01\n" +
+	"\fGetRoleUsers\x12!.user_role.v1.GetRoleUsersRequest\x1a\".user_role.v1.GetRoleUsersResponse\":\x8a\xb5\x18\x0euser_role:read\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/roles/{role_id}/users\x90\x02\x01\x12\x99\x01\n" +
+	"\x0eGetRoleMembers\x12#.user_role.v1.GetRoleMembersRequest\x1a$.user_role.v1.GetRoleMembersResponse\"<\x8a\xb5\x18\x0euser_role:read\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/roles/{role_id}/members\x90\x02\x01B[ZYgithub.com/Alexander-Mandzhiev/school_schedule/shared/pkg/proto/user_role/v1;user_role_v1b\x06proto3"
 
 var (
 	file_user_role_v1_user_role_proto_rawDescOnce sync.Once
@@ -410,32 +890,51 @@ func file_user_role_v1_user_role_proto_rawDescGZIP() []byte {
 	return file_user_role_v1_user_role_proto_rawDescData
 }
 
-var file_user_role_v1_user_role_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_role_v1_user_role_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_role_v1_user_role_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_user_role_v1_user_role_proto_goTypes = []any{
-	(*AssignRequest)(nil),          // 0: user_role.v1.AssignRequest
-	(*RevokeRequest)(nil),          // 1: user_role.v1.RevokeRequest
-	(*GetUserRolesRequest)(nil),    // 2: user_role.v1.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),   // 3: user_role.v1.GetUserRolesResponse
-	(*GetRoleUsersRequest)(nil),    // 4: user_role.v1.GetRoleUsersRequest
-	(*GetRoleUsersResponse)(nil),   // 5: user_role.v1.GetRoleUsersResponse
-	(*v1.RoleWithPermissions)(nil), // 6: common.v1.RoleWithPermissions
-	(*emptypb.Empty)(nil),          // 7: google.protobuf.Empty
+	(BulkAssignStatus)(0),               // 0: user_role.v1.BulkAssignStatus
+	(*AssignRequest)(nil),               // 1: user_role.v1.AssignRequest
+	(*BulkAssignUserRolesRequest)(nil),  // 2: user_role.v1.BulkAssignUserRolesRequest
+	(*BulkAssignResult)(nil),            // 3: user_role.v1.BulkAssignResult
+	(*BulkAssignUserRolesResponse)(nil), // 4: user_role.v1.BulkAssignUserRolesResponse
+	(*RevokeRequest)(nil),               // 5: user_role.v1.RevokeRequest
+	(*GetUserRolesRequest)(nil),         // 6: user_role.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),        // 7: user_role.v1.GetUserRolesResponse
+	(*GetRoleUsersRequest)(nil),         // 8: user_role.v1.GetRoleUsersRequest
+	(*GetRoleUsersResponse)(nil),        // 9: user_role.v1.GetRoleUsersResponse
+	(*GetRoleMembersRequest)(nil),       // 10: user_role.v1.GetRoleMembersRequest
+	(*RoleMember)(nil),                  // 11: user_role.v1.RoleMember
+	(*GetRoleMembersResponse)(nil),      // 12: user_role.v1.GetRoleMembersResponse
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*v1.RoleWithPermissions)(nil),      // 14: common.v1.RoleWithPermissions
+	(*emptypb.Empty)(nil),               // 15: google.protobuf.Empty
 }
 var file_user_role_v1_user_role_proto_depIdxs = []int32{
-	6, // 0: user_role.v1.GetUserRolesResponse.data:type_name -> common.v1.RoleWithPermissions
-	0, // 1: user_role.v1.UserRoleService.Assign:input_type -> user_role.v1.AssignRequest
-	1, // 2: user_role.v1.UserRoleService.Revoke:input_type -> user_role.v1.RevokeRequest
-	2, // 3: user_role.v1.UserRoleService.GetUserRoles:input_type -> user_role.v1.GetUserRolesRequest
-	4, // 4: user_role.v1.UserRoleService.GetRoleUsers:input_type -> user_role.v1.GetRoleUsersRequest
-	7, // 5: user_role.v1.UserRoleService.Assign:output_type -> google.protobuf.Empty
-	7, // 6: user_role.v1.UserRoleService.Revoke:output_type -> google.protobuf.Empty
-	3, // 7: user_role.v1.UserRoleService.GetUserRoles:output_type -> user_role.v1.GetUserRolesResponse
-	5, // 8: user_role.v1.UserRoleService.GetRoleUsers:output_type -> user_role.v1.GetRoleUsersResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	13, // 0: user_role.v1.AssignRequest.valid_from:type_name -> google.protobuf.Timestamp
+	13, // 1: user_role.v1.AssignRequest.valid_until:type_name -> google.protobuf.Timestamp
+	1,  // 2: user_role.v1.BulkAssignUserRolesRequest.assignments:type_name -> user_role.v1.AssignRequest
+	0,  // 3: user_role.v1.BulkAssignResult.status:type_name -> user_role.v1.BulkAssignStatus
+	3,  // 4: user_role.v1.BulkAssignUserRolesResponse.results:type_name -> user_role.v1.BulkAssignResult
+	14, // 5: user_role.v1.GetUserRolesResponse.data:type_name -> common.v1.RoleWithPermissions
+	11, // 6: user_role.v1.GetRoleMembersResponse.members:type_name -> user_role.v1.RoleMember
+	1,  // 7: user_role.v1.UserRoleService.Assign:input_type -> user_role.v1.AssignRequest
+	2,  // 8: user_role.v1.UserRoleService.BulkAssignUserRoles:input_type -> user_role.v1.BulkAssignUserRolesRequest
+	5,  // 9: user_role.v1.UserRoleService.Revoke:input_type -> user_role.v1.RevokeRequest
+	6,  // 10: user_role.v1.UserRoleService.GetUserRoles:input_type -> user_role.v1.GetUserRolesRequest
+	8,  // 11: user_role.v1.UserRoleService.GetRoleUsers:input_type -> user_role.v1.GetRoleUsersRequest
+	10, // 12: user_role.v1.UserRoleService.GetRoleMembers:input_type -> user_role.v1.GetRoleMembersRequest
+	15, // 13: user_role.v1.UserRoleService.Assign:output_type -> google.protobuf.Empty
+	4,  // 14: user_role.v1.UserRoleService.BulkAssignUserRoles:output_type -> user_role.v1.BulkAssignUserRolesResponse
+	15, // 15: user_role.v1.UserRoleService.Revoke:output_type -> google.protobuf.Empty
+	7,  // 16: user_role.v1.UserRoleService.GetUserRoles:output_type -> user_role.v1.GetUserRolesResponse
+	9,  // 17: user_role.v1.UserRoleService.GetRoleUsers:output_type -> user_role.v1.GetRoleUsersResponse
+	12, // 18: user_role.v1.UserRoleService.GetRoleMembers:output_type -> user_role.v1.GetRoleMembersResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_user_role_v1_user_role_proto_init() }
@@ -444,20 +943,24 @@ func file_user_role_v1_user_role_proto_init() {
 		return
 	}
 	file_user_role_v1_user_role_proto_msgTypes[0].OneofWrappers = []any{}
-	file_user_role_v1_user_role_proto_msgTypes[4].OneofWrappers = []any{}
-	file_user_role_v1_user_role_proto_msgTypes[5].OneofWrappers = []any{}
+	file_user_role_v1_user_role_proto_msgTypes[7].OneofWrappers = []any{}
+	file_user_role_v1_user_role_proto_msgTypes[8].OneofWrappers = []any{}
+	file_user_role_v1_user_role_proto_msgTypes[9].OneofWrappers = []any{}
+	file_user_role_v1_user_role_proto_msgTypes[10].OneofWrappers = []any{}
+	file_user_role_v1_user_role_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_role_v1_user_role_proto_rawDesc), len(file_user_role_v1_user_role_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_role_v1_user_role_proto_goTypes,
 		DependencyIndexes: file_user_role_v1_user_role_proto_depIdxs,
+		EnumInfos:         file_user_role_v1_user_role_proto_enumTypes,
 		MessageInfos:      file_user_role_v1_user_role_proto_msgTypes,
 	}.Build()
 	File_user_role_v1_user_role_proto = out.File
@@ -100,6 +100,72 @@ func (m *AssignRequest) validate(all bool) error {
 
 	}
 
+	if m.ValidFrom != nil {
+
+		if all {
+			switch v := interface{}(m.GetValidFrom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssignRequestValidationError{
+						field:  "ValidFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssignRequestValidationError{
+						field:  "ValidFrom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssignRequestValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ValidUntil != nil {
+
+		if all {
+			switch v := interface{}(m.GetValidUntil()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssignRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssignRequestValidationError{
+						field:  "ValidUntil",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssignRequestValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AssignRequestMultiError(errors)
 	}
@@ -186,33 +252,32 @@ var _ interface {
 	ErrorName() string
 } = AssignRequestValidationError{}
 
-// Validate checks the field values on RevokeRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RevokeRequest) Validate() error {
+// Validate checks the field values on BulkAssignUserRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkAssignUserRolesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RevokeRequestMultiError, or
-// nil if none found.
-func (m *RevokeRequest) ValidateAll() error {
+// ValidateAll checks the field values on BulkAssignUserRolesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkAssignUserRolesRequestMultiError, or nil if none found.
+func (m *BulkAssignUserRolesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeRequest) validate(all bool) error {
+func (m *BulkAssignUserRolesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetUserId()); err != nil {
-		err = RevokeRequestValidationError{
-			field:  "UserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	if l := len(m.GetAssignments()); l < 1 || l > 500 {
+		err := BulkAssignUserRolesRequestValidationError{
+			field:  "Assignments",
+			reason: "value must contain between 1 and 500 items, inclusive",
 		}
 		if !all {
 			return err
@@ -220,40 +285,54 @@ func (m *RevokeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetRoleId()); err != nil {
-		err = RevokeRequestValidationError{
-			field:  "RoleId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetAssignments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkAssignUserRolesRequestValidationError{
+						field:  fmt.Sprintf("Assignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkAssignUserRolesRequestValidationError{
+						field:  fmt.Sprintf("Assignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkAssignUserRolesRequestValidationError{
+					field:  fmt.Sprintf("Assignments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if len(errors) > 0 {
-		return RevokeRequestMultiError(errors)
 	}
 
-	return nil
-}
-
-func (m *RevokeRequest) _validateUuid(uuid string) error {
-	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return BulkAssignUserRolesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeRequestMultiError is an error wrapping multiple validation errors
-// returned by RevokeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type RevokeRequestMultiError []error
+// BulkAssignUserRolesRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkAssignUserRolesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BulkAssignUserRolesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeRequestMultiError) Error() string {
+func (m BulkAssignUserRolesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -262,11 +341,11 @@ func (m RevokeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeRequestMultiError) AllErrors() []error { return m }
+func (m BulkAssignUserRolesRequestMultiError) AllErrors() []error { return m }
 
-// RevokeRequestValidationError is the validation error returned by
-// RevokeRequest.Validate if the designated constraints aren't met.
-type RevokeRequestValidationError struct {
+// BulkAssignUserRolesRequestValidationError is the validation error returned
+// by BulkAssignUserRolesRequest.Validate if the designated constraints aren't met.
+type BulkAssignUserRolesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -274,22 +353,24 @@ type RevokeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeRequestValidationError) Field() string { return e.field }
+func (e BulkAssignUserRolesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeRequestValidationError) Reason() string { return e.reason }
+func (e BulkAssignUserRolesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeRequestValidationError) Cause() error { return e.cause }
+func (e BulkAssignUserRolesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeRequestValidationError) Key() bool { return e.key }
+func (e BulkAssignUserRolesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeRequestValidationError) ErrorName() string { return "RevokeRequestValidationError" }
+func (e BulkAssignUserRolesRequestValidationError) ErrorName() string {
+	return "BulkAssignUserRolesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RevokeRequestValidationError) Error() string {
+func (e BulkAssignUserRolesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -301,14 +382,14 @@ func (e RevokeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeRequest.%s: %s%s",
+		"invalid %sBulkAssignUserRolesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeRequestValidationError{}
+var _ error = BulkAssignUserRolesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -316,64 +397,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeRequestValidationError{}
+} = BulkAssignUserRolesRequestValidationError{}
 
-// Validate checks the field values on GetUserRolesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserRolesRequest) Validate() error {
+// Validate checks the field values on BulkAssignResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BulkAssignResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRolesRequest with the rules
+// ValidateAll checks the field values on BulkAssignResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserRolesRequestMultiError, or nil if none found.
-func (m *GetUserRolesRequest) ValidateAll() error {
+// BulkAssignResultMultiError, or nil if none found.
+func (m *BulkAssignResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRolesRequest) validate(all bool) error {
+func (m *BulkAssignResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetUserId()); err != nil {
-		err = GetUserRolesRequestValidationError{
-			field:  "UserId",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UserId
 
-	if len(errors) > 0 {
-		return GetUserRolesRequestMultiError(errors)
-	}
+	// no validation rules for RoleId
 
-	return nil
-}
+	// no validation rules for Status
 
-func (m *GetUserRolesRequest) _validateUuid(uuid string) error {
-	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return BulkAssignResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserRolesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserRolesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetUserRolesRequestMultiError []error
+// BulkAssignResultMultiError is an error wrapping multiple validation errors
+// returned by BulkAssignResult.ValidateAll() if the designated constraints
+// aren't met.
+type BulkAssignResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRolesRequestMultiError) Error() string {
+func (m BulkAssignResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -382,11 +449,11 @@ func (m GetUserRolesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRolesRequestMultiError) AllErrors() []error { return m }
+func (m BulkAssignResultMultiError) AllErrors() []error { return m }
 
-// GetUserRolesRequestValidationError is the validation error returned by
-// GetUserRolesRequest.Validate if the designated constraints aren't met.
-type GetUserRolesRequestValidationError struct {
+// BulkAssignResultValidationError is the validation error returned by
+// BulkAssignResult.Validate if the designated constraints aren't met.
+type BulkAssignResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -394,24 +461,22 @@ type GetUserRolesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRolesRequestValidationError) Field() string { return e.field }
+func (e BulkAssignResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRolesRequestValidationError) Reason() string { return e.reason }
+func (e BulkAssignResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRolesRequestValidationError) Cause() error { return e.cause }
+func (e BulkAssignResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRolesRequestValidationError) Key() bool { return e.key }
+func (e BulkAssignResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRolesRequestValidationError) ErrorName() string {
-	return "GetUserRolesRequestValidationError"
-}
+func (e BulkAssignResultValidationError) ErrorName() string { return "BulkAssignResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserRolesRequestValidationError) Error() string {
+func (e BulkAssignResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -423,14 +488,14 @@ func (e GetUserRolesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRolesRequest.%s: %s%s",
+		"invalid %sBulkAssignResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRolesRequestValidationError{}
+var _ error = BulkAssignResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -438,47 +503,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRolesRequestValidationError{}
+} = BulkAssignResultValidationError{}
 
-// Validate checks the field values on GetUserRolesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BulkAssignUserRolesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserRolesResponse) Validate() error {
+func (m *BulkAssignUserRolesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRolesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BulkAssignUserRolesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserRolesResponseMultiError, or nil if none found.
-func (m *GetUserRolesResponse) ValidateAll() error {
+// BulkAssignUserRolesResponseMultiError, or nil if none found.
+func (m *BulkAssignUserRolesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRolesResponse) validate(all bool) error {
+func (m *BulkAssignUserRolesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
+	for idx, item := range m.GetResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUserRolesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
+					errors = append(errors, BulkAssignUserRolesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUserRolesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
+					errors = append(errors, BulkAssignUserRolesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -486,8 +551,8 @@ func (m *GetUserRolesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUserRolesResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				return BulkAssignUserRolesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -496,20 +561,22 @@ func (m *GetUserRolesResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for AssignedCount
+
 	if len(errors) > 0 {
-		return GetUserRolesResponseMultiError(errors)
+		return BulkAssignUserRolesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserRolesResponseMultiError is an error wrapping multiple validation
-// errors returned by GetUserRolesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetUserRolesResponseMultiError []error
+// BulkAssignUserRolesResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkAssignUserRolesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BulkAssignUserRolesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRolesResponseMultiError) Error() string {
+func (m BulkAssignUserRolesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -518,11 +585,12 @@ func (m GetUserRolesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRolesResponseMultiError) AllErrors() []error { return m }
+func (m BulkAssignUserRolesResponseMultiError) AllErrors() []error { return m }
 
-// GetUserRolesResponseValidationError is the validation error returned by
-// GetUserRolesResponse.Validate if the designated constraints aren't met.
-type GetUserRolesResponseValidationError struct {
+// BulkAssignUserRolesResponseValidationError is the validation error returned
+// by BulkAssignUserRolesResponse.Validate if the designated constraints
+// aren't met.
+type BulkAssignUserRolesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -530,24 +598,24 @@ type GetUserRolesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRolesResponseValidationError) Field() string { return e.field }
+func (e BulkAssignUserRolesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRolesResponseValidationError) Reason() string { return e.reason }
+func (e BulkAssignUserRolesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRolesResponseValidationError) Cause() error { return e.cause }
+func (e BulkAssignUserRolesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRolesResponseValidationError) Key() bool { return e.key }
+func (e BulkAssignUserRolesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRolesResponseValidationError) ErrorName() string {
-	return "GetUserRolesResponseValidationError"
+func (e BulkAssignUserRolesResponseValidationError) ErrorName() string {
+	return "BulkAssignUserRolesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserRolesResponseValidationError) Error() string {
+func (e BulkAssignUserRolesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -559,14 +627,14 @@ func (e GetUserRolesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRolesResponse.%s: %s%s",
+		"invalid %sBulkAssignUserRolesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRolesResponseValidationError{}
+var _ error = BulkAssignUserRolesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -574,32 +642,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRolesResponseValidationError{}
+} = BulkAssignUserRolesResponseValidationError{}
 
-// Validate checks the field values on GetRoleUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleUsersRequest) Validate() error {
+// Validate checks the field values on RevokeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevokeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRoleUsersRequestMultiError, or nil if none found.
-func (m *GetRoleUsersRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevokeRequestMultiError, or
+// nil if none found.
+func (m *RevokeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleUsersRequest) validate(all bool) error {
+func (m *RevokeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = RevokeRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if err := m._validateUuid(m.GetRoleId()); err != nil {
-		err = GetRoleUsersRequestValidationError{
+		err = RevokeRequestValidationError{
 			field:  "RoleId",
 			reason: "value must be a valid UUID",
 			cause:  err,
@@ -610,13 +690,667 @@ func (m *GetRoleUsersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Limit != nil {
+	if len(errors) > 0 {
+		return RevokeRequestMultiError(errors)
+	}
 
-		if val := m.GetLimit(); val < 1 || val > 100 {
-			err := GetRoleUsersRequestValidationError{
-				field:  "Limit",
-				reason: "value must be inside range [1, 100]",
-			}
+	return nil
+}
+
+func (m *RevokeRequest) _validateUuid(uuid string) error {
+	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeRequestMultiError is an error wrapping multiple validation errors
+// returned by RevokeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeRequestMultiError) AllErrors() []error { return m }
+
+// RevokeRequestValidationError is the validation error returned by
+// RevokeRequest.Validate if the designated constraints aren't met.
+type RevokeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeRequestValidationError) ErrorName() string { return "RevokeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevokeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeRequestValidationError{}
+
+// Validate checks the field values on GetUserRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRolesRequestMultiError, or nil if none found.
+func (m *GetUserRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetUserId()); err != nil {
+		err = GetUserRolesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetUserRolesRequest) _validateUuid(uuid string) error {
+	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetUserRolesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserRolesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRolesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRolesRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRolesRequestValidationError is the validation error returned by
+// GetUserRolesRequest.Validate if the designated constraints aren't met.
+type GetUserRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRolesRequestValidationError) ErrorName() string {
+	return "GetUserRolesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRolesRequestValidationError{}
+
+// Validate checks the field values on GetUserRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserRolesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserRolesResponseMultiError, or nil if none found.
+func (m *GetUserRolesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRolesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserRolesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserRolesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserRolesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserRolesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRolesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserRolesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserRolesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRolesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRolesResponseMultiError) AllErrors() []error { return m }
+
+// GetUserRolesResponseValidationError is the validation error returned by
+// GetUserRolesResponse.Validate if the designated constraints aren't met.
+type GetUserRolesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRolesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRolesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRolesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRolesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRolesResponseValidationError) ErrorName() string {
+	return "GetUserRolesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserRolesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRolesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRolesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRolesResponseValidationError{}
+
+// Validate checks the field values on GetRoleUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleUsersRequestMultiError, or nil if none found.
+func (m *GetRoleUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRoleId()); err != nil {
+		err = GetRoleUsersRequestValidationError{
+			field:  "RoleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := GetRoleUsersRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return GetRoleUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRoleUsersRequest) _validateUuid(uuid string) error {
+	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRoleUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleUsersRequestMultiError) AllErrors() []error { return m }
+
+// GetRoleUsersRequestValidationError is the validation error returned by
+// GetRoleUsersRequest.Validate if the designated constraints aren't met.
+type GetRoleUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleUsersRequestValidationError) ErrorName() string {
+	return "GetRoleUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleUsersRequestValidationError{}
+
+// Validate checks the field values on GetRoleUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleUsersResponseMultiError, or nil if none found.
+func (m *GetRoleUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = GetRoleUsersResponseValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for HasMore
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return GetRoleUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRoleUsersResponse) _validateUuid(uuid string) error {
+	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRoleUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRoleUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoleUsersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoleUsersResponseMultiError) AllErrors() []error { return m }
+
+// GetRoleUsersResponseValidationError is the validation error returned by
+// GetRoleUsersResponse.Validate if the designated constraints aren't met.
+type GetRoleUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoleUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoleUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoleUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoleUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoleUsersResponseValidationError) ErrorName() string {
+	return "GetRoleUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoleUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoleUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoleUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoleUsersResponseValidationError{}
+
+// Validate checks the field values on GetRoleMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRoleMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoleMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoleMembersRequestMultiError, or nil if none found.
+func (m *GetRoleMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoleMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRoleId()); err != nil {
+		err = GetRoleMembersRequestValidationError{
+			field:  "RoleId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := GetRoleMembersRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
 			if !all {
 				return err
 			}
@@ -630,13 +1364,13 @@ func (m *GetRoleUsersRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetRoleUsersRequestMultiError(errors)
+		return GetRoleMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *GetRoleUsersRequest) _validateUuid(uuid string) error {
+func (m *GetRoleMembersRequest) _validateUuid(uuid string) error {
 	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -644,13 +1378,13 @@ func (m *GetRoleUsersRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// GetRoleUsersRequestMultiError is an error wrapping multiple validation
-// errors returned by GetRoleUsersRequest.ValidateAll() if the designated
+// GetRoleMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRoleMembersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetRoleUsersRequestMultiError []error
+type GetRoleMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleUsersRequestMultiError) Error() string {
+func (m GetRoleMembersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -659,11 +1393,11 @@ func (m GetRoleUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleUsersRequestMultiError) AllErrors() []error { return m }
+func (m GetRoleMembersRequestMultiError) AllErrors() []error { return m }
 
-// GetRoleUsersRequestValidationError is the validation error returned by
-// GetRoleUsersRequest.Validate if the designated constraints aren't met.
-type GetRoleUsersRequestValidationError struct {
+// GetRoleMembersRequestValidationError is the validation error returned by
+// GetRoleMembersRequest.Validate if the designated constraints aren't met.
+type GetRoleMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -671,24 +1405,24 @@ type GetRoleUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleUsersRequestValidationError) Field() string { return e.field }
+func (e GetRoleMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleUsersRequestValidationError) Reason() string { return e.reason }
+func (e GetRoleMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleUsersRequestValidationError) Cause() error { return e.cause }
+func (e GetRoleMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleUsersRequestValidationError) Key() bool { return e.key }
+func (e GetRoleMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleUsersRequestValidationError) ErrorName() string {
-	return "GetRoleUsersRequestValidationError"
+func (e GetRoleMembersRequestValidationError) ErrorName() string {
+	return "GetRoleMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleUsersRequestValidationError) Error() string {
+func (e GetRoleMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -700,14 +1434,14 @@ func (e GetRoleUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleUsersRequest.%s: %s%s",
+		"invalid %sGetRoleMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleUsersRequestValidationError{}
+var _ error = GetRoleMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -715,43 +1449,169 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleUsersRequestValidationError{}
+} = GetRoleMembersRequestValidationError{}
 
-// Validate checks the field values on GetRoleUsersResponse with the rules
+// Validate checks the field values on RoleMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleMemberMultiError, or
+// nil if none found.
+func (m *RoleMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if m.Login != nil {
+		// no validation rules for Login
+	}
+
+	if m.Email != nil {
+		// no validation rules for Email
+	}
+
+	if len(errors) > 0 {
+		return RoleMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleMemberMultiError is an error wrapping multiple validation errors
+// returned by RoleMember.ValidateAll() if the designated constraints aren't met.
+type RoleMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleMemberMultiError) AllErrors() []error { return m }
+
+// RoleMemberValidationError is the validation error returned by
+// RoleMember.Validate if the designated constraints aren't met.
+type RoleMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleMemberValidationError) ErrorName() string { return "RoleMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleMemberValidationError{}
+
+// Validate checks the field values on GetRoleMembersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRoleUsersResponse) Validate() error {
+func (m *GetRoleMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoleUsersResponse with the rules
+// ValidateAll checks the field values on GetRoleMembersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRoleUsersResponseMultiError, or nil if none found.
-func (m *GetRoleUsersResponse) ValidateAll() error {
+// GetRoleMembersResponseMultiError, or nil if none found.
+func (m *GetRoleMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoleUsersResponse) validate(all bool) error {
+func (m *GetRoleMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUserIds() {
+	for idx, item := range m.GetMembers() {
 		_, _ = idx, item
 
-		if err := m._validateUuid(item); err != nil {
-			err = GetRoleUsersResponseValidationError{
-				field:  fmt.Sprintf("UserIds[%v]", idx),
-				reason: "value must be a valid UUID",
-				cause:  err,
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRoleMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRoleMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRoleMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
@@ -765,27 +1625,19 @@ func (m *GetRoleUsersResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetRoleUsersResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetRoleUsersResponse) _validateUuid(uuid string) error {
-	if matched := _user_role_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return GetRoleMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoleUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRoleUsersResponse.ValidateAll() if the designated
+// GetRoleMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRoleMembersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetRoleUsersResponseMultiError []error
+type GetRoleMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoleUsersResponseMultiError) Error() string {
+func (m GetRoleMembersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -794,11 +1646,11 @@ func (m GetRoleUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoleUsersResponseMultiError) AllErrors() []error { return m }
+func (m GetRoleMembersResponseMultiError) AllErrors() []error { return m }
 
-// GetRoleUsersResponseValidationError is the validation error returned by
-// GetRoleUsersResponse.Validate if the designated constraints aren't met.
-type GetRoleUsersResponseValidationError struct {
+// GetRoleMembersResponseValidationError is the validation error returned by
+// GetRoleMembersResponse.Validate if the designated constraints aren't met.
+type GetRoleMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -806,24 +1658,24 @@ type GetRoleUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoleUsersResponseValidationError) Field() string { return e.field }
+func (e GetRoleMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoleUsersResponseValidationError) Reason() string { return e.reason }
+func (e GetRoleMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoleUsersResponseValidationError) Cause() error { return e.cause }
+func (e GetRoleMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoleUsersResponseValidationError) Key() bool { return e.key }
+func (e GetRoleMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoleUsersResponseValidationError) ErrorName() string {
-	return "GetRoleUsersResponseValidationError"
+func (e GetRoleMembersResponseValidationError) ErrorName() string {
+	return "GetRoleMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRoleUsersResponseValidationError) Error() string {
+func (e GetRoleMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -835,14 +1687,14 @@ func (e GetRoleUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoleUsersResponse.%s: %s%s",
+		"invalid %sGetRoleMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoleUsersResponseValidationError{}
+var _ error = GetRoleMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -850,4 +1702,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoleUsersResponseValidationError{}
+} = GetRoleMembersResponseValidationError{}
@@ -20,10 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserRoleService_Assign_FullMethodName       = "/user_role.v1.UserRoleService/Assign"
-	UserRoleService_Revoke_FullMethodName       = "/user_role.v1.UserRoleService/Revoke"
-	UserRoleService_GetUserRoles_FullMethodName = "/user_role.v1.UserRoleService/GetUserRoles"
-	UserRoleService_GetRoleUsers_FullMethodName = "/user_role.v1.UserRoleService/GetRoleUsers"
+	UserRoleService_Assign_FullMethodName              = "/user_role.v1.UserRoleService/Assign"
+	UserRoleService_BulkAssignUserRoles_FullMethodName = "/user_role.v1.UserRoleService/BulkAssignUserRoles"
+	UserRoleService_Revoke_FullMethodName              = "/user_role.v1.UserRoleService/Revoke"
+	UserRoleService_GetUserRoles_FullMethodName        = "/user_role.v1.UserRoleService/GetUserRoles"
+	UserRoleService_GetRoleUsers_FullMethodName        = "/user_role.v1.UserRoleService/GetRoleUsers"
+	UserRoleService_GetRoleMembers_FullMethodName      = "/user_role.v1.UserRoleService/GetRoleMembers"
 )
 
 // UserRoleServiceClient is the client API for UserRoleService service.
@@ -32,12 +34,16 @@ const (
 type UserRoleServiceClient interface {
 	// Назначение роли пользователю
 	Assign(ctx context.Context, in *AssignRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Пакетное назначение ролей пользователям в одной транзакции
+	BulkAssignUserRoles(ctx context.Context, in *BulkAssignUserRolesRequest, opts ...grpc.CallOption) (*BulkAssignUserRolesResponse, error)
 	// Отзыв роли у пользователя
 	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Получение ролей пользователя
 	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
 	// Получение пользователей роли
 	GetRoleUsers(ctx context.Context, in *GetRoleUsersRequest, opts ...grpc.CallOption) (*GetRoleUsersResponse, error)
+	// Получение участников роли с логином и email из IAM
+	GetRoleMembers(ctx context.Context, in *GetRoleMembersRequest, opts ...grpc.CallOption) (*GetRoleMembersResponse, error)
 }
 
 type userRoleServiceClient struct {
@@ -58,6 +64,16 @@ func (c *userRoleServiceClient) Assign(ctx context.Context, in *AssignRequest, o
 	return out, nil
 }
 
+func (c *userRoleServiceClient) BulkAssignUserRoles(ctx context.Context, in *BulkAssignUserRolesRequest, opts ...grpc.CallOption) (*BulkAssignUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAssignUserRolesResponse)
+	err := c.cc.Invoke(ctx, UserRoleService_BulkAssignUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userRoleServiceClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -88,18 +104,32 @@ func (c *userRoleServiceClient) GetRoleUsers(ctx context.Context, in *GetRoleUse
 	return out, nil
 }
 
+func (c *userRoleServiceClient) GetRoleMembers(ctx context.Context, in *GetRoleMembersRequest, opts ...grpc.CallOption) (*GetRoleMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRoleMembersResponse)
+	err := c.cc.Invoke(ctx, UserRoleService_GetRoleMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRoleServiceServer is the server API for UserRoleService service.
 // All implementations must embed UnimplementedUserRoleServiceServer
 // for forward compatibility.
 type UserRoleServiceServer interface {
 	// Назначение роли пользователю
 	Assign(context.Context, *AssignRequest) (*emptypb.Empty, error)
+	// Пакетное назначение ролей пользователям в одной транзакции
+	BulkAssignUserRoles(context.Context, *BulkAssignUserRolesRequest) (*BulkAssignUserRolesResponse, error)
 	// Отзыв роли у пользователя
 	Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error)
 	// Получение ролей пользователя
 	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
 	// Получение пользователей роли
 	GetRoleUsers(context.Context, *GetRoleUsersRequest) (*GetRoleUsersResponse, error)
+	// Получение участников роли с логином и email из IAM
+	GetRoleMembers(context.Context, *GetRoleMembersRequest) (*GetRoleMembersResponse, error)
 	mustEmbedUnimplementedUserRoleServiceServer()
 }
 
@@ -113,6 +143,9 @@ type UnimplementedUserRoleServiceServer struct{}
 func (UnimplementedUserRoleServiceServer) Assign(context.Context, *AssignRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Assign not implemented")
 }
+func (UnimplementedUserRoleServiceServer) BulkAssignUserRoles(context.Context, *BulkAssignUserRolesRequest) (*BulkAssignUserRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAssignUserRoles not implemented")
+}
 func (UnimplementedUserRoleServiceServer) Revoke(context.Context, *RevokeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
@@ -122,6 +155,9 @@ func (UnimplementedUserRoleServiceServer) GetUserRoles(context.Context, *GetUser
 func (UnimplementedUserRoleServiceServer) GetRoleUsers(context.Context, *GetRoleUsersRequest) (*GetRoleUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleUsers not implemented")
 }
+func (UnimplementedUserRoleServiceServer) GetRoleMembers(context.Context, *GetRoleMembersRequest) (*GetRoleMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleMembers not implemented")
+}
 func (UnimplementedUserRoleServiceServer) mustEmbedUnimplementedUserRoleServiceServer() {}
 func (UnimplementedUserRoleServiceServer) testEmbeddedByValue()                         {}
 
@@ -161,6 +197,24 @@ func _UserRoleService_Assign_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRoleService_BulkAssignUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAssignUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRoleServiceServer).BulkAssignUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRoleService_BulkAssignUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRoleServiceServer).BulkAssignUserRoles(ctx, req.(*BulkAssignUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserRoleService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeRequest)
 	if err := dec(in); err != nil {
@@ -215,6 +269,24 @@ func _UserRoleService_GetRoleUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRoleService_GetRoleMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRoleServiceServer).GetRoleMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRoleService_GetRoleMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRoleServiceServer).GetRoleMembers(ctx, req.(*GetRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserRoleService_ServiceDesc is the grpc.ServiceDesc for UserRoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +298,10 @@ var UserRoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Assign",
 			Handler:    _UserRoleService_Assign_Handler,
 		},
+		{
+			MethodName: "BulkAssignUserRoles",
+			Handler:    _UserRoleService_BulkAssignUserRoles_Handler,
+		},
 		{
 			MethodName: "Revoke",
 			Handler:    _UserRoleService_Revoke_Handler,
@@ -238,6 +314,10 @@ var UserRoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoleUsers",
 			Handler:    _UserRoleService_GetRoleUsers_Handler,
 		},
+		{
+			MethodName: "GetRoleMembers",
+			Handler:    _UserRoleService_GetRoleMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_role/v1/user_role.proto",